@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/connectors"
@@ -14,10 +16,13 @@ import (
 	"github.com/PeerDB-io/peer-flow/connectors/utils/monitoring"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
 	"github.com/PeerDB-io/peer-flow/shared"
 	"github.com/jackc/pglogrepl"
 	log "github.com/sirupsen/logrus"
 	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/proto"
 )
 
 // CheckConnectionResult is the result of a CheckConnection call.
@@ -32,9 +37,53 @@ type SlotSnapshotSignal struct {
 	connector    connectors.CDCPullConnector
 }
 
+// number of times a QRep partition is re-pulled after a transient source error, unless configured.
+const defaultMaxTransientPullRetries = 3
+
+// number of times consolidating QRep partitions is retried after a transient error, unless configured.
+const defaultMaxTransientConsolidateRetries = 3
+
+// number of times a normalize is retried after the destination connection dropped mid-merge, unless configured.
+const defaultMaxMergeDisconnectRetries = 3
+
+// raw tables without a mirror are only dropped once they have been left untouched this long.
+const defaultRawTableGracePeriod = 7 * 24 * time.Hour
+
 type FlowableActivity struct {
 	EnableMetrics        bool
 	CatalogMirrorMonitor *monitoring.CatalogMirrorMonitor
+	// MergeLimiter caps concurrent per-table merges across all mirrors on this worker, nil means no cap.
+	MergeLimiter *utils.MergeLimiter
+	// MetricsLabels are static labels, like the environment or team, attached to every emitted metric.
+	MetricsLabels map[string]string
+
+	// destinationKinds caches the destination column kinds of the tables of CDC mirrors, keyed by mirror
+	// and table, see cdcDestinationColumnKinds.
+	destinationKindsLock sync.Mutex
+	destinationKinds     map[string]*cachedColumnKinds
+}
+
+// cachedColumnKinds are the column kinds of a destination table, fetched while it had schema.
+type cachedColumnKinds struct {
+	schema *protos.TableSchema
+	kinds  map[string]qvalue.QValueKind
+}
+
+// withMetricsLabels adds the static metrics labels and the mirror's peer types to ctx,
+// to be attached to the metrics emitted with it.
+func (a *FlowableActivity) withMetricsLabels(ctx context.Context, source *protos.Peer,
+	destination *protos.Peer) context.Context {
+	labels := make(map[string]string, len(a.MetricsLabels)+2)
+	for key, value := range a.MetricsLabels {
+		labels[key] = value
+	}
+	if source != nil {
+		labels["source_peer_type"] = strings.ToLower(source.Type.String())
+	}
+	if destination != nil {
+		labels["destination_peer_type"] = strings.ToLower(destination.Type.String())
+	}
+	return context.WithValue(ctx, shared.MetricsLabelsKey, labels)
 }
 
 // CheckConnection implements CheckConnection.
@@ -70,6 +119,18 @@ func (a *FlowableActivity) SetupMetadataTables(ctx context.Context, config *prot
 	return nil
 }
 
+// EnsureSyncSetup checks that the tables the syncs of a mirror rely on exist on the destination,
+// setting them up depending on the mirror's policy.
+func (a *FlowableActivity) EnsureSyncSetup(ctx context.Context, config *protos.FlowConnectionConfigs) error {
+	dstConn, err := connectors.GetCDCSyncConnector(ctx, config.Destination)
+	if err != nil {
+		return fmt.Errorf("failed to get connector: %w", err)
+	}
+	defer connectors.CloseConnector(dstConn)
+
+	return connectors.EnsureSyncSetup(dstConn, config)
+}
+
 // GetLastSyncedID implements GetLastSyncedID.
 func (a *FlowableActivity) GetLastSyncedID(
 	ctx context.Context,
@@ -155,6 +216,32 @@ func (a *FlowableActivity) CreateNormalizedTable(
 	return conn.SetupNormalizedTables(config)
 }
 
+// quotaExceededError marks an error of the destination running out of quota as a non-retryable error of
+// type shared.QuotaExceededErrorType, so that the mirror backs off from it instead of the activity
+// retrying right away. Other errors are returned as is.
+func quotaExceededError(destination *protos.Peer, err error) error {
+	if !connectors.IsQuotaError(destination, err) {
+		return err
+	}
+	return temporal.NewNonRetryableApplicationError(err.Error(), shared.QuotaExceededErrorType, err)
+}
+
+// getAddedColumnDeltas returns the columns added to the source tables of the mirror since it last synced
+// their schema, which the change stream only sees once rows of the tables change.
+func getAddedColumnDeltas(srcConn connectors.CDCPullConnector, cfg *protos.FlowConnectionConfigs,
+	tableNameMapping map[string]string) ([]*protos.TableSchemaDelta, error) {
+	srcTableNames := make([]string, 0, len(tableNameMapping))
+	for srcTableName := range tableNameMapping {
+		srcTableNames = append(srcTableNames, srcTableName)
+	}
+	tableSchemas, err := srcConn.GetTableSchema(utils.GetTableSchemaInput(cfg, srcTableNames))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema of source tables: %w", err)
+	}
+	return utils.AddedColumnDeltas(tableNameMapping, tableSchemas.TableNameSchemaMapping,
+		cfg.TableNameSchemaMapping), nil
+}
+
 // StartFlow implements StartFlow.
 func (a *FlowableActivity) StartFlow(ctx context.Context,
 	input *protos.StartFlowInput) (*model.SyncResponse, error) {
@@ -162,6 +249,7 @@ func (a *FlowableActivity) StartFlow(ctx context.Context,
 	conn := input.FlowConnectionConfigs
 
 	ctx = context.WithValue(ctx, shared.EnableMetricsKey, a.EnableMetrics)
+	ctx = a.withMetricsLabels(ctx, conn.Source, conn.Destination)
 	ctx = context.WithValue(ctx, shared.CDCMirrorMonitorKey, a.CatalogMirrorMonitor)
 
 	srcConn, err := connectors.GetCDCPullConnector(ctx, conn.Source)
@@ -193,18 +281,39 @@ func (a *FlowableActivity) StartFlow(ctx context.Context,
 		tblNameMapping[v.SourceTableIdentifier] = v.DestinationTableIdentifier
 	}
 
+	// with deferred checkpoints, the last batch synced can be ahead of the checkpoint on the destination.
+	// pulling resumes after the former, while only the latter is confirmed to the source.
+	lastSyncState := input.LastSyncState
+	var persistedSyncState *protos.LastSyncState
+	syncedState := input.SyncFlowOptions.GetLastSyncState()
+	if syncedState != nil && syncedState.Checkpoint > lastSyncState.GetCheckpoint() {
+		lastSyncState = syncedState
+		persistedSyncState = input.LastSyncState
+		if persistedSyncState == nil {
+			persistedSyncState = &protos.LastSyncState{}
+		}
+	}
+
 	startTime := time.Now()
 	recordsWithTableSchemaDelta, err := srcConn.PullRecords(&model.PullRecordsRequest{
 		FlowJobName:                 input.FlowConnectionConfigs.FlowJobName,
 		SrcTableIDNameMapping:       input.FlowConnectionConfigs.SrcTableIdNameMapping,
 		TableNameMapping:            tblNameMapping,
-		LastSyncState:               input.LastSyncState,
+		LastSyncState:               lastSyncState,
+		PersistedSyncState:          persistedSyncState,
 		MaxBatchSize:                uint32(input.SyncFlowOptions.BatchSize),
 		IdleTimeout:                 10 * time.Second,
+		MaxPullDuration:             time.Duration(input.FlowConnectionConfigs.MaxPullDurationSeconds) * time.Second,
 		TableNameSchemaMapping:      input.FlowConnectionConfigs.TableNameSchemaMapping,
 		OverridePublicationName:     input.FlowConnectionConfigs.PublicationName,
 		OverrideReplicationSlotName: input.FlowConnectionConfigs.ReplicationSlotName,
 		RelationMessageMapping:      input.RelationMessageMapping,
+		StartLSN:                    input.FlowConnectionConfigs.StartLsn,
+		JSONAsText:                  input.FlowConnectionConfigs.JsonAsText,
+		RangesAsText:                input.FlowConnectionConfigs.RangesAsText,
+		DisabledTables:              utils.DisabledSourceTables(input.FlowConnectionConfigs.TableMappings),
+		CountEmptyTransactions:      input.FlowConnectionConfigs.CountEmptyTransactions,
+		FlushOnSchemaChange:         input.FlowConnectionConfigs.FlushOnSchemaChange,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to pull records: %w", err)
@@ -227,6 +336,9 @@ func (a *FlowableActivity) StartFlow(ctx context.Context,
 				BatchStartLSN: pglogrepl.LSN(recordBatch.FirstCheckPointID),
 				BatchEndlSN:   pglogrepl.LSN(recordBatch.LastCheckPointID),
 				StartTime:     startTime,
+				// transactions without changes to mirrored tables only count if the mirror asks for it
+				TransactionsInBatch: recordBatch.Transactions,
+				LastCommitTime:      recordBatch.LastCommitTime,
 			})
 		if err != nil {
 			return nil, err
@@ -237,22 +349,81 @@ func (a *FlowableActivity) StartFlow(ctx context.Context,
 	numRecords := len(recordBatch.Records)
 	log.WithFields(log.Fields{
 		"flowName": input.FlowConnectionConfigs.FlowJobName,
-	}).Infof("pulled %d records in %d seconds\n", numRecords, int(pullDuration.Seconds()))
+	}).Infof("pulled %d records from %d transactions in %d seconds", numRecords, recordBatch.Transactions,
+		int(pullDuration.Seconds()))
+	if !recordBatch.LastCommitTime.IsZero() {
+		log.WithFields(log.Fields{
+			"flowName": input.FlowConnectionConfigs.FlowJobName,
+		}).Infof("last pulled transaction committed at %v, %v ago", recordBatch.LastCommitTime,
+			time.Since(recordBatch.LastCommitTime).Round(time.Second))
+	}
 	activity.RecordHeartbeat(ctx, fmt.Sprintf("pulled %d records", numRecords))
 
 	if numRecords == 0 {
 		log.WithFields(log.Fields{
 			"flowName": input.FlowConnectionConfigs.FlowJobName,
 		}).Info("no records to push")
+		// a pull can carry schema changes without any rows, these are still replayed on the destination.
+		tableSchemaDeltas := recordsWithTableSchemaDelta.TableSchemaDeltas
+		if input.FlowConnectionConfigs.DetectIdleSchemaChanges {
+			idleSchemaDeltas, err := getAddedColumnDeltas(srcConn, input.FlowConnectionConfigs, tblNameMapping)
+			if err != nil {
+				return nil, err
+			}
+			tableSchemaDeltas = append(tableSchemaDeltas, idleSchemaDeltas...)
+		}
+		tableSchemaDeltas = utils.UnsyncedSchemaDeltas(tableSchemaDeltas,
+			input.FlowConnectionConfigs.TableNameSchemaMapping)
+		if len(tableSchemaDeltas) > 0 {
+			log.WithFields(log.Fields{
+				"flowName": input.FlowConnectionConfigs.FlowJobName,
+			}).Infof("passing on %d schema changes without records", len(tableSchemaDeltas))
+		}
 		metrics.LogSyncMetrics(ctx, input.FlowConnectionConfigs.FlowJobName, 0, 1)
 		metrics.LogNormalizeMetrics(ctx, input.FlowConnectionConfigs.FlowJobName, 0, 1, 0)
 		metrics.LogCDCRawThroughputMetrics(ctx, input.FlowConnectionConfigs.FlowJobName, 0)
 		return &model.SyncResponse{
 			RelationMessageMapping: recordsWithTableSchemaDelta.RelationMessageMapping,
-			TableSchemaDeltas:      recordsWithTableSchemaDelta.TableSchemaDeltas,
+			TableSchemaDeltas:      tableSchemaDeltas,
 		}, nil
 	}
 
+	// before compacting, so that changes to keys only differing in their normalization are compacted
+	if input.FlowConnectionConfigs.NfcPrimaryKeys {
+		recordBatch.NFCPrimaryKeys(input.FlowConnectionConfigs.TableNameSchemaMapping)
+	}
+	if input.FlowConnectionConfigs.CompactBatches {
+		recordBatch.CompactRecords(input.FlowConnectionConfigs.TableNameSchemaMapping)
+		log.WithFields(log.Fields{
+			"flowName": input.FlowConnectionConfigs.FlowJobName,
+		}).Infof("compacted %d records to %d records", numRecords, len(recordBatch.Records))
+	}
+	// by default the destination fails the sync on values out of range itself, no need to fetch its kinds
+	if input.FlowConnectionConfigs.IntRangePolicy != protos.IntRangePolicy_INT_RANGE_POLICY_ERROR {
+		destinationKinds, err := a.cdcDestinationColumnKinds(dstConn, input.FlowConnectionConfigs,
+			recordBatch.DestinationTableNames())
+		if err != nil {
+			return nil, err
+		}
+		err = recordBatch.FitIntRanges(destinationKinds, input.FlowConnectionConfigs.IntRangePolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(input.FlowConnectionConfigs.RecordTransformers) > 0 {
+		transformers, err := model.GetRecordTransformers(input.FlowConnectionConfigs.RecordTransformers)
+		if err != nil {
+			return nil, err
+		}
+		err = recordBatch.TransformRecords(transformers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// compacting and transforming can leave fewer records to push than were pulled
+	numPushedRecords := len(recordBatch.Records)
+
 	shutdown := utils.HeartbeatRoutine(ctx, 10*time.Second, func() string {
 		jobName := input.FlowConnectionConfigs.FlowJobName
 		return fmt.Sprintf("pushing records for job - %s", jobName)
@@ -264,22 +435,40 @@ func (a *FlowableActivity) StartFlow(ctx context.Context,
 
 	syncStartTime := time.Now()
 	res, err := dstConn.SyncRecords(&model.SyncRecordsRequest{
-		Records:         recordBatch,
-		FlowJobName:     input.FlowConnectionConfigs.FlowJobName,
-		SyncMode:        input.FlowConnectionConfigs.CdcSyncMode,
-		StagingPath:     input.FlowConnectionConfigs.CdcStagingPath,
-		PushBatchSize:   input.FlowConnectionConfigs.PushBatchSize,
-		PushParallelism: input.FlowConnectionConfigs.PushParallelism,
+		Records:                 recordBatch,
+		FlowJobName:             input.FlowConnectionConfigs.FlowJobName,
+		SyncMode:                input.FlowConnectionConfigs.CdcSyncMode,
+		StagingPath:             input.FlowConnectionConfigs.CdcStagingPath,
+		PushBatchSize:           input.FlowConnectionConfigs.PushBatchSize,
+		PushParallelism:         input.FlowConnectionConfigs.PushParallelism,
+		NullFloatSpecialValues:  input.FlowConnectionConfigs.NullFloatSpecialValues,
+		EmptyStringsAsNull:      input.FlowConnectionConfigs.EmptyStringsAsNull,
+		RawDataAsVariant:        input.FlowConnectionConfigs.RawDataAsVariant,
+		DeferCheckpoint:         input.SyncFlowOptions.GetDeferCheckpoint(),
+		MaxInlineRecordSize:     input.FlowConnectionConfigs.MaxInlineRecordSizeBytes,
+		SourceLSNColumn:         input.FlowConnectionConfigs.SourceLsnColumn,
+		IdempotentStagedInserts: input.FlowConnectionConfigs.IdempotentStagedRawInserts,
+		ParallelRawInserts:      input.FlowConnectionConfigs.MaxParallelRawInserts,
+		GuardSyncBatchID: input.FlowConnectionConfigs.SyncBatchIdConflictPolicy ==
+			protos.SyncBatchIDConflictPolicy_SYNC_BATCH_ID_CONFLICT_POLICY_FAIL,
 	})
+	if errors.Is(err, model.ErrSyncBatchIDConflict) {
+		log.WithFields(log.Fields{
+			"flowName": input.FlowConnectionConfigs.FlowJobName,
+		}).Warnf("another sync of the mirror recorded the batch first, is the mirror running on two workers? %v",
+			err)
+		return nil, fmt.Errorf("failed to push records: %w", err)
+	}
 	if err != nil {
 		log.Warnf("failed to push records: %v", err)
-		return nil, fmt.Errorf("failed to push records: %w", err)
+		return nil, quotaExceededError(conn.Destination, fmt.Errorf("failed to push records: %w", err))
 	}
 
 	syncDuration := time.Since(syncStartTime)
 	log.WithFields(log.Fields{
 		"flowName": input.FlowConnectionConfigs.FlowJobName,
-	}).Infof("pushed %d records in %d seconds\n", numRecords, int(syncDuration.Seconds()))
+	}).Infof("pushed %d of %d pulled records in %d seconds\n", numPushedRecords, numRecords,
+		int(syncDuration.Seconds()))
 
 	err = a.CatalogMirrorMonitor.
 		UpdateLatestLSNAtTargetForCDCFlow(ctx, input.FlowConnectionConfigs.FlowJobName,
@@ -297,14 +486,15 @@ func (a *FlowableActivity) StartFlow(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	res.TableSchemaDeltas = recordsWithTableSchemaDelta.TableSchemaDeltas
+	res.TableSchemaDeltas = utils.UnsyncedSchemaDeltas(recordsWithTableSchemaDelta.TableSchemaDeltas,
+		input.FlowConnectionConfigs.TableNameSchemaMapping)
 	res.RelationMessageMapping = recordsWithTableSchemaDelta.RelationMessageMapping
 
-	pushedRecordsWithCount := fmt.Sprintf("pushed %d records", numRecords)
+	pushedRecordsWithCount := fmt.Sprintf("pushed %d records", numPushedRecords)
 	activity.RecordHeartbeat(ctx, pushedRecordsWithCount)
 
 	metrics.LogCDCRawThroughputMetrics(ctx, input.FlowConnectionConfigs.FlowJobName,
-		float64(numRecords)/(pullDuration.Seconds()+syncDuration.Seconds()))
+		float64(numPushedRecords)/(pullDuration.Seconds()+syncDuration.Seconds()))
 
 	return res, nil
 }
@@ -316,6 +506,7 @@ func (a *FlowableActivity) StartNormalize(
 	conn := input.FlowConnectionConfigs
 
 	ctx = context.WithValue(ctx, shared.EnableMetricsKey, a.EnableMetrics)
+	ctx = a.withMetricsLabels(ctx, conn.Source, conn.Destination)
 	dstConn, err := connectors.GetCDCNormalizeConnector(ctx, conn.Destination)
 	if errors.Is(err, connectors.ErrUnsupportedFunctionality) {
 		dstConn, err := connectors.GetCDCSyncConnector(ctx, conn.Destination)
@@ -344,18 +535,35 @@ func (a *FlowableActivity) StartNormalize(
 		shutdown <- true
 	}()
 
+	// the backlog is only counted for the metric, skip the count query if metrics are off
+	if backlogConn, ok := dstConn.(connectors.NormalizeBacklogConnector); ok && a.EnableMetrics {
+		backlog, err := backlogConn.GetNormalizeBacklog(input.FlowConnectionConfigs.FlowJobName)
+		if err != nil {
+			log.Warnf("failed to get normalize backlog: %v", err)
+		} else {
+			metrics.LogNormalizeBacklogMetrics(ctx, input.FlowConnectionConfigs.FlowJobName, backlog)
+		}
+	}
+
 	log.Info("initializing table schema...")
 	err = dstConn.InitializeTableSchema(input.FlowConnectionConfigs.TableNameSchemaMapping)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize table schema: %w", err)
 	}
 
-	res, err := dstConn.NormalizeRecords(&model.NormalizeRecordsRequest{
-		FlowJobName: input.FlowConnectionConfigs.FlowJobName,
-		SoftDelete:  input.FlowConnectionConfigs.SoftDelete,
-	})
+	// a rebuild of one of the tables holds the lock until it is done, the batches are normalized after it
+	unlock, locked, err := a.CatalogMirrorMonitor.TryLockNormalize(ctx, input.FlowConnectionConfigs.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, fmt.Errorf("a normalized table of %s is being rebuilt", input.FlowConnectionConfigs.FlowJobName)
+	}
+	defer unlock()
+
+	res, err := dstConn.NormalizeRecords(a.normalizeRecordsRequest(input.FlowConnectionConfigs))
 	if err != nil {
-		return nil, fmt.Errorf("failed to normalized records: %w", err)
+		return nil, quotaExceededError(conn.Destination, fmt.Errorf("failed to normalized records: %w", err))
 	}
 
 	// normalize flow did not run due to no records, no need to update end time.
@@ -375,6 +583,144 @@ func (a *FlowableActivity) StartNormalize(
 	return res, nil
 }
 
+// normalizeRecordsRequest returns the request normalizing the raw records of a CDC mirror with its options.
+func (a *FlowableActivity) normalizeRecordsRequest(
+	config *protos.FlowConnectionConfigs) *model.NormalizeRecordsRequest {
+	maxDisconnectRetries := uint64(config.MaxMergeDisconnectRetries)
+	if maxDisconnectRetries == 0 {
+		maxDisconnectRetries = defaultMaxMergeDisconnectRetries
+	}
+	// a nil *MergeLimiter in the interface wouldn't compare equal to nil, so leave it unset instead
+	var mergeLimiter model.ConcurrencyLimiter
+	if a.MergeLimiter != nil {
+		mergeLimiter = a.MergeLimiter
+	}
+	return &model.NormalizeRecordsRequest{
+		FlowJobName:          config.FlowJobName,
+		SoftDelete:           config.SoftDelete,
+		MergeLimiter:         mergeLimiter,
+		RawDataAsVariant:     config.RawDataAsVariant,
+		DynamicTables:        config.DynamicNormalizedTables,
+		RawOnlyTables:        utils.RawOnlyTables(config.TableMappings),
+		SourceLSNColumn:      config.SourceLsnColumn,
+		DedupBySourceLSN:     config.DedupBySourceLsn,
+		Force:                config.ForceNormalize,
+		ColumnDefaults:       config.DestinationColumnDefaults,
+		MaxDisconnectRetries: maxDisconnectRetries,
+		DedupNullsOrdering:   config.DedupNullsOrdering,
+		VariantNullPolicy:    config.VariantNullPolicy,
+		MaxRecordsPerMerge:   config.MaxRecordsPerMerge,
+		TimeoutSplitPolicy:   config.MergeTimeoutSplitPolicy,
+		KeyVersionPolicy:     config.PrimaryKeyVersionPolicy,
+		LastOpColumn:         config.LastOpColumn,
+		RecordTypePolicy:     config.UnknownRecordTypePolicy,
+		PreNormalizeSQL:      config.PreNormalizeSql,
+		PostNormalizeSQL:     config.PostNormalizeSql,
+	}
+}
+
+// RebuildNormalizedTable truncates a normalized table of a CDC mirror and re-merges it from the raw table,
+// holding the normalize lock of the mirror so that its normalizes wait until the table is rebuilt.
+func (a *FlowableActivity) RebuildNormalizedTable(
+	ctx context.Context,
+	input *protos.RebuildNormalizedTableInput,
+) (*protos.RebuildNormalizedTableOutput, error) {
+	config := input.FlowConnectionConfigs
+	dstConn, err := connectors.GetNormalizedTableRebuildConnector(ctx, config.Destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connector: %w", err)
+	}
+	defer connectors.CloseConnector(dstConn)
+
+	shutdown := utils.HeartbeatRoutine(ctx, 2*time.Minute, func() string {
+		return fmt.Sprintf("rebuilding normalized table %s for job - %s",
+			input.DestinationTableIdentifier, config.FlowJobName)
+	})
+	defer func() {
+		shutdown <- true
+	}()
+
+	unlock, err := a.CatalogMirrorMonitor.LockNormalize(ctx, config.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	err = dstConn.InitializeTableSchema(config.TableNameSchemaMapping)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize table schema: %w", err)
+	}
+
+	res, err := dstConn.RebuildNormalizedTable(&model.RebuildNormalizedTableRequest{
+		Normalize:                  a.normalizeRecordsRequest(config),
+		DestinationTableIdentifier: input.DestinationTableIdentifier,
+		BatchesPerMerge:            int64(input.BatchesPerMerge),
+		InitialCopy:                config.DoInitialCopy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild normalized table: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"flowName": config.FlowJobName,
+	}).Infof("rebuilt normalized table %s up to batch %d, %d rows affected",
+		input.DestinationTableIdentifier, res.LastBatchId, res.RowsAffected)
+	return res, nil
+}
+
+// DropStaleRawTables drops raw tables left behind by mirrors that were dropped but whose cleanup failed,
+// which are raw tables of no mirror in the catalog. Raw tables changed within the grace period are kept.
+func (a *FlowableActivity) DropStaleRawTables(
+	ctx context.Context,
+	input *protos.DropStaleRawTablesInput,
+) (*protos.DropStaleRawTablesOutput, error) {
+	dstConn, err := connectors.GetRawTableJanitorConnector(ctx, input.PeerConnectionConfig)
+	if errors.Is(err, connectors.ErrUnsupportedFunctionality) {
+		return &protos.DropStaleRawTablesOutput{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connector: %w", err)
+	}
+	defer connectors.CloseConnector(dstConn)
+
+	// without the catalog there's no telling which mirrors still exist, so nothing is dropped
+	if !a.CatalogMirrorMonitor.IsActive() {
+		return nil, fmt.Errorf("the catalog is needed to find stale raw tables")
+	}
+	flowJobNames, err := a.CatalogMirrorMonitor.GetFlowJobNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mirrors from catalog: %w", err)
+	}
+
+	rawTables, err := dstConn.ListRawTables(flowJobNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list raw tables: %w", err)
+	}
+
+	gracePeriod := defaultRawTableGracePeriod
+	if input.GracePeriodHours > 0 {
+		gracePeriod = time.Duration(input.GracePeriodHours) * time.Hour
+	}
+
+	res := &protos.DropStaleRawTablesOutput{}
+	for _, rawTable := range utils.StaleRawTables(rawTables, gracePeriod, time.Now()) {
+		res.StaleRawTables = append(res.StaleRawTables, rawTable.TableIdentifier)
+		if input.DryRun {
+			log.Infof("found stale raw table %s, last altered at %v", rawTable.TableIdentifier, rawTable.LastAltered)
+			continue
+		}
+
+		err = dstConn.DropRawTable(rawTable.TableIdentifier)
+		if err != nil {
+			return nil, fmt.Errorf("failed to drop stale raw table: %w", err)
+		}
+		log.Infof("dropped stale raw table %s, last altered at %v", rawTable.TableIdentifier, rawTable.LastAltered)
+		utils.RecordHeartbeatWithRecover(ctx, fmt.Sprintf("dropped stale raw table %s", rawTable.TableIdentifier))
+	}
+
+	return res, nil
+}
+
 func (a *FlowableActivity) ReplayTableSchemaDeltas(
 	ctx context.Context,
 	input *protos.ReplayTableSchemaDeltaInput,
@@ -387,7 +733,38 @@ func (a *FlowableActivity) ReplayTableSchemaDeltas(
 	}
 	defer connectors.CloseConnector(dest)
 
-	return dest.ReplayTableSchemaDeltas(input.FlowConnectionConfigs.FlowJobName, input.TableSchemaDeltas)
+	// dynamic tables cannot be altered, they keep the columns they were created with.
+	if input.FlowConnectionConfigs.DynamicNormalizedTables {
+		if len(input.TableSchemaDeltas) > 0 {
+			log.WithFields(log.Fields{
+				"flowName": input.FlowConnectionConfigs.FlowJobName,
+			}).Warnf("not replaying %d schema changes on dynamic tables", len(input.TableSchemaDeltas))
+		}
+		return nil
+	}
+
+	// raw-only tables have no destination table to alter.
+	rawOnlyTables := utils.RawOnlyTables(input.FlowConnectionConfigs.TableMappings)
+	tableSchemaDeltas := make([]*protos.TableSchemaDelta, 0, len(input.TableSchemaDeltas))
+	for _, tableSchemaDelta := range input.TableSchemaDeltas {
+		if !rawOnlyTables[tableSchemaDelta.DstTableName] {
+			tableSchemaDeltas = append(tableSchemaDeltas, tableSchemaDelta)
+		}
+	}
+
+	err = dest.ReplayTableSchemaDeltas(input.FlowConnectionConfigs.FlowJobName, tableSchemaDeltas)
+	if err != nil {
+		return err
+	}
+
+	if len(tableSchemaDeltas) > 0 {
+		err = a.CatalogMirrorMonitor.AddSchemaDeltasForFlow(ctx, input.FlowConnectionConfigs.FlowJobName,
+			tableSchemaDeltas)
+		if err != nil {
+			return fmt.Errorf("failed to record schema deltas: %w", err)
+		}
+	}
+	return nil
 }
 
 // SetupQRepMetadataTables sets up the metadata tables for QReplication.
@@ -442,6 +819,44 @@ func (a *FlowableActivity) GetQRepPartitions(ctx context.Context,
 	}, nil
 }
 
+// SelectQRepSyncMode picks the sync mode for a qrep mirror with sync mode auto,
+// going by the size of rows sampled from its first partition, pulling only the sampled rows.
+func (a *FlowableActivity) SelectQRepSyncMode(ctx context.Context,
+	config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+) (protos.QRepSyncMode, error) {
+	// these destinations only support one of the sync modes
+	switch config.DestinationPeer.Type {
+	case protos.DBType_SNOWFLAKE:
+		return protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO, nil
+	case protos.DBType_POSTGRES:
+		return protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT, nil
+	}
+
+	srcConn, err := connectors.GetQRepPullConnector(ctx, config.SourcePeer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get qrep pull connector: %w", err)
+	}
+	defer connectors.CloseConnector(srcConn)
+
+	shutdown := utils.HeartbeatRoutine(ctx, 2*time.Minute, func() string {
+		return fmt.Sprintf("sampling rows to select sync mode for job - %s", config.FlowJobName)
+	})
+	defer func() {
+		shutdown <- true
+	}()
+
+	recordBatch, err := srcConn.SampleQRepRecords(config, partition, utils.AutoSyncModeSampleRows(config))
+	if err != nil {
+		return 0, fmt.Errorf("failed to pull records to sample: %w", err)
+	}
+	syncMode, averageRowSize := utils.SelectQRepSyncMode(config, recordBatch.Records)
+	log.WithFields(log.Fields{
+		"flowName": config.FlowJobName,
+	}).Infof("selected sync mode %s for average row size of %d bytes", syncMode, averageRowSize)
+	return syncMode, nil
+}
+
 // ReplicateQRepPartition replicates a QRepPartition from the source to the destination.
 func (a *FlowableActivity) ReplicateQRepPartitions(ctx context.Context,
 	config *protos.QRepConfig,
@@ -481,31 +896,92 @@ func (a *FlowableActivity) replicateQRepPartition(ctx context.Context,
 	}
 
 	ctx = context.WithValue(ctx, shared.EnableMetricsKey, a.EnableMetrics)
-	srcConn, err := connectors.GetQRepPullConnector(ctx, config.SourcePeer)
+	ctx = a.withMetricsLabels(ctx, config.SourcePeer, config.DestinationPeer)
+	// the destination connector slot is taken before the source one by every partition, so partitions
+	// waiting on each other's connectors can't deadlock.
+	dstLimiter := utils.GetConnectionLimiter(config.DestinationPeer.Name, "destination",
+		config.MaxDestinationConnections)
+	err = dstLimiter.Acquire(ctx, 1*time.Minute, func() string {
+		return fmt.Sprintf("destination connector for partition %s of flow %s",
+			partition.PartitionId, config.FlowJobName)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get qrep source connector: %w", err)
+		return err
 	}
-	defer connectors.CloseConnector(srcConn)
-
+	defer dstLimiter.Release()
 	dstConn, err := connectors.GetQRepSyncConnector(ctx, config.DestinationPeer)
 	if err != nil {
 		return fmt.Errorf("failed to get qrep destination connector: %w", err)
 	}
 	defer connectors.CloseConnector(dstConn)
+	destinationKinds, err := getDestinationColumnKinds(dstConn, []string{config.DestinationTableIdentifier})
+	if err != nil {
+		return err
+	}
 
 	log.Infof("replicating partition %s\n", partition.PartitionId)
 
+	maxPullRetries := uint64(config.MaxTransientPullRetries)
+	if maxPullRetries == 0 {
+		maxPullRetries = defaultMaxTransientPullRetries
+	}
+	// transient failures while pulling are retried here instead of failing the activity,
+	// since a Temporal retry would also throw away the destination connection.
+	err = utils.RetryTransientPullErrors(ctx, maxPullRetries, func() error {
+		return a.pullAndSyncQRepPartition(ctx, config, dstConn,
+			destinationKinds[config.DestinationTableIdentifier], idx, total, partition, runUUID)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = a.CatalogMirrorMonitor.UpdateEndTimeForPartition(ctx, runUUID, partition)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// pullAndSyncQRepPartition makes a single attempt at pulling a partition from the source and
+// syncing it to the destination, errors from the pull side are returned as *utils.PullError.
+func (a *FlowableActivity) pullAndSyncQRepPartition(ctx context.Context,
+	config *protos.QRepConfig,
+	dstConn connectors.QRepSyncConnector,
+	destinationKinds map[string]qvalue.QValueKind,
+	idx int,
+	total int,
+	partition *protos.QRepPartition,
+	runUUID string,
+) error {
+	pullCtx, cancelPull := context.WithCancel(ctx)
+	defer cancelPull()
+
+	srcLimiter := utils.GetConnectionLimiter(config.SourcePeer.Name, "source", config.MaxSourceConnections)
+	err := srcLimiter.Acquire(ctx, 1*time.Minute, func() string {
+		return fmt.Sprintf("source connector for partition %s of flow %s", partition.PartitionId, config.FlowJobName)
+	})
+	if err != nil {
+		return err
+	}
+	defer srcLimiter.Release()
+	srcConn, err := connectors.GetQRepPullConnector(pullCtx, config.SourcePeer)
+	if err != nil {
+		return &utils.PullError{Err: fmt.Errorf("failed to get qrep source connector: %w", err)}
+	}
+	defer connectors.CloseConnector(srcConn)
+
 	var stream *model.QRecordStream
 	bufferSize := shared.FetchAndChannelSize
-	var wg sync.WaitGroup
+	pullDone := make(chan struct{})
 	var numRecords int64
 
 	var goroutineErr error = nil
 	if config.SourcePeer.Type == protos.DBType_POSTGRES {
 		stream = model.NewQRecordStream(bufferSize)
-		wg.Add(1)
 
 		pullPgRecords := func() {
+			defer close(pullDone)
 			pgConn := srcConn.(*connpostgres.PostgresConnector)
 			tmp, err := pgConn.PullQRepRecordStream(config, partition, stream)
 			numRecords = int64(tmp)
@@ -513,21 +989,22 @@ func (a *FlowableActivity) replicateQRepPartition(ctx context.Context,
 				log.WithFields(log.Fields{
 					"flowName": config.FlowJobName,
 				}).Errorf("failed to pull records: %v", err)
-				goroutineErr = err
+				goroutineErr = &utils.PullError{Err: err}
+				return
 			}
 			err = a.CatalogMirrorMonitor.UpdatePullEndTimeAndRowsForPartition(ctx, runUUID, partition, numRecords)
 			if err != nil {
 				log.Errorf("%v", err)
 				goroutineErr = err
 			}
-			wg.Done()
 		}
 
 		go pullPgRecords()
 	} else {
+		close(pullDone)
 		recordBatch, err := srcConn.PullQRepRecords(config, partition)
 		if err != nil {
-			return fmt.Errorf("failed to pull records: %w", err)
+			return &utils.PullError{Err: err}
 		}
 		numRecords = int64(recordBatch.NumRecords)
 		log.WithFields(log.Fields{
@@ -545,44 +1022,63 @@ func (a *FlowableActivity) replicateQRepPartition(ctx context.Context,
 		}
 	}
 
+	var rowsSynced atomic.Int64
+	rowsEstimate := utils.EstimatePartitionRows(config, partition)
 	shutdown := utils.HeartbeatRoutine(ctx, 5*time.Minute, func() string {
-		return fmt.Sprintf("syncing partition - %s: %d of %d total.", partition.PartitionId, idx, total)
+		return utils.QRepPartitionProgress(partition.PartitionId, idx, total, rowsSynced.Load(), rowsEstimate)
 	})
 
 	defer func() {
 		shutdown <- true
 	}()
 
+	stream = model.FitIntRanges(pullCtx, stream, destinationKinds, config.IntRangePolicy)
+	stream = model.NFCColumns(pullCtx, stream, config.NfcColumns)
+	stream = model.CountRecords(pullCtx, stream, &rowsSynced)
 	res, err := dstConn.SyncQRepRecords(config, partition, stream)
 	if err != nil {
+		// a failed pull also fails the sync, stop the pull and drain the stream so that
+		// the pull error, which is the actual cause, can be returned instead.
+		cancelPull()
+		records := stream.Records
+		for pulling := true; pulling; {
+			select {
+			case <-pullDone:
+				pulling = false
+			case _, ok := <-records:
+				if !ok {
+					records = nil
+				}
+			}
+		}
+		if goroutineErr != nil {
+			return goroutineErr
+		}
 		return fmt.Errorf("failed to sync records: %w", err)
 	}
 
+	<-pullDone
+	if goroutineErr != nil {
+		return goroutineErr
+	}
+
 	if res == 0 {
 		log.WithFields(log.Fields{
 			"flowName": config.FlowJobName,
 		}).Infof("no records to push for partition %s\n", partition.PartitionId)
 	} else {
-		wg.Wait()
-		if goroutineErr != nil {
-			return goroutineErr
-		}
 		log.WithFields(log.Fields{
 			"flowName": config.FlowJobName,
 		}).Infof("pushed %d records\n", res)
 	}
 
-	err = a.CatalogMirrorMonitor.UpdateEndTimeForPartition(ctx, runUUID, partition)
-	if err != nil {
-		return err
-	}
-
 	return nil
 }
 
 func (a *FlowableActivity) ConsolidateQRepPartitions(ctx context.Context, config *protos.QRepConfig,
 	runUUID string) error {
 	ctx = context.WithValue(ctx, shared.EnableMetricsKey, a.EnableMetrics)
+	ctx = a.withMetricsLabels(ctx, config.SourcePeer, config.DestinationPeer)
 	dstConn, err := connectors.GetQRepConsolidateConnector(ctx, config.DestinationPeer)
 	if errors.Is(err, connectors.ErrUnsupportedFunctionality) {
 		return a.CatalogMirrorMonitor.UpdateEndTimeForQRepRun(ctx, runUUID)
@@ -598,7 +1094,15 @@ func (a *FlowableActivity) ConsolidateQRepPartitions(ctx context.Context, config
 		shutdown <- true
 	}()
 
-	err = dstConn.ConsolidateQRepPartitions(config)
+	maxRetries := uint64(config.MaxTransientConsolidateRetries)
+	if maxRetries == 0 {
+		maxRetries = defaultMaxTransientConsolidateRetries
+	}
+	// consolidation can be run again, so transient failures are retried here instead of
+	// failing the activity and starting over with a new connection.
+	err = utils.RetryTransientErrors(ctx, maxRetries, func() error {
+		return dstConn.ConsolidateQRepPartitions(config)
+	})
 	if err != nil {
 		return err
 	}
@@ -638,6 +1142,28 @@ func (a *FlowableActivity) DropFlow(ctx context.Context, config *protos.Shutdown
 	if err != nil {
 		return fmt.Errorf("failed to cleanup destination: %w", err)
 	}
+	err = a.CatalogMirrorMonitor.ClearSnapshotCompletion(ctx, config.FlowJobName)
+	if err != nil {
+		return fmt.Errorf("failed to clear snapshot completion: %w", err)
+	}
+	return nil
+}
+
+// IsSnapshotCompleted checks if the initial copy of a mirror already completed.
+func (a *FlowableActivity) IsSnapshotCompleted(ctx context.Context, flowJobName string) (bool, error) {
+	completed, err := a.CatalogMirrorMonitor.IsSnapshotCompleted(ctx, flowJobName)
+	if err != nil {
+		return false, fmt.Errorf("failed to check snapshot completion: %w", err)
+	}
+	return completed, nil
+}
+
+// MarkSnapshotCompleted records that the initial copy of a mirror completed.
+func (a *FlowableActivity) MarkSnapshotCompleted(ctx context.Context, flowJobName string) error {
+	err := a.CatalogMirrorMonitor.MarkSnapshotCompleted(ctx, flowJobName)
+	if err != nil {
+		return fmt.Errorf("failed to mark snapshot completion: %w", err)
+	}
 	return nil
 }
 
@@ -691,3 +1217,64 @@ func (a *FlowableActivity) QRepWaitUntilNewRows(ctx context.Context,
 
 	return nil
 }
+
+// cdcDestinationColumnKinds returns the kinds of the columns of the given destination tables of a CDC mirror,
+// like getDestinationColumnKinds. They only change along with the schema of their table, so they are fetched
+// once for each schema instead of for every batch.
+func (a *FlowableActivity) cdcDestinationColumnKinds(
+	dstConn connectors.Connector,
+	config *protos.FlowConnectionConfigs,
+	tableIdentifiers []string,
+) (map[string]map[string]qvalue.QValueKind, error) {
+	kinds := make(map[string]map[string]qvalue.QValueKind, len(tableIdentifiers))
+	var uncachedTables []string
+	a.destinationKindsLock.Lock()
+	for _, table := range tableIdentifiers {
+		cached, ok := a.destinationKinds[config.FlowJobName+"/"+table]
+		if ok && proto.Equal(cached.schema, config.TableNameSchemaMapping[table]) {
+			kinds[table] = cached.kinds
+		} else {
+			uncachedTables = append(uncachedTables, table)
+		}
+	}
+	a.destinationKindsLock.Unlock()
+	if len(uncachedTables) == 0 {
+		return kinds, nil
+	}
+
+	fetchedKinds, err := getDestinationColumnKinds(dstConn, uncachedTables)
+	if err != nil {
+		return nil, err
+	}
+	a.destinationKindsLock.Lock()
+	defer a.destinationKindsLock.Unlock()
+	if a.destinationKinds == nil {
+		a.destinationKinds = make(map[string]*cachedColumnKinds)
+	}
+	for _, table := range uncachedTables {
+		kinds[table] = fetchedKinds[table]
+		a.destinationKinds[config.FlowJobName+"/"+table] = &cachedColumnKinds{
+			schema: proto.Clone(config.TableNameSchemaMapping[table]).(*protos.TableSchema),
+			kinds:  fetchedKinds[table],
+		}
+	}
+	return kinds, nil
+}
+
+// getDestinationColumnKinds returns the kinds of the columns of the given destination tables, keyed by
+// table and column name, for destinations with integer types narrower than the source's. Values synced to
+// other destinations can't be out of range for their column, so nil is returned for them.
+func getDestinationColumnKinds(
+	dstConn connectors.Connector,
+	tableIdentifiers []string,
+) (map[string]map[string]qvalue.QValueKind, error) {
+	kindsConn, ok := dstConn.(connectors.ColumnKindsConnector)
+	if !ok {
+		return nil, nil
+	}
+	kinds, err := kindsConn.GetColumnKinds(tableIdentifiers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get destination column types: %w", err)
+	}
+	return kinds, nil
+}
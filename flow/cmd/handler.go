@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/connectors"
+	connutils "github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/PeerDB-io/peer-flow/shared"
 	peerflow "github.com/PeerDB-io/peer-flow/workflows"
@@ -123,6 +124,15 @@ func (h *FlowRequestHandler) Close() {
 func (h *FlowRequestHandler) CreateCDCFlow(
 	ctx context.Context, req *protos.CreateCDCFlowRequest) (*protos.CreateCDCFlowResponse, error) {
 	cfg := req.ConnectionConfigs
+	err := connutils.ValidateTableMappings(cfg.TableMappings)
+	if err != nil {
+		return nil, fmt.Errorf("invalid table mappings: %w", err)
+	}
+	_, err = connutils.WithDatabaseOverride(cfg.Destination, cfg.DestinationDatabase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination database: %w", err)
+	}
+
 	workflowID := fmt.Sprintf("%s-peerflow-%s", cfg.FlowJobName, uuid.New())
 	workflowOptions := client.StartWorkflowOptions{
 		ID:        workflowID,
@@ -148,7 +158,6 @@ func (h *FlowRequestHandler) CreateCDCFlow(
 		}
 	}
 
-	var err error
 	err = h.updateFlowConfigInCatalog(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("unable to update flow config in catalog: %w", err)
@@ -258,6 +267,126 @@ func (h *FlowRequestHandler) updateQRepConfigInCatalog(
 	return nil
 }
 
+// GenerateDDL returns the DDL the mirror would run on the destination to create its normalized
+// tables, without executing it.
+func (h *FlowRequestHandler) GenerateDDL(
+	ctx context.Context,
+	req *protos.GenerateDDLRequest,
+) (*protos.GenerateDDLResponse, error) {
+	cfg := req.ConnectionConfigs
+	if cfg == nil || cfg.Source == nil || cfg.Destination == nil {
+		return nil, fmt.Errorf("connection configs with source and destination peers are required")
+	}
+
+	// the same names the mirror's setup would create the tables with
+	err := connutils.FitDestinationIdentifiers(cfg.TableMappings, cfg.Destination.Type, cfg.IdentifierLengthPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid table mappings: %w", err)
+	}
+
+	srcConn, err := connectors.GetCDCPullConnector(ctx, cfg.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source connector: %w", err)
+	}
+	defer connectors.CloseConnector(srcConn)
+
+	sourceTables := make([]string, 0, len(cfg.TableMappings))
+	tableNameMapping := make(map[string]string, len(cfg.TableMappings))
+	for _, mapping := range cfg.TableMappings {
+		sourceTables = append(sourceTables, mapping.SourceTableIdentifier)
+		tableNameMapping[mapping.SourceTableIdentifier] = mapping.DestinationTableIdentifier
+	}
+
+	tblSchemaOutput, err := srcConn.GetTableSchema(connutils.GetTableSchemaInput(cfg, sourceTables))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schema for source tables: %w", err)
+	}
+
+	normalizedTableMapping := make(map[string]*protos.TableSchema)
+	for srcTableName, tableSchema := range tblSchemaOutput.TableNameSchemaMapping {
+		normalizedTableMapping[tableNameMapping[srcTableName]] = tableSchema
+	}
+
+	destination, err := connutils.WithDatabaseOverride(cfg.Destination, cfg.DestinationDatabase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination database: %w", err)
+	}
+	ddlOutput, err := connectors.GenerateDDL(destination,
+		connutils.SetupNormalizedTablesInput(cfg, normalizedTableMapping))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate DDL: %w", err)
+	}
+
+	return &protos.GenerateDDLResponse{
+		TableDdlMapping: ddlOutput.TableDdlMapping,
+	}, nil
+}
+
+// TestTypeFidelity writes a value of each column type of the mirror's source tables to the destination
+// and reads it back, reporting the types whose values don't survive the round trip.
+func (h *FlowRequestHandler) TestTypeFidelity(
+	ctx context.Context,
+	req *protos.TestTypeFidelityRequest,
+) (*protos.TestTypeFidelityResponse, error) {
+	cfg := req.ConnectionConfigs
+	if cfg == nil || cfg.Source == nil || cfg.Destination == nil {
+		return nil, fmt.Errorf("connection configs with source and destination peers are required")
+	}
+
+	srcConn, err := connectors.GetCDCPullConnector(ctx, cfg.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source connector: %w", err)
+	}
+	defer connectors.CloseConnector(srcConn)
+
+	destination, err := connutils.WithDatabaseOverride(cfg.Destination, cfg.DestinationDatabase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination database: %w", err)
+	}
+	dstConn, err := connectors.GetTypeFidelityConnector(ctx, destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get type fidelity connector: %w", err)
+	}
+	defer connectors.CloseConnector(dstConn)
+
+	sourceTables := make([]string, 0, len(cfg.TableMappings))
+	tableNameMapping := make(map[string]string, len(cfg.TableMappings))
+	for _, mapping := range cfg.TableMappings {
+		sourceTables = append(sourceTables, mapping.SourceTableIdentifier)
+		tableNameMapping[mapping.SourceTableIdentifier] = mapping.DestinationTableIdentifier
+	}
+
+	tblSchemaOutput, err := srcConn.GetTableSchema(&protos.GetTableSchemaBatchInput{
+		PeerConnectionConfig: cfg.Source,
+		TableIdentifiers:     sourceTables,
+		JsonAsText:           cfg.JsonAsText,
+		RangesAsText:         cfg.RangesAsText,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schema for source tables: %w", err)
+	}
+
+	results := make([]*protos.TypeFidelityResult, 0)
+	for _, srcTableName := range sourceTables {
+		tableSchema, ok := tblSchemaOutput.TableNameSchemaMapping[srcTableName]
+		if !ok {
+			continue
+		}
+		tableResults, err := dstConn.TestTypeFidelity(cfg, tableSchema)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range tableResults {
+			result.TableIdentifier = tableNameMapping[srcTableName]
+		}
+		results = append(results, tableResults...)
+	}
+
+	return &protos.TestTypeFidelityResponse{
+		Results: results,
+	}, nil
+}
+
 func (h *FlowRequestHandler) ShutdownFlow(
 	ctx context.Context,
 	req *protos.ShutdownRequest,
@@ -278,6 +407,23 @@ func (h *FlowRequestHandler) ShutdownFlow(
 		return nil, fmt.Errorf("unable to wait for PeerFlow workflow to close: %w", err)
 	}
 
+	// the raw table and metadata of a CDC mirror with its own destination database are in that database,
+	// so they are dropped through it.
+	cdcFlow, err := h.isCDCFlow(ctx, req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+	if cdcFlow {
+		config, err := h.getFlowConfigFromCatalog(req.FlowJobName)
+		if err != nil {
+			return nil, err
+		}
+		req.DestinationPeer, err = connutils.WithDatabaseOverride(req.DestinationPeer, config.DestinationDatabase)
+		if err != nil {
+			return nil, fmt.Errorf("invalid destination database: %w", err)
+		}
+	}
+
 	workflowID := fmt.Sprintf("%s-dropflow-%s", req.FlowJobName, uuid.New())
 	workflowOptions := client.StartWorkflowOptions{
 		ID:        workflowID,
@@ -383,6 +529,45 @@ func (h *FlowRequestHandler) handleWorkflowNotClosed(ctx context.Context, workfl
 	return nil
 }
 
+// RebuildNormalizedTable starts a workflow truncating a normalized table of a CDC mirror and re-merging it
+// from the raw table with the options of the mirror.
+func (h *FlowRequestHandler) RebuildNormalizedTable(
+	ctx context.Context,
+	req *protos.RebuildNormalizedTableRequest,
+) (*protos.RebuildNormalizedTableResponse, error) {
+	config, err := h.getFlowConfigFromCatalog(req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+	config.Destination, err = connutils.WithDatabaseOverride(config.Destination, config.DestinationDatabase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination database: %w", err)
+	}
+
+	workflowID := fmt.Sprintf("%s-rebuild-table-%s", req.FlowJobName, uuid.New())
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        workflowID,
+		TaskQueue: shared.PeerFlowTaskQueue,
+	}
+	_, err = h.temporalClient.ExecuteWorkflow(
+		ctx,
+		workflowOptions,
+		peerflow.RebuildNormalizedTableWorkflow,
+		&protos.RebuildNormalizedTableInput{
+			FlowConnectionConfigs:      config,
+			DestinationTableIdentifier: req.DestinationTableIdentifier,
+			BatchesPerMerge:            req.BatchesPerMerge,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to start RebuildNormalizedTable workflow: %w", err)
+	}
+
+	return &protos.RebuildNormalizedTableResponse{
+		WorkflowId: workflowID,
+	}, nil
+}
+
 func (h *FlowRequestHandler) ValidatePeer(
 	ctx context.Context,
 	req *protos.ValidatePeerRequest,
@@ -420,10 +605,25 @@ func (h *FlowRequestHandler) ValidatePeer(
 		}, nil
 	}
 
+	message := fmt.Sprintf("%s peer %s is valid", req.Peer.Type, req.Peer.Name)
+	if keyPairValidator, ok := conn.(connectors.KeyPairValidator); ok {
+		warning, err := keyPairValidator.ValidateKeyPair()
+		if err != nil {
+			return &protos.ValidatePeerResponse{
+				Status: protos.ValidatePeerStatus_INVALID,
+				Message: fmt.Sprintf("failed to validate key pair of %s peer %s: %s",
+					req.Peer.Type, req.Peer.Name, err),
+			}, nil
+		}
+		if warning != "" {
+			log.Warnf("%s peer %s: %s", req.Peer.Type, req.Peer.Name, warning)
+			message = fmt.Sprintf("%s, but %s", message, warning)
+		}
+	}
+
 	return &protos.ValidatePeerResponse{
-		Status: protos.ValidatePeerStatus_VALID,
-		Message: fmt.Sprintf("%s peer %s is valid",
-			req.Peer.Type, req.Peer.Name),
+		Status:  protos.ValidatePeerStatus_VALID,
+		Message: message,
 	}, nil
 }
 
@@ -65,6 +65,27 @@ func main() {
 		EnvVars: []string{"PEERDB_TEMPORAL_NAMESPACE"},
 	}
 
+	maxConcurrentMergesFlag := &cli.UintFlag{
+		Name:    "max-concurrent-merges",
+		Value:   0,
+		Usage:   "Maximum number of normalize merges running at once across all mirrors, 0 for no limit",
+		EnvVars: []string{"PEERDB_MAX_CONCURRENT_MERGES"},
+	}
+
+	metadataBatchWindowFlag := &cli.DurationFlag{
+		Name:    "metadata-batch-window",
+		Value:   0,
+		Usage:   "Time mirrors sharing a metadata store wait to record finished batches together, 0 to not wait",
+		EnvVars: []string{"PEERDB_METADATA_BATCH_WINDOW"},
+	}
+
+	metricsLabelsFlag := &cli.StringFlag{
+		Name:    "metrics-labels",
+		Value:   "",
+		Usage:   "Labels attached to all emitted metrics, as comma separated key=value pairs like env=prod,team=data",
+		EnvVars: []string{"PEERDB_METRICS_LABELS"},
+	}
+
 	app := &cli.App{
 		Name: "PeerDB Flows CLI",
 		Commands: []*cli.Command{
@@ -73,12 +94,15 @@ func main() {
 				Action: func(ctx *cli.Context) error {
 					temporalHostPort := ctx.String("temporal-host-port")
 					return WorkerMain(&WorkerOptions{
-						TemporalHostPort:  temporalHostPort,
-						EnableProfiling:   ctx.Bool("enable-profiling"),
-						EnableMetrics:     ctx.Bool("enable-metrics"),
-						PyroscopeServer:   ctx.String("pyroscope-server-address"),
-						MetricsServer:     ctx.String("metrics-server"),
-						TemporalNamespace: ctx.String("temporal-namespace"),
+						TemporalHostPort:    temporalHostPort,
+						EnableProfiling:     ctx.Bool("enable-profiling"),
+						EnableMetrics:       ctx.Bool("enable-metrics"),
+						PyroscopeServer:     ctx.String("pyroscope-server-address"),
+						MetricsServer:       ctx.String("metrics-server"),
+						TemporalNamespace:   ctx.String("temporal-namespace"),
+						MaxConcurrentMerges: ctx.Uint("max-concurrent-merges"),
+						MetricsLabels:       ctx.String("metrics-labels"),
+						MetadataBatchWindow: ctx.Duration("metadata-batch-window"),
 					})
 				},
 				Flags: []cli.Flag{
@@ -88,6 +112,9 @@ func main() {
 					pyroscopeServerFlag,
 					metricsServerFlag,
 					temporalNamespaceFlag,
+					maxConcurrentMergesFlag,
+					metricsLabelsFlag,
+					metadataBatchWindowFlag,
 				},
 			},
 			{
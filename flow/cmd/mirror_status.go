@@ -5,7 +5,11 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/PeerDB-io/peer-flow/connectors"
+	connutils "github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/connectors/utils/monitoring"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
+	peerflow "github.com/PeerDB-io/peer-flow/workflows"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
@@ -89,9 +93,46 @@ func (h *FlowRequestHandler) CDCFlowStatus(
 	return &protos.CDCMirrorStatus{
 		Config:         config,
 		SnapshotStatus: initialCopyStatus,
+		QuotaBackoff:   h.getQuotaBackoffStatus(ctx, req.FlowJobName),
 	}, nil
 }
 
+// getQuotaBackoffStatus queries the workflow of a CDC mirror for its backoff from destination quota errors,
+// returning nil if the mirror isn't backing off or its workflow can't be queried.
+func (h *FlowRequestHandler) getQuotaBackoffStatus(ctx context.Context,
+	flowJobName string) *protos.QuotaBackoffStatus {
+	var workflowID string
+	err := h.pool.QueryRow(ctx, "SELECT workflow_id FROM flows WHERE name = $1 LIMIT 1",
+		flowJobName).Scan(&workflowID)
+	if err != nil {
+		logrus.Warnf("unable to get workflow ID of %s: %s", flowJobName, err.Error())
+		return nil
+	}
+
+	res, err := h.temporalClient.QueryWorkflow(ctx, workflowID, "", peerflow.CDCFlowQuotaBackoffQuery)
+	if err != nil {
+		logrus.Warnf("unable to query quota backoff of %s: %s", flowJobName, err.Error())
+		return nil
+	}
+	var backoff peerflow.QuotaBackoffState
+	if err := res.Get(&backoff); err != nil {
+		logrus.Warnf("unable to decode quota backoff of %s: %s", flowJobName, err.Error())
+		return nil
+	}
+
+	if backoff.ConsecutiveErrors == 0 {
+		return nil
+	}
+	status := &protos.QuotaBackoffStatus{
+		ConsecutiveQuotaErrors: backoff.ConsecutiveErrors,
+		LastError:              backoff.LastError,
+	}
+	if !backoff.Until.IsZero() {
+		status.BackoffUntil = timestamppb.New(backoff.Until)
+	}
+	return status
+}
+
 func (h *FlowRequestHandler) QRepFlowStatus(
 	ctx context.Context,
 	req *protos.MirrorStatusRequest,
@@ -176,6 +217,74 @@ func (h *FlowRequestHandler) getPartitionUUIDs(
 	return partitionUUIDs, nil
 }
 
+// GetRawTableSchema returns the live layout of the raw table of a CDC mirror on its destination.
+func (h *FlowRequestHandler) GetRawTableSchema(
+	ctx context.Context,
+	req *protos.GetRawTableSchemaRequest,
+) (*protos.GetRawTableSchemaResponse, error) {
+	destination, err := h.getDestinationFromCatalog(req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+
+	dstConn, err := connectors.GetRawTableSchemaConnector(ctx, destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get raw table schema connector: %w", err)
+	}
+	defer connectors.CloseConnector(dstConn)
+
+	rawTableSchema, err := dstConn.GetRawTableSchema(req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.GetRawTableSchemaResponse{
+		TableIdentifier: rawTableSchema.TableIdentifier,
+		Columns:         rawTableSchema.Columns,
+	}, nil
+}
+
+// GetNormalizeBacklog returns the number of raw table rows of a CDC mirror that are pending normalization.
+func (h *FlowRequestHandler) GetNormalizeBacklog(
+	ctx context.Context,
+	req *protos.GetNormalizeBacklogRequest,
+) (*protos.GetNormalizeBacklogResponse, error) {
+	destination, err := h.getDestinationFromCatalog(req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+
+	dstConn, err := connectors.GetNormalizeBacklogConnector(ctx, destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get normalize backlog connector: %w", err)
+	}
+	defer connectors.CloseConnector(dstConn)
+
+	backlog, err := dstConn.GetNormalizeBacklog(req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.GetNormalizeBacklogResponse{
+		BacklogRows: backlog,
+	}, nil
+}
+
+// GetSchemaDeltaHistory lists the schema changes applied to the destination tables of a CDC mirror.
+func (h *FlowRequestHandler) GetSchemaDeltaHistory(
+	ctx context.Context,
+	req *protos.GetSchemaDeltaHistoryRequest,
+) (*protos.GetSchemaDeltaHistoryResponse, error) {
+	entries, err := monitoring.NewCatalogMirrorMonitor(h.pool).GetSchemaDeltaHistoryForFlow(ctx, req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.GetSchemaDeltaHistoryResponse{
+		Entries: entries,
+	}, nil
+}
+
 func (h *FlowRequestHandler) getFlowConfigFromCatalog(
 	flowJobName string,
 ) (*protos.FlowConnectionConfigs, error) {
@@ -197,6 +306,21 @@ func (h *FlowRequestHandler) getFlowConfigFromCatalog(
 	return &config, nil
 }
 
+// getDestinationFromCatalog returns the destination peer of a CDC mirror, pointed at the mirror's
+// destination database if it has one, like the mirror's own activities connect to it.
+func (h *FlowRequestHandler) getDestinationFromCatalog(flowJobName string) (*protos.Peer, error) {
+	config, err := h.getFlowConfigFromCatalog(flowJobName)
+	if err != nil {
+		return nil, err
+	}
+
+	destination, err := connutils.WithDatabaseOverride(config.Destination, config.DestinationDatabase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination database: %w", err)
+	}
+	return destination, nil
+}
+
 func (h *FlowRequestHandler) getQRepConfigFromCatalog(flowJobName string) *protos.QRepConfig {
 	var configBytes []byte
 	var config protos.QRepConfig
@@ -9,7 +9,10 @@ import (
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/activities"
+	connmetadata "github.com/PeerDB-io/peer-flow/connectors/external_metadata"
+	connutils "github.com/PeerDB-io/peer-flow/connectors/utils"
 	utils "github.com/PeerDB-io/peer-flow/connectors/utils/catalog"
+	"github.com/PeerDB-io/peer-flow/connectors/utils/metrics"
 	"github.com/PeerDB-io/peer-flow/connectors/utils/monitoring"
 	"github.com/PeerDB-io/peer-flow/shared"
 	peerflow "github.com/PeerDB-io/peer-flow/workflows"
@@ -31,6 +34,13 @@ type WorkerOptions struct {
 	PyroscopeServer   string
 	MetricsServer     string
 	TemporalNamespace string
+	// MaxConcurrentMerges caps concurrent per-table merges across all mirrors, 0 means no cap.
+	MaxConcurrentMerges uint
+	// MetricsLabels are comma separated key=value labels attached to every emitted metric.
+	MetricsLabels string
+	// MetadataBatchWindow is how long batches finished by mirrors sharing an external metadata
+	// store are collected to be recorded in one statement, 0 records each batch on its own.
+	MetadataBatchWindow time.Duration
 }
 
 func setupPyroscope(opts *WorkerOptions) {
@@ -104,6 +114,13 @@ func WorkerMain(opts *WorkerOptions) error {
 		))
 	}
 
+	connmetadata.SetFinishBatchWindow(opts.MetadataBatchWindow)
+
+	metricsLabels, err := metrics.ParseLabels(opts.MetricsLabels)
+	if err != nil {
+		return fmt.Errorf("invalid metrics labels: %w", err)
+	}
+
 	conn, err := utils.GetCatalogConnectionPoolFromEnv()
 	if err != nil {
 		return fmt.Errorf("unable to create catalog connection pool: %w", err)
@@ -125,9 +142,12 @@ func WorkerMain(opts *WorkerOptions) error {
 	w.RegisterWorkflow(peerflow.QRepFlowWorkflow)
 	w.RegisterWorkflow(peerflow.QRepPartitionWorkflow)
 	w.RegisterWorkflow(peerflow.DropFlowWorkflow)
+	w.RegisterWorkflow(peerflow.RebuildNormalizedTableWorkflow)
 	w.RegisterActivity(&activities.FlowableActivity{
 		EnableMetrics:        opts.EnableMetrics,
 		CatalogMirrorMonitor: catalogMirrorMonitor,
+		MergeLimiter:         connutils.NewMergeLimiter(opts.MaxConcurrentMerges),
+		MetricsLabels:        metricsLabels,
 	})
 
 	err = w.Run(worker.InterruptCh())
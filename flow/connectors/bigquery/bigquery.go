@@ -327,19 +327,22 @@ func (c *BigQueryConnector) GetLastNormalizeBatchID(jobName string) (int64, erro
 	err = it.Next(&row)
 	if err != nil {
 		log.Printf("no row found for job %s", jobName)
-		return 0, nil
+		return model.NoNormalizedBatchID, nil
 	}
 
 	if row[0] == nil {
-		log.Printf("no normalize_batch_id found for job %s, returning 0", jobName)
-		return 0, nil
+		// the metadata is inserted by the first sync without a normalize batch id
+		log.Printf("no normalize_batch_id found for job %s, no batch was normalized yet", jobName)
+		return model.NoNormalizedBatchID, nil
 	} else {
 		return row[0].(int64), nil
 	}
 }
 
+// getDistinctTableNamesInBatch returns the destination tables with records in the batches,
+// except for raw-only tables, which are not normalized.
 func (c *BigQueryConnector) getDistinctTableNamesInBatch(flowJobName string, syncBatchID int64,
-	normalizeBatchID int64) ([]string, error) {
+	normalizeBatchID int64, rawOnlyTables map[string]bool) ([]string, error) {
 	rawTableName := c.getRawTableName(flowJobName)
 
 	// Prepare the query to retrieve distinct tables in that batch
@@ -367,6 +370,9 @@ func (c *BigQueryConnector) getDistinctTableNamesInBatch(flowJobName string, syn
 		}
 		if len(row) > 0 {
 			value := row[0].(string)
+			if rawOnlyTables[value] {
+				continue
+			}
 			distinctTableNames = append(distinctTableNames, value)
 		}
 	}
@@ -484,9 +490,7 @@ func (c *BigQueryConnector) syncRecordsViaSQL(req *model.SyncRecordsRequest,
 	stagingBatchID := rand.Int63()
 	records := make([]StagingBQRecord, 0)
 	tableNameRowsMapping := make(map[string]uint32)
-	first := true
-	var firstCP int64 = 0
-	lastCP := req.Records.LastCheckPointID
+	firstCP, lastCP := req.Records.CheckPointRange()
 	// loop over req.Records
 	for _, record := range req.Records.Records {
 		switch r := record.(type) {
@@ -578,11 +582,6 @@ func (c *BigQueryConnector) syncRecordsViaSQL(req *model.SyncRecordsRequest,
 		default:
 			return nil, fmt.Errorf("record type %T not supported", r)
 		}
-
-		if first {
-			firstCP = record.GetCheckPointID()
-			first = false
-		}
 	}
 
 	numRecords := len(records)
@@ -650,9 +649,7 @@ func (c *BigQueryConnector) syncRecordsViaSQL(req *model.SyncRecordsRequest,
 func (c *BigQueryConnector) syncRecordsViaAvro(req *model.SyncRecordsRequest,
 	rawTableName string, syncBatchID int64) (*model.SyncResponse, error) {
 	tableNameRowsMapping := make(map[string]uint32)
-	first := true
-	var firstCP int64 = 0
-	lastCP := req.Records.LastCheckPointID
+	firstCP, lastCP := req.Records.CheckPointRange()
 	recordStream := model.NewQRecordStream(len(req.Records.Records))
 	err := recordStream.SetSchema(&model.QRecordSchema{
 		Fields: []*model.QField{
@@ -810,11 +807,6 @@ func (c *BigQueryConnector) syncRecordsViaAvro(req *model.SyncRecordsRequest,
 			return nil, fmt.Errorf("record type %T not supported", r)
 		}
 
-		if first {
-			firstCP = record.GetCheckPointID()
-			first = false
-		}
-
 		entries[0] = qvalue.QValue{
 			Kind:  qvalue.QValueKindString,
 			Value: uuid.New().String(),
@@ -888,6 +880,12 @@ func (c *BigQueryConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check if job exists: %w", err)
 	}
+	// a forced normalize merges the last normalized batch again.
+	if req.Force && hasJob && normalizeBatchID == syncBatchID && normalizeBatchID > model.NoNormalizedBatchID {
+		log.Printf("forcing normalize of batch %d for job %s, which was normalized already",
+			normalizeBatchID, req.FlowJobName)
+		normalizeBatchID--
+	}
 	// if job is not yet found in the peerdb_mirror_jobs_table
 	// OR sync is lagging end normalize
 	if !hasJob || normalizeBatchID == syncBatchID {
@@ -898,7 +896,8 @@ func (c *BigQueryConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 			EndBatchID:   syncBatchID,
 		}, nil
 	}
-	distinctTableNames, err := c.getDistinctTableNamesInBatch(req.FlowJobName, syncBatchID, normalizeBatchID)
+	distinctTableNames, err := c.getDistinctTableNamesInBatch(req.FlowJobName, syncBatchID, normalizeBatchID,
+		req.RawOnlyTables)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get distinct table names to normalize: %w", err)
 	}
@@ -923,6 +922,7 @@ func (c *BigQueryConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 			SyncBatchID:           syncBatchID,
 			NormalizeBatchID:      normalizeBatchID,
 			UnchangedToastColumns: tableNametoUnchangedToastCols[tableName],
+			NullsOrdering:         req.DedupNullsOrdering,
 		}
 		// normalize anything between last normalized batch id to last sync batchid
 		mergeStmts := mergeGen.GenerateMergeStmts()
@@ -1196,6 +1196,8 @@ type MergeStmtGenerator struct {
 	NormalizedTableSchema *protos.TableSchema
 	// array of toast column combinations that are unchanged
 	UnchangedToastColumns []string
+	// where records with a NULL timestamp rank when deduplicating
+	NullsOrdering protos.DedupNullsOrdering
 }
 
 // GenerateMergeStmt generates a merge statements.
@@ -1239,7 +1241,8 @@ func (m *MergeStmtGenerator) generateFlattenedCTE() string {
 			castStmt = fmt.Sprintf("FROM_BASE64(JSON_EXTRACT_SCALAR(_peerdb_data, '$.%s')) AS `%s`",
 				colName, colName)
 		case qvalue.QValueKindArrayFloat32, qvalue.QValueKindArrayFloat64,
-			qvalue.QValueKindArrayInt32, qvalue.QValueKindArrayInt64, qvalue.QValueKindArrayString:
+			qvalue.QValueKindArrayInt32, qvalue.QValueKindArrayInt64, qvalue.QValueKindArrayString,
+			qvalue.QValueKindArrayUUID:
 			castStmt = fmt.Sprintf("ARRAY(SELECT CAST(element AS %s) FROM "+
 				"UNNEST(CAST(JSON_EXTRACT_ARRAY(_peerdb_data, '$.%s') AS ARRAY<STRING>)) AS element) AS `%s`",
 				bqType, colName, colName)
@@ -1283,14 +1286,14 @@ func (m *MergeStmtGenerator) generateDeDupedCTE() string {
 		SELECT _peerdb_ranked.*
 			FROM (
 				SELECT RANK() OVER (
-					PARTITION BY %s ORDER BY _peerdb_timestamp_nanos DESC
+					PARTITION BY %s ORDER BY %s
 				) as _peerdb_rank, * FROM _peerdb_flattened
 			) _peerdb_ranked
 			WHERE _peerdb_rank = 1
 	) SELECT * FROM _peerdb_de_duplicated_data_res`
 	pkeyColsStr := fmt.Sprintf("(CONCAT(%s))", strings.Join(m.NormalizedTableSchema.PrimaryKeyColumns,
 		", '_peerdb_concat_', "))
-	return fmt.Sprintf(cte, pkeyColsStr)
+	return fmt.Sprintf(cte, pkeyColsStr, utils.DedupOrderSQL("_peerdb_timestamp_nanos", m.NullsOrdering))
 }
 
 // generateMergeStmt generates a merge statement.
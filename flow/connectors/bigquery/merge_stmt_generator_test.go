@@ -4,6 +4,9 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGenerateUpdateStatement_WithUnchangedToastCols(t *testing.T) {
@@ -74,3 +77,15 @@ func removeSpacesTabsNewlines(s string) string {
 	s = strings.ReplaceAll(s, "\n", "")
 	return s
 }
+
+func TestGenerateDeDupedCTE_NullsOrdering(t *testing.T) {
+	m := &MergeStmtGenerator{
+		NormalizedTableSchema: &protos.TableSchema{PrimaryKeyColumns: []string{"id"}},
+	}
+	require.Contains(t, m.generateDeDupedCTE(),
+		"PARTITION BY (CONCAT(id)) ORDER BY _peerdb_timestamp_nanos DESC NULLS LAST")
+
+	m.NullsOrdering = protos.DedupNullsOrdering_DEDUP_NULLS_FIRST
+	require.Contains(t, m.generateDeDupedCTE(),
+		"PARTITION BY (CONCAT(id)) ORDER BY _peerdb_timestamp_nanos DESC NULLS FIRST")
+}
@@ -176,7 +176,7 @@ func (q QRecordValueSaver) Save() (map[string]bigquery.Value, string, error) {
 			}
 			bqValues[k] = val
 
-		case qvalue.QValueKindArrayString:
+		case qvalue.QValueKindArrayString, qvalue.QValueKindArrayUUID:
 			val, ok := v.Value.([]string)
 			if !ok {
 				return nil, "", fmt.Errorf("failed to convert %v to []string", v.Value)
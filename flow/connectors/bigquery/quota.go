@@ -0,0 +1,36 @@
+package connbigquery
+
+import (
+	"errors"
+	"net/http"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/googleapi"
+)
+
+// BigQuery error reasons for requests over a quota or rate limit of the project.
+var quotaErrorReasons = map[string]bool{
+	"quotaExceeded":     true,
+	"rateLimitExceeded": true,
+}
+
+// IsQuotaError returns true if err is BigQuery refusing a request or job for exceeding a quota or rate
+// limit, such as the slots or the number of DML statements of the project.
+func IsQuotaError(err error) bool {
+	var jobErr *bigquery.Error
+	if errors.As(err, &jobErr) {
+		return quotaErrorReasons[jobErr.Reason]
+	}
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		if apiErr.Code == http.StatusTooManyRequests {
+			return true
+		}
+		for _, item := range apiErr.Errors {
+			if quotaErrorReasons[item.Reason] {
+				return true
+			}
+		}
+	}
+	return false
+}
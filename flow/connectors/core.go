@@ -13,6 +13,7 @@ import (
 	connsqlserver "github.com/PeerDB-io/peer-flow/connectors/sqlserver"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
 )
 
 var ErrUnsupportedFunctionality = errors.New("requested connector does not support functionality")
@@ -22,6 +23,13 @@ type Connector interface {
 	ConnectionActive() bool
 }
 
+// KeyPairValidator is implemented by connectors that authenticate with a key pair.
+type KeyPairValidator interface {
+	// ValidateKeyPair checks that the configured key can still authenticate,
+	// returning a warning if it is about to stop working.
+	ValidateKeyPair() (string, error)
+}
+
 type CDCPullConnector interface {
 	Connector
 
@@ -78,6 +86,16 @@ type CDCSyncConnector interface {
 	SyncFlowCleanup(jobName string) error
 }
 
+// SyncSetupConnector is implemented by destinations that can check for the tables a mirror syncs into,
+// unlike NeedsSetupMetadataTables failed checks are returned as errors.
+type SyncSetupConnector interface {
+	// MetadataTablesExist checks if the metadata table [PEERDB_MIRROR_JOBS] exists.
+	MetadataTablesExist() (bool, error)
+
+	// RawTableExists checks if the raw table of the mirror exists.
+	RawTableExists(flowJobName string) (bool, error)
+}
+
 type CDCNormalizeConnector interface {
 	Connector
 
@@ -101,6 +119,10 @@ type QRepPullConnector interface {
 
 	// GetQRepRecords returns the records for a given partition.
 	PullQRepRecords(config *protos.QRepConfig, partition *protos.QRepPartition) (*model.QRecordBatch, error)
+
+	// SampleQRepRecords returns at most limit records of a given partition.
+	SampleQRepRecords(config *protos.QRepConfig, partition *protos.QRepPartition,
+		limit int) (*model.QRecordBatch, error)
 }
 
 type QRepSyncConnector interface {
@@ -125,6 +147,62 @@ type QRepConsolidateConnector interface {
 	CleanupQRepFlow(config *protos.QRepConfig) error
 }
 
+type NormalizedTableRebuildConnector interface {
+	Connector
+
+	// InitializeTableSchema initializes the table schema of all the destination tables for the connector.
+	InitializeTableSchema(req map[string]*protos.TableSchema) error
+
+	// RebuildNormalizedTable truncates a normalized table and re-merges it from the raw table.
+	RebuildNormalizedTable(req *model.RebuildNormalizedTableRequest) (*protos.RebuildNormalizedTableOutput, error)
+}
+
+type RawTableSchemaConnector interface {
+	Connector
+
+	// GetRawTableSchema returns the columns of the raw table of a mirror as they exist on the destination.
+	GetRawTableSchema(flowJobName string) (*protos.GetRawTableSchemaOutput, error)
+}
+
+type RawTableJanitorConnector interface {
+	Connector
+
+	// ListRawTables lists every raw table on the destination, along with the mirror among flowJobNames
+	// it belongs to.
+	ListRawTables(flowJobNames []string) ([]*model.RawTable, error)
+
+	// DropRawTable drops a raw table returned by ListRawTables.
+	DropRawTable(rawTableIdentifier string) error
+}
+
+type NormalizeBacklogConnector interface {
+	Connector
+
+	// GetNormalizeBacklog counts the raw table rows of a mirror that have been synced but not normalized yet.
+	GetNormalizeBacklog(flowJobName string) (int64, error)
+}
+
+// TypeFidelityConnector is implemented by connectors that can test whether values of each column type
+// of a table are kept intact by the destination.
+type TypeFidelityConnector interface {
+	Connector
+
+	// TestTypeFidelity writes a value of each column type of the table to the destination the way the
+	// mirror would, and reads it back.
+	TestTypeFidelity(config *protos.FlowConnectionConfigs,
+		tableSchema *protos.TableSchema) ([]*protos.TypeFidelityResult, error)
+}
+
+// ColumnKindsConnector is implemented by destinations with integer types narrower than the source's,
+// whose values are fitted into the range of their destination column before they are synced.
+type ColumnKindsConnector interface {
+	Connector
+
+	// GetColumnKinds returns the kinds of the columns of the given tables, keyed by table and column name.
+	// Tables that don't exist yet are left out.
+	GetColumnKinds(tableIdentifiers []string) (map[string]map[string]qvalue.QValueKind, error)
+}
+
 func GetCDCPullConnector(ctx context.Context, config *protos.Peer) (CDCPullConnector, error) {
 	inner := config.Config
 	switch inner.(type) {
@@ -249,6 +327,87 @@ func GetQRepConsolidateConnector(ctx context.Context,
 	}
 }
 
+func GetNormalizedTableRebuildConnector(ctx context.Context,
+	config *protos.Peer) (NormalizedTableRebuildConnector, error) {
+	inner := config.Config
+	switch inner.(type) {
+	case *protos.Peer_SnowflakeConfig:
+		return connsnowflake.NewSnowflakeConnector(ctx, config.GetSnowflakeConfig())
+	default:
+		return nil, ErrUnsupportedFunctionality
+	}
+}
+
+// GenerateDDL returns the statements that would be used to create the normalized tables on the peer,
+// without executing them or connecting to the peer.
+func GenerateDDL(config *protos.Peer,
+	req *protos.SetupNormalizedTableBatchInput) (*protos.GenerateDDLOutput, error) {
+	inner := config.Config
+	switch inner.(type) {
+	case *protos.Peer_SnowflakeConfig:
+		return connsnowflake.GenerateDDL(config.GetSnowflakeConfig(), req)
+	default:
+		return nil, ErrUnsupportedFunctionality
+	}
+}
+
+func GetTypeFidelityConnector(ctx context.Context, config *protos.Peer) (TypeFidelityConnector, error) {
+	inner := config.Config
+	switch inner.(type) {
+	case *protos.Peer_SnowflakeConfig:
+		return connsnowflake.NewSnowflakeConnector(ctx, config.GetSnowflakeConfig())
+	default:
+		return nil, ErrUnsupportedFunctionality
+	}
+}
+
+func GetRawTableSchemaConnector(ctx context.Context, config *protos.Peer) (RawTableSchemaConnector, error) {
+	inner := config.Config
+	switch inner.(type) {
+	case *protos.Peer_PostgresConfig:
+		return connpostgres.NewPostgresConnector(ctx, config.GetPostgresConfig())
+	case *protos.Peer_SnowflakeConfig:
+		return connsnowflake.NewSnowflakeConnector(ctx, config.GetSnowflakeConfig())
+	default:
+		return nil, ErrUnsupportedFunctionality
+	}
+}
+
+func GetNormalizeBacklogConnector(ctx context.Context, config *protos.Peer) (NormalizeBacklogConnector, error) {
+	inner := config.Config
+	switch inner.(type) {
+	case *protos.Peer_PostgresConfig:
+		return connpostgres.NewPostgresConnector(ctx, config.GetPostgresConfig())
+	case *protos.Peer_SnowflakeConfig:
+		return connsnowflake.NewSnowflakeConnector(ctx, config.GetSnowflakeConfig())
+	default:
+		return nil, ErrUnsupportedFunctionality
+	}
+}
+
+func GetRawTableJanitorConnector(ctx context.Context, config *protos.Peer) (RawTableJanitorConnector, error) {
+	inner := config.Config
+	switch inner.(type) {
+	case *protos.Peer_SnowflakeConfig:
+		return connsnowflake.NewSnowflakeConnector(ctx, config.GetSnowflakeConfig())
+	default:
+		return nil, ErrUnsupportedFunctionality
+	}
+}
+
+// IsQuotaError returns true if err is the destination peer refusing work for exceeding one of its quotas,
+// which retrying soon after won't get past.
+func IsQuotaError(config *protos.Peer, err error) bool {
+	switch config.Config.(type) {
+	case *protos.Peer_BigqueryConfig:
+		return connbigquery.IsQuotaError(err)
+	case *protos.Peer_SnowflakeConfig:
+		return connsnowflake.IsQuotaError(err)
+	default:
+		return false
+	}
+}
+
 func CloseConnector(conn Connector) {
 	if conn == nil {
 		return
@@ -111,16 +111,6 @@ func (c *EventHubConnector) GetLastOffset(jobName string) (*protos.LastSyncState
 	return res, nil
 }
 
-func (c *EventHubConnector) updateLastOffset(jobName string, offset int64) error {
-	err := c.pgMetadata.UpdateLastOffset(jobName, offset)
-	if err != nil {
-		log.Errorf("failed to update last offset: %v", err)
-		return err
-	}
-
-	return nil
-}
-
 func (c *EventHubConnector) processBatch(
 	flowJobName string,
 	batch *model.RecordBatch,
@@ -234,14 +224,9 @@ func (c *EventHubConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 		}
 	}
 
-	err = c.updateLastOffset(req.FlowJobName, batch.LastCheckPointID)
-	if err != nil {
-		log.Errorf("failed to update last offset: %v", err)
-		return nil, err
-	}
-	err = c.pgMetadata.IncrementID(req.FlowJobName)
+	firstCP, lastCP := batch.CheckPointRange()
+	err = c.pgMetadata.FinishBatch(req.FlowJobName, lastCP)
 	if err != nil {
-		log.Errorf("%v", err)
 		return nil, err
 	}
 
@@ -249,8 +234,8 @@ func (c *EventHubConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 	metrics.LogSyncMetrics(c.ctx, req.FlowJobName, rowsSynced, time.Since(startTime))
 	metrics.LogNormalizeMetrics(c.ctx, req.FlowJobName, rowsSynced, time.Since(startTime), rowsSynced)
 	return &model.SyncResponse{
-		FirstSyncedCheckPointID: batch.FirstCheckPointID,
-		LastSyncedCheckPointID:  batch.LastCheckPointID,
+		FirstSyncedCheckPointID: firstCP,
+		LastSyncedCheckPointID:  lastCP,
 		NumRecordsSynced:        rowsSynced,
 		TableNameRowsMapping:    make(map[string]uint32),
 	}, nil
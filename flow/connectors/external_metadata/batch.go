@@ -0,0 +1,140 @@
+package connmetadata
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// finishBatchWindow is how long the first sync to finish a batch waits for syncs of other mirrors
+// using the same metadata store, to record their batches in the same statement. 0 records each batch
+// on its own.
+var finishBatchWindow atomic.Int64
+
+// SetFinishBatchWindow sets how long finished batches of mirrors sharing a metadata store are
+// collected before they are recorded together, 0 to record each batch on its own.
+func SetFinishBatchWindow(window time.Duration) {
+	finishBatchWindow.Store(int64(window))
+}
+
+// finishBatchWriteTimeout bounds the write of collected batches. The write doesn't use the context of
+// the sync that started it, cancelling that sync must not fail the others waiting on the write.
+const finishBatchWriteTimeout = time.Minute
+
+// syncStateUpdate records the batches a mirror finished, the last offset it synced and how many
+// batches to add to its sync batch id.
+type syncStateUpdate struct {
+	jobName     string
+	lastOffset  int64
+	batchIDIncr int64
+}
+
+// syncStateBatch collects the finished batches of mirrors until it is written, all of them at once.
+type syncStateBatch struct {
+	updates map[string]*syncStateUpdate
+	done    chan struct{}
+	err     error
+}
+
+func (b *syncStateBatch) add(jobName string, offset int64) {
+	update, ok := b.updates[jobName]
+	if !ok {
+		update = &syncStateUpdate{jobName: jobName}
+		b.updates[jobName] = update
+	}
+	update.lastOffset = offset
+	update.batchIDIncr++
+}
+
+// sorted returns the updates in the order of their job names, so concurrent writes lock the rows of
+// the metadata table in the same order.
+func (b *syncStateBatch) sorted() []*syncStateUpdate {
+	updates := make([]*syncStateUpdate, 0, len(b.updates))
+	for _, update := range b.updates {
+		updates = append(updates, update)
+	}
+	sort.Slice(updates, func(i, j int) bool {
+		return updates[i].jobName < updates[j].jobName
+	})
+	return updates
+}
+
+// syncStateBatcher group commits the finished batches of the mirrors using a metadata store. The first
+// sync to finish a batch waits for the window and writes the batches collected meanwhile, the others
+// wait for its write. The write is a single statement, so the batches are all recorded or none are, and
+// each sync only returns once its batch is.
+type syncStateBatcher struct {
+	mu      sync.Mutex
+	pending *syncStateBatch
+}
+
+var (
+	syncStateBatchersLock sync.Mutex
+	// syncStateBatchers are keyed by metadata database and schema.
+	syncStateBatchers = make(map[string]*syncStateBatcher)
+)
+
+func getSyncStateBatcher(key string) *syncStateBatcher {
+	syncStateBatchersLock.Lock()
+	defer syncStateBatchersLock.Unlock()
+	batcher, ok := syncStateBatchers[key]
+	if !ok {
+		batcher = &syncStateBatcher{}
+		syncStateBatchers[key] = batcher
+	}
+	return batcher
+}
+
+// finishBatch adds a finished batch to the pending write and waits for it to be written. write is
+// only called by the sync that started the pending write, with its own connection to the store and a
+// context detached from ctx. Other syncs stop waiting once their context is done, their batch may
+// still be recorded by the write, which a retried sync then resumes after.
+func (b *syncStateBatcher) finishBatch(ctx context.Context, window time.Duration, jobName string,
+	offset int64, write func(context.Context, []*syncStateUpdate) error) error {
+	b.mu.Lock()
+	batch := b.pending
+	leader := batch == nil
+	if leader {
+		batch = &syncStateBatch{
+			updates: make(map[string]*syncStateUpdate),
+			done:    make(chan struct{}),
+		}
+		b.pending = batch
+	}
+	batch.add(jobName, offset)
+	b.mu.Unlock()
+
+	if !leader {
+		select {
+		case <-batch.done:
+			return batch.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	// the batch is written even if the context is done while collecting, the other syncs wait on it.
+	timer := time.NewTimer(window)
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		timer.Stop()
+	}
+	b.mu.Lock()
+	b.pending = nil
+	b.mu.Unlock()
+
+	updates := batch.sorted()
+	if len(updates) > 1 {
+		log.Infof("recording finished batches of %d mirrors at once", len(updates))
+	}
+	writeCtx, cancel := context.WithTimeout(context.Background(), finishBatchWriteTimeout)
+	defer cancel()
+	batch.err = write(writeCtx, updates)
+	close(batch.done)
+	return batch.err
+}
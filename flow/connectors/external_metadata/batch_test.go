@@ -0,0 +1,157 @@
+package connmetadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSyncState is the last_sync_state table, written the way the finish batch statement writes it.
+type fakeSyncState struct {
+	mu          sync.Mutex
+	lastOffsets map[string]int64
+	batchIDs    map[string]int64
+	writes      int
+}
+
+func newFakeSyncState() *fakeSyncState {
+	return &fakeSyncState{
+		lastOffsets: make(map[string]int64),
+		batchIDs:    make(map[string]int64),
+	}
+}
+
+func (s *fakeSyncState) write(_ context.Context, updates []*syncStateUpdate) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writes++
+	for _, update := range updates {
+		s.lastOffsets[update.jobName] = update.lastOffset
+		s.batchIDs[update.jobName] += update.batchIDIncr
+	}
+	return nil
+}
+
+func TestBatchedFinishBatchesMatchIndividualWrites(t *testing.T) {
+	const numJobs = 8
+	const batchesPerJob = 5
+
+	individual := newFakeSyncState()
+	for job := 0; job < numJobs; job++ {
+		for batch := 1; batch <= batchesPerJob; batch++ {
+			require.NoError(t, individual.write(context.Background(), []*syncStateUpdate{{
+				jobName:     fmt.Sprintf("job_%d", job),
+				lastOffset:  int64(job*100 + batch),
+				batchIDIncr: 1,
+			}}))
+		}
+	}
+
+	// every mirror finishes its batches one after the other, like its syncs.
+	batched := newFakeSyncState()
+	batcher := &syncStateBatcher{}
+	var wg sync.WaitGroup
+	errs := make([]error, numJobs)
+	for job := 0; job < numJobs; job++ {
+		wg.Add(1)
+		go func(job int) {
+			defer wg.Done()
+			for batch := 1; batch <= batchesPerJob; batch++ {
+				err := batcher.finishBatch(context.Background(), 20*time.Millisecond,
+					fmt.Sprintf("job_%d", job), int64(job*100+batch), batched.write)
+				if err != nil {
+					errs[job] = err
+					return
+				}
+			}
+		}(job)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, individual.lastOffsets, batched.lastOffsets)
+	require.Equal(t, individual.batchIDs, batched.batchIDs)
+	require.Less(t, batched.writes, individual.writes)
+}
+
+func TestFailedFinishBatchWriteFailsEverySync(t *testing.T) {
+	batcher := &syncStateBatcher{}
+	writeErr := errors.New("metadata store is down")
+	var writes int
+	var written []*syncStateUpdate
+	write := func(_ context.Context, updates []*syncStateUpdate) error {
+		writes++
+		written = updates
+		return writeErr
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for job := range errs {
+		wg.Add(1)
+		go func(job int) {
+			defer wg.Done()
+			errs[job] = batcher.finishBatch(context.Background(), 100*time.Millisecond,
+				fmt.Sprintf("job_%d", job), 10, write)
+		}(job)
+	}
+	wg.Wait()
+
+	// the batches were written in one statement, which recorded none of them, so every sync is retried
+	require.Equal(t, 1, writes)
+	require.Len(t, written, 3)
+	for _, err := range errs {
+		require.ErrorIs(t, err, writeErr)
+	}
+}
+
+func TestCancelledSyncDoesNotFailFinishBatchWrite(t *testing.T) {
+	batcher := &syncStateBatcher{}
+	state := newFakeSyncState()
+	var writeCtxErr error
+	write := func(ctx context.Context, updates []*syncStateUpdate) error {
+		// the leader is cancelled before the write, which must still be recorded for everyone.
+		writeCtxErr = ctx.Err()
+		return state.write(ctx, updates)
+	}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	followerCtx, cancelFollower := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		leaderErr <- batcher.finishBatch(leaderCtx, time.Hour, "job_0", 10, write)
+	}()
+	// wait for the leader to start collecting before the follower joins its batch.
+	require.Eventually(t, func() bool {
+		batcher.mu.Lock()
+		defer batcher.mu.Unlock()
+		return batcher.pending != nil
+	}, time.Second, time.Millisecond)
+
+	followerErr := make(chan error, 1)
+	go func() {
+		followerErr <- batcher.finishBatch(followerCtx, time.Hour, "job_1", 20, write)
+	}()
+	require.Eventually(t, func() bool {
+		batcher.mu.Lock()
+		defer batcher.mu.Unlock()
+		return batcher.pending != nil && len(batcher.pending.updates) == 2
+	}, time.Second, time.Millisecond)
+	// the cancelled follower stops waiting for the write, which still records its batch.
+	cancelFollower()
+	require.ErrorIs(t, <-followerErr, context.Canceled)
+	cancelLeader()
+
+	require.NoError(t, <-leaderErr)
+	require.NoError(t, writeCtxErr)
+	require.Equal(t, map[string]int64{"job_0": 10, "job_1": 20}, state.lastOffsets)
+	require.Equal(t, 1, state.writes)
+}
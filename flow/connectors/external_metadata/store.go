@@ -2,6 +2,7 @@ package connmetadata
 
 import (
 	"context"
+	"time"
 
 	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
@@ -18,6 +19,8 @@ type PostgresMetadataStore struct {
 	config     *protos.PostgresConfig
 	pool       *pgxpool.Pool
 	schemaName string
+	// batcherKey identifies the metadata table, shared by the stores of every mirror using it.
+	batcherKey string
 }
 
 func NewPostgresMetadataStore(ctx context.Context, pgConfig *protos.PostgresConfig,
@@ -36,6 +39,7 @@ func NewPostgresMetadataStore(ctx context.Context, pgConfig *protos.PostgresConf
 		config:     pgConfig,
 		pool:       pool,
 		schemaName: schemaName,
+		batcherKey: connectionString + "|" + schemaName,
 	}, nil
 }
 
@@ -160,61 +164,52 @@ func (p *PostgresMetadataStore) GetLastBatchID(jobName string) (int64, error) {
 	return syncBatchID, nil
 }
 
-// update offset for a job
-func (p *PostgresMetadataStore) UpdateLastOffset(jobName string, offset int64) error {
-	// start a transaction
-	tx, err := p.pool.Begin(p.ctx)
-	if err != nil {
-		log.Errorf("failed to start transaction: %v", err)
-		return err
-	}
-
-	// update the last offset
+// FinishBatch records a synced batch of a job, setting its last offset and incrementing its sync
+// batch id in one statement. With a finish batch window set, the batches of jobs using the same
+// metadata table are collected for the window and recorded together.
+func (p *PostgresMetadataStore) FinishBatch(jobName string, offset int64) error {
 	log.WithFields(log.Fields{
 		"flowName": jobName,
-	}).Infof("updating last offset for job `%s` to `%d`", jobName, offset)
-	_, err = tx.Exec(p.ctx, `
-		INSERT INTO `+p.schemaName+`.`+lastSyncStateTableName+` (job_name, last_offset, sync_batch_id)
-		VALUES ($1, $2, $3)
-		ON CONFLICT (job_name)
-		DO UPDATE SET last_offset = $2, updated_at = NOW()
-	`, jobName, offset, 0)
-
-	if err != nil {
-		log.WithFields(log.Fields{
-			"flowName": jobName,
-		}).Errorf("failed to update last offset: %v", err)
+	}).Infof("finishing batch for job `%s` at offset `%d`", jobName, offset)
+
+	window := time.Duration(finishBatchWindow.Load())
+	if window <= 0 {
+		err := p.writeSyncStates(p.ctx, []*syncStateUpdate{{jobName: jobName, lastOffset: offset, batchIDIncr: 1}})
+		if err != nil {
+			log.WithFields(log.Fields{
+				"flowName": jobName,
+			}).Errorf("failed to finish batch: %v", err)
+		}
 		return err
 	}
 
-	// commit the transaction
-	err = tx.Commit(p.ctx)
+	err := getSyncStateBatcher(p.batcherKey).finishBatch(p.ctx, window, jobName, offset, p.writeSyncStates)
 	if err != nil {
-		log.Errorf("failed to commit transaction: %v", err)
-		return err
+		log.WithFields(log.Fields{
+			"flowName": jobName,
+		}).Errorf("failed to finish batch: %v", err)
 	}
-
-	return nil
+	return err
 }
 
-// update offset for a job
-func (p *PostgresMetadataStore) IncrementID(jobName string) error {
-	log.WithFields(log.Fields{
-		"flowName": jobName,
-	}).Infof("incrementing sync batch id for job `%s`", jobName)
-	_, err := p.pool.Exec(p.ctx, `
-		UPDATE `+p.schemaName+`.`+lastSyncStateTableName+`
-		 SET sync_batch_id=sync_batch_id+1 WHERE job_name=$1
-	`, jobName)
-
-	if err != nil {
-		log.WithFields(log.Fields{
-			"flowName": jobName,
-		}).Errorf("failed to increment sync batch id: %v", err)
-		return err
+func (p *PostgresMetadataStore) writeSyncStates(ctx context.Context, updates []*syncStateUpdate) error {
+	jobNames := make([]string, 0, len(updates))
+	offsets := make([]int64, 0, len(updates))
+	batchIDIncrs := make([]int64, 0, len(updates))
+	for _, update := range updates {
+		jobNames = append(jobNames, update.jobName)
+		offsets = append(offsets, update.lastOffset)
+		batchIDIncrs = append(batchIDIncrs, update.batchIDIncr)
 	}
 
-	return nil
+	_, err := p.pool.Exec(ctx, `
+		INSERT INTO `+p.schemaName+`.`+lastSyncStateTableName+` (job_name, last_offset, sync_batch_id)
+		SELECT * FROM unnest($1::text[], $2::bigint[], $3::bigint[])
+		ON CONFLICT (job_name)
+		DO UPDATE SET last_offset = EXCLUDED.last_offset,
+		 sync_batch_id = `+lastSyncStateTableName+`.sync_batch_id + EXCLUDED.sync_batch_id, updated_at = NOW()
+	`, jobNames, offsets, batchIDIncrs)
+	return err
 }
 
 func (p *PostgresMetadataStore) DropMetadata(jobName string) error {
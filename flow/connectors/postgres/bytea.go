@@ -0,0 +1,47 @@
+package connpostgres
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// decodeByteaText decodes a bytea value in text format to its bytes, in either format of the bytea_output
+// setting of the source. The hex format is \x followed by two hex digits per byte. In the escape format,
+// backslashes are doubled, bytes that aren't printable are a backslash and three octal digits, and all
+// other bytes are themselves.
+func decodeByteaText(data []byte) ([]byte, error) {
+	if len(data) >= 2 && data[0] == '\\' && data[1] == 'x' {
+		decoded := make([]byte, hex.DecodedLen(len(data)-2))
+		if _, err := hex.Decode(decoded, data[2:]); err != nil {
+			return nil, fmt.Errorf("invalid bytea in hex format: %w", err)
+		}
+		return decoded, nil
+	}
+
+	decoded := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		if data[i] != '\\' {
+			decoded = append(decoded, data[i])
+			continue
+		}
+		if i+1 < len(data) && data[i+1] == '\\' {
+			decoded = append(decoded, '\\')
+			i++
+			continue
+		}
+		if i+3 >= len(data) || !isOctalDigit(data[i+1]) || !isOctalDigit(data[i+2]) || !isOctalDigit(data[i+3]) {
+			return nil, fmt.Errorf("invalid bytea in escape format: bad escape at position %d", i)
+		}
+		value := uint16(data[i+1]-'0')<<6 | uint16(data[i+2]-'0')<<3 | uint16(data[i+3]-'0')
+		if value > 0xff {
+			return nil, fmt.Errorf("invalid bytea in escape format: bad escape at position %d", i)
+		}
+		decoded = append(decoded, byte(value))
+		i += 3
+	}
+	return decoded, nil
+}
+
+func isOctalDigit(c byte) bool {
+	return c >= '0' && c <= '7'
+}
@@ -0,0 +1,63 @@
+package connpostgres
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+// byteaEscapeOutput is how Postgres outputs a bytea with bytea_output set to escape.
+func byteaEscapeOutput(data []byte) string {
+	var output string
+	for _, b := range data {
+		switch {
+		case b == '\\':
+			output += `\\`
+		case b >= 0x20 && b <= 0x7e:
+			output += string(b)
+		default:
+			output += fmt.Sprintf(`\%03o`, b)
+		}
+	}
+	return output
+}
+
+func TestReplicateByteaInHexAndEscapeFormats(t *testing.T) {
+	data := make([]byte, 0, 258)
+	for b := 0; b < 256; b++ {
+		data = append(data, byte(b))
+	}
+	data = append(data, '\\', 'x')
+
+	cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{}, map[uint32]string{})
+	require.NoError(t, err)
+	rel := &protos.RelationMessage{
+		Columns: []*protos.RelationMessageColumn{{Name: "b", DataType: pgtype.ByteaOID}},
+	}
+	for _, output := range []string{`\x` + hex.EncodeToString(data), byteaEscapeOutput(data)} {
+		items, _, err := cdc.convertTupleToMap(&pglogrepl.TupleData{
+			Columns: []*pglogrepl.TupleDataColumn{{DataType: 't', Data: []byte(output)}},
+		}, rel)
+		require.NoError(t, err)
+		val, err := items.GetValueByColName("b")
+		require.NoError(t, err)
+		require.Equal(t, &qvalue.QValue{Kind: qvalue.QValueKindBytes, Value: data}, val)
+	}
+
+	// the empty bytea is the same in both formats.
+	for _, output := range []string{`\x`, ``} {
+		decoded, err := decodeByteaText([]byte(output))
+		require.NoError(t, err)
+		require.Empty(t, decoded)
+	}
+	for _, output := range []string{`\xabc`, `\xzz`, `a\`, `\12`, `\400`, `\9aa`} {
+		_, err := decodeByteaText([]byte(output))
+		require.Error(t, err, output)
+	}
+}
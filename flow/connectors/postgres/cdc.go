@@ -4,7 +4,6 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
-	"reflect"
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/connectors/utils"
@@ -20,6 +19,9 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// defaultMaxPullDuration leaves the sync activity plenty of its timeout for syncing what was pulled.
+const defaultMaxPullDuration = 10 * time.Minute
+
 type PostgresCDCSource struct {
 	ctx                    context.Context
 	replPool               *pgxpool.Pool
@@ -30,8 +32,19 @@ type PostgresCDCSource struct {
 	relationMessageMapping model.RelationMessageMapping
 	typeMap                *pgtype.Map
 	startLSN               pglogrepl.LSN
+	requestedStartLSN      pglogrepl.LSN
 	commitLock             bool
 	customTypeMapping      map[uint32]string
+	domainBaseTypes        map[uint32]uint32
+	compositeTypes         *compositeTypeMap
+	jsonAsText             bool
+	rangesAsText           bool
+	disabledTables         map[string]bool
+	countEmptyTransactions bool
+	// txStartRecords is the number of records in the batch when the current transaction began,
+	// txStartCheckPointID its last checkpoint at that time.
+	txStartRecords      int
+	txStartCheckPointID int64
 }
 
 type PostgresCDCConfig struct {
@@ -42,6 +55,19 @@ type PostgresCDCConfig struct {
 	SrcTableIDNameMapping  map[uint32]string
 	TableNameMapping       map[string]string
 	RelationMessageMapping model.RelationMessageMapping
+	DomainBaseTypes        map[uint32]uint32
+	// CompositeTypes decodes composite types and arrays of them to json.
+	CompositeTypes *compositeTypeMap
+	// StartLSN is where to start replicating from if nothing has been synced yet, 0 for the slot's default.
+	StartLSN pglogrepl.LSN
+	// JSONAsText replicates json columns as text, exactly as written.
+	JSONAsText bool
+	// RangesAsText replicates range columns in their Postgres text form instead of as json.
+	RangesAsText bool
+	// DisabledTables are the source tables whose changes are skipped.
+	DisabledTables map[string]bool
+	// CountEmptyTransactions counts transactions without changes to replicated tables in the batch.
+	CountEmptyTransactions bool
 }
 
 // Create a new PostgresCDCSource
@@ -57,6 +83,13 @@ func NewPostgresCDCSource(cdcConfig *PostgresCDCConfig, customTypeMap map[uint32
 		typeMap:                pgtype.NewMap(),
 		commitLock:             false,
 		customTypeMapping:      customTypeMap,
+		domainBaseTypes:        cdcConfig.DomainBaseTypes,
+		compositeTypes:         cdcConfig.CompositeTypes,
+		requestedStartLSN:      cdcConfig.StartLSN,
+		jsonAsText:             cdcConfig.JSONAsText,
+		rangesAsText:           cdcConfig.RangesAsText,
+		disabledTables:         cdcConfig.DisabledTables,
+		countEmptyTransactions: cdcConfig.CountEmptyTransactions,
 	}, nil
 }
 
@@ -103,6 +136,9 @@ func (p *PostgresCDCSource) PullRecords(req *model.PullRecordsRequest) (
 	if req.LastSyncState != nil && req.LastSyncState.Checkpoint > 0 {
 		log.Infof("starting replication from last sync state - %d", req.LastSyncState.Checkpoint)
 		p.startLSN = pglogrepl.LSN(req.LastSyncState.Checkpoint + 1)
+	} else if p.requestedStartLSN != 0 {
+		log.Infof("starting replication from requested start LSN - %s", p.requestedStartLSN)
+		p.startLSN = p.requestedStartLSN
 	}
 
 	err = pglogrepl.StartReplication(p.ctx, pgConn, replicationSlot, p.startLSN, replicationOpts)
@@ -137,6 +173,13 @@ func (p *PostgresCDCSource) consumeStream(
 	standbyMessageTimeout := req.IdleTimeout
 	nextStandbyMessageDeadline := time.Now().Add(standbyMessageTimeout)
 
+	// changes can keep arriving before the idle timeout, end the pull after a while regardless.
+	maxPullDuration := req.MaxPullDuration
+	if maxPullDuration == 0 {
+		maxPullDuration = defaultMaxPullDuration
+	}
+	pullDeadline := time.Now().Add(maxPullDuration)
+
 	defer func() {
 		err := conn.Close(p.ctx)
 		if err != nil {
@@ -153,6 +196,11 @@ func (p *PostgresCDCSource) consumeStream(
 	if clientXLogPos > 0 {
 		consumedXLogPos = clientXLogPos - 1
 	}
+	// batches whose checkpoint was deferred are not persisted on the destination yet,
+	// only confirm what a crash would resume from.
+	if req.PersistedSyncState != nil && pglogrepl.LSN(req.PersistedSyncState.Checkpoint) < consumedXLogPos {
+		consumedXLogPos = pglogrepl.LSN(req.PersistedSyncState.Checkpoint)
+	}
 
 	for {
 		if time.Now().After(nextStandbyMessageDeadline) ||
@@ -175,12 +223,27 @@ func (p *PostgresCDCSource) consumeStream(
 			}
 		}
 
-		ctx, cancel := context.WithDeadline(p.ctx, nextStandbyMessageDeadline)
+		// a transaction is never split across pulls, so the pull may run over until it commits.
+		receiveDeadline := nextStandbyMessageDeadline
+		if !p.commitLock {
+			if !time.Now().Before(pullDeadline) {
+				log.WithFields(log.Fields{
+					"flowName": req.FlowJobName,
+				}).Infof("max pull duration of %v reached, returning %d accumulated records",
+					maxPullDuration, len(records.Records))
+				return result, nil
+			}
+			if pullDeadline.Before(receiveDeadline) {
+				receiveDeadline = pullDeadline
+			}
+		}
+
+		ctx, cancel := context.WithDeadline(p.ctx, receiveDeadline)
 		rawMsg, err := conn.ReceiveMessage(ctx)
 		cancel()
 		if err != nil && !p.commitLock {
 			if pgconn.Timeout(err) {
-				log.Infof("Idle timeout reached, returning currently accumulated records")
+				log.Infof("Idle timeout or max pull duration reached, returning currently accumulated records")
 				return result, nil
 			} else {
 				return nil, fmt.Errorf("ReceiveMessage failed: %w", err)
@@ -235,67 +298,16 @@ func (p *PostgresCDCSource) consumeStream(
 				records.FirstCheckPointID = int64(xld.WALStart)
 			}
 			if rec != nil {
-				tableName := rec.GetTableName()
-				switch r := rec.(type) {
-				case *model.UpdateRecord:
-					// tableName here is destination tableName.
-					// should be ideally sourceTableName as we are in PullRecords.
-					// will change in future
-					isFullReplica := req.TableNameSchemaMapping[tableName].IsReplicaIdentityFull
-					if isFullReplica {
-						records.Records = append(records.Records, rec)
-					} else {
-						compositePKeyString, err := p.compositePKeyToString(req, rec)
-						if err != nil {
-							return nil, err
-						}
-
-						tablePkeyVal := model.TableWithPkey{
-							TableName:  tableName,
-							PkeyColVal: compositePKeyString,
-						}
-						_, ok := records.TablePKeyLastSeen[tablePkeyVal]
-						if !ok {
-							records.Records = append(records.Records, rec)
-							records.TablePKeyLastSeen[tablePkeyVal] = len(records.Records) - 1
-						} else {
-							oldRec := records.Records[records.TablePKeyLastSeen[tablePkeyVal]]
-							// iterate through unchanged toast cols and set them in new record
-							updatedCols := r.NewItems.UpdateIfNotExists(oldRec.GetItems())
-							for _, col := range updatedCols {
-								delete(r.UnchangedToastColumns, col)
-							}
-							records.Records = append(records.Records, rec)
-							records.TablePKeyLastSeen[tablePkeyVal] = len(records.Records) - 1
-						}
-					}
-				case *model.InsertRecord:
-					isFullReplica := req.TableNameSchemaMapping[tableName].IsReplicaIdentityFull
-					if isFullReplica {
-						records.Records = append(records.Records, rec)
-					} else {
-						compositePKeyString, err := p.compositePKeyToString(req, rec)
-						if err != nil {
-							return nil, err
-						}
-
-						tablePkeyVal := model.TableWithPkey{
-							TableName:  tableName,
-							PkeyColVal: compositePKeyString,
-						}
-						records.Records = append(records.Records, rec)
-						// all columns will be set in insert record, so add it to the map
-						records.TablePKeyLastSeen[tablePkeyVal] = len(records.Records) - 1
-					}
-				case *model.DeleteRecord:
-					records.Records = append(records.Records, rec)
-				case *model.RelationRecord:
-					tableSchemaDelta := r.TableSchemaDelta
-					if len(tableSchemaDelta.AddedColumns) > 0 {
-						log.Infof("Detected schema change for table %s, addedColumns: %v",
-							tableSchemaDelta.SrcTableName, tableSchemaDelta.AddedColumns)
-						result.TableSchemaDeltas = append(result.TableSchemaDeltas, tableSchemaDelta)
-					}
+				flush, err := p.addRecord(req, result, rec)
+				if err != nil {
+					return nil, err
+				}
+				if flush {
+					log.WithFields(log.Fields{
+						"flowName": req.FlowJobName,
+					}).Infof("schema change detected, returning %d records pulled before it",
+						len(records.Records))
+					return result, nil
 				}
 			}
 
@@ -307,6 +319,112 @@ func (p *PostgresCDCSource) consumeStream(
 	}
 }
 
+// addRecord adds a record pulled from the stream to the batch, or the schema change of a relation
+// record to the result. It returns true if the pull should end, because the schema changed and the
+// batch was reset to the last transaction before the change.
+func (p *PostgresCDCSource) addRecord(req *model.PullRecordsRequest, result *model.RecordsWithTableSchemaDelta,
+	rec model.Record) (bool, error) {
+	records := result.RecordBatch
+	tableName := rec.GetTableName()
+	switch r := rec.(type) {
+	case *model.UpdateRecord:
+		// tableName here is destination tableName.
+		// should be ideally sourceTableName as we are in PullRecords.
+		// will change in future
+		isFullReplica := req.TableNameSchemaMapping[tableName].IsReplicaIdentityFull
+		if isFullReplica {
+			records.Records = append(records.Records, rec)
+		} else {
+			compositePKeyString, err := p.compositePKeyToString(req, rec)
+			if err != nil {
+				return false, err
+			}
+
+			tablePkeyVal := model.TableWithPkey{
+				TableName:  tableName,
+				PkeyColVal: compositePKeyString,
+			}
+			_, ok := records.TablePKeyLastSeen[tablePkeyVal]
+			if !ok {
+				records.Records = append(records.Records, rec)
+				records.TablePKeyLastSeen[tablePkeyVal] = len(records.Records) - 1
+			} else {
+				oldRec := records.Records[records.TablePKeyLastSeen[tablePkeyVal]]
+				// iterate through unchanged toast cols and set them in new record
+				updatedCols := r.NewItems.UpdateIfNotExists(oldRec.GetItems())
+				for _, col := range updatedCols {
+					delete(r.UnchangedToastColumns, col)
+				}
+				records.Records = append(records.Records, rec)
+				records.TablePKeyLastSeen[tablePkeyVal] = len(records.Records) - 1
+			}
+		}
+	case *model.InsertRecord:
+		isFullReplica := req.TableNameSchemaMapping[tableName].IsReplicaIdentityFull
+		if isFullReplica {
+			records.Records = append(records.Records, rec)
+		} else {
+			compositePKeyString, err := p.compositePKeyToString(req, rec)
+			if err != nil {
+				return false, err
+			}
+
+			tablePkeyVal := model.TableWithPkey{
+				TableName:  tableName,
+				PkeyColVal: compositePKeyString,
+			}
+			records.Records = append(records.Records, rec)
+			// all columns will be set in insert record, so add it to the map
+			records.TablePKeyLastSeen[tablePkeyVal] = len(records.Records) - 1
+		}
+	case *model.DeleteRecord:
+		records.Records = append(records.Records, rec)
+	case *model.RelationRecord:
+		tableSchemaDelta := r.TableSchemaDelta
+		if len(tableSchemaDelta.AddedColumns) > 0 {
+			log.Infof("Detected schema change for table %s, addedColumns: %v",
+				tableSchemaDelta.SrcTableName, tableSchemaDelta.AddedColumns)
+			result.TableSchemaDeltas = append(result.TableSchemaDeltas, tableSchemaDelta)
+			// a pull already ended at this change, pulling its transaction again must not end the pull again.
+			// otherwise a transaction changing the schema twice would be rewound forever, the first change
+			// pulled again looks like dropping the columns of the second, which then look added again.
+			rel := p.relationMessageMapping[r.RelationID]
+			if req.FlushOnSchemaChange && uint64(r.CheckPointID) > rel.FlushedSchemaChangeLsn {
+				// the records of the transaction with the change are pulled again by the next pull,
+				// the relation mapping already has the new columns by then.
+				rel.FlushedSchemaChangeLsn = uint64(r.CheckPointID)
+				return true, p.resetToTransactionStart(req, records)
+			}
+		}
+	}
+	return false, nil
+}
+
+// resetToTransactionStart drops the records of the transaction being pulled from the batch,
+// so that it ends with the last transaction committed before it.
+func (p *PostgresCDCSource) resetToTransactionStart(req *model.PullRecordsRequest, records *model.RecordBatch) error {
+	records.Records = records.Records[:p.txStartRecords]
+	records.LastCheckPointID = p.txStartCheckPointID
+	records.TablePKeyLastSeen = make(map[model.TableWithPkey]int)
+	for i, rec := range records.Records {
+		switch rec.(type) {
+		case *model.InsertRecord, *model.UpdateRecord:
+			if req.TableNameSchemaMapping[rec.GetTableName()].IsReplicaIdentityFull {
+				continue
+			}
+			compositePKeyString, err := p.compositePKeyToString(req, rec)
+			if err != nil {
+				return err
+			}
+			records.TablePKeyLastSeen[model.TableWithPkey{
+				TableName:  rec.GetTableName(),
+				PkeyColVal: compositePKeyString,
+			}] = i
+		}
+	}
+	return nil
+}
+
 func (p *PostgresCDCSource) processMessage(batch *model.RecordBatch, xld pglogrepl.XLogData) (model.Record, error) {
 	logicalMsg, err := pglogrepl.Parse(xld.WALData)
 	if err != nil {
@@ -318,6 +436,8 @@ func (p *PostgresCDCSource) processMessage(batch *model.RecordBatch, xld pglogre
 		log.Debugf("BeginMessage => FinalLSN: %v, XID: %v", msg.FinalLSN, msg.Xid)
 		log.Debugf("Locking PullRecords at BeginMessage, awaiting CommitMessage")
 		p.commitLock = true
+		p.txStartRecords = len(batch.Records)
+		p.txStartCheckPointID = batch.LastCheckPointID
 	case *pglogrepl.InsertMessage:
 		return p.processInsertMessage(xld.WALStart, msg)
 	case *pglogrepl.UpdateMessage:
@@ -329,7 +449,13 @@ func (p *PostgresCDCSource) processMessage(batch *model.RecordBatch, xld pglogre
 		log.Debugf("CommitMessage => CommitLSN: %v, TransactionEndLSN: %v",
 			msg.CommitLSN, msg.TransactionEndLSN)
 		batch.LastCheckPointID = int64(xld.WALStart)
+		batch.LastCommitTime = msg.CommitTime
 		p.commitLock = false
+		// transactions only touching tables outside the mirror still arrive as BEGIN and COMMIT,
+		// records of a transaction are all added to the batch before its commit is processed.
+		if p.countEmptyTransactions || len(batch.Records) > p.txStartRecords {
+			batch.Transactions++
+		}
 	case *pglogrepl.RelationMessage:
 		// TODO (kaushik): consider persistent state for a mirror job
 		// to be stored somewhere in temporal state. We might need to persist
@@ -342,11 +468,14 @@ func (p *PostgresCDCSource) processMessage(batch *model.RecordBatch, xld pglogre
 			return p.processRelationMessage(xld.WALStart, convertRelationMessageToProto(msg))
 		}
 
+	case *pglogrepl.OriginMessage:
+		// sent before the changes of a transaction replicated from another node, it carries no changes.
+		log.Debugf("OriginMessage => CommitLSN: %v, Name: %s", msg.CommitLSN, msg.Name)
 	case *pglogrepl.TruncateMessage:
 		log.Warnf("TruncateMessage not supported")
 	default:
 		// Ignore other message types
-		log.Warnf("Ignoring message type: %T", reflect.TypeOf(logicalMsg))
+		log.Warnf("Ignoring message type: %T", logicalMsg)
 	}
 
 	return nil, nil
@@ -356,7 +485,7 @@ func (p *PostgresCDCSource) processInsertMessage(
 	lsn pglogrepl.LSN,
 	msg *pglogrepl.InsertMessage,
 ) (model.Record, error) {
-	tableName, exists := p.SrcTableIDNameMapping[msg.RelationID]
+	tableName, exists := p.replicatedTableName(msg.RelationID)
 	if !exists {
 		return nil, nil
 	}
@@ -383,12 +512,22 @@ func (p *PostgresCDCSource) processInsertMessage(
 	}, nil
 }
 
+// replicatedTableName returns the name of the source table with the relation id,
+// and false if the table isn't part of the mirror or its replication is disabled.
+func (p *PostgresCDCSource) replicatedTableName(relID uint32) (string, bool) {
+	tableName, exists := p.SrcTableIDNameMapping[relID]
+	if !exists || p.disabledTables[tableName] {
+		return "", false
+	}
+	return tableName, true
+}
+
 // processUpdateMessage processes an update message and returns an UpdateRecord
 func (p *PostgresCDCSource) processUpdateMessage(
 	lsn pglogrepl.LSN,
 	msg *pglogrepl.UpdateMessage,
 ) (model.Record, error) {
-	tableName, exists := p.SrcTableIDNameMapping[msg.RelationID]
+	tableName, exists := p.replicatedTableName(msg.RelationID)
 	if !exists {
 		return nil, nil
 	}
@@ -427,7 +566,7 @@ func (p *PostgresCDCSource) processDeleteMessage(
 	lsn pglogrepl.LSN,
 	msg *pglogrepl.DeleteMessage,
 ) (model.Record, error) {
-	tableName, exists := p.SrcTableIDNameMapping[msg.RelationID]
+	tableName, exists := p.replicatedTableName(msg.RelationID)
 	if !exists {
 		return nil, nil
 	}
@@ -481,7 +620,7 @@ func (p *PostgresCDCSource) convertTupleToMap(
 			val := &qvalue.QValue{Kind: qvalue.QValueKindInvalid, Value: nil}
 			items.AddColumn(colName, val)
 		case 't': // text
-			/* bytea also appears here as a hex */
+			/* bytea also appears here, as hex or escaped depending on bytea_output */
 			data, err := p.decodeColumnData(col.Data, rel.Columns[idx].DataType, pgtype.TextFormatCode)
 			if err != nil {
 				return nil, nil, fmt.Errorf("error decoding text column data: %w", err)
@@ -503,9 +642,35 @@ func (p *PostgresCDCSource) convertTupleToMap(
 }
 
 func (p *PostgresCDCSource) decodeColumnData(data []byte, dataType uint32, formatCode int16) (*qvalue.QValue, error) {
+	// relation messages carry the domain oid, decode the value as its base type instead.
+	if baseType, ok := p.domainBaseTypes[dataType]; ok {
+		dataType = baseType
+	}
+	// json is sent as text in both formats, decoding it would lose key order and whitespace.
+	if p.jsonAsText && dataType == pgtype.JSONOID {
+		return &qvalue.QValue{Kind: qvalue.QValueKindString, Value: string(data)}, nil
+	}
+	if p.compositeTypes.isComposite(dataType) {
+		return p.compositeTypes.decode(dataType, formatCode, data)
+	}
+	// pgtype only decodes bytea text in the hex format, bytea_output of the source may be escape instead.
+	if dataType == pgtype.ByteaOID && formatCode == pgtype.TextFormatCode {
+		rawBytes, err := decodeByteaText(data)
+		if err != nil {
+			return nil, err
+		}
+		return &qvalue.QValue{Kind: qvalue.QValueKindBytes, Value: rawBytes}, nil
+	}
 	var parsedData any
 	var err error
 	if dt, ok := p.typeMap.TypeForOID(dataType); ok {
+		if isRangeOID(dataType) {
+			parsedData, err = dt.Codec.DecodeValue(p.typeMap, dataType, formatCode, data)
+			if err != nil {
+				return nil, err
+			}
+			return parseRange(dataType, parsedData, p.rangesAsText)
+		}
 		if dt.Name == "uuid" {
 			// below is required to decode uuid to string
 			parsedData, err = dt.Codec.DecodeDatabaseSQLValue(p.typeMap, dataType, pgtype.TextFormatCode, data)
@@ -592,9 +757,15 @@ func (p *PostgresCDCSource) processRelationMessage(
 	for _, column := range currRel.Columns {
 		// not present in previous relation message, but in current one, so added.
 		if prevRelMap[column.Name] == nil {
-			qKind := postgresOIDToQValueKind(column.DataType)
-			if qKind == qvalue.QValueKindInvalid {
-				typeName, ok := p.customTypeMapping[column.DataType]
+			dataType := column.DataType
+			if baseType, ok := p.domainBaseTypes[dataType]; ok {
+				dataType = baseType
+			}
+			qKind := postgresColumnQValueKind(dataType, p.jsonAsText, p.rangesAsText)
+			if p.compositeTypes.isComposite(dataType) {
+				qKind = qvalue.QValueKindJSON
+			} else if qKind == qvalue.QValueKindInvalid {
+				typeName, ok := p.customTypeMapping[dataType]
 				if ok {
 					qKind = customTypeToQKind(typeName)
 				}
@@ -618,10 +789,12 @@ func (p *PostgresCDCSource) processRelationMessage(
 		}
 	}
 
+	currRel.FlushedSchemaChangeLsn = prevRel.FlushedSchemaChangeLsn
 	p.relationMessageMapping[currRel.RelationId] = currRel
 	return &model.RelationRecord{
 		TableSchemaDelta: schemaDelta,
 		CheckPointID:     int64(lsn),
+		RelationID:       currRel.RelationId,
 	}, nil
 }
 
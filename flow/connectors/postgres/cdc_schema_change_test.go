@@ -0,0 +1,212 @@
+package connpostgres
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func relationMessage(relID uint32, namespace string, relationName string, columnNames ...string) []byte {
+	msg := []byte{'R'}
+	msg = binary.BigEndian.AppendUint32(msg, relID)
+	msg = append(append(msg, namespace...), 0)
+	msg = append(append(msg, relationName...), 0)
+	msg = append(msg, 'd')
+	msg = binary.BigEndian.AppendUint16(msg, uint16(len(columnNames)))
+	for _, columnName := range columnNames {
+		msg = append(msg, 0)
+		msg = append(append(msg, columnName...), 0)
+		msg = binary.BigEndian.AppendUint32(msg, pgtype.Int8OID)
+		msg = binary.BigEndian.AppendUint32(msg, 0xFFFFFFFF)
+	}
+	return msg
+}
+
+func TestFlushOnSchemaChange(t *testing.T) {
+	commitTime := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	// a column is added to the table between two transactions inserting into it
+	walMessages := [][]byte{
+		beginMessage(100, commitTime, 1), insertMessage(1, "1"), commitMessage(100, commitTime),
+		beginMessage(200, commitTime, 2), relationMessage(1, "public", "t", "id", "val"),
+		insertMessage(1, "2", "20"), commitMessage(200, commitTime),
+	}
+
+	for _, flushOnSchemaChange := range []bool{false, true} {
+		cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{
+			AppContext:            context.Background(),
+			SrcTableIDNameMapping: map[uint32]string{1: "public.t"},
+			TableNameMapping:      map[string]string{"public.t": "public.t_dst"},
+			RelationMessageMapping: model.RelationMessageMapping{
+				1: {RelationId: 1, RelationName: "t", Columns: []*protos.RelationMessageColumn{
+					{Name: "id", DataType: pgtype.Int8OID},
+				}},
+			},
+		}, nil)
+		require.NoError(t, err)
+		req := &model.PullRecordsRequest{
+			TableNameSchemaMapping: map[string]*protos.TableSchema{
+				"public.t_dst": {
+					TableIdentifier:   "public.t_dst",
+					Columns:           map[string]string{"id": string(qvalue.QValueKindInt64)},
+					PrimaryKeyColumns: []string{"id"},
+				},
+			},
+			FlushOnSchemaChange: flushOnSchemaChange,
+		}
+		result := &model.RecordsWithTableSchemaDelta{
+			RecordBatch: &model.RecordBatch{TablePKeyLastSeen: make(map[model.TableWithPkey]int)},
+		}
+
+		// pulls the messages the way consumeStream does
+		flushed := false
+		for i, walData := range walMessages {
+			rec, err := cdc.processMessage(result.RecordBatch, pglogrepl.XLogData{
+				WALStart: pglogrepl.LSN(1000 + i),
+				WALData:  walData,
+			})
+			require.NoError(t, err)
+			if rec != nil {
+				flushed, err = cdc.addRecord(req, result, rec)
+				require.NoError(t, err)
+				if flushed {
+					break
+				}
+			}
+			result.RecordBatch.LastCheckPointID = int64(1000 + i)
+		}
+
+		require.Equal(t, flushOnSchemaChange, flushed)
+		require.Len(t, result.TableSchemaDeltas, 1)
+		require.Equal(t, "val", result.TableSchemaDeltas[0].AddedColumns[0].ColumnName)
+		records := result.RecordBatch.Records
+		if flushOnSchemaChange {
+			// only the rows from before the column was added are synced with the schema change,
+			// the batch ends with the commit of their transaction
+			require.Len(t, records, 1)
+			require.Equal(t, int64(1002), result.RecordBatch.LastCheckPointID)
+			require.Len(t, result.RecordBatch.TablePKeyLastSeen, 1)
+			continue
+		}
+		require.Len(t, records, 2)
+		val, err := records[1].GetItems().GetValueByColName("val")
+		require.NoError(t, err)
+		require.Equal(t, int64(20), val.Value)
+	}
+}
+
+// pullAfter pulls the messages after lastCheckPointID with the relation mapping the previous pull returned,
+// the way consumeStream does, until the pull ends at a schema change or runs out of messages. Messages are
+// at LSN 1000 and up.
+func pullAfter(t *testing.T, walMessages [][]byte, lastCheckPointID int64,
+	relationMessageMapping model.RelationMessageMapping) (*model.RecordsWithTableSchemaDelta, bool) {
+	// the mapping is serialized between pulls
+	mapping := make(model.RelationMessageMapping, len(relationMessageMapping))
+	for relID, rel := range relationMessageMapping {
+		mapping[relID] = proto.Clone(rel).(*protos.RelationMessage)
+	}
+	cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{
+		AppContext:             context.Background(),
+		SrcTableIDNameMapping:  map[uint32]string{1: "public.t"},
+		TableNameMapping:       map[string]string{"public.t": "public.t_dst"},
+		RelationMessageMapping: mapping,
+	}, nil)
+	require.NoError(t, err)
+	req := &model.PullRecordsRequest{
+		TableNameSchemaMapping: map[string]*protos.TableSchema{
+			"public.t_dst": {
+				TableIdentifier:   "public.t_dst",
+				Columns:           map[string]string{"id": string(qvalue.QValueKindInt64)},
+				PrimaryKeyColumns: []string{"id"},
+			},
+		},
+		FlushOnSchemaChange: true,
+	}
+	result := &model.RecordsWithTableSchemaDelta{
+		RecordBatch: &model.RecordBatch{
+			TablePKeyLastSeen: make(map[model.TableWithPkey]int),
+			LastCheckPointID:  lastCheckPointID,
+		},
+		RelationMessageMapping: mapping,
+	}
+
+	for i := int(lastCheckPointID-1000) + 1; i < len(walMessages); i++ {
+		rec, err := cdc.processMessage(result.RecordBatch, pglogrepl.XLogData{
+			WALStart: pglogrepl.LSN(1000 + i),
+			WALData:  walMessages[i],
+		})
+		require.NoError(t, err)
+		if rec != nil {
+			flushed, err := cdc.addRecord(req, result, rec)
+			require.NoError(t, err)
+			if flushed {
+				return result, true
+			}
+		}
+		result.RecordBatch.LastCheckPointID = int64(1000 + i)
+	}
+	return result, false
+}
+
+func TestFlushOnSchemaChangePullsAgain(t *testing.T) {
+	commitTime := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	// a transaction adds two columns to the table, inserting rows after each
+	walMessages := [][]byte{
+		beginMessage(100, commitTime, 1), insertMessage(1, "1"), commitMessage(100, commitTime),
+		beginMessage(200, commitTime, 2),
+		relationMessage(1, "public", "t", "id", "val"), insertMessage(1, "2", "20"),
+		relationMessage(1, "public", "t", "id", "val", "val2"), insertMessage(1, "3", "30", "300"),
+		commitMessage(200, commitTime),
+		beginMessage(300, commitTime, 3), insertMessage(1, "4", "40", "400"), commitMessage(300, commitTime),
+	}
+
+	relationMessageMapping := model.RelationMessageMapping{
+		1: {RelationId: 1, RelationName: "t", Columns: []*protos.RelationMessageColumn{
+			{Name: "id", DataType: pgtype.Int8OID},
+		}},
+	}
+	lastCheckPointID := int64(999)
+	var pulls []*model.RecordsWithTableSchemaDelta
+	for flushed := true; flushed; {
+		require.Less(t, len(pulls), 5, "pulls keep ending at the same schema change")
+		var result *model.RecordsWithTableSchemaDelta
+		result, flushed = pullAfter(t, walMessages, lastCheckPointID, relationMessageMapping)
+		pulls = append(pulls, result)
+		lastCheckPointID = result.RecordBatch.LastCheckPointID
+		relationMessageMapping = result.RelationMessageMapping
+	}
+
+	// the first pull ends at the first column, the second at the second one, both before the transaction
+	require.Len(t, pulls, 3)
+	require.Len(t, pulls[0].RecordBatch.Records, 1)
+	require.Equal(t, int64(1002), pulls[0].RecordBatch.LastCheckPointID)
+	require.Equal(t, "val", pulls[0].TableSchemaDeltas[0].AddedColumns[0].ColumnName)
+	require.Empty(t, pulls[1].RecordBatch.Records)
+	require.Equal(t, int64(1002), pulls[1].RecordBatch.LastCheckPointID)
+	require.Equal(t, "val2", pulls[1].TableSchemaDeltas[0].AddedColumns[0].ColumnName)
+
+	// the last pull has the rows of the transaction in the schema they were written in
+	records := pulls[2].RecordBatch.Records
+	require.Len(t, records, 3)
+	require.Equal(t, int64(len(walMessages)+999), pulls[2].RecordBatch.LastCheckPointID)
+	for i, expected := range []map[string]int64{
+		{"id": 2, "val": 20},
+		{"id": 3, "val": 30, "val2": 300},
+		{"id": 4, "val": 40, "val2": 400},
+	} {
+		require.Equal(t, len(expected), records[i].GetItems().Len())
+		for column, value := range expected {
+			qv, err := records[i].GetItems().GetValueByColName(column)
+			require.NoError(t, err)
+			require.Equal(t, value, qv.Value)
+		}
+	}
+}
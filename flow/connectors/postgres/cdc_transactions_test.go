@@ -0,0 +1,93 @@
+package connpostgres
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+// microseconds between the unix and the Postgres epoch, commit times are sent relative to the latter.
+const postgresEpochMicros = 946684800000000
+
+func beginMessage(lsn uint64, commitTime time.Time, xid uint32) []byte {
+	msg := []byte{'B'}
+	msg = binary.BigEndian.AppendUint64(msg, lsn)
+	msg = binary.BigEndian.AppendUint64(msg, uint64(commitTime.UnixMicro()-postgresEpochMicros))
+	return binary.BigEndian.AppendUint32(msg, xid)
+}
+
+func commitMessage(lsn uint64, commitTime time.Time) []byte {
+	msg := []byte{'C', 0}
+	msg = binary.BigEndian.AppendUint64(msg, lsn)
+	msg = binary.BigEndian.AppendUint64(msg, lsn+8)
+	return binary.BigEndian.AppendUint64(msg, uint64(commitTime.UnixMicro()-postgresEpochMicros))
+}
+
+func insertMessage(relID uint32, values ...string) []byte {
+	msg := []byte{'I'}
+	msg = binary.BigEndian.AppendUint32(msg, relID)
+	msg = append(msg, 'N')
+	msg = binary.BigEndian.AppendUint16(msg, uint16(len(values)))
+	for _, value := range values {
+		msg = append(msg, 't')
+		msg = binary.BigEndian.AppendUint32(msg, uint32(len(value)))
+		msg = append(msg, value...)
+	}
+	return msg
+}
+
+func TestEmptyTransactionsAreNotCounted(t *testing.T) {
+	commitTime := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	// two empty transactions, one inserting into the mirrored table and one inserting
+	// into a table that isn't part of the mirror
+	walMessages := [][]byte{
+		beginMessage(100, commitTime, 1), commitMessage(100, commitTime),
+		beginMessage(200, commitTime, 2), commitMessage(200, commitTime),
+		beginMessage(300, commitTime, 3), insertMessage(1, "1"), commitMessage(300, commitTime),
+		beginMessage(400, commitTime, 4), insertMessage(2, "2"), commitMessage(400, commitTime.Add(time.Second)),
+	}
+
+	for _, countEmptyTransactions := range []bool{false, true} {
+		cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{
+			AppContext:            context.Background(),
+			SrcTableIDNameMapping: map[uint32]string{1: "public.t"},
+			TableNameMapping:      map[string]string{"public.t": "public.t_dst"},
+			RelationMessageMapping: model.RelationMessageMapping{
+				1: {RelationId: 1, RelationName: "t", Columns: []*protos.RelationMessageColumn{
+					{Name: "id", DataType: pgtype.Int8OID},
+				}},
+			},
+			CountEmptyTransactions: countEmptyTransactions,
+		}, nil)
+		require.NoError(t, err)
+
+		batch := &model.RecordBatch{}
+		for i, walData := range walMessages {
+			rec, err := cdc.processMessage(batch, pglogrepl.XLogData{
+				WALStart: pglogrepl.LSN(1000 + i),
+				WALData:  walData,
+			})
+			require.NoError(t, err)
+			if rec != nil {
+				batch.Records = append(batch.Records, rec)
+			}
+		}
+
+		require.Len(t, batch.Records, 1)
+		require.False(t, cdc.commitLock)
+		require.Equal(t, int64(1000+len(walMessages)-1), batch.LastCheckPointID)
+		require.True(t, commitTime.Add(time.Second).Equal(batch.LastCommitTime))
+		if countEmptyTransactions {
+			require.Equal(t, int64(4), batch.Transactions)
+		} else {
+			require.Equal(t, int64(1), batch.Transactions)
+		}
+	}
+}
@@ -1,26 +1,34 @@
 package connpostgres
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
 	"github.com/jackc/pglogrepl"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
+// rollbackTimeout bounds rolling back a transaction, which normally returns right away.
+const rollbackTimeout = 30 * time.Second
+
 //nolint:stylecheck
 const (
 	internalSchema            = "_peerdb_internal"
 	mirrorJobsTableIdentifier = "peerdb_mirror_jobs"
 	createMirrorJobsTableSQL  = `CREATE TABLE IF NOT EXISTS %s.%s(mirror_job_name TEXT PRIMARY KEY,
-		lsn_offset BIGINT NOT NULL,sync_batch_id BIGINT NOT NULL,normalize_batch_id BIGINT NOT NULL)`
+		lsn_offset BIGINT NOT NULL,sync_batch_id BIGINT NOT NULL,normalize_batch_id BIGINT)`
 	rawTablePrefix          = "_peerdb_raw"
 	createInternalSchemaSQL = "CREATE SCHEMA IF NOT EXISTS %s"
 	createRawTableSQL       = `CREATE TABLE IF NOT EXISTS %s.%s(_peerdb_uid TEXT NOT NULL,
@@ -30,23 +38,41 @@ const (
 	createRawTableBatchIDIndexSQL  = "CREATE INDEX IF NOT EXISTS %s_batchid_idx ON %s.%s(_peerdb_batch_id)"
 	createRawTableDstTableIndexSQL = "CREATE INDEX IF NOT EXISTS %s_dst_table_idx ON %s.%s(_peerdb_destination_table_name)"
 
+	getRawTableSchemaSQL = `SELECT column_name,data_type FROM information_schema.columns
+	 WHERE table_schema=$1 AND table_name=$2 ORDER BY ordinal_position`
+
+	countRawTableRowsInBatchesSQL = "SELECT COUNT(*) FROM %s.%s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2"
+
 	getLastOffsetSQL            = "SELECT lsn_offset FROM %s.%s WHERE mirror_job_name=$1"
 	getLastSyncBatchID_SQL      = "SELECT sync_batch_id FROM %s.%s WHERE mirror_job_name=$1"
 	getLastNormalizeBatchID_SQL = "SELECT normalize_batch_id FROM %s.%s WHERE mirror_job_name=$1"
 	createNormalizedTableSQL    = "CREATE TABLE IF NOT EXISTS %s(%s)"
 
+	// normalize_batch_id is NULL until the first normalize of a mirror, which mirror jobs tables created
+	// before have to allow.
+	dropNormalizeBatchIDNotNullSQL = "ALTER TABLE %s.%s ALTER COLUMN normalize_batch_id DROP NOT NULL"
+
 	insertJobMetadataSQL                 = "INSERT INTO %s.%s VALUES ($1,$2,$3,$4)"
 	checkIfJobMetadataExistsSQL          = "SELECT COUNT(1)::TEXT::BOOL FROM %s.%s WHERE mirror_job_name=$1"
 	updateMetadataForSyncRecordsSQL      = "UPDATE %s.%s SET lsn_offset=$1, sync_batch_id=$2 WHERE mirror_job_name=$3"
+	updateSyncBatchIDSQL                 = "UPDATE %s.%s SET sync_batch_id=$1 WHERE mirror_job_name=$2"
 	updateMetadataForNormalizeRecordsSQL = "UPDATE %s.%s SET normalize_batch_id=$1 WHERE mirror_job_name=$2"
 
+	// guarded variants of the sync metadata updates, only taking effect if no other sync recorded a
+	// batch since the sync batch id was read.
+	insertJobMetadataIfAbsentSQL           = "INSERT INTO %s.%s VALUES ($1,$2,$3,$4) ON CONFLICT DO NOTHING"
+	guardedUpdateMetadataForSyncRecordsSQL = `UPDATE %s.%s SET lsn_offset=$1, sync_batch_id=$2
+	 WHERE mirror_job_name=$3 AND sync_batch_id=$4`
+	guardedUpdateSyncBatchIDSQL = `UPDATE %s.%s SET sync_batch_id=$1
+	 WHERE mirror_job_name=$2 AND sync_batch_id=$3`
+
 	getTableNameToUnchangedToastColsSQL = `SELECT _peerdb_destination_table_name,
 	ARRAY_AGG(DISTINCT _peerdb_unchanged_toast_columns) FROM %s.%s WHERE
 	_peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 GROUP BY _peerdb_destination_table_name`
 	srcTableName      = "src"
 	mergeStatementSQL = `WITH src_rank AS (
 		SELECT _peerdb_data,_peerdb_record_type,_peerdb_unchanged_toast_columns,
-		RANK() OVER (PARTITION BY %s ORDER BY _peerdb_timestamp DESC) AS _peerdb_rank
+		RANK() OVER (PARTITION BY %s ORDER BY %s) AS _peerdb_rank
 		FROM %s.%s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 AND _peerdb_destination_table_name=$3
 	)
 	MERGE INTO %s dst
@@ -59,20 +85,24 @@ const (
 	DELETE`
 	fallbackUpsertStatementSQL = `WITH src_rank AS (
 		SELECT _peerdb_data,_peerdb_record_type,_peerdb_unchanged_toast_columns,
-		RANK() OVER (PARTITION BY %s ORDER BY _peerdb_timestamp DESC) AS _peerdb_rank
+		RANK() OVER (PARTITION BY %s ORDER BY %s) AS _peerdb_rank
 		FROM %s.%s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 AND _peerdb_destination_table_name=$3
 	)
 	INSERT INTO %s (%s) SELECT %s FROM src_rank WHERE _peerdb_rank=1 AND _peerdb_record_type!=2
 	ON CONFLICT (%s) DO UPDATE SET %s`
 	fallbackDeleteStatementSQL = `WITH src_rank AS (
 		SELECT _peerdb_data,_peerdb_record_type,_peerdb_unchanged_toast_columns,
-		RANK() OVER (PARTITION BY %s ORDER BY _peerdb_timestamp DESC) AS _peerdb_rank
+		RANK() OVER (PARTITION BY %s ORDER BY %s) AS _peerdb_rank
 		FROM %s.%s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 AND _peerdb_destination_table_name=$3
 	)
 	DELETE FROM %s USING src_rank WHERE %s AND src_rank._peerdb_rank=1 AND src_rank._peerdb_record_type=2`
 
 	dropTableIfExistsSQL = "DROP TABLE IF EXISTS %s.%s"
 	deleteJobMetadataSQL = "DELETE FROM %s.%s WHERE MIRROR_JOB_NAME=$1"
+
+	// values of pg_class.relreplident
+	replicaIdentityFull    = "f"
+	replicaIdentityNothing = "n"
 )
 
 // getRelIDForTable returns the relation ID for a table.
@@ -96,14 +126,77 @@ func (c *PostgresConnector) isTableFullReplica(schemaTable *SchemaTable) (bool,
 		return false, fmt.Errorf("failed to get relation id for table %s: %w", schemaTable, relIDErr)
 	}
 
+	replicaIdentity, err := c.getReplicaIdentity(relID)
+	if err != nil {
+		return false, fmt.Errorf("error getting replica identity for table %s: %w", schemaTable, err)
+	}
+	return replicaIdentity == replicaIdentityFull, nil
+}
+
+// getReplicaIdentity returns the relreplident of a table, one of the replicaIdentity constants.
+func (c *PostgresConnector) getReplicaIdentity(relID uint32) (string, error) {
 	var replicaIdentity rune
 	err := c.pool.QueryRow(c.ctx,
 		`SELECT relreplident FROM pg_class WHERE oid = $1;`,
 		relID).Scan(&replicaIdentity)
 	if err != nil {
-		return false, fmt.Errorf("error getting replica identity for table %s: %w", schemaTable, err)
+		return "", err
+	}
+	return string(replicaIdentity), nil
+}
+
+// getReplicaIdentityForTable returns the relreplident of the table with the given schema qualified name.
+func (c *PostgresConnector) getReplicaIdentityForTable(tableName string) (string, error) {
+	schemaTable, err := parseSchemaTable(tableName)
+	if err != nil {
+		return "", err
+	}
+	relID, err := c.getRelIDForTable(schemaTable)
+	if err != nil {
+		return "", fmt.Errorf("failed to get relation id for table %s: %w", schemaTable, err)
+	}
+	replicaIdentity, err := c.getReplicaIdentity(relID)
+	if err != nil {
+		return "", fmt.Errorf("error getting replica identity for table %s: %w", schemaTable, err)
+	}
+	return replicaIdentity, nil
+}
+
+// handleReplicaIdentityNothing applies the policy to a table whose replica identity is NOTHING.
+func (c *PostgresConnector) handleReplicaIdentityNothing(schemaTable *SchemaTable, relID uint32,
+	policy protos.ReplicaIdentityNothingPolicy) error {
+	switch policy {
+	case protos.ReplicaIdentityNothingPolicy_REPLICA_IDENTITY_NOTHING_POLICY_ALLOW:
+		log.Warnf("table %s has REPLICA IDENTITY NOTHING, only its inserts are published and replicated",
+			schemaTable)
+		return nil
+	case protos.ReplicaIdentityNothingPolicy_REPLICA_IDENTITY_NOTHING_POLICY_SET_IDENTITY:
+		var hasPrimaryKey bool
+		err := c.pool.QueryRow(c.ctx,
+			`SELECT EXISTS(SELECT 1 FROM pg_index WHERE indrelid = $1 AND indisprimary)`,
+			relID).Scan(&hasPrimaryKey)
+		if err != nil {
+			return fmt.Errorf("error checking primary key of table %s: %w", schemaTable, err)
+		}
+		replicaIdentity := "DEFAULT"
+		if !hasPrimaryKey {
+			replicaIdentity = "FULL"
+		}
+		// the table was looked up by its lowercased name, alter the same table.
+		_, err = c.pool.Exec(c.ctx, fmt.Sprintf("ALTER TABLE %s REPLICA IDENTITY %s",
+			pgx.Identifier{strings.ToLower(schemaTable.Schema), strings.ToLower(schemaTable.Table)}.Sanitize(),
+			replicaIdentity))
+		if err != nil {
+			return fmt.Errorf("error setting replica identity of table %s to %s: %w",
+				schemaTable, replicaIdentity, err)
+		}
+		log.Infof("set replica identity of table %s from NOTHING to %s", schemaTable, replicaIdentity)
+		return nil
+	default:
+		return fmt.Errorf("table %s has REPLICA IDENTITY NOTHING, so its updates and deletes cannot be "+
+			"replicated. set its replica identity to DEFAULT or FULL, or choose another replica identity policy",
+			schemaTable)
 	}
-	return string(replicaIdentity) == "f", nil
 }
 
 // getPrimaryKeyColumns for table returns the primary key column for a given table
@@ -181,24 +274,38 @@ func (c *PostgresConnector) checkSlotAndPublication(slot string, publication str
 		slotExists = true
 	}
 
-	// Check if the publication exists
+	publicationExists, err = c.publicationExists(publication)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SlotCheckResult{
+		SlotExists:        slotExists,
+		PublicationExists: publicationExists,
+	}, nil
+}
+
+// publicationExists checks if the publication exists.
+func (c *PostgresConnector) publicationExists(publication string) (bool, error) {
 	var pubName string
-	err = c.pool.QueryRow(c.ctx,
+	err := c.pool.QueryRow(c.ctx,
 		"SELECT pubname FROM pg_publication WHERE pubname = $1",
 		publication).Scan(&pubName)
 	if err != nil {
 		// check if the error is a "no rows" error
 		if err != pgx.ErrNoRows {
-			return nil, fmt.Errorf("error checking for publication - %s: %w", publication, err)
+			return false, fmt.Errorf("error checking for publication - %s: %w", publication, err)
 		}
-	} else {
-		publicationExists = true
+		return false, nil
 	}
+	return true, nil
+}
 
-	return &SlotCheckResult{
-		SlotExists:        slotExists,
-		PublicationExists: publicationExists,
-	}, nil
+// insertOnlyPublicationName returns the name of the publication of the tables with REPLICA IDENTITY
+// NOTHING that a mirror replicates. A publication publishing the updates and deletes of such a table
+// fails them on the source, so these tables are published by a publication of their inserts only.
+func insertOnlyPublicationName(publication string) string {
+	return publication + "_insert_only"
 }
 
 // createSlotAndPublication creates the replication slot and publication.
@@ -215,21 +322,42 @@ func (c *PostgresConnector) createSlotAndPublication(
 		expecting tablenames to be schema qualified
 	*/
 	srcTableNames := make([]string, 0, len(tableNameMapping))
+	insertOnlyTableNames := make([]string, 0)
 	for srcTableName := range tableNameMapping {
 		if len(strings.Split(srcTableName, ".")) != 2 {
 			return fmt.Errorf("source tables identifier is invalid: %v", srcTableName)
 		}
-		srcTableNames = append(srcTableNames, srcTableName)
+		// tables still with REPLICA IDENTITY NOTHING were allowed by EnsurePullability.
+		replicaIdentity, err := c.getReplicaIdentityForTable(srcTableName)
+		if err != nil {
+			return err
+		}
+		if replicaIdentity == replicaIdentityNothing {
+			insertOnlyTableNames = append(insertOnlyTableNames, srcTableName)
+		} else {
+			srcTableNames = append(srcTableNames, srcTableName)
+		}
 	}
-	tableNameString := strings.Join(srcTableNames, ", ")
 
 	if !s.PublicationExists {
 		// Create the publication to help filter changes only for the given tables
-		stmt := fmt.Sprintf("CREATE PUBLICATION %s FOR TABLE %s", publication, tableNameString)
+		stmt := fmt.Sprintf("CREATE PUBLICATION %s", publication)
+		if len(srcTableNames) > 0 {
+			stmt += fmt.Sprintf(" FOR TABLE %s", strings.Join(srcTableNames, ", "))
+		}
 		_, err := c.pool.Exec(c.ctx, stmt)
 		if err != nil {
 			log.Warnf("Error creating publication '%s': %v", publication, err)
 		}
+
+		if len(insertOnlyTableNames) > 0 {
+			insertOnlyPublication := insertOnlyPublicationName(publication)
+			_, err = c.pool.Exec(c.ctx, fmt.Sprintf("CREATE PUBLICATION %s FOR TABLE %s WITH (publish = 'insert')",
+				insertOnlyPublication, strings.Join(insertOnlyTableNames, ", ")))
+			if err != nil {
+				return fmt.Errorf("error creating publication %s: %w", insertOnlyPublication, err)
+			}
+		}
 	}
 
 	// create slot only after we succeeded in creating publication.
@@ -298,6 +426,15 @@ func (c *PostgresConnector) createInternalSchema(createSchemaTx pgx.Tx) error {
 	return nil
 }
 
+// rollbackTx rolls back a transaction under its own context, so that a transaction started under a
+// context that got cancelled, like that of a cancelled activity, is still rolled back cleanly
+// instead of the rollback failing and the connection being closed mid-transaction.
+func rollbackTx(tx pgx.Tx) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rollbackTimeout)
+	defer cancel()
+	return tx.Rollback(ctx)
+}
+
 func getRawTableIdentifier(jobName string) string {
 	jobName = regexp.MustCompile("[^a-zA-Z0-9]+").ReplaceAllString(jobName, "_")
 	return fmt.Sprintf("%s_%s", rawTablePrefix, strings.ToLower(jobName))
@@ -355,16 +492,20 @@ func (c *PostgresConnector) getLastNormalizeBatchID(jobName string) (int64, erro
 	}
 	defer rows.Close()
 
-	var result int64
+	var result pgtype.Int8
 	if !rows.Next() {
-		log.Warnf("No row found for job %s, returning 0", jobName)
-		return 0, nil
+		log.Warnf("No row found for job %s, no batch was normalized yet", jobName)
+		return model.NoNormalizedBatchID, nil
 	}
 	err = rows.Scan(&result)
 	if err != nil {
 		return 0, fmt.Errorf("error while reading result row: %w", err)
 	}
-	return result, nil
+	if !result.Valid {
+		log.Infof("no batch of job %s was normalized yet", jobName)
+		return model.NoNormalizedBatchID, nil
+	}
+	return result.Int64, nil
 }
 
 func (c *PostgresConnector) jobMetadataExists(jobName string) (bool, error) {
@@ -395,19 +536,30 @@ func (c *PostgresConnector) majorVersionCheck(majorVersion int) (bool, error) {
 }
 
 func (c *PostgresConnector) updateSyncMetadata(flowJobName string, lastCP int64, syncBatchID int64,
-	syncRecordsTx pgx.Tx) error {
+	deferCheckpoint bool, guardSyncBatchID bool, syncRecordsTx pgx.Tx) error {
 	jobMetadataExists, err := c.jobMetadataExists(flowJobName)
 	if err != nil {
 		return fmt.Errorf("failed to get sync status for flow job: %w", err)
 	}
 
+	if guardSyncBatchID {
+		return c.updateSyncMetadataGuarded(flowJobName, lastCP, syncBatchID, deferCheckpoint,
+			jobMetadataExists, syncRecordsTx)
+	}
 	if !jobMetadataExists {
 		_, err := syncRecordsTx.Exec(c.ctx,
 			fmt.Sprintf(insertJobMetadataSQL, internalSchema, mirrorJobsTableIdentifier),
-			flowJobName, lastCP, syncBatchID, 0)
+			flowJobName, lastCP, syncBatchID, nil)
 		if err != nil {
 			return fmt.Errorf("failed to insert flow job status: %w", err)
 		}
+	} else if deferCheckpoint {
+		_, err := syncRecordsTx.Exec(c.ctx,
+			fmt.Sprintf(updateSyncBatchIDSQL, internalSchema, mirrorJobsTableIdentifier),
+			syncBatchID, flowJobName)
+		if err != nil {
+			return fmt.Errorf("failed to update flow job status: %w", err)
+		}
 	} else {
 		_, err := syncRecordsTx.Exec(c.ctx,
 			fmt.Sprintf(updateMetadataForSyncRecordsSQL, internalSchema, mirrorJobsTableIdentifier),
@@ -420,6 +572,35 @@ func (c *PostgresConnector) updateSyncMetadata(flowJobName string, lastCP int64,
 	return nil
 }
 
+// updateSyncMetadataGuarded records the batch only if the sync batch ID is still the one before it.
+// A concurrent sync of the mirror holds the row lock until it commits, after which the update no
+// longer matches and this sync fails with ErrSyncBatchIDConflict instead of recording the same batch ID.
+func (c *PostgresConnector) updateSyncMetadataGuarded(flowJobName string, lastCP int64, syncBatchID int64,
+	deferCheckpoint bool, jobMetadataExists bool, syncRecordsTx pgx.Tx) error {
+	var tag pgconn.CommandTag
+	var err error
+	if !jobMetadataExists {
+		tag, err = syncRecordsTx.Exec(c.ctx,
+			fmt.Sprintf(insertJobMetadataIfAbsentSQL, internalSchema, mirrorJobsTableIdentifier),
+			flowJobName, lastCP, syncBatchID, nil)
+	} else if deferCheckpoint {
+		tag, err = syncRecordsTx.Exec(c.ctx,
+			fmt.Sprintf(guardedUpdateSyncBatchIDSQL, internalSchema, mirrorJobsTableIdentifier),
+			syncBatchID, flowJobName, syncBatchID-1)
+	} else {
+		tag, err = syncRecordsTx.Exec(c.ctx,
+			fmt.Sprintf(guardedUpdateMetadataForSyncRecordsSQL, internalSchema, mirrorJobsTableIdentifier),
+			lastCP, syncBatchID, flowJobName, syncBatchID-1)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update flow job status: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: batch %d of mirror %s", model.ErrSyncBatchIDConflict, syncBatchID, flowJobName)
+	}
+	return nil
+}
+
 func (c *PostgresConnector) updateNormalizeMetadata(flowJobName string, normalizeBatchID int64,
 	normalizeRecordsTx pgx.Tx) error {
 	jobMetadataExists, err := c.jobMetadataExists(flowJobName)
@@ -470,17 +651,20 @@ func (c *PostgresConnector) getTableNametoUnchangedCols(flowJobName string, sync
 }
 
 func (c *PostgresConnector) generateNormalizeStatements(destinationTableIdentifier string,
-	unchangedToastColumns []string, rawTableIdentifier string, supportsMerge bool) []string {
+	unchangedToastColumns []string, rawTableIdentifier string, supportsMerge bool,
+	nullsOrdering protos.DedupNullsOrdering) []string {
 	if supportsMerge {
-		return []string{c.generateMergeStatement(destinationTableIdentifier, unchangedToastColumns, rawTableIdentifier)}
+		return []string{c.generateMergeStatement(destinationTableIdentifier, unchangedToastColumns,
+			rawTableIdentifier, nullsOrdering)}
 	}
 	log.Warnf("Postgres version is not high enough to support MERGE, falling back to UPSERT + DELETE")
 	log.Warnf("TOAST columns will not be updated properly, use REPLICA IDENTITY FULL or upgrade Postgres")
-	return c.generateFallbackStatements(destinationTableIdentifier, rawTableIdentifier)
+	return c.generateFallbackStatements(destinationTableIdentifier, rawTableIdentifier, nullsOrdering)
 }
 
 func (c *PostgresConnector) generateFallbackStatements(destinationTableIdentifier string,
-	rawTableIdentifier string) []string {
+	rawTableIdentifier string, nullsOrdering protos.DedupNullsOrdering) []string {
+	dedupOrderSQL := utils.DedupOrderSQL("_peerdb_timestamp", nullsOrdering)
 	normalizedTableSchema := c.tableSchemaMapping[destinationTableIdentifier]
 	columnNames := make([]string, 0, len(normalizedTableSchema.Columns))
 	flattenedCastsSQLArray := make([]string, 0, len(normalizedTableSchema.Columns))
@@ -510,18 +694,18 @@ func (c *PostgresConnector) generateFallbackStatements(destinationTableIdentifie
 	deleteWhereClauseSQL := strings.TrimSuffix(strings.Join(deleteWhereClauseArray, ""), "AND ")
 
 	fallbackUpsertStatement := fmt.Sprintf(fallbackUpsertStatementSQL,
-		strings.TrimSuffix(strings.Join(maps.Values(primaryKeyColumnCasts), ","), ","), internalSchema,
+		strings.TrimSuffix(strings.Join(maps.Values(primaryKeyColumnCasts), ","), ","), dedupOrderSQL, internalSchema,
 		rawTableIdentifier, destinationTableIdentifier, insertColumnsSQL, flattenedCastsSQL,
 		strings.Join(normalizedTableSchema.PrimaryKeyColumns, ","), updateColumnsSQL)
 	fallbackDeleteStatement := fmt.Sprintf(fallbackDeleteStatementSQL,
-		strings.Join(maps.Values(primaryKeyColumnCasts), ","), internalSchema,
+		strings.Join(maps.Values(primaryKeyColumnCasts), ","), dedupOrderSQL, internalSchema,
 		rawTableIdentifier, destinationTableIdentifier, deleteWhereClauseSQL)
 
 	return []string{fallbackUpsertStatement, fallbackDeleteStatement}
 }
 
 func (c *PostgresConnector) generateMergeStatement(destinationTableIdentifier string, unchangedToastColumns []string,
-	rawTableIdentifier string) string {
+	rawTableIdentifier string, nullsOrdering protos.DedupNullsOrdering) string {
 	normalizedTableSchema := c.tableSchemaMapping[destinationTableIdentifier]
 	columnNames := maps.Keys(normalizedTableSchema.Columns)
 	for i, columnName := range columnNames {
@@ -558,7 +742,8 @@ func (c *PostgresConnector) generateMergeStatement(destinationTableIdentifier st
 	updateStatements := c.generateUpdateStatement(columnNames, unchangedToastColumns)
 
 	return fmt.Sprintf(mergeStatementSQL, strings.Join(maps.Values(primaryKeyColumnCasts), ","),
-		internalSchema, rawTableIdentifier, destinationTableIdentifier, flattenedCastsSQL,
+		utils.DedupOrderSQL("_peerdb_timestamp", nullsOrdering), internalSchema, rawTableIdentifier,
+		destinationTableIdentifier, flattenedCastsSQL,
 		strings.Join(primaryKeySelectSQLArray, " AND "), insertColumnsSQL, insertValuesSQL, updateStatements)
 }
 
@@ -617,6 +802,40 @@ func (c *PostgresConnector) getApproxTableCounts(tables []string) (int64, error)
 	return totalCount, nil
 }
 
+// validateStartLSN checks that replication on the slot can start at startLSN, which has to be
+// between the slot's confirmed flush LSN and the current WAL LSN.
+func (c *PostgresConnector) validateStartLSN(slot string, startLSN pglogrepl.LSN) error {
+	var confirmedFlushLSNText pgtype.Text
+	var currentLSNText string
+	err := c.pool.QueryRow(c.ctx,
+		"SELECT confirmed_flush_lsn::text, pg_current_wal_lsn()::text FROM pg_replication_slots WHERE slot_name = $1",
+		slot).Scan(&confirmedFlushLSNText, &currentLSNText)
+	if err != nil {
+		return fmt.Errorf("error getting LSN range of replication slot %s: %w", slot, err)
+	}
+	if !confirmedFlushLSNText.Valid {
+		return fmt.Errorf("replication slot %s is not a logical replication slot", slot)
+	}
+	confirmedFlushLSN, err := pglogrepl.ParseLSN(confirmedFlushLSNText.String)
+	if err != nil {
+		return fmt.Errorf("error parsing confirmed flush LSN of replication slot %s: %w", slot, err)
+	}
+	currentLSN, err := pglogrepl.ParseLSN(currentLSNText)
+	if err != nil {
+		return fmt.Errorf("error parsing current WAL LSN: %w", err)
+	}
+
+	// changes before the confirmed flush LSN have been released by the slot and may already be recycled.
+	if startLSN < confirmedFlushLSN {
+		return fmt.Errorf("start LSN %s is before the confirmed flush LSN %s of replication slot %s, "+
+			"WAL before it may have been recycled", startLSN, confirmedFlushLSN, slot)
+	}
+	if startLSN > currentLSN {
+		return fmt.Errorf("start LSN %s is after the current WAL LSN %s", startLSN, currentLSN)
+	}
+	return nil
+}
+
 func (c *PostgresConnector) getCurrentLSN() (pglogrepl.LSN, error) {
 	row := c.pool.QueryRow(c.ctx, "SELECT pg_current_wal_lsn();")
 	var result string
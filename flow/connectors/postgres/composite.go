@@ -0,0 +1,107 @@
+package connpostgres
+
+import (
+	"fmt"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// compositeTypeMap decodes values of composite types and arrays of them, which are replicated
+// as json: composites as objects keyed by field name, arrays of composites as arrays of objects.
+type compositeTypeMap struct {
+	typeMap *pgtype.Map
+	// oids are the composite types and their array types
+	oids map[uint32]struct{}
+}
+
+func newCompositeTypeMap(compositeTypes map[uint32]*utils.CompositeType) *compositeTypeMap {
+	m := &compositeTypeMap{
+		typeMap: pgtype.NewMap(),
+		oids:    make(map[uint32]struct{}),
+	}
+	arrayElemOIDs := make(map[uint32]uint32, len(compositeTypes))
+	for typeOID, compositeType := range compositeTypes {
+		arrayElemOIDs[compositeType.ArrayOID] = typeOID
+	}
+
+	var register func(typeOID uint32) *pgtype.Type
+	register = func(typeOID uint32) *pgtype.Type {
+		if t, ok := m.typeMap.TypeForOID(typeOID); ok {
+			return t
+		}
+		if compositeType, ok := compositeTypes[typeOID]; ok {
+			fields := make([]pgtype.CompositeCodecField, 0, len(compositeType.Fields))
+			for _, field := range compositeType.Fields {
+				fields = append(fields, pgtype.CompositeCodecField{Name: field.Name, Type: register(field.TypeOID)})
+			}
+			t := &pgtype.Type{Name: compositeType.Name, OID: typeOID, Codec: &pgtype.CompositeCodec{Fields: fields}}
+			m.typeMap.RegisterType(t)
+			m.oids[typeOID] = struct{}{}
+			return t
+		}
+		if elemOID, ok := arrayElemOIDs[typeOID]; ok {
+			elemType := register(elemOID)
+			t := &pgtype.Type{Name: "_" + elemType.Name, OID: typeOID, Codec: &pgtype.ArrayCodec{ElementType: elemType}}
+			m.typeMap.RegisterType(t)
+			m.oids[typeOID] = struct{}{}
+			return t
+		}
+		// fields of types pgx doesn't know are kept as text
+		t := &pgtype.Type{Name: fmt.Sprintf("oid_%d", typeOID), OID: typeOID, Codec: pgtype.TextCodec{}}
+		m.typeMap.RegisterType(t)
+		return t
+	}
+	for typeOID, compositeType := range compositeTypes {
+		register(typeOID)
+		register(compositeType.ArrayOID)
+	}
+	return m
+}
+
+// isComposite returns whether values of the type are decoded by the map.
+func (m *compositeTypeMap) isComposite(typeOID uint32) bool {
+	if m == nil {
+		return false
+	}
+	_, ok := m.oids[typeOID]
+	return ok
+}
+
+// decode decodes a composite or an array of composites to json, nulls at any depth become json nulls.
+func (m *compositeTypeMap) decode(typeOID uint32, formatCode int16, data []byte) (*qvalue.QValue, error) {
+	if data == nil {
+		return &qvalue.QValue{Kind: qvalue.QValueKindJSON, Value: nil}, nil
+	}
+	t, ok := m.typeMap.TypeForOID(typeOID)
+	if !ok {
+		return nil, fmt.Errorf("unknown composite type %d", typeOID)
+	}
+	value, err := t.Codec.DecodeValue(m.typeMap, typeOID, formatCode, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode composite type %s: %w", t.Name, err)
+	}
+	return parseJSON(compositeValueToJSON(value))
+}
+
+// compositeValueToJSON converts decoded field values that don't serialize to json as they should.
+func compositeValueToJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for fieldName, fieldValue := range v {
+			v[fieldName] = compositeValueToJSON(fieldValue)
+		}
+		return v
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = compositeValueToJSON(elem)
+		}
+		return v
+	case [16]byte:
+		return uuid.UUID(v).String()
+	default:
+		return value
+	}
+}
@@ -0,0 +1,66 @@
+package connpostgres
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testItemOID          = 90000
+	testItemArrayOID     = 90001
+	testPurchaseOID      = 90002
+	testPurchaseArrayOID = 90003
+)
+
+func testCompositeTypeMap() *compositeTypeMap {
+	return newCompositeTypeMap(map[uint32]*utils.CompositeType{
+		testItemOID: {
+			Name:     "item",
+			ArrayOID: testItemArrayOID,
+			Fields: []utils.CompositeTypeField{
+				{Name: "id", TypeOID: pgtype.Int4OID},
+				{Name: "name", TypeOID: pgtype.TextOID},
+				{Name: "tags", TypeOID: pgtype.TextArrayOID},
+			},
+		},
+		testPurchaseOID: {
+			Name:     "purchase",
+			ArrayOID: testPurchaseArrayOID,
+			Fields: []utils.CompositeTypeField{
+				{Name: "id", TypeOID: pgtype.UUIDOID},
+				{Name: "items", TypeOID: testItemArrayOID},
+			},
+		},
+	})
+}
+
+func TestDecodeCompositeArray(t *testing.T) {
+	compositeTypes := testCompositeTypeMap()
+	require.True(t, compositeTypes.isComposite(testItemArrayOID))
+	require.False(t, compositeTypes.isComposite(pgtype.TextArrayOID))
+
+	val, err := compositeTypes.decode(testItemArrayOID, pgtype.TextFormatCode,
+		[]byte(`{"(1,apple,\"{a,b}\")","(2,,)",NULL}`))
+	require.NoError(t, err)
+	require.Equal(t, qvalue.QValueKindJSON, val.Kind)
+	require.JSONEq(t, `[{"id":1,"name":"apple","tags":["a","b"]},{"id":2,"name":null,"tags":null},null]`,
+		val.Value.(string))
+}
+
+func TestDecodeNestedComposite(t *testing.T) {
+	compositeTypes := testCompositeTypeMap()
+
+	val, err := compositeTypes.decode(testPurchaseOID, pgtype.TextFormatCode,
+		[]byte(`(a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11,"{""(1,apple,)""}")`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11","items":[{"id":1,"name":"apple","tags":null}]}`,
+		val.Value.(string))
+
+	val, err = compositeTypes.decode(testPurchaseOID, pgtype.TextFormatCode, nil)
+	require.NoError(t, err)
+	require.Nil(t, val.Value)
+}
@@ -0,0 +1,80 @@
+package connpostgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForceNormalizeReappliesLastBatch(t *testing.T) {
+	connector, err := NewPostgresConnector(context.Background(), &protos.PostgresConfig{
+		Host:     "localhost",
+		Port:     7132,
+		User:     "postgres",
+		Password: "postgres",
+		Database: "postgres",
+	})
+	require.NoError(t, err)
+	defer connector.Close()
+
+	flowJobName := "test_force_normalize"
+	dstTable := "public.test_force_normalize"
+	require.NoError(t, connector.SetupMetadataTables())
+	_, err = connector.CreateRawTable(&protos.CreateRawTableInput{FlowJobName: flowJobName})
+	require.NoError(t, err)
+	_, err = connector.pool.Exec(context.Background(),
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(id BIGINT PRIMARY KEY, val TEXT)", dstTable))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, connector.SyncFlowCleanup(flowJobName))
+		_, err := connector.pool.Exec(context.Background(), fmt.Sprintf("DROP TABLE IF EXISTS %s", dstTable))
+		require.NoError(t, err)
+	}()
+	require.NoError(t, connector.InitializeTableSchema(map[string]*protos.TableSchema{
+		dstTable: {
+			TableIdentifier:   dstTable,
+			Columns:           map[string]string{"id": "int64", "val": "string"},
+			PrimaryKeyColumns: []string{"id"},
+		},
+	}))
+
+	_, err = connector.pool.Exec(context.Background(), fmt.Sprintf(`INSERT INTO %s.%s(_peerdb_uid,
+		_peerdb_timestamp,_peerdb_destination_table_name,_peerdb_data,_peerdb_record_type,_peerdb_batch_id)
+		VALUES(gen_random_uuid()::TEXT,0,$1,'{"id":1,"val":"a"}',0,1)`,
+		internalSchema, getRawTableIdentifier(flowJobName)), dstTable)
+	require.NoError(t, err)
+	_, err = connector.pool.Exec(context.Background(), fmt.Sprintf(insertJobMetadataSQL, internalSchema,
+		mirrorJobsTableIdentifier), flowJobName, 0, 1, 0)
+	require.NoError(t, err)
+
+	res, err := connector.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: flowJobName})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+
+	// the normalized row is changed by hand, normalize has caught up so it isn't merged again
+	_, err = connector.pool.Exec(context.Background(), fmt.Sprintf("DELETE FROM %s", dstTable))
+	require.NoError(t, err)
+	res, err = connector.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: flowJobName})
+	require.NoError(t, err)
+	require.False(t, res.Done)
+
+	res, err = connector.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: flowJobName, Force: true})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	require.Equal(t, int64(1), res.StartBatchID)
+	require.Equal(t, int64(1), res.EndBatchID)
+
+	var val string
+	err = connector.pool.QueryRow(context.Background(),
+		fmt.Sprintf("SELECT val FROM %s WHERE id=1", dstTable)).Scan(&val)
+	require.NoError(t, err)
+	require.Equal(t, "a", val)
+
+	normalizeBatchID, err := connector.getLastNormalizeBatchID(flowJobName)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), normalizeBatchID)
+}
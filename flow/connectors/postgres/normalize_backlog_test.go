@@ -0,0 +1,54 @@
+package connpostgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNormalizeBacklog(t *testing.T) {
+	connector, err := NewPostgresConnector(context.Background(), &protos.PostgresConfig{
+		Host:     "localhost",
+		Port:     7132,
+		User:     "postgres",
+		Password: "postgres",
+		Database: "postgres",
+	})
+	require.NoError(t, err)
+	defer connector.Close()
+
+	flowJobName := "test_normalize_backlog"
+	require.NoError(t, connector.SetupMetadataTables())
+	_, err = connector.CreateRawTable(&protos.CreateRawTableInput{FlowJobName: flowJobName})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, connector.SyncFlowCleanup(flowJobName))
+	}()
+
+	// three batches of two rows each
+	for batchID := 1; batchID <= 3; batchID++ {
+		_, err = connector.pool.Exec(context.Background(), fmt.Sprintf(`INSERT INTO %s.%s(_peerdb_uid,
+			_peerdb_timestamp,_peerdb_destination_table_name,_peerdb_data,_peerdb_record_type,_peerdb_batch_id)
+			SELECT gen_random_uuid()::TEXT,0,'public.test','{}',0,$1 FROM generate_series(1,2)`,
+			internalSchema, getRawTableIdentifier(flowJobName)), batchID)
+		require.NoError(t, err)
+	}
+
+	// only the first batch has been normalized
+	_, err = connector.pool.Exec(context.Background(), fmt.Sprintf(insertJobMetadataSQL, internalSchema,
+		mirrorJobsTableIdentifier), flowJobName, 0, 3, 1)
+	require.NoError(t, err)
+	backlog, err := connector.GetNormalizeBacklog(flowJobName)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), backlog)
+
+	_, err = connector.pool.Exec(context.Background(), fmt.Sprintf(updateMetadataForNormalizeRecordsSQL,
+		internalSchema, mirrorJobsTableIdentifier), 3, flowJobName)
+	require.NoError(t, err)
+	backlog, err = connector.GetNormalizeBacklog(flowJobName)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), backlog)
+}
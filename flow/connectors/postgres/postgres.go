@@ -16,6 +16,7 @@ import (
 	"github.com/PeerDB-io/peer-flow/model/qvalue"
 	"github.com/PeerDB-io/peer-flow/shared"
 	"github.com/google/uuid"
+	"github.com/jackc/pglogrepl"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -32,6 +33,10 @@ type PostgresConnector struct {
 	replPool           *pgxpool.Pool
 	tableSchemaMapping map[string]*protos.TableSchema
 	customTypesMapping map[uint32]string
+	// domains resolve to their base type, so they replicate like the type they wrap.
+	domainBaseTypes map[uint32]uint32
+	// composite types and arrays of them are replicated as json.
+	compositeTypes *compositeTypeMap
 }
 
 // SchemaTable is a table in a schema.
@@ -72,6 +77,16 @@ func NewPostgresConnector(ctx context.Context, pgConfig *protos.PostgresConfig)
 		return nil, fmt.Errorf("failed to get custom type map: %w", err)
 	}
 
+	domainBaseTypes, err := utils.GetDomainBaseTypes(ctx, pool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain base types: %w", err)
+	}
+
+	compositeTypes, err := utils.GetCompositeTypes(ctx, pool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get composite types: %w", err)
+	}
+
 	// ensure that replication is set to database
 	replConnConfig, err := pgxpool.ParseConfig(connectionString)
 	if err != nil {
@@ -94,6 +109,8 @@ func NewPostgresConnector(ctx context.Context, pgConfig *protos.PostgresConfig)
 		pool:               pool,
 		replPool:           replPool,
 		customTypesMapping: customTypeMap,
+		domainBaseTypes:    domainBaseTypes,
+		compositeTypes:     newCompositeTypeMap(compositeTypes),
 	}, nil
 }
 
@@ -120,16 +137,29 @@ func (c *PostgresConnector) ConnectionActive() bool {
 
 // NeedsSetupMetadataTables returns true if the metadata tables need to be set up.
 func (c *PostgresConnector) NeedsSetupMetadataTables() bool {
-	result, err := c.tableExists(&SchemaTable{
-		Schema: internalSchema,
-		Table:  mirrorJobsTableIdentifier,
-	})
+	result, err := c.MetadataTablesExist()
 	if err != nil {
 		return true
 	}
 	return !result
 }
 
+// MetadataTablesExist checks if the metadata tables exist.
+func (c *PostgresConnector) MetadataTablesExist() (bool, error) {
+	return c.tableExists(&SchemaTable{
+		Schema: internalSchema,
+		Table:  mirrorJobsTableIdentifier,
+	})
+}
+
+// RawTableExists checks if the raw table of the mirror exists.
+func (c *PostgresConnector) RawTableExists(flowJobName string) (bool, error) {
+	return c.tableExists(&SchemaTable{
+		Schema: internalSchema,
+		Table:  getRawTableIdentifier(flowJobName),
+	})
+}
+
 // SetupMetadataTables sets up the metadata tables.
 func (c *PostgresConnector) SetupMetadataTables() error {
 	createMetadataTablesTx, err := c.pool.Begin(c.ctx)
@@ -137,9 +167,9 @@ func (c *PostgresConnector) SetupMetadataTables() error {
 		return fmt.Errorf("error starting transaction for creating metadata tables: %w", err)
 	}
 	defer func() {
-		deferErr := createMetadataTablesTx.Rollback(c.ctx)
+		deferErr := rollbackTx(createMetadataTablesTx)
 		if deferErr != pgx.ErrTxClosed && deferErr != nil {
-			log.Errorf("unexpected error rolling back transaction for creating metadata tables: %v", err)
+			log.Errorf("unexpected error rolling back transaction for creating metadata tables: %v", deferErr)
 		}
 	}()
 
@@ -152,6 +182,11 @@ func (c *PostgresConnector) SetupMetadataTables() error {
 	if err != nil {
 		return fmt.Errorf("error creating table %s: %w", mirrorJobsTableIdentifier, err)
 	}
+	_, err = createMetadataTablesTx.Exec(c.ctx, fmt.Sprintf(dropNormalizeBatchIDNotNullSQL,
+		internalSchema, mirrorJobsTableIdentifier))
+	if err != nil {
+		return fmt.Errorf("error making normalize_batch_id of table %s nullable: %w", mirrorJobsTableIdentifier, err)
+	}
 
 	err = createMetadataTablesTx.Commit(c.ctx)
 	if err != nil {
@@ -160,6 +195,62 @@ func (c *PostgresConnector) SetupMetadataTables() error {
 	return nil
 }
 
+// GetNormalizeBacklog counts the raw table rows synced since the last normalize, which the batch ID index keeps cheap.
+func (c *PostgresConnector) GetNormalizeBacklog(flowJobName string) (int64, error) {
+	syncBatchID, err := c.GetLastSyncBatchID(flowJobName)
+	if err != nil {
+		return 0, err
+	}
+	normalizeBatchID, err := c.getLastNormalizeBatchID(flowJobName)
+	if err != nil {
+		return 0, err
+	}
+	if syncBatchID == normalizeBatchID {
+		return 0, nil
+	}
+
+	var backlog int64
+	err = c.pool.QueryRow(c.ctx, fmt.Sprintf(countRawTableRowsInBatchesSQL, internalSchema,
+		getRawTableIdentifier(flowJobName)), normalizeBatchID, syncBatchID).Scan(&backlog)
+	if err != nil {
+		return 0, fmt.Errorf("error counting rows pending normalization for job %s: %w", flowJobName, err)
+	}
+	return backlog, nil
+}
+
+// GetRawTableSchema returns the live columns of the raw table of a mirror, for debugging.
+func (c *PostgresConnector) GetRawTableSchema(flowJobName string) (*protos.GetRawTableSchemaOutput, error) {
+	rawTableIdentifier := getRawTableIdentifier(flowJobName)
+	rows, err := c.pool.Query(c.ctx, getRawTableSchemaSQL, internalSchema, rawTableIdentifier)
+	if err != nil {
+		return nil, fmt.Errorf("error querying schema of raw table %s: %w", rawTableIdentifier, err)
+	}
+	defer rows.Close()
+
+	res := &protos.GetRawTableSchemaOutput{
+		TableIdentifier: fmt.Sprintf("%s.%s", internalSchema, rawTableIdentifier),
+	}
+	var columnName, columnType string
+	for rows.Next() {
+		err = rows.Scan(&columnName, &columnType)
+		if err != nil {
+			return nil, fmt.Errorf("error reading row for schema of raw table %s: %w", rawTableIdentifier, err)
+		}
+		res.Columns = append(res.Columns, &protos.RawTableColumn{
+			Name: columnName,
+			Type: columnType,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over schema of raw table %s: %w", rawTableIdentifier, err)
+	}
+	if len(res.Columns) == 0 {
+		return nil, fmt.Errorf("raw table %s does not exist", res.TableIdentifier)
+	}
+
+	return res, nil
+}
+
 // GetLastOffset returns the last synced offset for a job.
 func (c *PostgresConnector) GetLastOffset(jobName string) (*protos.LastSyncState, error) {
 	rows, err := c.pool.
@@ -213,6 +304,16 @@ func (c *PostgresConnector) PullRecords(req *model.PullRecordsRequest) (*model.R
 			"flowName": req.FlowJobName,
 		}).Warnf("publication %s does not exist", publicationName)
 		publicationName = ""
+	} else {
+		insertOnlyPublication := insertOnlyPublicationName(publicationName)
+		insertOnlyPublicationExists, err := c.publicationExists(insertOnlyPublication)
+		if err != nil {
+			return nil, err
+		}
+		if insertOnlyPublicationExists {
+			// pgoutput takes a comma separated list of publications.
+			publicationName = fmt.Sprintf("%s,%s", publicationName, insertOnlyPublication)
+		}
 	}
 
 	if !exists.SlotExists {
@@ -226,6 +327,14 @@ func (c *PostgresConnector) PullRecords(req *model.PullRecordsRequest) (*model.R
 		"flowName": req.FlowJobName,
 	}).Infof("PullRecords: performed checks for slot and publication")
 
+	var startLSN pglogrepl.LSN
+	if req.StartLSN != "" {
+		startLSN, err = pglogrepl.ParseLSN(req.StartLSN)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start LSN %s: %w", req.StartLSN, err)
+		}
+	}
+
 	cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{
 		AppContext:             c.ctx,
 		Connection:             c.replPool,
@@ -234,6 +343,13 @@ func (c *PostgresConnector) PullRecords(req *model.PullRecordsRequest) (*model.R
 		Publication:            publicationName,
 		TableNameMapping:       req.TableNameMapping,
 		RelationMessageMapping: req.RelationMessageMapping,
+		DomainBaseTypes:        c.domainBaseTypes,
+		CompositeTypes:         c.compositeTypes,
+		StartLSN:               startLSN,
+		JSONAsText:             req.JSONAsText,
+		RangesAsText:           req.RangesAsText,
+		DisabledTables:         req.DisabledTables,
+		CountEmptyTransactions: req.CountEmptyTransactions,
 	}, c.customTypesMapping)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cdc source: %w", err)
@@ -283,9 +399,7 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 	records := make([][]interface{}, 0)
 	tableNameRowsMapping := make(map[string]uint32)
 
-	first := true
-	var firstCP int64 = 0
-	lastCP := req.Records.LastCheckPointID
+	firstCP, lastCP := req.Records.CheckPointRange()
 
 	for _, record := range req.Records.Records {
 		switch typedRecord := record.(type) {
@@ -347,11 +461,6 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 		default:
 			return nil, fmt.Errorf("unsupported record type for Postgres flow connector: %T", typedRecord)
 		}
-
-		if first {
-			firstCP = record.GetCheckPointID()
-			first = false
-		}
 	}
 
 	if len(records) == 0 {
@@ -367,11 +476,11 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 		return nil, fmt.Errorf("error starting transaction for syncing records: %w", err)
 	}
 	defer func() {
-		deferErr := syncRecordsTx.Rollback(c.ctx)
+		deferErr := rollbackTx(syncRecordsTx)
 		if deferErr != pgx.ErrTxClosed && deferErr != nil {
 			log.WithFields(log.Fields{
 				"flowName": req.FlowJobName,
-			}).Errorf("unexpected error rolling back transaction for syncing records: %v", err)
+			}).Errorf("unexpected error rolling back transaction for syncing records: %v", deferErr)
 		}
 	}()
 
@@ -394,7 +503,8 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 	}).Printf("synced %d records to Postgres table %s via COPY", syncedRecordsCount, rawTableIdentifier)
 
 	// updating metadata with new offset and syncBatchID
-	err = c.updateSyncMetadata(req.FlowJobName, lastCP, syncBatchID, syncRecordsTx)
+	err = c.updateSyncMetadata(req.FlowJobName, lastCP, syncBatchID, req.DeferCheckpoint, req.GuardSyncBatchID,
+		syncRecordsTx)
 	if err != nil {
 		return nil, err
 	}
@@ -427,6 +537,14 @@ func (c *PostgresConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 	if err != nil {
 		return nil, err
 	}
+	// a forced normalize merges the last normalized batch again.
+	if req.Force && syncBatchID == normalizeBatchID && normalizeBatchID > model.NoNormalizedBatchID &&
+		jobMetadataExists {
+		log.WithFields(log.Fields{
+			"flowName": req.FlowJobName,
+		}).Warnf("forcing normalize of batch %d, which was normalized already", normalizeBatchID)
+		normalizeBatchID--
+	}
 	// normalize has caught up with sync or no SyncFlow has run, chill until more records are loaded.
 	if syncBatchID == normalizeBatchID || !jobMetadataExists {
 		log.WithFields(log.Fields{
@@ -443,17 +561,20 @@ func (c *PostgresConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 	if err != nil {
 		return nil, err
 	}
+	for rawOnlyTable := range req.RawOnlyTables {
+		delete(unchangedToastColsMap, rawOnlyTable)
+	}
 
 	normalizeRecordsTx, err := c.pool.Begin(c.ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error starting transaction for normalizing records: %w", err)
 	}
 	defer func() {
-		deferErr := normalizeRecordsTx.Rollback(c.ctx)
+		deferErr := rollbackTx(normalizeRecordsTx)
 		if deferErr != pgx.ErrTxClosed && deferErr != nil {
 			log.WithFields(log.Fields{
 				"flowName": req.FlowJobName,
-			}).Errorf("unexpected error rolling back transaction for normalizing records: %v", err)
+			}).Errorf("unexpected error rolling back transaction for normalizing records: %v", deferErr)
 		}
 	}()
 
@@ -465,7 +586,7 @@ func (c *PostgresConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 	totalRowsAffected := 0
 	for destinationTableName, unchangedToastCols := range unchangedToastColsMap {
 		normalizeStatements := c.generateNormalizeStatements(destinationTableName, unchangedToastCols,
-			rawTableIdentifier, supportsMerge)
+			rawTableIdentifier, supportsMerge, req.DedupNullsOrdering)
 		for _, normalizeStatement := range normalizeStatements {
 			mergeStatementsBatch.Queue(normalizeStatement, normalizeBatchID, syncBatchID, destinationTableName).Exec(
 				func(ct pgconn.CommandTag) error {
@@ -526,11 +647,11 @@ func (c *PostgresConnector) CreateRawTable(req *protos.CreateRawTableInput) (*pr
 		return nil, fmt.Errorf("error starting transaction for creating raw table: %w", err)
 	}
 	defer func() {
-		deferErr := createRawTableTx.Rollback(c.ctx)
+		deferErr := rollbackTx(createRawTableTx)
 		if deferErr != pgx.ErrTxClosed && deferErr != nil {
 			log.WithFields(log.Fields{
 				"flowName": req.FlowJobName,
-			}).Errorf("unexpected error rolling back transaction for creating raw table: %v", err)
+			}).Errorf("unexpected error rolling back transaction for creating raw table: %v", deferErr)
 		}
 	}()
 
@@ -564,14 +685,30 @@ func (c *PostgresConnector) CreateRawTable(req *protos.CreateRawTableInput) (*pr
 // GetTableSchema returns the schema for a table, implementing the Connector interface.
 func (c *PostgresConnector) GetTableSchema(
 	req *protos.GetTableSchemaBatchInput) (*protos.GetTableSchemaBatchOutput, error) {
-	res := make(map[string]*protos.TableSchema)
-	for _, tableName := range req.TableIdentifiers {
-		tableSchema, err := c.getTableSchemaForTable(tableName)
-		if err != nil {
-			return nil, err
-		}
-		res[tableName] = tableSchema
-		utils.RecordHeartbeatWithRecover(c.ctx, fmt.Sprintf("fetched schema for table %s", tableName))
+	maxParallelFetches := int(req.MaxParallelFetches)
+	if maxParallelFetches == 0 {
+		maxParallelFetches = defaultMaxParallelSchemaFetches
+	}
+	// more fetches than connections in the pool would only wait on each other.
+	if maxConns := int(c.pool.Config().MaxConns); maxParallelFetches > maxConns {
+		maxParallelFetches = maxConns
+	}
+	tableSchemas, err := fetchTableSchemas(req.TableIdentifiers, maxParallelFetches,
+		func(tableName string) (*protos.TableSchema, error) {
+			tableSchema, err := c.getTableSchemaForTable(tableName, req.JsonAsText, req.RangesAsText)
+			if err != nil {
+				return nil, err
+			}
+			utils.RecordHeartbeatWithRecover(c.ctx, fmt.Sprintf("fetched schema for table %s", tableName))
+			return tableSchema, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*protos.TableSchema, len(tableSchemas))
+	for i, tableName := range req.TableIdentifiers {
+		res[tableName] = tableSchemas[i]
 	}
 
 	return &protos.GetTableSchemaBatchOutput{
@@ -579,8 +716,56 @@ func (c *PostgresConnector) GetTableSchema(
 	}, nil
 }
 
+// GetColumnKinds returns the kinds of the columns of the given tables, keyed by table and column name,
+// so that values can be fitted into the range of integer columns narrower than the source's.
+// Tables that don't exist yet are left out.
+func (c *PostgresConnector) GetColumnKinds(
+	tableIdentifiers []string,
+) (map[string]map[string]qvalue.QValueKind, error) {
+	res := make(map[string]map[string]qvalue.QValueKind, len(tableIdentifiers))
+	for _, tableIdentifier := range tableIdentifiers {
+		schemaTable, err := parseSchemaTable(tableIdentifier)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := c.pool.Query(c.ctx,
+			`SELECT a.attname, a.atttypid FROM pg_attribute a
+			 JOIN pg_class c ON c.oid = a.attrelid
+			 JOIN pg_namespace n ON n.oid = c.relnamespace
+			 WHERE n.nspname = $1 AND c.relname = $2 AND a.attnum > 0 AND NOT a.attisdropped`,
+			schemaTable.Schema, schemaTable.Table)
+		if err != nil {
+			return nil, fmt.Errorf("error getting column types of table %s: %w", schemaTable, err)
+		}
+		kinds := make(map[string]qvalue.QValueKind)
+		for rows.Next() {
+			var columnName string
+			var typeOID uint32
+			err = rows.Scan(&columnName, &typeOID)
+			if err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("error scanning column type of table %s: %w", schemaTable, err)
+			}
+			if baseTypeOID, ok := c.domainBaseTypes[typeOID]; ok {
+				typeOID = baseTypeOID
+			}
+			kinds[columnName] = postgresColumnQValueKind(typeOID, false, false)
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return nil, fmt.Errorf("error getting column types of table %s: %w", schemaTable, err)
+		}
+		if len(kinds) > 0 {
+			res[tableIdentifier] = kinds
+		}
+	}
+	return res, nil
+}
+
 func (c *PostgresConnector) getTableSchemaForTable(
 	tableName string,
+	jsonAsText bool,
+	rangesAsText bool,
 ) (*protos.TableSchema, error) {
 	schemaTable, err := parseSchemaTable(tableName)
 	if err != nil {
@@ -598,7 +783,14 @@ func (c *PostgresConnector) getTableSchemaForTable(
 	if err != nil {
 		return nil, fmt.Errorf("error getting table schema for table %s: %w", schemaTable, err)
 	}
-	defer rows.Close()
+	// the field descriptions are reused by the next query of the connection, so they are copied before
+	// releasing it. it is released before looking up the primary key on another connection, so that
+	// parallel fetches hold at most one connection each.
+	fieldDescriptions := append([]pgconn.FieldDescription(nil), rows.FieldDescriptions()...)
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over table schema: %w", err)
+	}
 
 	pKeyCols, err := c.getPrimaryKeyColumns(schemaTable)
 	if err != nil {
@@ -612,10 +804,16 @@ func (c *PostgresConnector) getTableSchemaForTable(
 		IsReplicaIdentityFull: isFullReplica,
 	}
 
-	for _, fieldDescription := range rows.FieldDescriptions() {
-		genericColType := postgresOIDToQValueKind(fieldDescription.DataTypeOID)
-		if genericColType == qvalue.QValueKindInvalid {
-			typeName, ok := c.customTypesMapping[fieldDescription.DataTypeOID]
+	for _, fieldDescription := range fieldDescriptions {
+		dataTypeOID := fieldDescription.DataTypeOID
+		if baseTypeOID, ok := c.domainBaseTypes[dataTypeOID]; ok {
+			dataTypeOID = baseTypeOID
+		}
+		genericColType := postgresColumnQValueKind(dataTypeOID, jsonAsText, rangesAsText)
+		if c.compositeTypes.isComposite(dataTypeOID) {
+			genericColType = qvalue.QValueKindJSON
+		} else if genericColType == qvalue.QValueKindInvalid {
+			typeName, ok := c.customTypesMapping[dataTypeOID]
 			if ok {
 				genericColType = customTypeToQKind(typeName)
 			} else {
@@ -626,10 +824,6 @@ func (c *PostgresConnector) getTableSchemaForTable(
 		res.Columns[fieldDescription.Name] = string(genericColType)
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating over table schema: %w", err)
-	}
-
 	return res, nil
 }
 
@@ -644,11 +838,11 @@ func (c *PostgresConnector) SetupNormalizedTables(req *protos.SetupNormalizedTab
 	}
 
 	defer func() {
-		deferErr := createNormalizedTablesTx.Rollback(c.ctx)
+		deferErr := rollbackTx(createNormalizedTablesTx)
 		if deferErr != pgx.ErrTxClosed && deferErr != nil {
 			log.WithFields(log.Fields{
 				"tableMapping": req.TableNameSchemaMapping,
-			}).Errorf("unexpected error rolling back transaction for creating raw table: %v", err)
+			}).Errorf("unexpected error rolling back transaction for creating raw table: %v", deferErr)
 		}
 	}()
 
@@ -705,11 +899,11 @@ func (c *PostgresConnector) ReplayTableSchemaDeltas(flowJobName string,
 			err)
 	}
 	defer func() {
-		deferErr := tableSchemaModifyTx.Rollback(c.ctx)
+		deferErr := rollbackTx(tableSchemaModifyTx)
 		if deferErr != pgx.ErrTxClosed && deferErr != nil {
 			log.WithFields(log.Fields{
 				"flowName": flowJobName,
-			}).Errorf("unexpected error rolling back transaction for table schema modification: %v", err)
+			}).Errorf("unexpected error rolling back transaction for table schema modification: %v", deferErr)
 		}
 	}()
 
@@ -761,6 +955,17 @@ func (c *PostgresConnector) EnsurePullability(req *protos.EnsurePullabilityBatch
 			return nil, err
 		}
 
+		replicaIdentity, err := c.getReplicaIdentity(relID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting replica identity for table %s: %w", schemaTable, err)
+		}
+		if replicaIdentity == replicaIdentityNothing {
+			err = c.handleReplicaIdentityNothing(schemaTable, relID, req.ReplicaIdentityNothingPolicy)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		tableIdentifierMapping[tableName] = &protos.TableIdentifier{
 			TableIdentifier: &protos.TableIdentifier_PostgresTableIdentifier{
 				PostgresTableIdentifier: &protos.PostgresTableIdentifier{
@@ -799,6 +1004,22 @@ func (c *PostgresConnector) SetupReplication(signal *SlotSignal, req *protos.Set
 		return fmt.Errorf("error checking for replication slot and publication: %w", err)
 	}
 
+	if req.StartLsn != "" {
+		// a new slot can only stream changes made after it is created, so there is nothing to pick from.
+		if !exists.SlotExists {
+			return fmt.Errorf("start LSN %s requires an existing replication slot, slot %s does not exist",
+				req.StartLsn, slotName)
+		}
+		startLSN, err := pglogrepl.ParseLSN(req.StartLsn)
+		if err != nil {
+			return fmt.Errorf("invalid start LSN %s: %w", req.StartLsn, err)
+		}
+		err = c.validateStartLSN(slotName, startLSN)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Create the replication slot and publication
 	err = c.createSlotAndPublication(signal, exists,
 		slotName, publicationName, req.TableNameMapping, req.DoInitialCopy)
@@ -821,15 +1042,16 @@ func (c *PostgresConnector) PullFlowCleanup(jobName string) error {
 		return fmt.Errorf("error starting transaction for flow cleanup: %w", err)
 	}
 	defer func() {
-		deferErr := pullFlowCleanupTx.Rollback(c.ctx)
+		deferErr := rollbackTx(pullFlowCleanupTx)
 		if deferErr != pgx.ErrTxClosed && deferErr != nil {
 			log.WithFields(log.Fields{
 				"flowName": jobName,
-			}).Errorf("unexpected error rolling back transaction for flow cleanup: %v", err)
+			}).Errorf("unexpected error rolling back transaction for flow cleanup: %v", deferErr)
 		}
 	}()
 
-	_, err = pullFlowCleanupTx.Exec(c.ctx, fmt.Sprintf("DROP PUBLICATION IF EXISTS %s", publicationName))
+	_, err = pullFlowCleanupTx.Exec(c.ctx, fmt.Sprintf("DROP PUBLICATION IF EXISTS %s, %s",
+		publicationName, insertOnlyPublicationName(publicationName)))
 	if err != nil {
 		return fmt.Errorf("error dropping publication: %w", err)
 	}
@@ -854,7 +1076,7 @@ func (c *PostgresConnector) SyncFlowCleanup(jobName string) error {
 		return fmt.Errorf("unable to begin transaction for sync flow cleanup: %w", err)
 	}
 	defer func() {
-		deferErr := syncFlowCleanupTx.Rollback(c.ctx)
+		deferErr := rollbackTx(syncFlowCleanupTx)
 		if deferErr != sql.ErrTxDone && deferErr != nil {
 			log.WithFields(log.Fields{
 				"flowName": jobName,
@@ -580,6 +580,109 @@ func (suite *PostgresCDCTestSuite) TestSimpleHappyFlow() {
 	suite.dropTable(simpleHappyFlowSrcTableName)
 }
 
+func (suite *PostgresCDCTestSuite) TestStartFromSpecifiedLSN() {
+	startLSNFlowName := "start_lsn_testing_flow"
+	startLSNSrcTableName := "pgpeer_test.start_lsn_table"
+	startLSNDstTableName := "start_lsn_table_dst"
+
+	_, err := suite.connector.pool.Exec(context.Background(),
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(id INT PRIMARY KEY, name TEXT)", startLSNSrcTableName))
+	suite.failTestError(err)
+
+	ensurePullabilityOutput, err := suite.connector.EnsurePullability(&protos.EnsurePullabilityBatchInput{
+		FlowJobName:            startLSNFlowName,
+		SourceTableIdentifiers: []string{startLSNSrcTableName},
+		PeerConnectionConfig:   nil, // not used by the connector itself.
+	})
+	suite.failTestError(err)
+	tableRelID := ensurePullabilityOutput.TableIdentifierMapping[startLSNSrcTableName].
+		GetPostgresTableIdentifier().RelId
+
+	relIDTableNameMapping := map[uint32]string{
+		tableRelID: startLSNSrcTableName,
+	}
+	tableNameMapping := map[string]string{
+		startLSNSrcTableName: startLSNDstTableName,
+	}
+
+	// a start LSN needs an existing slot to pick from.
+	err = suite.connector.SetupReplication(nil, &protos.SetupReplicationInput{
+		FlowJobName:          startLSNFlowName,
+		TableNameMapping:     tableNameMapping,
+		PeerConnectionConfig: nil, // not used by the connector itself.
+		StartLsn:             "0/1",
+	})
+	suite.Error(err)
+
+	err = suite.connector.SetupReplication(nil, &protos.SetupReplicationInput{
+		FlowJobName:          startLSNFlowName,
+		TableNameMapping:     tableNameMapping,
+		PeerConnectionConfig: nil, // not used by the connector itself.
+	})
+	suite.failTestError(err)
+
+	tableNameSchema, err := suite.connector.GetTableSchema(&protos.GetTableSchemaBatchInput{
+		TableIdentifiers:     []string{startLSNSrcTableName},
+		PeerConnectionConfig: nil,
+	})
+	suite.failTestError(err)
+	tableNameSchemaMapping := map[string]*protos.TableSchema{
+		startLSNDstTableName: tableNameSchema.TableNameSchemaMapping[startLSNSrcTableName],
+	}
+
+	suite.insertSimpleRecords(startLSNSrcTableName)
+	startLSN, err := suite.connector.getCurrentLSN()
+	suite.failTestError(err)
+	suite.mutateSimpleRecords(startLSNSrcTableName)
+
+	// WAL from before the slot was created is not available to it.
+	err = suite.connector.SetupReplication(nil, &protos.SetupReplicationInput{
+		FlowJobName:          startLSNFlowName,
+		TableNameMapping:     tableNameMapping,
+		PeerConnectionConfig: nil, // not used by the connector itself.
+		StartLsn:             "0/1",
+	})
+	suite.ErrorContains(err, "may have been recycled")
+
+	err = suite.connector.SetupReplication(nil, &protos.SetupReplicationInput{
+		FlowJobName:          startLSNFlowName,
+		TableNameMapping:     tableNameMapping,
+		PeerConnectionConfig: nil, // not used by the connector itself.
+		StartLsn:             "FFFFFFFF/0",
+	})
+	suite.ErrorContains(err, "is after the current WAL LSN")
+
+	err = suite.connector.SetupReplication(nil, &protos.SetupReplicationInput{
+		FlowJobName:          startLSNFlowName,
+		TableNameMapping:     tableNameMapping,
+		PeerConnectionConfig: nil, // not used by the connector itself.
+		StartLsn:             startLSN.String(),
+	})
+	suite.failTestError(err)
+
+	// only the changes made after the start LSN are pulled, the inserts before it are skipped.
+	recordsWithSchemaDelta, err := suite.connector.PullRecords(&model.PullRecordsRequest{
+		FlowJobName:            startLSNFlowName,
+		LastSyncState:          nil,
+		IdleTimeout:            5 * time.Second,
+		MaxBatchSize:           100,
+		SrcTableIDNameMapping:  relIDTableNameMapping,
+		TableNameMapping:       tableNameMapping,
+		TableNameSchemaMapping: tableNameSchemaMapping,
+		RelationMessageMapping: make(model.RelationMessageMapping),
+		StartLSN:               startLSN.String(),
+	})
+	suite.failTestError(err)
+	suite.validateSimpleMutatedRecords(recordsWithSchemaDelta.RecordBatch.Records,
+		startLSNSrcTableName, startLSNDstTableName)
+	suite.GreaterOrEqual(recordsWithSchemaDelta.RecordBatch.FirstCheckPointID, int64(startLSN))
+
+	err = suite.connector.PullFlowCleanup(startLSNFlowName)
+	suite.failTestError(err)
+
+	suite.dropTable(startLSNSrcTableName)
+}
+
 func (suite *PostgresCDCTestSuite) TestAllTypesHappyFlow() {
 	allTypesHappyFlowName := "all_types_happy_flow_testing"
 	allTypesHappyFlowSrcTableName := "pgpeer_test.all_types_table"
@@ -826,6 +929,261 @@ func (suite *PostgresCDCTestSuite) TestToastHappyFlow() {
 	suite.dropTable(toastHappyFlowSrcTableName)
 }
 
+func (suite *PostgresCDCTestSuite) TestReplicaIdentityNothing() {
+	replIdentFlowName := "replica_identity_nothing_testing_flow"
+	pkeyTableName := "pgpeer_test.replica_identity_nothing_pkey"
+	noPkeyTableName := "pgpeer_test.replica_identity_nothing_no_pkey"
+
+	_, err := suite.connector.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE %[1]s(id INT PRIMARY KEY, name TEXT);
+		ALTER TABLE %[1]s REPLICA IDENTITY NOTHING;
+		CREATE TABLE %[2]s(id INT, name TEXT);
+		ALTER TABLE %[2]s REPLICA IDENTITY NOTHING;`, pkeyTableName, noPkeyTableName))
+	suite.failTestError(err)
+	defer suite.dropTable(pkeyTableName)
+	defer suite.dropTable(noPkeyTableName)
+
+	replicaIdentity := func(tableName string) string {
+		schemaTable, err := parseSchemaTable(tableName)
+		suite.failTestError(err)
+		relID, err := suite.connector.getRelIDForTable(schemaTable)
+		suite.failTestError(err)
+		replicaIdentity, err := suite.connector.getReplicaIdentity(relID)
+		suite.failTestError(err)
+		return replicaIdentity
+	}
+	ensurePullability := func(policy protos.ReplicaIdentityNothingPolicy) error {
+		_, err := suite.connector.EnsurePullability(&protos.EnsurePullabilityBatchInput{
+			FlowJobName:                  replIdentFlowName,
+			SourceTableIdentifiers:       []string{pkeyTableName, noPkeyTableName},
+			PeerConnectionConfig:         nil, // not used by the connector itself.
+			ReplicaIdentityNothingPolicy: policy,
+		})
+		return err
+	}
+
+	// by default the setup fails, naming the table.
+	err = ensurePullability(protos.ReplicaIdentityNothingPolicy_REPLICA_IDENTITY_NOTHING_POLICY_ERROR)
+	suite.ErrorContains(err, "has REPLICA IDENTITY NOTHING")
+
+	// allowing it leaves the tables as they are.
+	err = ensurePullability(protos.ReplicaIdentityNothingPolicy_REPLICA_IDENTITY_NOTHING_POLICY_ALLOW)
+	suite.NoError(err)
+	suite.Equal(replicaIdentityNothing, replicaIdentity(pkeyTableName))
+	suite.Equal(replicaIdentityNothing, replicaIdentity(noPkeyTableName))
+
+	// the tables are only published for their inserts, so their updates and deletes keep working on the source.
+	err = suite.connector.SetupReplication(nil, &protos.SetupReplicationInput{
+		FlowJobName: replIdentFlowName,
+		TableNameMapping: map[string]string{
+			pkeyTableName:   "replica_identity_nothing_pkey_dst",
+			noPkeyTableName: "replica_identity_nothing_no_pkey_dst",
+		},
+		PeerConnectionConfig: nil, // not used by the connector itself.
+	})
+	suite.failTestError(err)
+	var publishesUpdates bool
+	var publishedTables int
+	err = suite.connector.pool.QueryRow(context.Background(), `SELECT bool_or(p.pubupdate OR p.pubdelete), count(*)
+		FROM pg_publication p JOIN pg_publication_tables t ON t.pubname = p.pubname WHERE p.pubname = $1`,
+		insertOnlyPublicationName("peerflow_pub_"+replIdentFlowName)).Scan(&publishesUpdates, &publishedTables)
+	suite.failTestError(err)
+	suite.False(publishesUpdates)
+	suite.Equal(2, publishedTables)
+	_, err = suite.connector.pool.Exec(context.Background(), fmt.Sprintf(`
+		INSERT INTO %[1]s VALUES (1, 'a');
+		UPDATE %[1]s SET name = 'b';
+		DELETE FROM %[1]s;
+		INSERT INTO %[2]s VALUES (1, 'a');
+		UPDATE %[2]s SET name = 'b';
+		DELETE FROM %[2]s;`, pkeyTableName, noPkeyTableName))
+	suite.NoError(err)
+	suite.failTestError(suite.connector.PullFlowCleanup(replIdentFlowName))
+
+	// the primary key becomes the replica identity, or the full row if there is none.
+	err = ensurePullability(protos.ReplicaIdentityNothingPolicy_REPLICA_IDENTITY_NOTHING_POLICY_SET_IDENTITY)
+	suite.NoError(err)
+	suite.Equal("d", replicaIdentity(pkeyTableName))
+	suite.Equal(replicaIdentityFull, replicaIdentity(noPkeyTableName))
+}
+
+func (suite *PostgresCDCTestSuite) TestDisabledTable() {
+	disabledTableFlowName := "disabled_table_testing_flow"
+	enabledSrcTableName := "pgpeer_test.disabled_table_enabled"
+	disabledSrcTableName := "pgpeer_test.disabled_table_disabled"
+	tableNameMapping := map[string]string{
+		enabledSrcTableName:  "disabled_table_enabled_dst",
+		disabledSrcTableName: "disabled_table_disabled_dst",
+	}
+
+	_, err := suite.connector.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE %s(id INT PRIMARY KEY, name TEXT);
+		CREATE TABLE %s(id INT PRIMARY KEY, name TEXT);`, enabledSrcTableName, disabledSrcTableName))
+	suite.failTestError(err)
+	defer suite.dropTable(enabledSrcTableName)
+	defer suite.dropTable(disabledSrcTableName)
+
+	srcTableNames := []string{enabledSrcTableName, disabledSrcTableName}
+	ensurePullabilityOutput, err := suite.connector.EnsurePullability(&protos.EnsurePullabilityBatchInput{
+		FlowJobName:            disabledTableFlowName,
+		SourceTableIdentifiers: srcTableNames,
+		PeerConnectionConfig:   nil, // not used by the connector itself.
+	})
+	suite.failTestError(err)
+	relIDTableNameMapping := make(map[uint32]string)
+	for _, srcTableName := range srcTableNames {
+		relID := ensurePullabilityOutput.TableIdentifierMapping[srcTableName].GetPostgresTableIdentifier().RelId
+		relIDTableNameMapping[relID] = srcTableName
+	}
+
+	err = suite.connector.SetupReplication(nil, &protos.SetupReplicationInput{
+		FlowJobName:          disabledTableFlowName,
+		TableNameMapping:     tableNameMapping,
+		PeerConnectionConfig: nil, // not used by the connector itself.
+	})
+	suite.failTestError(err)
+	defer func() {
+		suite.failTestError(suite.connector.PullFlowCleanup(disabledTableFlowName))
+	}()
+
+	tableSchemas, err := suite.connector.GetTableSchema(&protos.GetTableSchemaBatchInput{
+		TableIdentifiers: srcTableNames,
+	})
+	suite.failTestError(err)
+	tableNameSchemaMapping := make(map[string]*protos.TableSchema)
+	for srcTableName, dstTableName := range tableNameMapping {
+		tableNameSchemaMapping[dstTableName] = tableSchemas.TableNameSchemaMapping[srcTableName]
+	}
+
+	relationMessageMapping := make(model.RelationMessageMapping)
+	pullRecords := func(lastSyncState *protos.LastSyncState,
+		disabledTables map[string]bool) *model.RecordsWithTableSchemaDelta {
+		recordsWithSchemaDelta, err := suite.connector.PullRecords(&model.PullRecordsRequest{
+			FlowJobName:            disabledTableFlowName,
+			LastSyncState:          lastSyncState,
+			IdleTimeout:            5 * time.Second,
+			MaxBatchSize:           100,
+			SrcTableIDNameMapping:  relIDTableNameMapping,
+			TableNameMapping:       tableNameMapping,
+			TableNameSchemaMapping: tableNameSchemaMapping,
+			RelationMessageMapping: relationMessageMapping,
+			DisabledTables:         disabledTables,
+		})
+		suite.failTestError(err)
+		relationMessageMapping = recordsWithSchemaDelta.RelationMessageMapping
+		return recordsWithSchemaDelta
+	}
+	insertRecords := func(ids ...int) {
+		for _, srcTableName := range srcTableNames {
+			for _, id := range ids {
+				_, err := suite.connector.pool.Exec(context.Background(),
+					fmt.Sprintf("INSERT INTO %s(id, name) VALUES ($1, 'name')", srcTableName), id)
+				suite.failTestError(err)
+			}
+		}
+	}
+	countRecords := func(records []model.Record) map[string]int {
+		counts := make(map[string]int)
+		for _, record := range records {
+			counts[record.GetTableName()]++
+		}
+		return counts
+	}
+
+	pullRecords(nil, nil)
+
+	// while disabled, the table's changes are skipped.
+	insertRecords(1, 2, 3)
+	recordsWithSchemaDelta := pullRecords(nil, map[string]bool{disabledSrcTableName: true})
+	suite.Equal(map[string]int{"disabled_table_enabled_dst": 3},
+		countRecords(recordsWithSchemaDelta.RecordBatch.Records))
+
+	// once enabled again, its new changes are replicated but the skipped ones are not.
+	insertRecords(4, 5)
+	recordsWithSchemaDelta = pullRecords(&protos.LastSyncState{
+		Checkpoint: recordsWithSchemaDelta.RecordBatch.LastCheckPointID,
+	}, nil)
+	suite.Equal(map[string]int{"disabled_table_enabled_dst": 2, "disabled_table_disabled_dst": 2},
+		countRecords(recordsWithSchemaDelta.RecordBatch.Records))
+}
+
+func (suite *PostgresCDCTestSuite) TestMaxPullDuration() {
+	maxPullDurationFlowName := "max_pull_duration_testing_flow"
+	maxPullDurationSrcTableName := "pgpeer_test.max_pull_duration"
+	tableNameMapping := map[string]string{
+		maxPullDurationSrcTableName: "max_pull_duration_dst",
+	}
+
+	_, err := suite.connector.pool.Exec(context.Background(),
+		fmt.Sprintf("CREATE TABLE %s(id INT PRIMARY KEY, name TEXT)", maxPullDurationSrcTableName))
+	suite.failTestError(err)
+	defer suite.dropTable(maxPullDurationSrcTableName)
+
+	ensurePullabilityOutput, err := suite.connector.EnsurePullability(&protos.EnsurePullabilityBatchInput{
+		FlowJobName:            maxPullDurationFlowName,
+		SourceTableIdentifiers: []string{maxPullDurationSrcTableName},
+		PeerConnectionConfig:   nil, // not used by the connector itself.
+	})
+	suite.failTestError(err)
+	relID := ensurePullabilityOutput.TableIdentifierMapping[maxPullDurationSrcTableName].
+		GetPostgresTableIdentifier().RelId
+
+	err = suite.connector.SetupReplication(nil, &protos.SetupReplicationInput{
+		FlowJobName:          maxPullDurationFlowName,
+		TableNameMapping:     tableNameMapping,
+		PeerConnectionConfig: nil, // not used by the connector itself.
+	})
+	suite.failTestError(err)
+	defer func() {
+		suite.failTestError(suite.connector.PullFlowCleanup(maxPullDurationFlowName))
+	}()
+
+	tableSchemas, err := suite.connector.GetTableSchema(&protos.GetTableSchemaBatchInput{
+		TableIdentifiers: []string{maxPullDurationSrcTableName},
+	})
+	suite.failTestError(err)
+
+	// a change arrives well within the idle timeout, every time.
+	stopInserting := make(chan struct{})
+	insertsDone := make(chan struct{})
+	go func() {
+		defer close(insertsDone)
+		for id := 1; ; id++ {
+			select {
+			case <-stopInserting:
+				return
+			case <-time.After(200 * time.Millisecond):
+			}
+			_, err := suite.connector.pool.Exec(context.Background(),
+				fmt.Sprintf("INSERT INTO %s(id, name) VALUES ($1, 'name')", maxPullDurationSrcTableName), id)
+			suite.NoError(err)
+		}
+	}()
+
+	startTime := time.Now()
+	recordsWithSchemaDelta, err := suite.connector.PullRecords(&model.PullRecordsRequest{
+		FlowJobName:           maxPullDurationFlowName,
+		LastSyncState:         nil,
+		IdleTimeout:           5 * time.Second,
+		MaxPullDuration:       3 * time.Second,
+		MaxBatchSize:          1000,
+		SrcTableIDNameMapping: map[uint32]string{relID: maxPullDurationSrcTableName},
+		TableNameMapping:      tableNameMapping,
+		TableNameSchemaMapping: map[string]*protos.TableSchema{
+			"max_pull_duration_dst": tableSchemas.TableNameSchemaMapping[maxPullDurationSrcTableName],
+		},
+		RelationMessageMapping: make(model.RelationMessageMapping),
+	})
+	pullDuration := time.Since(startTime)
+	close(stopInserting)
+	<-insertsDone
+	suite.failTestError(err)
+
+	// the pull returns what it has once the max pull duration is hit, without waiting to be idle.
+	suite.Less(pullDuration, 5*time.Second)
+	suite.NotEmpty(recordsWithSchemaDelta.RecordBatch.Records)
+}
+
 func TestPostgresCDCTestSuite(t *testing.T) {
 	suite.Run(t, new(PostgresCDCTestSuite))
 }
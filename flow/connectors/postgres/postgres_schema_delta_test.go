@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/PeerDB-io/peer-flow/model/qvalue"
 	"github.com/jackc/pgx/v5"
@@ -243,6 +244,34 @@ func (suite *PostgresSchemaDeltaTestSuite) TestAddDropWhitespaceColumnNames() {
 	suite.Equal(expectedTableSchema, output.TableNameSchemaMapping[tableName])
 }
 
+func (suite *PostgresSchemaDeltaTestSuite) TestDomainColumnTypes() {
+	tableName := fmt.Sprintf("%s.domain_column_types", schemaDeltaTestSchemaName)
+	_, err := suite.connector.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE DOMAIN %[1]s.short_text AS TEXT CHECK (length(VALUE) <= 10);
+		CREATE DOMAIN %[1]s.positive_int AS BIGINT CHECK (VALUE > 0);
+		CREATE TABLE %[2]s(id %[1]s.positive_int PRIMARY KEY, name %[1]s.short_text)`,
+		schemaDeltaTestSchemaName, tableName))
+	suite.failTestError(err)
+
+	// domains are not custom types of their own, they should map like the type they wrap.
+	domainBaseTypes, err := utils.GetDomainBaseTypes(context.Background(), suite.connector.pool)
+	suite.failTestError(err)
+	suite.connector.domainBaseTypes = domainBaseTypes
+
+	output, err := suite.connector.GetTableSchema(&protos.GetTableSchemaBatchInput{
+		TableIdentifiers: []string{tableName},
+	})
+	suite.failTestError(err)
+	suite.Equal(&protos.TableSchema{
+		TableIdentifier: tableName,
+		Columns: map[string]string{
+			"id":   string(qvalue.QValueKindInt64),
+			"name": string(qvalue.QValueKindString),
+		},
+		PrimaryKeyColumns: []string{"id"},
+	}, output.TableNameSchemaMapping[tableName])
+}
+
 func TestPostgresSchemaDeltaTestSuite(t *testing.T) {
 	suite.Run(t, new(PostgresSchemaDeltaTestSuite))
 }
@@ -3,6 +3,7 @@ package connpostgres
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
 	"time"
 
@@ -33,20 +34,21 @@ func (c *PostgresConnector) GetQRepPartitions(
 		return []*protos.QRepPartition{partition}, nil
 	}
 
+	isoLevel, err := c.sourceIsoLevel(config)
+	if err != nil {
+		return nil, err
+	}
 	// begin a transaction
-	tx, err := c.pool.BeginTx(c.ctx, pgx.TxOptions{
-		AccessMode: pgx.ReadOnly,
-		IsoLevel:   pgx.RepeatableRead,
-	})
+	tx, err := c.pool.BeginTx(c.ctx, sourceTxOptions(isoLevel))
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer func() {
-		deferErr := tx.Rollback(c.ctx)
+		deferErr := rollbackTx(tx)
 		if deferErr != pgx.ErrTxClosed && deferErr != nil {
 			log.WithFields(log.Fields{
 				"flowName": config.FlowJobName,
-			}).Errorf("unexpected error rolling back transaction for get partitions: %v", err)
+			}).Errorf("unexpected error rolling back transaction for get partitions: %v", deferErr)
 		}
 	}()
 
@@ -83,7 +85,7 @@ func (c *PostgresConnector) GetQRepPartitions(
 	// only hit when there is no data in the source table
 	case nil:
 		log.Warnf("no records to replicate for flow job %s, returning", config.FlowJobName)
-		return make([]*protos.QRepPartition, 0), nil
+		return emptySourceTablePartitions(config, last), nil
 	default:
 		return nil, fmt.Errorf("unsupported type: %T", v)
 	}
@@ -95,6 +97,84 @@ func (c *PostgresConnector) GetQRepPartitions(
 	return partitions, nil
 }
 
+// emptySourceTablePartitions returns the partitions of a source table without rows to replicate. On the
+// first run of the flow that is a single schema only partition, so the destination table is created from
+// the schema of the query, unless the config skips empty source tables or the destination doesn't create
+// tables from partitions. Later runs, which are passed the last partition of a previous run, replicate
+// nothing.
+func emptySourceTablePartitions(config *protos.QRepConfig, last *protos.QRepPartition) []*protos.QRepPartition {
+	firstRun := last == nil || (last.Range == nil && !last.SchemaOnly)
+	if !firstRun || config.EmptySourceTablePolicy == protos.EmptySourceTablePolicy_EMPTY_SOURCE_TABLE_POLICY_SKIP ||
+		!createsDestinationTables(config.DestinationPeer) {
+		return make([]*protos.QRepPartition, 0)
+	}
+	return []*protos.QRepPartition{{
+		PartitionId: uuid.New().String(),
+		SchemaOnly:  true,
+	}}
+}
+
+// createsDestinationTables returns true if the destination creates a missing destination table from the
+// schema of a schema only partition. Other destinations need the table to exist to sync any partition.
+func createsDestinationTables(destination *protos.Peer) bool {
+	switch destination.GetType() {
+	case protos.DBType_SNOWFLAKE, protos.DBType_POSTGRES:
+		return true
+	default:
+		return false
+	}
+}
+
+// sourceIsoLevel returns the isolation level to read the source with for the QRep config.
+// Postgres only imports a snapshot exported by a repeatable read transaction, which is how
+// replication slots export theirs, into another repeatable read transaction.
+func (c *PostgresConnector) sourceIsoLevel(config *protos.QRepConfig) (pgx.TxIsoLevel, error) {
+	switch config.SourceIsolationLevel {
+	case protos.SourceIsolationLevel_SOURCE_ISOLATION_LEVEL_REPEATABLE_READ:
+		return pgx.RepeatableRead, nil
+	case protos.SourceIsolationLevel_SOURCE_ISOLATION_LEVEL_SERIALIZABLE:
+		if c.config.TransactionSnapshot != "" {
+			return "", fmt.Errorf("flow %s reads from exported snapshot %s, which is only possible with "+
+				"isolation level repeatable read", config.FlowJobName, c.config.TransactionSnapshot)
+		}
+		return pgx.Serializable, nil
+	default:
+		return "", fmt.Errorf("unsupported source isolation level: %s", config.SourceIsolationLevel)
+	}
+}
+
+// sourceTxOptions are the options of the read only transactions reading from the source.
+func sourceTxOptions(isoLevel pgx.TxIsoLevel) pgx.TxOptions {
+	opts := pgx.TxOptions{
+		AccessMode: pgx.ReadOnly,
+		IsoLevel:   isoLevel,
+	}
+	// a deferrable serializable read waits for a safe snapshot instead of risking serialization failures.
+	if isoLevel == pgx.Serializable {
+		opts.DeferrableMode = pgx.Deferrable
+	}
+	return opts
+}
+
+// newQRepExecutor returns an executor reading a partition of the QRep config from the source,
+// inside the exported snapshot if the connector has one.
+func (c *PostgresConnector) newQRepExecutor(config *protos.QRepConfig,
+	partition *protos.QRepPartition) (*QRepQueryExecutor, error) {
+	isoLevel, err := c.sourceIsoLevel(config)
+	if err != nil {
+		return nil, err
+	}
+	executor, err := NewQRepQueryExecutorSnapshot(c.pool, c.ctx, c.config.TransactionSnapshot,
+		config.FlowJobName, partition.PartitionId)
+	if err != nil {
+		return nil, err
+	}
+	executor.SetJSONAsText(config.JsonAsText)
+	executor.SetRangesAsText(config.RangesAsText)
+	executor.SetIsoLevel(isoLevel)
+	return executor, nil
+}
+
 func (c *PostgresConnector) setTransactionSnapshot(tx pgx.Tx) error {
 	snapshot := c.config.TransactionSnapshot
 	if snapshot != "" {
@@ -147,7 +227,7 @@ func (c *PostgresConnector) getNumRowsPartitions(
 
 	if totalRows == 0 {
 		log.Warnf("no records to replicate for flow job %s, returning", config.FlowJobName)
-		return make([]*protos.QRepPartition, 0), nil
+		return emptySourceTablePartitions(config, last), nil
 	}
 
 	// Calculate the number of partitions
@@ -303,11 +383,11 @@ func (c *PostgresConnector) CheckForUpdatedMaxValue(config *protos.QRepConfig,
 		return false, fmt.Errorf("unable to begin transaction for getting max value: %w", err)
 	}
 	defer func() {
-		deferErr := tx.Rollback(c.ctx)
+		deferErr := rollbackTx(tx)
 		if deferErr != pgx.ErrTxClosed && deferErr != nil {
 			log.WithFields(log.Fields{
 				"flowName": config.FlowJobName,
-			}).Errorf("unexpected error rolling back transaction for getting max value: %v", err)
+			}).Errorf("unexpected error rolling back transaction for getting max value: %v", deferErr)
 		}
 	}()
 
@@ -335,16 +415,39 @@ func (c *PostgresConnector) CheckForUpdatedMaxValue(config *protos.QRepConfig,
 func (c *PostgresConnector) PullQRepRecords(
 	config *protos.QRepConfig,
 	partition *protos.QRepPartition) (*model.QRecordBatch, error) {
+	return c.pullQRepRecords(config, partition, 0)
+}
+
+// SampleQRepRecords pulls at most limit records of the partition, leaving the rest of it on the source.
+func (c *PostgresConnector) SampleQRepRecords(
+	config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+	limit int) (*model.QRecordBatch, error) {
+	return c.pullQRepRecords(config, partition, limit)
+}
+
+// limitQuery limits the rows of a query to limit, a limit of 0 leaves the query as is.
+func limitQuery(query string, limit int) string {
+	if limit <= 0 {
+		return query
+	}
+	return fmt.Sprintf("SELECT * FROM (%s) AS peerdb_sample LIMIT %d",
+		strings.TrimRight(strings.TrimSpace(query), ";"), limit)
+}
+
+func (c *PostgresConnector) pullQRepRecords(
+	config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+	limit int) (*model.QRecordBatch, error) {
 	if partition.FullTablePartition {
 		log.WithFields(log.Fields{
 			"partitionId": partition.PartitionId,
 		}).Infof("pulling full table partition for flow job %s", config.FlowJobName)
-		executor, err := NewQRepQueryExecutorSnapshot(c.pool, c.ctx, c.config.TransactionSnapshot,
-			config.FlowJobName, partition.PartitionId)
+		executor, err := c.newQRepExecutor(config, partition)
 		if err != nil {
 			return nil, err
 		}
-		query := config.Query
+		query := limitQuery(config.Query, limit)
 		return executor.ExecuteAndProcessQuery(query)
 	}
 
@@ -352,7 +455,7 @@ func (c *PostgresConnector) PullQRepRecords(
 	var rangeEnd interface{}
 
 	// Depending on the type of the range, convert the range into the correct type
-	switch x := partition.Range.Range.(type) {
+	switch x := partition.GetRange().GetRange().(type) {
 	case *protos.PartitionRange_IntRange:
 		rangeStart = x.IntRange.Start
 		rangeEnd = x.IntRange.End
@@ -370,6 +473,11 @@ func (c *PostgresConnector) PullQRepRecords(
 			OffsetNumber: uint16(x.TidRange.End.OffsetNumber),
 			Valid:        true,
 		}
+	case nil:
+		// the range bounds of a schema only partition are NULL, which the range condition matches no rows for.
+		if !partition.SchemaOnly {
+			return nil, fmt.Errorf("unknown range type: %v", x)
+		}
 	default:
 		return nil, fmt.Errorf("unknown range type: %v", x)
 	}
@@ -385,8 +493,9 @@ func (c *PostgresConnector) PullQRepRecords(
 		return nil, err
 	}
 
-	executor, err := NewQRepQueryExecutorSnapshot(c.pool, c.ctx, c.config.TransactionSnapshot,
-		config.FlowJobName, partition.PartitionId)
+	query = limitQuery(query, limit)
+
+	executor, err := c.newQRepExecutor(config, partition)
 	if err != nil {
 		return nil, err
 	}
@@ -396,6 +505,9 @@ func (c *PostgresConnector) PullQRepRecords(
 	if err != nil {
 		return nil, err
 	}
+	if limit > 0 {
+		return records, nil
+	}
 
 	totalRecordsAtSource, err := c.getApproxTableCounts([]string{config.WatermarkTable})
 	if err != nil {
@@ -415,8 +527,7 @@ func (c *PostgresConnector) PullQRepRecordStream(
 			"flowName":    config.FlowJobName,
 			"partitionId": partition.PartitionId,
 		}).Infof("pulling full table partition for flow job %s", config.FlowJobName)
-		executor, err := NewQRepQueryExecutorSnapshot(c.pool, c.ctx, c.config.TransactionSnapshot,
-			config.FlowJobName, partition.PartitionId)
+		executor, err := c.newQRepExecutor(config, partition)
 		if err != nil {
 			return 0, err
 		}
@@ -434,7 +545,7 @@ func (c *PostgresConnector) PullQRepRecordStream(
 	var rangeEnd interface{}
 
 	// Depending on the type of the range, convert the range into the correct type
-	switch x := partition.Range.Range.(type) {
+	switch x := partition.GetRange().GetRange().(type) {
 	case *protos.PartitionRange_IntRange:
 		rangeStart = x.IntRange.Start
 		rangeEnd = x.IntRange.End
@@ -452,6 +563,11 @@ func (c *PostgresConnector) PullQRepRecordStream(
 			OffsetNumber: uint16(x.TidRange.End.OffsetNumber),
 			Valid:        true,
 		}
+	case nil:
+		// the range bounds of a schema only partition are NULL, which the range condition matches no rows for.
+		if !partition.SchemaOnly {
+			return 0, fmt.Errorf("unknown range type: %v", x)
+		}
 	default:
 		return 0, fmt.Errorf("unknown range type: %v", x)
 	}
@@ -463,8 +579,7 @@ func (c *PostgresConnector) PullQRepRecordStream(
 		return 0, err
 	}
 
-	executor, err := NewQRepQueryExecutorSnapshot(c.pool, c.ctx, c.config.TransactionSnapshot,
-		config.FlowJobName, partition.PartitionId)
+	executor, err := c.newQRepExecutor(config, partition)
 	if err != nil {
 		return 0, err
 	}
@@ -501,7 +616,12 @@ func (c *PostgresConnector) SyncQRepRecords(
 	}
 
 	if !exists {
-		return 0, fmt.Errorf("table %s does not exist, used schema: %s", dstTable.Table, dstTable.Schema)
+		if !partition.SchemaOnly {
+			return 0, fmt.Errorf("table %s does not exist, used schema: %s", dstTable.Table, dstTable.Schema)
+		}
+		if err := c.createQRepDestinationTable(dstTable, stream); err != nil {
+			return 0, err
+		}
 	}
 
 	done, err := c.isPartitionSynced(partition.PartitionId)
@@ -590,6 +710,26 @@ func BuildQuery(query string, flowJobName string) (string, error) {
 	return res, nil
 }
 
+// createQRepDestinationTable creates the missing destination table of a schema only partition, with a
+// column for each field of the schema of the source query.
+func (c *PostgresConnector) createQRepDestinationTable(dstTable *SchemaTable, stream *model.QRecordStream) error {
+	schema, err := stream.Schema()
+	if err != nil {
+		return fmt.Errorf("failed to get schema from stream: %w", err)
+	}
+
+	columns := make([]string, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		columns = append(columns, fmt.Sprintf(`"%s" %s`, field.Name, qValueKindToPostgresType(string(field.Type))))
+	}
+	_, err = c.pool.Exec(c.ctx, fmt.Sprintf(createNormalizedTableSQL, dstTable.String(), strings.Join(columns, ",")))
+	if err != nil {
+		return fmt.Errorf("failed to create destination table %s: %w", dstTable, err)
+	}
+	log.Infof("created destination table %s from the schema of the source query", dstTable)
+	return nil
+}
+
 // isPartitionSynced checks whether a specific partition is synced
 func (c *PostgresConnector) isPartitionSynced(partitionID string) (bool, error) {
 	// setup the query string
@@ -0,0 +1,34 @@
+package connpostgres
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceIsoLevel(t *testing.T) {
+	connector := &PostgresConnector{config: &protos.PostgresConfig{}}
+	config := &protos.QRepConfig{FlowJobName: "test_source_iso_level"}
+
+	isoLevel, err := connector.sourceIsoLevel(config)
+	require.NoError(t, err)
+	require.Equal(t, pgx.RepeatableRead, isoLevel)
+	require.Empty(t, sourceTxOptions(isoLevel).DeferrableMode)
+
+	config.SourceIsolationLevel = protos.SourceIsolationLevel_SOURCE_ISOLATION_LEVEL_SERIALIZABLE
+	isoLevel, err = connector.sourceIsoLevel(config)
+	require.NoError(t, err)
+	require.Equal(t, pgx.Serializable, isoLevel)
+	require.Equal(t, pgx.Deferrable, sourceTxOptions(isoLevel).DeferrableMode)
+
+	// a slot's snapshot can only be imported with repeatable read
+	connector.config.TransactionSnapshot = "00000003-00000002-1"
+	_, err = connector.sourceIsoLevel(config)
+	require.Error(t, err)
+	config.SourceIsolationLevel = protos.SourceIsolationLevel_SOURCE_ISOLATION_LEVEL_REPEATABLE_READ
+	isoLevel, err = connector.sourceIsoLevel(config)
+	require.NoError(t, err)
+	require.Equal(t, pgx.RepeatableRead, isoLevel)
+}
@@ -320,3 +320,19 @@ func prepareTestData(test *testing.T, pool *pgxpool.Pool, schema string) int {
 
 	return len(times)
 }
+
+func TestEmptySourceTablePartitions(t *testing.T) {
+	snowflake := &protos.Peer{Type: protos.DBType_SNOWFLAKE}
+	bigquery := &protos.Peer{Type: protos.DBType_BIGQUERY}
+
+	partitions := emptySourceTablePartitions(&protos.QRepConfig{DestinationPeer: snowflake}, nil)
+	assert.Len(t, partitions, 1)
+	assert.True(t, partitions[0].SchemaOnly)
+
+	assert.Empty(t, emptySourceTablePartitions(&protos.QRepConfig{DestinationPeer: bigquery}, nil))
+	assert.Empty(t, emptySourceTablePartitions(&protos.QRepConfig{
+		DestinationPeer:        snowflake,
+		EmptySourceTablePolicy: protos.EmptySourceTablePolicy_EMPTY_SOURCE_TABLE_POLICY_SKIP,
+	}, nil))
+	assert.Empty(t, emptySourceTablePartitions(&protos.QRepConfig{DestinationPeer: snowflake}, partitions[0]))
+}
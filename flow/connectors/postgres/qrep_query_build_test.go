@@ -45,3 +45,14 @@ func TestBuildQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestLimitQuery(t *testing.T) {
+	query := "SELECT * FROM table WHERE id BETWEEN $1 AND $2 ORDER BY id;"
+	expected := "SELECT * FROM (SELECT * FROM table WHERE id BETWEEN $1 AND $2 ORDER BY id) AS peerdb_sample LIMIT 1000"
+	if actual := limitQuery(query, 1000); actual != expected {
+		t.Fatalf("Expected query %q, got %q", expected, actual)
+	}
+	if actual := limitQuery(query, 0); actual != query {
+		t.Fatalf("Expected query %q, got %q", query, actual)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	util "github.com/PeerDB-io/peer-flow/utils"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	log "github.com/sirupsen/logrus"
 	"go.temporal.io/sdk/activity"
@@ -25,6 +26,11 @@ type QRepQueryExecutor struct {
 	flowJobName   string
 	partitionID   string
 	customTypeMap map[uint32]string
+	// compositeTypes is nil unless loaded, leaving composite types to be read as text.
+	compositeTypes *compositeTypeMap
+	jsonAsText     bool
+	rangesAsText   bool
+	isoLevel       pgx.TxIsoLevel
 }
 
 func NewQRepQueryExecutor(pool *pgxpool.Pool, ctx context.Context,
@@ -35,6 +41,7 @@ func NewQRepQueryExecutor(pool *pgxpool.Pool, ctx context.Context,
 		snapshot:    "",
 		flowJobName: flowJobName,
 		partitionID: partitionID,
+		isoLevel:    pgx.RepeatableRead,
 	}
 }
 
@@ -48,13 +55,19 @@ func NewQRepQueryExecutorSnapshot(pool *pgxpool.Pool, ctx context.Context, snaps
 	if err != nil {
 		return nil, fmt.Errorf("failed to get custom data types: %w", err)
 	}
+	compositeTypes, err := utils.GetCompositeTypes(ctx, pool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get composite types: %w", err)
+	}
 	return &QRepQueryExecutor{
-		pool:          pool,
-		ctx:           ctx,
-		snapshot:      snapshot,
-		flowJobName:   flowJobName,
-		partitionID:   partitionID,
-		customTypeMap: CustomTypeMap,
+		pool:           pool,
+		ctx:            ctx,
+		snapshot:       snapshot,
+		flowJobName:    flowJobName,
+		partitionID:    partitionID,
+		customTypeMap:  CustomTypeMap,
+		compositeTypes: newCompositeTypeMap(compositeTypes),
+		isoLevel:       pgx.RepeatableRead,
 	}, nil
 }
 
@@ -62,6 +75,21 @@ func (qe *QRepQueryExecutor) SetTestEnv(testEnv bool) {
 	qe.testEnv = testEnv
 }
 
+// SetJSONAsText makes json columns be read as text, exactly as written.
+func (qe *QRepQueryExecutor) SetJSONAsText(jsonAsText bool) {
+	qe.jsonAsText = jsonAsText
+}
+
+// SetRangesAsText makes range columns be read in their Postgres text form instead of as json.
+func (qe *QRepQueryExecutor) SetRangesAsText(rangesAsText bool) {
+	qe.rangesAsText = rangesAsText
+}
+
+// SetIsoLevel sets the isolation level of the transaction the query runs in, repeatable read by default.
+func (qe *QRepQueryExecutor) SetIsoLevel(isoLevel pgx.TxIsoLevel) {
+	qe.isoLevel = isoLevel
+}
+
 func (qe *QRepQueryExecutor) ExecuteQuery(query string, args ...interface{}) (pgx.Rows, error) {
 	rows, err := qe.pool.Query(qe.ctx, query, args...)
 	if err != nil {
@@ -100,8 +128,10 @@ func (qe *QRepQueryExecutor) fieldDescriptionsToSchema(fds []pgconn.FieldDescrip
 	qfields := make([]*model.QField, len(fds))
 	for i, fd := range fds {
 		cname := fd.Name
-		ctype := postgresOIDToQValueKind(fd.DataTypeOID)
-		if ctype == qvalue.QValueKindInvalid {
+		ctype := postgresColumnQValueKind(fd.DataTypeOID, qe.jsonAsText, qe.rangesAsText)
+		if qe.compositeTypes.isComposite(fd.DataTypeOID) {
+			ctype = qvalue.QValueKindJSON
+		} else if ctype == qvalue.QValueKindInvalid {
 			var err error
 			ctype = qvalue.QValueKind(qe.customTypeMap[fd.DataTypeOID])
 			if err != nil {
@@ -136,7 +166,8 @@ func (qe *QRepQueryExecutor) ProcessRows(
 	}).Info("Processing rows")
 	// Iterate over the rows
 	for rows.Next() {
-		record, err := mapRowToQRecord(rows, fieldDescriptions, qe.customTypeMap)
+		record, err := mapRowToQRecord(rows, fieldDescriptions, qe.customTypeMap, qe.compositeTypes,
+			qe.jsonAsText, qe.rangesAsText)
 		if err != nil {
 			return nil, fmt.Errorf("failed to map row to QRecord: %w", err)
 		}
@@ -173,7 +204,8 @@ func (qe *QRepQueryExecutor) processRowsStream(
 
 	// Iterate over the rows
 	for rows.Next() {
-		record, err := mapRowToQRecord(rows, fieldDescriptions, qe.customTypeMap)
+		record, err := mapRowToQRecord(rows, fieldDescriptions, qe.customTypeMap, qe.compositeTypes,
+			qe.jsonAsText, qe.rangesAsText)
 		if err != nil {
 			stream.Records <- &model.QRecordOrError{
 				Err: fmt.Errorf("failed to map row to QRecord: %w", err),
@@ -279,7 +311,8 @@ func (qe *QRepQueryExecutor) ExecuteAndProcessQuery(
 		return nil, err
 	case schema := <-stream.SchemaChan():
 		if schema.Err != nil {
-			return nil, fmt.Errorf("failed to get schema from stream: %w", schema.Err)
+			// the query failed before its schema was read, its own error says why.
+			return nil, <-errors
 		}
 		batch := &model.QRecordBatch{
 			NumRecords: 0,
@@ -308,11 +341,16 @@ func (qe *QRepQueryExecutor) ExecuteAndProcessQueryStream(
 		"partitionID": qe.partitionID,
 	}).Infof("Executing and processing query stream '%s'", query)
 	defer close(stream.Records)
+	// the schema is set once the query runs, even without rows. If it fails before that, readers
+	// waiting on the schema would block forever, so they get an error instead.
+	defer func() {
+		if !stream.IsSchemaSet() {
+			_ = stream.SetSchemaError(fmt.Errorf("query for partition %s failed before its schema was read",
+				qe.partitionID))
+		}
+	}()
 
-	tx, err := qe.pool.BeginTx(qe.ctx, pgx.TxOptions{
-		AccessMode: pgx.ReadOnly,
-		IsoLevel:   pgx.RepeatableRead,
-	})
+	tx, err := qe.pool.BeginTx(qe.ctx, sourceTxOptions(qe.isoLevel))
 	if err != nil {
 		log.WithFields(log.Fields{
 			"flowName":    qe.flowJobName,
@@ -414,7 +452,8 @@ func (qe *QRepQueryExecutor) ExecuteAndProcessQueryStream(
 }
 
 func mapRowToQRecord(row pgx.Rows, fds []pgconn.FieldDescription,
-	customTypeMap map[uint32]string) (*model.QRecord, error) {
+	customTypeMap map[uint32]string, compositeTypes *compositeTypeMap, jsonAsText bool,
+	rangesAsText bool) (*model.QRecord, error) {
 	// make vals an empty array of QValue of size len(fds)
 	record := model.NewQRecord(len(fds))
 
@@ -426,7 +465,26 @@ func mapRowToQRecord(row pgx.Rows, fds []pgconn.FieldDescription,
 	for i, fd := range fds {
 		// Check if it's a custom type first
 		typeName, ok := customTypeMap[fd.DataTypeOID]
-		if !ok {
+		if jsonAsText && fd.DataTypeOID == pgtype.JSONOID {
+			// the raw value is the json as written, in both formats.
+			var jsonText interface{}
+			if rawValue := row.RawValues()[i]; rawValue != nil {
+				jsonText = string(rawValue)
+			}
+			record.Set(i, qvalue.QValue{Kind: qvalue.QValueKindString, Value: jsonText})
+		} else if compositeTypes.isComposite(fd.DataTypeOID) {
+			tmp, err := compositeTypes.decode(fd.DataTypeOID, fd.Format, row.RawValues()[i])
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse field: %w", err)
+			}
+			record.Set(i, *tmp)
+		} else if isRangeOID(fd.DataTypeOID) {
+			tmp, err := parseRange(fd.DataTypeOID, values[i], rangesAsText)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse field: %w", err)
+			}
+			record.Set(i, *tmp)
+		} else if !ok {
 			tmp, err := parseFieldFromPostgresOID(fd.DataTypeOID, values[i])
 			if err != nil {
 				return nil, fmt.Errorf("failed to parse field: %w", err)
@@ -7,10 +7,13 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"net"
+	"net/netip"
 	"strings"
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/lib/pq/oid"
 	log "github.com/sirupsen/logrus"
@@ -67,6 +70,15 @@ func postgresOIDToQValueKind(recvOID uint32) qvalue.QValueKind {
 		return qvalue.QValueKindArrayFloat64
 	case pgtype.TextArrayOID, pgtype.VarcharArrayOID, pgtype.BPCharArrayOID:
 		return qvalue.QValueKindArrayString
+	case pgtype.UUIDArrayOID:
+		return qvalue.QValueKindArrayUUID
+	case pgtype.InetOID, pgtype.CIDROID, pgtype.MacaddrOID:
+		// network types are replicated in their text form, see networkValueToString.
+		return qvalue.QValueKindString
+	case pgtype.Int4rangeOID, pgtype.Int8rangeOID, pgtype.NumrangeOID,
+		pgtype.TsrangeOID, pgtype.TstzrangeOID, pgtype.DaterangeOID:
+		// ranges are replicated as json objects unless kept as text, see parseRange.
+		return rangeQValueKind(false)
 	default:
 		typeName, ok := pgtype.NewMap().TypeForOID(recvOID)
 		if !ok {
@@ -95,6 +107,19 @@ func postgresOIDToQValueKind(recvOID uint32) qvalue.QValueKind {
 	}
 }
 
+// postgresColumnQValueKind is postgresOIDToQValueKind, except that json columns are mapped to
+// text when jsonAsText is set, to be replicated exactly as written instead of normalized like jsonb,
+// and range columns are mapped to text when rangesAsText is set.
+func postgresColumnQValueKind(recvOID uint32, jsonAsText bool, rangesAsText bool) qvalue.QValueKind {
+	if jsonAsText && recvOID == pgtype.JSONOID {
+		return qvalue.QValueKindString
+	}
+	if isRangeOID(recvOID) {
+		return rangeQValueKind(rangesAsText)
+	}
+	return postgresOIDToQValueKind(recvOID)
+}
+
 func qValueKindToPostgresType(qvalueKind string) string {
 	switch qvalue.QValueKind(qvalueKind) {
 	case qvalue.QValueKindBoolean:
@@ -139,6 +164,8 @@ func qValueKindToPostgresType(qvalueKind string) string {
 		return "DOUBLE PRECISION[]"
 	case qvalue.QValueKindArrayString:
 		return "TEXT[]"
+	case qvalue.QValueKindArrayUUID:
+		return "UUID[]"
 	default:
 		return "TEXT"
 	}
@@ -152,6 +179,23 @@ func parseJSON(value interface{}) (*qvalue.QValue, error) {
 	return &qvalue.QValue{Kind: qvalue.QValueKindJSON, Value: string(jsonVal)}, nil
 }
 
+// parseTimeTZ parses a time with time zone as Postgres prints it, like 12:34:56.789+05:30,
+// converting it to UTC as destinations have no time of day type with an offset.
+func parseTimeTZ(timeVal string) (time.Time, error) {
+	// edge case, Postgres supports this extreme value for time
+	if strings.HasPrefix(timeVal, "24:00:00") {
+		timeVal = "23:59:59.999999" + strings.TrimLeft(strings.TrimPrefix(timeVal, "24:00:00"), ".0")
+	}
+	// Postgres leaves out the minutes and seconds of the offset when they are zero
+	for _, layout := range []string{"15:04:05.999999-07", "15:04:05.999999-07:00", "15:04:05.999999-07:00:00"} {
+		t, err := time.Parse(layout, timeVal)
+		if err == nil {
+			return t.UTC().AddDate(1970, 0, 0), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("failed to parse time with time zone: %s", timeVal)
+}
+
 func parseFieldFromQValueKind(qvalueKind qvalue.QValueKind, value interface{}) (*qvalue.QValue, error) {
 	var val *qvalue.QValue = nil
 
@@ -188,16 +232,10 @@ func parseFieldFromQValueKind(qvalueKind qvalue.QValueKind, value interface{}) (
 			val = &qvalue.QValue{Kind: qvalue.QValueKindTime, Value: t}
 		}
 	case qvalue.QValueKindTimeTZ:
-		timeVal := value.(string)
-		// edge case, Postgres supports this extreme value for time
-		timeVal = strings.Replace(timeVal, "24:00:00.000000", "23:59:59.999999", 1)
-		// edge case, Postgres prints +0000 as +00
-		timeVal = strings.Replace(timeVal, "+00", "+0000", 1)
-		t, err := time.Parse("15:04:05.999999-0700", timeVal)
+		t, err := parseTimeTZ(value.(string))
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse time: %w", err)
+			return nil, err
 		}
-		t = t.AddDate(1970, 0, 0)
 		val = &qvalue.QValue{Kind: qvalue.QValueKindTimeTZ, Value: t}
 
 	case qvalue.QValueKindBoolean:
@@ -339,6 +377,14 @@ func parseFieldFromQValueKind(qvalueKind qvalue.QValueKind, value interface{}) (
 		default:
 			return nil, fmt.Errorf("failed to parse array string: %v", value)
 		}
+	case qvalue.QValueKindArrayUUID:
+		uuidArray, err := parseUUIDArray(value)
+		if err != nil {
+			return nil, err
+		}
+		if uuidArray != nil {
+			val = &qvalue.QValue{Kind: qvalue.QValueKindArrayUUID, Value: uuidArray}
+		}
 	case qvalue.QValueKindHStore:
 		hstoreVal, err := value.(pgtype.Hstore).HstoreValue()
 		if err != nil {
@@ -366,10 +412,91 @@ func parseFieldFromQValueKind(qvalueKind qvalue.QValueKind, value interface{}) (
 	return val, nil
 }
 
+// parseUUIDArray parses a uuid[] into the string form of its uuids, which is how they are written out.
+func parseUUIDArray(value interface{}) ([]string, error) {
+	var elements []interface{}
+	switch v := value.(type) {
+	case []string:
+		return v, nil
+	case [][16]byte:
+		elements = make([]interface{}, 0, len(v))
+		for _, element := range v {
+			elements = append(elements, element)
+		}
+	case pgtype.Array[pgtype.UUID]:
+		if !v.Valid {
+			return nil, nil
+		}
+		elements = make([]interface{}, 0, len(v.Elements))
+		for _, element := range v.Elements {
+			elements = append(elements, element)
+		}
+	case []interface{}:
+		elements = v
+	default:
+		return nil, fmt.Errorf("failed to parse array uuid: %v", value)
+	}
+
+	uuidArray := make([]string, 0, len(elements))
+	for _, element := range elements {
+		switch e := element.(type) {
+		case string:
+			uuidArray = append(uuidArray, e)
+		case [16]byte:
+			uuidArray = append(uuidArray, uuid.UUID(e).String())
+		case pgtype.UUID:
+			if !e.Valid {
+				return nil, fmt.Errorf("failed to parse array uuid: NULL elements are not supported")
+			}
+			uuidArray = append(uuidArray, uuid.UUID(e.Bytes).String())
+		default:
+			return nil, fmt.Errorf("failed to parse array uuid element: %v", element)
+		}
+	}
+	return uuidArray, nil
+}
+
 func parseFieldFromPostgresOID(oid uint32, value interface{}) (*qvalue.QValue, error) {
+	switch oid {
+	case pgtype.InetOID, pgtype.CIDROID, pgtype.MacaddrOID:
+		if value == nil {
+			return &qvalue.QValue{Kind: qvalue.QValueKindString, Value: nil}, nil
+		}
+		text, err := networkValueToString(oid, value)
+		if err != nil {
+			return nil, err
+		}
+		return &qvalue.QValue{Kind: qvalue.QValueKindString, Value: text}, nil
+	case pgtype.Int4rangeOID, pgtype.Int8rangeOID, pgtype.NumrangeOID,
+		pgtype.TsrangeOID, pgtype.TstzrangeOID, pgtype.DaterangeOID:
+		return parseRange(oid, value, false)
+	}
 	return parseFieldFromQValueKind(postgresOIDToQValueKind(oid), value)
 }
 
+// networkValueToString formats an inet, cidr or macaddr value the way Postgres does.
+// inet omits the prefix length of host addresses, cidr always has one.
+func networkValueToString(oid uint32, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case netip.Prefix:
+		if oid == pgtype.InetOID && v.Bits() == v.Addr().BitLen() {
+			return v.Addr().String(), nil
+		}
+		return v.String(), nil
+	case netip.Addr:
+		if oid == pgtype.CIDROID {
+			return netip.PrefixFrom(v, v.BitLen()).String(), nil
+		}
+		return v.String(), nil
+	case net.HardwareAddr:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("failed to parse network address: %v", value)
+	}
+}
+
 func numericToRat(numVal *pgtype.Numeric) (*big.Rat, error) {
 	if numVal.Valid {
 		if numVal.NaN {
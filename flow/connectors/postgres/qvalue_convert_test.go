@@ -0,0 +1,166 @@
+package connpostgres
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/lib/pq/oid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXMLReplicatesAsText(t *testing.T) {
+	require.Equal(t, qvalue.QValueKindString, postgresOIDToQValueKind(uint32(oid.T_xml)))
+	require.Equal(t, "TEXT", qValueKindToPostgresType(string(qvalue.QValueKindString)))
+
+	cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{}, map[uint32]string{})
+	require.NoError(t, err)
+
+	// the document must come through exactly as serialized by Postgres, including whitespace
+	for _, doc := range []string{
+		`<?xml version="1.0"?><root a="1">  <child>text &amp; more</child></root>`,
+		"<note>\n\t<to>x</to>\n</note>",
+		"",
+	} {
+		val, err := cdc.decodeColumnData([]byte(doc), uint32(oid.T_xml), pgtype.TextFormatCode)
+		require.NoError(t, err)
+		require.Equal(t, &qvalue.QValue{Kind: qvalue.QValueKindString, Value: doc}, val)
+	}
+
+	val, err := parseFieldFromQValueKind(qvalue.QValueKindString, nil)
+	require.NoError(t, err)
+	require.Nil(t, val.Value)
+}
+
+func TestJSONAsTextKeepsJSONVerbatim(t *testing.T) {
+	const doc = `{"b": 1,  "a": [2, {"d": null, "c": true}]}`
+	const normalizedDoc = `{"a":[2,{"c":true,"d":null}],"b":1}`
+
+	cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{JSONAsText: true}, map[uint32]string{})
+	require.NoError(t, err)
+
+	// json keeps its key order and whitespace, jsonb is normalized either way.
+	require.Equal(t, qvalue.QValueKindString, postgresColumnQValueKind(pgtype.JSONOID, true, false))
+	require.Equal(t, qvalue.QValueKindJSON, postgresColumnQValueKind(pgtype.JSONBOID, true, false))
+	val, err := cdc.decodeColumnData([]byte(doc), pgtype.JSONOID, pgtype.TextFormatCode)
+	require.NoError(t, err)
+	require.Equal(t, &qvalue.QValue{Kind: qvalue.QValueKindString, Value: doc}, val)
+	val, err = cdc.decodeColumnData([]byte(doc), pgtype.JSONBOID, pgtype.TextFormatCode)
+	require.NoError(t, err)
+	require.Equal(t, &qvalue.QValue{Kind: qvalue.QValueKindJSON, Value: normalizedDoc}, val)
+
+	cdc, err = NewPostgresCDCSource(&PostgresCDCConfig{}, map[uint32]string{})
+	require.NoError(t, err)
+
+	require.Equal(t, qvalue.QValueKindJSON, postgresColumnQValueKind(pgtype.JSONOID, false, false))
+	val, err = cdc.decodeColumnData([]byte(doc), pgtype.JSONOID, pgtype.TextFormatCode)
+	require.NoError(t, err)
+	require.Equal(t, &qvalue.QValue{Kind: qvalue.QValueKindJSON, Value: normalizedDoc}, val)
+}
+
+func TestTimeAndTimeTZ(t *testing.T) {
+	cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{}, map[uint32]string{})
+	require.NoError(t, err)
+
+	// time is kept as is
+	val, err := cdc.decodeColumnData([]byte("12:34:56.789"), pgtype.TimeOID, pgtype.TextFormatCode)
+	require.NoError(t, err)
+	require.Equal(t, qvalue.QValueKindTime, val.Kind)
+	timeStr, err := val.GoTimeConvert()
+	require.NoError(t, err)
+	require.Equal(t, "12:34:56.789", timeStr)
+
+	// time with time zone is converted to UTC, whichever way Postgres prints the offset
+	for timeTZ, utcTime := range map[string]string{
+		"12:34:56.789+00":    "12:34:56.789",
+		"12:34:56-08":        "20:34:56",
+		"12:34:56.5+05:30":   "07:04:56.5",
+		"01:00:00+05:30:15":  "19:29:45",
+		"24:00:00+00":        "23:59:59.999999",
+		"24:00:00.000000+00": "23:59:59.999999",
+	} {
+		val, err := cdc.decodeColumnData([]byte(timeTZ), uint32(oid.T_timetz), pgtype.TextFormatCode)
+		require.NoError(t, err)
+		require.Equal(t, qvalue.QValueKindTimeTZ, val.Kind)
+		timeStr, err := val.GoTimeConvert()
+		require.NoError(t, err)
+		require.Equal(t, utcTime, timeStr, timeTZ)
+	}
+
+	_, err = cdc.decodeColumnData([]byte("not a time"), uint32(oid.T_timetz), pgtype.TextFormatCode)
+	require.Error(t, err)
+}
+
+func TestUUIDArrayKeepsElements(t *testing.T) {
+	const first = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	const second = "00000000-0000-0000-0000-000000000000"
+
+	require.Equal(t, qvalue.QValueKindArrayUUID, postgresOIDToQValueKind(pgtype.UUIDArrayOID))
+	require.Equal(t, "UUID[]", qValueKindToPostgresType(string(qvalue.QValueKindArrayUUID)))
+
+	cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{}, map[uint32]string{})
+	require.NoError(t, err)
+
+	val, err := cdc.decodeColumnData([]byte("{"+first+","+second+"}"), pgtype.UUIDArrayOID, pgtype.TextFormatCode)
+	require.NoError(t, err)
+	require.Equal(t, &qvalue.QValue{Kind: qvalue.QValueKindArrayUUID, Value: []string{first, second}}, val)
+
+	items := model.NewRecordItemWithData([]string{"ids"}, []*qvalue.QValue{val})
+	itemsJSON, err := items.ToJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"ids":["`+first+`","`+second+`"]}`, itemsJSON)
+
+	// the initial copy reads uuids as bytes
+	firstBytes := [16]byte{0xa0, 0xee, 0xbc, 0x99, 0x9c, 0x0b, 0x4e, 0xf8,
+		0xbb, 0x6d, 0x6b, 0xb9, 0xbd, 0x38, 0x0a, 0x11}
+	val, err = parseFieldFromQValueKind(qvalue.QValueKindArrayUUID, []interface{}{firstBytes})
+	require.NoError(t, err)
+	require.Equal(t, []string{first}, val.Value)
+}
+
+func TestNetworkTypesKeepCanonicalText(t *testing.T) {
+	cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{}, map[uint32]string{})
+	require.NoError(t, err)
+	typeMap := pgtype.NewMap()
+
+	for _, tc := range []struct {
+		oid  uint32
+		text string
+	}{
+		{pgtype.InetOID, "192.168.1.5"},
+		{pgtype.InetOID, "192.168.1.5/24"},
+		{pgtype.InetOID, "2001:db8::1"},
+		{pgtype.CIDROID, "10.1.0.0/16"},
+		{pgtype.CIDROID, "1.1.10.2/32"},
+		{pgtype.CIDROID, "2001:db8::/32"},
+		{pgtype.MacaddrOID, "08:00:2b:01:02:03"},
+	} {
+		require.Equal(t, qvalue.QValueKindString, postgresOIDToQValueKind(tc.oid))
+		expected := &qvalue.QValue{Kind: qvalue.QValueKindString, Value: tc.text}
+
+		val, err := cdc.decodeColumnData([]byte(tc.text), tc.oid, pgtype.TextFormatCode)
+		require.NoError(t, err)
+		require.Equal(t, expected, val, tc.text)
+
+		// the binary form has the prefix length of inet host addresses too, it must not be shown
+		dt, ok := typeMap.TypeForOID(tc.oid)
+		require.True(t, ok)
+		parsed, err := dt.Codec.DecodeValue(typeMap, tc.oid, pgtype.TextFormatCode, []byte(tc.text))
+		require.NoError(t, err)
+		binary, err := typeMap.Encode(tc.oid, pgtype.BinaryFormatCode, parsed, nil)
+		require.NoError(t, err)
+		val, err = cdc.decodeColumnData(binary, tc.oid, pgtype.BinaryFormatCode)
+		require.NoError(t, err)
+		require.Equal(t, expected, val, tc.text)
+
+		// the initial copy gets the decoded values
+		val, err = parseFieldFromPostgresOID(tc.oid, parsed)
+		require.NoError(t, err)
+		require.Equal(t, expected, val, tc.text)
+	}
+
+	val, err := parseFieldFromPostgresOID(pgtype.InetOID, nil)
+	require.NoError(t, err)
+	require.Nil(t, val.Value)
+}
@@ -0,0 +1,151 @@
+package connpostgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// rangeJSON is the json form of a range. A bound is null when the range is unbounded on that side,
+// infinite timestamps and dates keep their infinity and -infinity values.
+type rangeJSON struct {
+	Empty          bool    `json:"empty"`
+	Lower          *string `json:"lower"`
+	Upper          *string `json:"upper"`
+	LowerInclusive bool    `json:"lower_inclusive"`
+	UpperInclusive bool    `json:"upper_inclusive"`
+}
+
+func isRangeOID(oid uint32) bool {
+	switch oid {
+	case pgtype.Int4rangeOID, pgtype.Int8rangeOID, pgtype.NumrangeOID,
+		pgtype.TsrangeOID, pgtype.TstzrangeOID, pgtype.DaterangeOID:
+		return true
+	default:
+		return false
+	}
+}
+
+// rangeQValueKind is the kind range columns are replicated as, json unless they are kept as text.
+func rangeQValueKind(rangesAsText bool) qvalue.QValueKind {
+	if rangesAsText {
+		return qvalue.QValueKindString
+	}
+	return qvalue.QValueKindJSON
+}
+
+// parseRange converts a range decoded by pgx to its json form, or to its Postgres text form when
+// rangesAsText is set.
+func parseRange(oid uint32, value interface{}, rangesAsText bool) (*qvalue.QValue, error) {
+	kind := rangeQValueKind(rangesAsText)
+	if value == nil {
+		return &qvalue.QValue{Kind: kind, Value: nil}, nil
+	}
+	r, ok := value.(pgtype.Range[interface{}])
+	if !ok {
+		return nil, fmt.Errorf("failed to parse range: unexpected value of type %T", value)
+	}
+	if !r.Valid {
+		return &qvalue.QValue{Kind: kind, Value: nil}, nil
+	}
+
+	var lower, upper *string
+	if r.LowerType == pgtype.Inclusive || r.LowerType == pgtype.Exclusive {
+		bound, err := formatRangeBound(oid, r.Lower)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse lower bound of range: %w", err)
+		}
+		lower = &bound
+	}
+	if r.UpperType == pgtype.Inclusive || r.UpperType == pgtype.Exclusive {
+		bound, err := formatRangeBound(oid, r.Upper)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse upper bound of range: %w", err)
+		}
+		upper = &bound
+	}
+	empty := r.LowerType == pgtype.Empty
+
+	if rangesAsText {
+		return &qvalue.QValue{Kind: kind, Value: rangeText(empty, lower, upper,
+			r.LowerType == pgtype.Inclusive, r.UpperType == pgtype.Inclusive)}, nil
+	}
+	rangeBytes, err := json.Marshal(&rangeJSON{
+		Empty:          empty,
+		Lower:          lower,
+		Upper:          upper,
+		LowerInclusive: r.LowerType == pgtype.Inclusive,
+		UpperInclusive: r.UpperType == pgtype.Inclusive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal range: %w", err)
+	}
+	return &qvalue.QValue{Kind: kind, Value: string(rangeBytes)}, nil
+}
+
+// formatRangeBound formats a bound the way Postgres prints it, with timestamps in UTC.
+func formatRangeBound(oid uint32, bound interface{}) (string, error) {
+	switch v := bound.(type) {
+	case int32, int64:
+		return fmt.Sprint(v), nil
+	case pgtype.Numeric:
+		numeric, err := v.Value()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprint(numeric), nil
+	case pgtype.InfinityModifier:
+		if v == pgtype.NegativeInfinity {
+			return "-infinity", nil
+		}
+		return "infinity", nil
+	case time.Time:
+		switch oid {
+		case pgtype.TstzrangeOID:
+			return v.UTC().Format("2006-01-02 15:04:05.999999-07"), nil
+		case pgtype.DaterangeOID:
+			return v.Format("2006-01-02"), nil
+		default:
+			return v.Format("2006-01-02 15:04:05.999999"), nil
+		}
+	}
+	return "", fmt.Errorf("unexpected bound of type %T", bound)
+}
+
+// rangeText renders a range in its Postgres text form, quoting bounds that need it.
+func rangeText(empty bool, lower *string, upper *string, lowerInclusive bool, upperInclusive bool) string {
+	if empty {
+		return "empty"
+	}
+	var sb strings.Builder
+	if lowerInclusive {
+		sb.WriteByte('[')
+	} else {
+		sb.WriteByte('(')
+	}
+	if lower != nil {
+		sb.WriteString(quoteRangeBound(*lower))
+	}
+	sb.WriteByte(',')
+	if upper != nil {
+		sb.WriteString(quoteRangeBound(*upper))
+	}
+	if upperInclusive {
+		sb.WriteByte(']')
+	} else {
+		sb.WriteByte(')')
+	}
+	return sb.String()
+}
+
+func quoteRangeBound(bound string) string {
+	if bound != "" && !strings.ContainsAny(bound, ` ,()[]"\`) {
+		return bound
+	}
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + escaper.Replace(bound) + `"`
+}
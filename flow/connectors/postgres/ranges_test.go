@@ -0,0 +1,120 @@
+package connpostgres
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTstzrangeBoundsRoundTrip(t *testing.T) {
+	lower := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	upper := time.Date(2023, 2, 1, 7, 0, 0, 500000000, time.UTC)
+	typeMap := pgtype.NewMap()
+	dt, ok := typeMap.TypeForOID(pgtype.TstzrangeOID)
+	require.True(t, ok)
+
+	for _, tc := range []struct {
+		name  string
+		value pgtype.Range[pgtype.Timestamptz]
+		json  string
+		text  string
+	}{
+		{
+			name: "bounded",
+			value: pgtype.Range[pgtype.Timestamptz]{
+				Lower: pgtype.Timestamptz{Time: lower, Valid: true}, LowerType: pgtype.Inclusive,
+				Upper:     pgtype.Timestamptz{Time: upper.In(time.FixedZone("", 5*3600+1800)), Valid: true},
+				UpperType: pgtype.Exclusive, Valid: true,
+			},
+			json: `{"empty":false,"lower":"2023-01-01 00:00:00+00","upper":"2023-02-01 07:00:00.5+00",` +
+				`"lower_inclusive":true,"upper_inclusive":false}`,
+			text: `["2023-01-01 00:00:00+00","2023-02-01 07:00:00.5+00")`,
+		},
+		{
+			name: "infinite and unbounded",
+			value: pgtype.Range[pgtype.Timestamptz]{
+				LowerType: pgtype.Unbounded,
+				Upper:     pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true},
+				UpperType: pgtype.Inclusive, Valid: true,
+			},
+			json: `{"empty":false,"lower":null,"upper":"infinity","lower_inclusive":false,"upper_inclusive":true}`,
+			text: `(,infinity]`,
+		},
+		{
+			name:  "empty",
+			value: pgtype.Range[pgtype.Timestamptz]{LowerType: pgtype.Empty, UpperType: pgtype.Empty, Valid: true},
+			json:  `{"empty":true,"lower":null,"upper":null,"lower_inclusive":false,"upper_inclusive":false}`,
+			text:  `empty`,
+		},
+	} {
+		for _, rangesAsText := range []bool{false, true} {
+			cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{RangesAsText: rangesAsText}, map[uint32]string{})
+			require.NoError(t, err)
+			expected := &qvalue.QValue{Kind: qvalue.QValueKindJSON, Value: tc.json}
+			if rangesAsText {
+				expected = &qvalue.QValue{Kind: qvalue.QValueKindString, Value: tc.text}
+			}
+			require.Equal(t, expected.Kind, postgresColumnQValueKind(pgtype.TstzrangeOID, false, rangesAsText))
+
+			// replication sends the text form, the initial copy reads the binary form
+			text, err := typeMap.Encode(pgtype.TstzrangeOID, pgtype.TextFormatCode, tc.value, nil)
+			require.NoError(t, err)
+			val, err := cdc.decodeColumnData(text, pgtype.TstzrangeOID, pgtype.TextFormatCode)
+			require.NoError(t, err)
+			require.Equal(t, expected, val, tc.name)
+
+			binary, err := typeMap.Encode(pgtype.TstzrangeOID, pgtype.BinaryFormatCode, tc.value, nil)
+			require.NoError(t, err)
+			parsed, err := dt.Codec.DecodeValue(typeMap, pgtype.TstzrangeOID, pgtype.BinaryFormatCode, binary)
+			require.NoError(t, err)
+			val, err = parseRange(pgtype.TstzrangeOID, parsed, rangesAsText)
+			require.NoError(t, err)
+			require.Equal(t, expected, val, tc.name)
+		}
+
+		// the text form reads back as the same range
+		var roundTripped pgtype.Range[pgtype.Timestamptz]
+		require.NoError(t, typeMap.Scan(pgtype.TstzrangeOID, pgtype.TextFormatCode, []byte(tc.text), &roundTripped))
+		require.Equal(t, tc.value.LowerType, roundTripped.LowerType, tc.name)
+		require.Equal(t, tc.value.UpperType, roundTripped.UpperType, tc.name)
+		require.True(t, tc.value.Lower.Time.Equal(roundTripped.Lower.Time), tc.name)
+		require.True(t, tc.value.Upper.Time.Equal(roundTripped.Upper.Time), tc.name)
+		require.Equal(t, tc.value.Upper.InfinityModifier, roundTripped.Upper.InfinityModifier, tc.name)
+
+		// and so do the bounds of the json form
+		var rangeObj rangeJSON
+		require.NoError(t, json.Unmarshal([]byte(tc.json), &rangeObj))
+		if rangeObj.Lower != nil {
+			var bound pgtype.Timestamptz
+			err := typeMap.Scan(pgtype.TimestamptzOID, pgtype.TextFormatCode, []byte(*rangeObj.Lower), &bound)
+			require.NoError(t, err)
+			require.True(t, tc.value.Lower.Time.Equal(bound.Time), tc.name)
+		}
+	}
+}
+
+func TestRangeBoundsOfOtherTypes(t *testing.T) {
+	cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{RangesAsText: true}, map[uint32]string{})
+	require.NoError(t, err)
+
+	for oid, text := range map[uint32]string{
+		pgtype.Int4rangeOID: "[1,10)",
+		pgtype.Int8rangeOID: "[-5,9000000000)",
+		pgtype.NumrangeOID:  "(1.50,10.25]",
+		pgtype.DaterangeOID: "[2023-01-01,infinity)",
+		pgtype.TsrangeOID:   `["2023-01-01 01:02:03.5",)`,
+	} {
+		require.Equal(t, qvalue.QValueKindJSON, postgresOIDToQValueKind(oid))
+		val, err := cdc.decodeColumnData([]byte(text), oid, pgtype.TextFormatCode)
+		require.NoError(t, err)
+		require.Equal(t, &qvalue.QValue{Kind: qvalue.QValueKindString, Value: text}, val)
+	}
+
+	val, err := parseRange(pgtype.Int4rangeOID, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, &qvalue.QValue{Kind: qvalue.QValueKindJSON, Value: nil}, val)
+}
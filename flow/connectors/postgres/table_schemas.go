@@ -0,0 +1,45 @@
+package connpostgres
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+// number of tables whose schema is fetched at the same time, unless configured.
+const defaultMaxParallelSchemaFetches = 8
+
+// fetchTableSchemas calls fetch for every table, at most maxParallelFetches at a time, and returns
+// the schemas in the order of tableNames. The errors of all failed tables are joined in that order.
+func fetchTableSchemas(
+	tableNames []string,
+	maxParallelFetches int,
+	fetch func(tableName string) (*protos.TableSchema, error),
+) ([]*protos.TableSchema, error) {
+	if maxParallelFetches < 1 {
+		maxParallelFetches = 1
+	}
+
+	tableSchemas := make([]*protos.TableSchema, len(tableNames))
+	errs := make([]error, len(tableNames))
+	sem := make(chan struct{}, maxParallelFetches)
+	var wg sync.WaitGroup
+	for i, tableName := range tableNames {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, tableName string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			tableSchemas[i], errs[i] = fetch(tableName)
+		}(i, tableName)
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return tableSchemas, nil
+}
@@ -0,0 +1,135 @@
+package connpostgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchTableSchemasConcurrently(t *testing.T) {
+	const numTables = 100
+	const maxParallelFetches = 8
+
+	tableNames := make([]string, 0, numTables)
+	for i := 0; i < numTables; i++ {
+		tableNames = append(tableNames, fmt.Sprintf("public.t_%d", i))
+	}
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	fetch := func(tableName string) (*protos.TableSchema, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		return &protos.TableSchema{
+			TableIdentifier:   tableName,
+			Columns:           map[string]string{"id": "int64", tableName: "string"},
+			PrimaryKeyColumns: []string{"id"},
+		}, nil
+	}
+
+	tableSchemas, err := fetchTableSchemas(tableNames, maxParallelFetches, fetch)
+	require.NoError(t, err)
+	require.Len(t, tableSchemas, numTables)
+	for i, tableSchema := range tableSchemas {
+		require.Equal(t, tableNames[i], tableSchema.TableIdentifier)
+		require.Equal(t, map[string]string{"id": "int64", tableNames[i]: "string"}, tableSchema.Columns)
+		require.Equal(t, []string{"id"}, tableSchema.PrimaryKeyColumns)
+	}
+	require.LessOrEqual(t, maxInFlight, maxParallelFetches)
+	require.Greater(t, maxInFlight, 1)
+}
+
+func TestFetchTableSchemasJoinsErrors(t *testing.T) {
+	tableNames := []string{"public.a", "public.b", "public.c", "public.d"}
+	errB := errors.New("table public.b does not exist")
+	errD := errors.New("table public.d does not exist")
+	fetch := func(tableName string) (*protos.TableSchema, error) {
+		switch tableName {
+		case "public.b":
+			return nil, errB
+		case "public.d":
+			return nil, errD
+		}
+		return &protos.TableSchema{TableIdentifier: tableName}, nil
+	}
+
+	_, err := fetchTableSchemas(tableNames, 2, fetch)
+	require.ErrorIs(t, err, errB)
+	require.ErrorIs(t, err, errD)
+	require.Equal(t, errB.Error()+"\n"+errD.Error(), err.Error())
+}
+
+func TestGetTableSchemaWithSmallPool(t *testing.T) {
+	const numTables = 16
+	const schemaName = "pgtable_schemas_pool_test"
+
+	connector, err := NewPostgresConnector(context.Background(), &protos.PostgresConfig{
+		Host:     "localhost",
+		Port:     7132,
+		User:     "postgres",
+		Password: "postgres",
+		Database: "postgres",
+	})
+	require.NoError(t, err)
+	defer connector.Close()
+
+	_, err = connector.pool.Exec(context.Background(), fmt.Sprintf("DROP SCHEMA IF EXISTS %s CASCADE", schemaName))
+	require.NoError(t, err)
+	_, err = connector.pool.Exec(context.Background(), fmt.Sprintf("CREATE SCHEMA %s", schemaName))
+	require.NoError(t, err)
+	defer func() {
+		_, err := connector.pool.Exec(context.Background(), fmt.Sprintf("DROP SCHEMA %s CASCADE", schemaName))
+		require.NoError(t, err)
+	}()
+	tableNames := make([]string, 0, numTables)
+	for i := 0; i < numTables; i++ {
+		tableName := fmt.Sprintf("%s.t_%d", schemaName, i)
+		_, err = connector.pool.Exec(context.Background(),
+			fmt.Sprintf("CREATE TABLE %s(id INT PRIMARY KEY, name TEXT)", tableName))
+		require.NoError(t, err)
+		tableNames = append(tableNames, tableName)
+	}
+
+	// a pool of 2 connections, fewer than the parallel fetches, which must not wait on each other for
+	// connections they hold.
+	poolConfig := connector.pool.Config()
+	poolConfig.MaxConns = 2
+	smallPool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+	require.NoError(t, err)
+	defer smallPool.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	smallPoolConnector := &PostgresConnector{
+		ctx:             ctx,
+		pool:            smallPool,
+		domainBaseTypes: connector.domainBaseTypes,
+		compositeTypes:  connector.compositeTypes,
+	}
+	res, err := smallPoolConnector.GetTableSchema(&protos.GetTableSchemaBatchInput{
+		TableIdentifiers:   tableNames,
+		MaxParallelFetches: 8,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TableNameSchemaMapping, numTables)
+	for _, tableName := range tableNames {
+		require.Equal(t, []string{"id"}, res.TableNameSchemaMapping[tableName].PrimaryKeyColumns)
+		require.Equal(t, map[string]string{"id": "int32", "name": "string"},
+			res.TableNameSchemaMapping[tableName].Columns)
+	}
+}
@@ -124,17 +124,6 @@ func (c *S3Connector) GetLastOffset(jobName string) (*protos.LastSyncState, erro
 	return res, nil
 }
 
-// update offset for a job
-func (c *S3Connector) updateLastOffset(jobName string, offset int64) error {
-	err := c.pgMetadata.UpdateLastOffset(jobName, offset)
-	if err != nil {
-		log.Errorf("failed to update last offset: %v", err)
-		return err
-	}
-
-	return nil
-}
-
 func (c *S3Connector) SyncRecords(req *model.SyncRecordsRequest) (*model.SyncResponse, error) {
 	if len(req.Records.Records) == 0 {
 		return &model.SyncResponse{
@@ -149,7 +138,7 @@ func (c *S3Connector) SyncRecords(req *model.SyncRecordsRequest) (*model.SyncRes
 		return nil, fmt.Errorf("failed to get previous syncBatchID: %w", err)
 	}
 	syncBatchID = syncBatchID + 1
-	lastCP := req.Records.LastCheckPointID
+	firstCP, lastCP := req.Records.CheckPointRange()
 
 	tableNameRowsMapping := make(map[string]uint32)
 	streamRes, err := utils.RecordsToRawTableStream(model.RecordsToStreamRequest{
@@ -161,7 +150,6 @@ func (c *S3Connector) SyncRecords(req *model.SyncRecordsRequest) (*model.SyncRes
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert records to raw table stream: %w", err)
 	}
-	firstCP := streamRes.CP
 	recordStream := streamRes.Stream
 	qrepConfig := &protos.QRepConfig{
 		FlowJobName:                req.FlowJobName,
@@ -177,15 +165,9 @@ func (c *S3Connector) SyncRecords(req *model.SyncRecordsRequest) (*model.SyncRes
 		return nil, err
 	}
 
-	err = c.updateLastOffset(req.FlowJobName, lastCP)
+	err = c.pgMetadata.FinishBatch(req.FlowJobName, lastCP)
 	if err != nil {
-		log.Errorf("failed to update last offset for s3 cdc: %v", err)
-		return nil, err
-	}
-	err = c.pgMetadata.IncrementID(req.FlowJobName)
-	if err != nil {
-		log.Errorf("%v", err)
-		return nil, err
+		return nil, fmt.Errorf("failed to finish batch for s3 cdc: %w", err)
 	}
 	metrics.LogSyncMetrics(c.ctx, req.FlowJobName, int64(numRecords), time.Since(startTime))
 	return &model.SyncResponse{
@@ -0,0 +1,89 @@
+package connsnowflake
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// getNotNullColumnsSQL lists the columns of a table that an INSERT not naming them fails on.
+const getNotNullColumnsSQL = `SELECT COLUMN_NAME FROM INFORMATION_SCHEMA.COLUMNS
+	 WHERE TABLE_SCHEMA=? AND TABLE_NAME=? AND IS_NULLABLE='NO' AND COLUMN_DEFAULT IS NULL
+	 AND IS_IDENTITY='NO'`
+
+// uncoveredNotNullColumns returns the NOT NULL columns of an existing normalized table that are
+// neither replicated from the source nor given a default, in sorted order. Inserting into the
+// table fails as long as there are any.
+func uncoveredNotNullColumns(notNullColumns []string, sourceColumns map[string]string,
+	columnDefaults map[string]string) []string {
+	covered := make(map[string]struct{}, len(sourceColumns)+len(columnDefaults))
+	for columnName := range sourceColumns {
+		covered[strings.ToUpper(columnName)] = struct{}{}
+	}
+	for columnName := range columnDefaults {
+		covered[strings.ToUpper(columnName)] = struct{}{}
+	}
+
+	uncovered := make([]string, 0)
+	for _, columnName := range notNullColumns {
+		// columns added by PeerDB are written by the merge itself
+		if strings.HasPrefix(strings.ToUpper(columnName), "_PEERDB_") {
+			continue
+		}
+		if _, ok := covered[strings.ToUpper(columnName)]; !ok {
+			uncovered = append(uncovered, columnName)
+		}
+	}
+	sort.Strings(uncovered)
+	return uncovered
+}
+
+// generateColumnDefaultsInsertSQL returns the quoted column names and the expressions to append
+// to the INSERT of the merge for columns that are not replicated, in matching sorted order.
+func generateColumnDefaultsInsertSQL(columnDefaults map[string]string) ([]string, []string) {
+	columnNames := make([]string, 0, len(columnDefaults))
+	for columnName := range columnDefaults {
+		columnNames = append(columnNames, columnName)
+	}
+	sort.Strings(columnNames)
+
+	quotedColumnNames := make([]string, 0, len(columnNames))
+	values := make([]string, 0, len(columnNames))
+	for _, columnName := range columnNames {
+		quotedColumnNames = append(quotedColumnNames, fmt.Sprintf(`"%s"`, strings.ToUpper(columnName)))
+		values = append(values, columnDefaults[columnName])
+	}
+	return quotedColumnNames, values
+}
+
+// checkNotNullColumnsCovered fails if an existing normalized table has NOT NULL columns that
+// the merge would not write, naming them so a default can be configured for each.
+func (c *SnowflakeConnector) checkNotNullColumnsCovered(tableIdentifier string,
+	components *tableNameComponents, sourceColumns map[string]string, columnDefaults map[string]string) error {
+	rows, err := c.database.QueryContext(c.ctx, getNotNullColumnsSQL,
+		components.schemaIdentifier, components.tableIdentifier)
+	if err != nil {
+		return fmt.Errorf("error querying NOT NULL columns of table %s: %w", tableIdentifier, err)
+	}
+	defer rows.Close()
+
+	notNullColumns := make([]string, 0)
+	for rows.Next() {
+		var columnName string
+		if err := rows.Scan(&columnName); err != nil {
+			return fmt.Errorf("error reading NOT NULL columns of table %s: %w", tableIdentifier, err)
+		}
+		notNullColumns = append(notNullColumns, columnName)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error reading NOT NULL columns of table %s: %w", tableIdentifier, err)
+	}
+
+	uncovered := uncoveredNotNullColumns(notNullColumns, sourceColumns, columnDefaults)
+	if len(uncovered) > 0 {
+		return fmt.Errorf("table %s has NOT NULL columns %s that are not replicated from the source, "+
+			"configure a default for them in destination_column_defaults", tableIdentifier,
+			strings.Join(uncovered, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUncoveredNotNullColumns(t *testing.T) {
+	sourceColumns := map[string]string{"id": string(qvalue.QValueKindInt64), "val": string(qvalue.QValueKindString)}
+	notNullColumns := []string{"ID", "TENANT", "CREATED_BY", "_PEERDB_IS_DELETED"}
+
+	// a NOT NULL column added to the destination fails setup until it has a default
+	require.Equal(t, []string{"CREATED_BY", "TENANT"}, uncoveredNotNullColumns(notNullColumns, sourceColumns, nil))
+	require.Equal(t, []string{"CREATED_BY"}, uncoveredNotNullColumns(notNullColumns, sourceColumns,
+		map[string]string{"tenant": "'acme'"}))
+	require.Empty(t, uncoveredNotNullColumns(notNullColumns, sourceColumns,
+		map[string]string{"TENANT": "'acme'", "CREATED_BY": "CURRENT_USER()"}))
+}
+
+func TestGenerateMergeStatement_ColumnDefaults(t *testing.T) {
+	c := &SnowflakeConnector{
+		tableSchemaMapping: map[string]*protos.TableSchema{
+			"PUBLIC.T": {
+				TableIdentifier:   "PUBLIC.T",
+				Columns:           map[string]string{"ID": string(qvalue.QValueKindInt64)},
+				PrimaryKeyColumns: []string{"ID"},
+			},
+		},
+	}
+
+	mergeStatement, err := c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, false, map[string]string{"tenant": "'acme'", "CREATED_BY": "CURRENT_USER()"},
+		protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	// the defaults are only inserted, updates leave the columns as they are
+	require.Contains(t, mergeStatement,
+		`INSERT ("ID","CREATED_BY","TENANT") VALUES(SOURCE."ID",CURRENT_USER(),'acme')`)
+	require.NotContains(t, mergeStatement, `"TENANT" =`)
+
+	mergeStatement, err = c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	require.Contains(t, mergeStatement, `INSERT ("ID") VALUES(SOURCE."ID")`)
+}
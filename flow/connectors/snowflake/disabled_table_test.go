@@ -0,0 +1,33 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeMergesRawRecordsOfDisabledTable(t *testing.T) {
+	// the table was disabled after batch 2 synced its records, before they were normalized.
+	tableMappings := []*protos.TableMapping{
+		{SourceTableIdentifier: "public.t", DestinationTableIdentifier: "PUBLIC.T", Disabled: true},
+	}
+	w := &fakeWarehouse{
+		syncBatchID:      2,
+		normalizeBatchID: 1,
+		rawRows:          map[int64]int{2: 2},
+	}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+	res, err := c.NormalizeRecords(&model.NormalizeRecordsRequest{
+		FlowJobName:   "test",
+		RawOnlyTables: utils.RawOnlyTables(tableMappings),
+	})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	require.Equal(t, 1, w.committedMerges)
+	require.Equal(t, []int{2}, w.mergedRawRows)
+	require.Equal(t, int64(2), w.normalizeBatchID)
+}
@@ -0,0 +1,52 @@
+package connsnowflake
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// initial interval between attempts of a normalize after a disconnect, overridden in tests.
+var mergeRetryInitialInterval = 5 * time.Second
+
+// isDisconnectError returns true if err means the connection to Snowflake was lost while a
+// statement was in flight, as opposed to the statement itself failing.
+func isDisconnectError(err error) bool {
+	// a statement running into the activity's deadline or being cancelled is not a disconnect.
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var snowflakeErr *gosnowflake.SnowflakeError
+	if errors.As(err, &snowflakeErr) {
+		switch snowflakeErr.Number {
+		case gosnowflake.ErrFailedToPostQuery, gosnowflake.ErrFailedToRenewSession,
+			gosnowflake.ErrFailedToHeartbeat, gosnowflake.ErrCodeServiceUnavailable,
+			gosnowflake.ErrCodeFailedToConnect:
+			return true
+		}
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// retryMergeDisconnects runs normalize, running it again up to maxRetries times if the connection
+// dropped while merging. normalize must be safe to run again, the pool reconnects for the next attempt.
+func (c *SnowflakeConnector) retryMergeDisconnects(flowJobName string, maxRetries uint64,
+	normalize func() error) error {
+	return utils.RetryWithBackoff(c.ctx, mergeRetryInitialInterval, maxRetries, isDisconnectError,
+		"lost connection to Snowflake while normalizing "+flowJobName, normalize)
+}
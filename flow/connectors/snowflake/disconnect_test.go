@@ -0,0 +1,57 @@
+package connsnowflake
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeRecordsRecoversFromDisconnect(t *testing.T) {
+	setMergeRetryInitialInterval(t, time.Millisecond)
+
+	// the connection drops in the middle of the merge, the normalize reconnects and merges again
+	w := &fakeWarehouse{syncBatchID: 2, normalizeBatchID: 1, mergeDisconnects: 1}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+	res, err := c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test", MaxDisconnectRetries: 3})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	require.Equal(t, int64(2), res.StartBatchID)
+	require.Equal(t, int64(2), res.EndBatchID)
+	require.Equal(t, 1, w.committedMerges)
+	require.Equal(t, int64(2), w.normalizeBatchID)
+
+	// the connection drops after the commit went through, the batch is not merged a second time
+	w = &fakeWarehouse{syncBatchID: 2, normalizeBatchID: 1, commitDisconnects: 1}
+	c = newFakeWarehouseConnector(w)
+	defer c.database.Close()
+	res, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test", MaxDisconnectRetries: 3})
+	require.NoError(t, err)
+	require.False(t, res.Done)
+	require.Equal(t, 1, w.committedMerges)
+	require.Equal(t, int64(2), w.normalizeBatchID)
+
+	// without retries the disconnect fails the normalize, nothing is merged
+	w = &fakeWarehouse{syncBatchID: 2, normalizeBatchID: 1, mergeDisconnects: 1}
+	c = newFakeWarehouseConnector(w)
+	defer c.database.Close()
+	_, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test"})
+	require.ErrorIs(t, err, driver.ErrBadConn)
+	require.Equal(t, 0, w.committedMerges)
+	require.Equal(t, int64(1), w.normalizeBatchID)
+}
+
+func TestIsDisconnectError(t *testing.T) {
+	require.True(t, isDisconnectError(fmt.Errorf("failed to merge records: %w", driver.ErrBadConn)))
+	require.True(t, isDisconnectError(&gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery}))
+	require.False(t, isDisconnectError(&gosnowflake.SnowflakeError{Number: noActiveWarehouseErrorNumber}))
+	require.False(t, isDisconnectError(errors.New("SQL compilation error: invalid identifier")))
+	require.False(t, isDisconnectError(context.DeadlineExceeded))
+}
@@ -0,0 +1,126 @@
+package connsnowflake
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// dsnExtraFieldKeys are DSN parameters that PeerDB sets from dedicated fields of the peer config,
+// which can't be overridden through the extras.
+var dsnExtraFieldKeys = map[string]string{
+	"account":            "account_id",
+	"user":               "username",
+	"password":           "private_key",
+	"privatekey":         "private_key",
+	"authenticator":      "private_key",
+	"token":              "private_key",
+	"passcode":           "private_key",
+	"passcodeinpassword": "private_key",
+	"database":           "database",
+	"warehouse":          "warehouse",
+	"role":               "role",
+	"requesttimeout":     "query_timeout",
+}
+
+// sessionParameterRe matches the names of Snowflake session parameters, which is what gosnowflake
+// takes DSN parameters it doesn't know to be.
+var sessionParameterRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// getSnowflakeDSN builds the DSN for a Snowflake peer, authenticating with the given private key.
+func getSnowflakeDSN(snowflakeProtoConfig *protos.SnowflakeConfig, privateKey *rsa.PrivateKey) (string, error) {
+	snowflakeConfig := gosnowflake.Config{
+		Account:          snowflakeProtoConfig.AccountId,
+		User:             snowflakeProtoConfig.Username,
+		Authenticator:    gosnowflake.AuthTypeJwt,
+		PrivateKey:       privateKey,
+		Database:         snowflakeProtoConfig.Database,
+		Warehouse:        snowflakeProtoConfig.Warehouse,
+		Role:             snowflakeProtoConfig.Role,
+		RequestTimeout:   time.Duration(snowflakeProtoConfig.QueryTimeout),
+		DisableTelemetry: true,
+	}
+	err := applyDSNExtras(&snowflakeConfig, snowflakeProtoConfig.DsnExtras)
+	if err != nil {
+		return "", fmt.Errorf("invalid DSN extras for Snowflake peer: %w", err)
+	}
+
+	snowflakeConfigDSN, err := gosnowflake.DSN(&snowflakeConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to get DSN from Snowflake config: %w", err)
+	}
+	return snowflakeConfigDSN, nil
+}
+
+// applyDSNExtras merges extra DSN parameters into the config. Connection parameters gosnowflake knows
+// are set on their field, anything else is passed on as a session parameter.
+func applyDSNExtras(config *gosnowflake.Config, extras map[string]string) error {
+	// sorted so that the first invalid key is reported consistently
+	keys := make([]string, 0, len(extras))
+	for key := range extras {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := extras[key]
+		if field, ok := dsnExtraFieldKeys[strings.ToLower(key)]; ok {
+			return fmt.Errorf("DSN parameter %s cannot be set as an extra, it is set from the %s field", key, field)
+		}
+
+		var err error
+		switch strings.ToLower(key) {
+		case "host":
+			config.Host = value
+		case "port":
+			config.Port, err = strconv.Atoi(value)
+		case "protocol":
+			if value != "http" && value != "https" {
+				err = fmt.Errorf("must be http or https")
+			}
+			config.Protocol = value
+		case "region":
+			config.Region = value
+		case "application":
+			config.Application = value
+		case "logintimeout":
+			config.LoginTimeout, err = parseDSNTimeout(value)
+		case "clienttimeout":
+			config.ClientTimeout, err = parseDSNTimeout(value)
+		case "jwttimeout":
+			config.JWTExpireTimeout, err = parseDSNTimeout(value)
+		case "tmpdirpath":
+			config.TmpDirPath = value
+		default:
+			if !sessionParameterRe.MatchString(key) {
+				return fmt.Errorf("DSN parameter %s is neither a known connection parameter "+
+					"nor a session parameter", key)
+			}
+			if config.Params == nil {
+				config.Params = make(map[string]*string)
+			}
+			paramValue := value
+			config.Params[key] = &paramValue
+		}
+		if err != nil {
+			return fmt.Errorf("invalid value %q for DSN parameter %s: %w", value, key, err)
+		}
+	}
+	return nil
+}
+
+// parseDSNTimeout parses a timeout in seconds, like gosnowflake does for timeouts in a DSN.
+func parseDSNTimeout(value string) (time.Duration, error) {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
@@ -0,0 +1,51 @@
+package connsnowflake
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/require"
+)
+
+func dsnTestConfig(extras map[string]string) *protos.SnowflakeConfig {
+	return &protos.SnowflakeConfig{
+		AccountId: "peerdb-account",
+		Username:  "PEERDB_USER",
+		Database:  "PEERDB_DB",
+		Warehouse: "PEERDB_WH",
+		Role:      "PEERDB_ROLE",
+		DsnExtras: extras,
+	}
+}
+
+func TestSnowflakeDSNExtras(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	dsn, err := getSnowflakeDSN(dsnTestConfig(map[string]string{
+		"host":      "peerdb-account.privatelink.snowflakecomputing.com",
+		"port":      "8443",
+		"QUERY_TAG": "peerdb",
+	}), privateKey)
+	require.NoError(t, err)
+	require.Contains(t, dsn, "@peerdb-account.privatelink.snowflakecomputing.com:8443")
+	require.Contains(t, dsn, "QUERY_TAG=peerdb")
+	require.Contains(t, dsn, "warehouse=PEERDB_WH")
+}
+
+func TestSnowflakeDSNExtrasInvalid(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	_, err = getSnowflakeDSN(dsnTestConfig(map[string]string{"warehouse": "OTHER_WH"}), privateKey)
+	require.ErrorContains(t, err,
+		"DSN parameter warehouse cannot be set as an extra, it is set from the warehouse field")
+
+	_, err = getSnowflakeDSN(dsnTestConfig(map[string]string{"port": "https"}), privateKey)
+	require.ErrorContains(t, err, `invalid value "https" for DSN parameter port`)
+
+	_, err = getSnowflakeDSN(dsnTestConfig(map[string]string{"proxy host": "localhost"}), privateKey)
+	require.ErrorContains(t, err, "DSN parameter proxy host is neither a known connection parameter")
+}
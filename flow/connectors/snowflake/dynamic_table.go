@@ -0,0 +1,131 @@
+package connsnowflake
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	// the dynamic table keeps the latest record of each primary key like the merge does, taking each column
+	// from the latest record that has it so that unchanged TOAST columns keep their earlier value.
+	createDynamicTableSQL = `CREATE DYNAMIC TABLE IF NOT EXISTS %s TARGET_LAG = %s WAREHOUSE = %s AS
+	 SELECT %s FROM (SELECT %s %s,_PEERDB_RECORD_TYPE,_PEERDB_TIMESTAMP,
+	 SPLIT(COALESCE(_PEERDB_UNCHANGED_TOAST_COLUMNS,''),',') AS _PEERDB_UNCHANGED_TOAST_COLUMNS
+	 FROM %s WHERE _PEERDB_DESTINATION_TABLE_NAME = '%s')
+	 QUALIFY RANK() OVER (PARTITION BY %s ORDER BY %s) = 1 AND %s`
+	// the latest value of a column, skipping deletes and records where the column is an unchanged TOAST column.
+	// values are wrapped in an object so that nulls are kept apart from records that don't have the column.
+	dynamicTableColumnSQL = `LAST_VALUE(IFF(_PEERDB_RECORD_TYPE != 2 AND
+	 NOT ARRAY_CONTAINS('%s'::VARIANT,_PEERDB_UNCHANGED_TOAST_COLUMNS),OBJECT_CONSTRUCT_KEEP_NULL('v',%s:"%s"),NULL))
+	 IGNORE NULLS OVER (PARTITION BY %s ORDER BY _PEERDB_TIMESTAMP %s
+	 ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING):v`
+
+	defaultDynamicTableTargetLag = "5 minutes"
+	downstreamTargetLag          = "DOWNSTREAM"
+)
+
+// a target lag is a number of seconds, minutes, hours or days, or DOWNSTREAM.
+var targetLagRegex = regexp.MustCompile(`(?i)^[0-9]+ (second|minute|hour|day)s?$`)
+
+// dynamicTableTargetLagSQL validates a target lag and returns it the way CREATE DYNAMIC TABLE takes it.
+func dynamicTableTargetLagSQL(targetLag string) (string, error) {
+	if targetLag == "" {
+		targetLag = defaultDynamicTableTargetLag
+	}
+	if strings.EqualFold(targetLag, downstreamTargetLag) {
+		return downstreamTargetLag, nil
+	}
+	if !targetLagRegex.MatchString(targetLag) {
+		return "", fmt.Errorf("invalid dynamic table target lag %q, expected a number of seconds, minutes, "+
+			"hours or days, or DOWNSTREAM", targetLag)
+	}
+	return fmt.Sprintf("'%s'", targetLag), nil
+}
+
+// qualifiedRawTableSQL returns the raw table qualified with its database unless that is empty, so that
+// a dynamic table reads the raw table of its mirror whatever database its refreshes run in.
+func qualifiedRawTableSQL(database string, rawTableIdentifier string) string {
+	rawTableSQL := fmt.Sprintf("%s.%s", peerDBInternalSchema, rawTableIdentifier)
+	if database == "" {
+		return rawTableSQL
+	}
+	return fmt.Sprintf(`"%s".%s`, strings.ReplaceAll(normalizeSnowflakeIdentifier(database), `"`, `""`), rawTableSQL)
+}
+
+// generateCreateDynamicTableSQL returns the statement creating a normalized table as a dynamic table
+// that Snowflake refreshes from the raw table on its own, instead of PeerDB merging into it. The rows
+// of the dynamic table are told apart by primary key, so tables without one are rejected.
+func generateCreateDynamicTableSQL(
+	destinationTableIdentifier string,
+	tableSchema *protos.TableSchema,
+	rawTableIdentifier string,
+	database string,
+	targetLag string,
+	warehouse string,
+	softDelete bool,
+	rawDataAsVariant bool,
+	nullsOrdering protos.DedupNullsOrdering,
+	variantNullPolicy protos.VariantNullPolicy,
+) (string, error) {
+	if len(tableSchema.PrimaryKeyColumns) == 0 {
+		return "", fmt.Errorf("table %s has no primary key, which dynamic normalized tables need",
+			destinationTableIdentifier)
+	}
+	targetLagSQL, err := dynamicTableTargetLagSQL(targetLag)
+	if err != nil {
+		return "", err
+	}
+	if warehouse == "" {
+		return "", fmt.Errorf("dynamic normalized tables need a warehouse to refresh them")
+	}
+	warehouseSQL := fmt.Sprintf(`"%s"`, strings.ReplaceAll(normalizeSnowflakeIdentifier(warehouse), `"`, `""`))
+	// the latest value of a column is the last in ascending order, so NULL timestamps sort the other way.
+	ascendingNullsSQL := "NULLS FIRST"
+	if nullsOrdering == protos.DedupNullsOrdering_DEDUP_NULLS_FIRST {
+		ascendingNullsSQL = "NULLS LAST"
+	}
+
+	variantColumn := variantColumnName(tableSchema)
+	primaryKeySQLArray := make([]string, 0, len(tableSchema.PrimaryKeyColumns))
+	isPrimaryKey := make(map[string]bool, len(tableSchema.PrimaryKeyColumns))
+	for _, primaryKeyCol := range tableSchema.PrimaryKeyColumns {
+		primaryKeySQLArray = append(primaryKeySQLArray, fmt.Sprintf(`%s:"%s"`, variantColumn, primaryKeyCol))
+		isPrimaryKey[primaryKeyCol] = true
+	}
+	primaryKeySQL := strings.Join(primaryKeySQLArray, ",")
+
+	columnNames := maps.Keys(tableSchema.Columns)
+	sort.Strings(columnNames)
+	selectSQLArray := make([]string, 0, len(columnNames)+1)
+	for _, columnName := range columnNames {
+		variantSQL := fmt.Sprintf(`%s:"%s"`, variantColumn, columnName)
+		if !isPrimaryKey[columnName] {
+			variantSQL = fmt.Sprintf(dynamicTableColumnSQL, columnName, variantColumn, columnName, primaryKeySQL,
+				ascendingNullsSQL)
+		}
+		selectSQLArray = append(selectSQLArray, fmt.Sprintf(`%s AS "%s"`,
+			castVariantSQL(variantSQL, tableSchema.Columns[columnName], variantNullPolicy),
+			strings.ToUpper(columnName)))
+	}
+
+	// hard deletes drop the row, soft deletes keep the row as last inserted or updated and flag it.
+	// like the merge, a row that was only ever deleted is never added.
+	deleteFilterSQL := "_PEERDB_RECORD_TYPE != 2"
+	isDeletedSQL := "FALSE"
+	if softDelete {
+		deleteFilterSQL = fmt.Sprintf("COUNT_IF(_PEERDB_RECORD_TYPE != 2) OVER (PARTITION BY %s) > 0", primaryKeySQL)
+		isDeletedSQL = "_PEERDB_RECORD_TYPE = 2"
+	}
+	selectSQLArray = append(selectSQLArray, fmt.Sprintf(`%s AS "%s"`, isDeletedSQL, isDeletedColumnName))
+
+	return fmt.Sprintf(createDynamicTableSQL, destinationTableIdentifier, targetLagSQL, warehouseSQL,
+		strings.Join(selectSQLArray, ","), rawDataToVariantSQL(rawDataAsVariant), variantColumn,
+		qualifiedRawTableSQL(database, rawTableIdentifier), strings.ReplaceAll(destinationTableIdentifier, "'", "''"),
+		primaryKeySQL, utils.DedupOrderSQL("_PEERDB_TIMESTAMP", nullsOrdering), deleteFilterSQL), nil
+}
@@ -0,0 +1,135 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+)
+
+func dynamicTableTestSchema() *protos.TableSchema {
+	return &protos.TableSchema{
+		TableIdentifier: "public.test",
+		Columns: map[string]string{
+			"id":   "int64",
+			"name": "string",
+			"ts":   "timestamp",
+		},
+		PrimaryKeyColumns: []string{"id"},
+	}
+}
+
+func TestGenerateCreateDynamicTableSQL_HardDelete(t *testing.T) {
+	createSQL, err := generateCreateDynamicTableSQL("PUBLIC.TEST", dynamicTableTestSchema(),
+		"_PEERDB_RAW_TEST", "", "", "TEST_WH", false, false,
+		protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL)
+	require.NoError(t, err)
+
+	require.Contains(t, createSQL, "CREATE DYNAMIC TABLE IF NOT EXISTS PUBLIC.TEST TARGET_LAG = '5 minutes'")
+	require.Contains(t, createSQL, `WAREHOUSE = "TEST_WH"`)
+	require.Contains(t, createSQL, "FROM _PEERDB_INTERNAL._PEERDB_RAW_TEST WHERE "+
+		"_PEERDB_DESTINATION_TABLE_NAME = 'PUBLIC.TEST'")
+	require.Contains(t, createSQL, "TO_VARIANT(PARSE_JSON(_PEERDB_DATA)) VAR_COLS")
+	require.Contains(t, createSQL, `QUALIFY RANK() OVER (PARTITION BY VAR_COLS:"id" `+
+		`ORDER BY _PEERDB_TIMESTAMP DESC NULLS LAST) = 1 AND _PEERDB_RECORD_TYPE != 2`)
+	// columns are cast the same way the merge casts them.
+	require.Contains(t, createSQL, `CAST(STRIP_NULL_VALUE(VAR_COLS:"id") AS INTEGER) AS "ID"`)
+	require.Contains(t, createSQL, `:v) AS STRING) AS "NAME"`)
+	require.Contains(t, createSQL, `FALSE AS "_PEERDB_IS_DELETED"`)
+}
+
+func TestGenerateCreateDynamicTableSQL_SoftDelete(t *testing.T) {
+	createSQL, err := generateCreateDynamicTableSQL("PUBLIC.TEST", dynamicTableTestSchema(),
+		"_PEERDB_RAW_TEST", "", "1 minute", "TEST_WH", true, true,
+		protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL)
+	require.NoError(t, err)
+
+	require.Contains(t, createSQL, "TARGET_LAG = '1 minute'")
+	require.Contains(t, createSQL, "SELECT _PEERDB_DATA VAR_COLS")
+	require.Contains(t, createSQL, `COUNT_IF(_PEERDB_RECORD_TYPE != 2) OVER (PARTITION BY VAR_COLS:"id") > 0`)
+	require.Contains(t, createSQL, `_PEERDB_RECORD_TYPE = 2 AS "_PEERDB_IS_DELETED"`)
+}
+
+func TestGenerateCreateDynamicTableSQL_Statement(t *testing.T) {
+	tableSchema := &protos.TableSchema{
+		TableIdentifier: "public.test",
+		Columns: map[string]string{
+			"id":   "int64",
+			"name": "string",
+		},
+		PrimaryKeyColumns: []string{"id"},
+	}
+	createSQL, err := generateCreateDynamicTableSQL("PUBLIC.TEST", tableSchema, "_PEERDB_RAW_TEST", "test_db",
+		"downstream", "test_wh", false, true, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL)
+	require.NoError(t, err)
+	require.Equal(t, `CREATE DYNAMIC TABLE IF NOT EXISTS PUBLIC.TEST TARGET_LAG = DOWNSTREAM WAREHOUSE = "TEST_WH" AS
+	 SELECT CAST(STRIP_NULL_VALUE(VAR_COLS:"id") AS INTEGER) AS "ID",`+
+		`CAST(STRIP_NULL_VALUE(LAST_VALUE(IFF(_PEERDB_RECORD_TYPE != 2 AND
+	 NOT ARRAY_CONTAINS('name'::VARIANT,_PEERDB_UNCHANGED_TOAST_COLUMNS),`+
+		`OBJECT_CONSTRUCT_KEEP_NULL('v',VAR_COLS:"name"),NULL))
+	 IGNORE NULLS OVER (PARTITION BY VAR_COLS:"id" ORDER BY _PEERDB_TIMESTAMP NULLS FIRST
+	 ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING):v) AS STRING) AS "NAME",`+
+		`FALSE AS "_PEERDB_IS_DELETED" FROM (SELECT _PEERDB_DATA VAR_COLS,_PEERDB_RECORD_TYPE,_PEERDB_TIMESTAMP,
+	 SPLIT(COALESCE(_PEERDB_UNCHANGED_TOAST_COLUMNS,''),',') AS _PEERDB_UNCHANGED_TOAST_COLUMNS
+	 FROM "TEST_DB"._PEERDB_INTERNAL._PEERDB_RAW_TEST WHERE _PEERDB_DESTINATION_TABLE_NAME = 'PUBLIC.TEST')
+	 QUALIFY RANK() OVER (PARTITION BY VAR_COLS:"id" ORDER BY _PEERDB_TIMESTAMP DESC NULLS LAST) = 1 `+
+		`AND _PEERDB_RECORD_TYPE != 2`, createSQL)
+}
+
+func TestGenerateCreateDynamicTableSQL_Rejected(t *testing.T) {
+	generate := func(tableSchema *protos.TableSchema, targetLag string, warehouse string) error {
+		_, err := generateCreateDynamicTableSQL("PUBLIC.TEST", tableSchema, "_PEERDB_RAW_TEST", "", targetLag,
+			warehouse, false, false, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+			protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL)
+		return err
+	}
+
+	noPrimaryKeySchema := dynamicTableTestSchema()
+	noPrimaryKeySchema.PrimaryKeyColumns = nil
+	require.ErrorContains(t, generate(noPrimaryKeySchema, "", "TEST_WH"), "no primary key")
+	require.ErrorContains(t, generate(dynamicTableTestSchema(), "1 minute' WAREHOUSE = OTHER_WH --", "TEST_WH"),
+		"invalid dynamic table target lag")
+	require.ErrorContains(t, generate(dynamicTableTestSchema(), "", ""), "need a warehouse")
+
+	// warehouse names are quoted, so they can't inject anything either
+	createSQL, err := generateCreateDynamicTableSQL("PUBLIC.TEST", dynamicTableTestSchema(), "_PEERDB_RAW_TEST", "",
+		"2 hours", `"my""wh"`, false, false, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL)
+	require.NoError(t, err)
+	require.Contains(t, createSQL, `TARGET_LAG = '2 hours' WAREHOUSE = "my""wh" AS`)
+}
+
+func TestNormalizeRecordsDynamicTables(t *testing.T) {
+	w := &fakeWarehouse{
+		syncBatchID:      3,
+		normalizeBatchID: 1,
+		rawRows:          map[int64]int{1: 10, 2: 20, 3: 30},
+	}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+
+	backlog, err := c.GetNormalizeBacklog("test")
+	require.NoError(t, err)
+	require.Equal(t, int64(50), backlog)
+
+	// the synced batches are recorded as normalized without merging them
+	res, err := c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test", DynamicTables: true})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	require.Equal(t, int64(2), res.StartBatchID)
+	require.Equal(t, int64(3), res.EndBatchID)
+	require.Equal(t, int64(3), w.normalizeBatchID)
+	require.Zero(t, w.committedMerges)
+
+	backlog, err = c.GetNormalizeBacklog("test")
+	require.NoError(t, err)
+	require.Zero(t, backlog)
+
+	res, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test", DynamicTables: true})
+	require.NoError(t, err)
+	require.False(t, res.Done)
+}
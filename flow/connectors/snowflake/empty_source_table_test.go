@@ -0,0 +1,57 @@
+package connsnowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestSnapshotEmptySourceTableCreatesDestinationTable(t *testing.T) {
+	w := &fakeWarehouse{tables: make(map[string][]string)}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+
+	config := &protos.QRepConfig{
+		FlowJobName:                "test_empty_snapshot",
+		DestinationTableIdentifier: "PUBLIC.EMPTY",
+		SyncMode:                   protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO,
+	}
+	// the source table has no rows, so its snapshot is a single schema only partition whose query
+	// reads no rows, only the schema of the table.
+	partition := &protos.QRepPartition{PartitionId: "empty", SchemaOnly: true}
+	stream := model.NewQRecordStream(1)
+	require.NoError(t, stream.SetSchema(model.NewQRecordSchema([]*model.QField{
+		{Name: "id", Type: qvalue.QValueKindInt64},
+		{Name: "name", Type: qvalue.QValueKindString, Nullable: true},
+		{Name: "amount", Type: qvalue.QValueKindNumeric, Nullable: true},
+		{Name: "created_at", Type: qvalue.QValueKindTimestamp, Nullable: true},
+	})))
+	close(stream.Records)
+
+	// the sync heartbeats, so it runs as an activity.
+	syncPartition := func(ctx context.Context) (int, error) {
+		c.ctx = ctx
+		return c.SyncQRepRecords(config, partition, stream)
+	}
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	env.RegisterActivity(syncPartition)
+	res, err := env.ExecuteActivity(syncPartition)
+	require.NoError(t, err)
+	var numRecords int
+	require.NoError(t, res.Get(&numRecords))
+	require.Equal(t, 0, numRecords)
+
+	require.Equal(t, []string{
+		"ID INTEGER",
+		"NAME STRING",
+		"AMOUNT NUMBER",
+		"CREATED_AT TIMESTAMP_NTZ",
+	}, w.tables["PUBLIC.EMPTY"])
+	require.Equal(t, 1, w.syncedPartitions)
+}
@@ -0,0 +1,692 @@
+package connsnowflake
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+var (
+	mergeBatchRange      = regexp.MustCompile(`_PEERDB_BATCH_ID > \d+ AND _PEERDB_BATCH_ID <= \d+`)
+	createTableStatement = regexp.MustCompile(`^CREATE TABLE IF NOT EXISTS (\S+)\(`)
+	createTableColumn    = regexp.MustCompile(`"([^"]+)" ([A-Z_]+)`)
+	selectTableSchema    = regexp.MustCompile(`FROM (\S+)\s+LIMIT 0`)
+	// a merge reads the raw rows after one and up to another in the order of their timestamp and uid.
+	mergeRowsAfter = regexp.MustCompile(
+		`_PEERDB_TIMESTAMP > (\d+) OR \(_PEERDB_TIMESTAMP = \d+ AND _PEERDB_UID > '([^']*)'\)`)
+	mergeRowsUpTo = regexp.MustCompile(
+		`_PEERDB_TIMESTAMP < (\d+) OR \(_PEERDB_TIMESTAMP = \d+ AND _PEERDB_UID <= '([^']*)'\)`)
+	mergeRowBoundaryEvery = regexp.MustCompile(`MOD\(ROW_NUMBER\(\) OVER \([^)]*\), (\d+)\)`)
+)
+
+// fakeWarehouse stands in for the Snowflake account of the connector tests, reached through a
+// connector from newFakeWarehouseConnector. Its connections route every statement and query by its
+// text to the state below, see ExecContext and QueryContext of fakeWarehouseConn, and fail anything
+// they don't know. Statements of a transaction only change the state once it commits. It only keeps
+// what the control flow of the connector depends on, like batch IDs, row counts and locks: what the
+// statements do to the data is covered by the tests of the generated SQL and the e2e tests.
+type fakeWarehouse struct {
+	mu sync.Mutex
+	// noJobMetadata leaves out the metadata row of the mirror, as before its first sync, which inserts
+	// it. normalizeBatchIDNull has NORMALIZE_BATCH_ID NULL, as the first sync records it.
+	noJobMetadata        bool
+	syncBatchID          int64
+	normalizeBatchID     int64
+	normalizeBatchIDNull bool
+	// mergedBatchRanges has the batch range of each merge of a committed transaction.
+	mergedBatchRanges []string
+	// committedMerges counts the merges of committed transactions.
+	committedMerges int
+	// mergeDisconnects drops the connection on that many merges, before they run.
+	mergeDisconnects int
+	// commitDisconnects drops the connection on that many commits, after they went through.
+	commitDisconnects int
+	// syncMetadataDisconnects drops the connection on that many sync metadata updates, before they run.
+	syncMetadataDisconnects int
+	// rawRows counts the raw table rows of each batch, see fakeRawRowKey, a COPY loads stagedRows rows
+	// into the batch after the last synced one.
+	rawRows    map[int64]int
+	stagedRows int
+	// warehouseSize and maxClusterCount are what SHOW WAREHOUSES reports for warehouse WH.
+	warehouseSize   string
+	maxClusterCount string
+	// rawInsertBarrier holds raw table inserts until all syncs it counts have inserted.
+	rawInsertBarrier *sync.WaitGroup
+	// metadataLock is the connection whose transaction updated the metadata table, which other
+	// updates wait on until it ends, like the table lock Snowflake takes for DML.
+	metadataLock     *fakeWarehouseConn
+	metadataUnlocked *sync.Cond
+	// mergedRawRows has the number of raw table rows each committed merge read. unknownRecordTypes counts
+	// the raw table rows of each batch by their record type, for those that are no insert, update or delete.
+	mergedRawRows      []int
+	unknownRecordTypes map[int64]map[int64]int
+	// tables has the column definitions of each QRep destination table, syncedPartitions counts
+	// the partitions whose sync was recorded in the QRep metadata table.
+	tables           map[string][]string
+	syncedPartitions int
+	// mergeTimeoutRows times out merges of more raw table rows than that, like the statement timeout.
+	mergeTimeoutRows int
+	// rawRowValues has the values of each raw table row inserted by a committed statement,
+	// rawInsertsOutsideTx counts the raw table inserts that committed on their own.
+	rawRowValues        [][]driver.Value
+	rawInsertsOutsideTx int
+	// hookStatements has each ALTER MATERIALIZED VIEW statement run with the number of merges committed
+	// before it, statements on view MISSING fail.
+	hookStatements []string
+	// noCreateSchemaPrivilege fails creating schemas like for a role without the CREATE SCHEMA privilege,
+	// noInternalSchema leaves out the internal schema that could have been created for the role beforehand.
+	noCreateSchemaPrivilege bool
+	noInternalSchema        bool
+	// noActiveWarehouse fails merges like for a suspended warehouse the role may not resume.
+	noActiveWarehouse bool
+	// tagStatements has each statement that set tags.
+	tagStatements []string
+	// statementLimit fails statements once more than that many run at once, like Snowflake does for a
+	// session, and statementDuration is how long each statement takes. maxRunningStatements is the most
+	// statements that ran at once.
+	statementLimit       int64
+	statementDuration    time.Duration
+	runningStatements    atomic.Int64
+	maxRunningStatements atomic.Int64
+	// truncatedTables has each table TRUNCATE TABLE emptied, afterCommit runs with the warehouse locked
+	// after each commit, like another session changing it in between transactions.
+	truncatedTables []string
+	afterCommit     func(w *fakeWarehouse)
+}
+
+// fakeRawRowKey returns the timestamp and uid of raw table row i of a batch. Row i of batch b has
+// _PEERDB_TIMESTAMP b*1000000+i/2 and _PEERDB_UID i, so pairs of rows share a timestamp and are ordered
+// by their uid.
+func fakeRawRowKey(batchID int64, i int) rawRowKey {
+	return rawRowKey{timestamp: batchID*1000000 + int64(i/2), uid: fmt.Sprintf("%08d", i)}
+}
+
+func newFakeWarehouseConnector(w *fakeWarehouse) *SnowflakeConnector {
+	return &SnowflakeConnector{
+		ctx:      context.Background(),
+		database: sql.OpenDB(w),
+		tableSchemaMapping: map[string]*protos.TableSchema{
+			"PUBLIC.T": {
+				TableIdentifier:   "PUBLIC.T",
+				Columns:           map[string]string{"ID": string(qvalue.QValueKindInt64)},
+				PrimaryKeyColumns: []string{"ID"},
+			},
+		},
+	}
+}
+
+// setMergeRetryInitialInterval shortens the wait before merges are retried for the test.
+func setMergeRetryInitialInterval(t *testing.T, interval time.Duration) {
+	previous := mergeRetryInitialInterval
+	mergeRetryInitialInterval = interval
+	t.Cleanup(func() {
+		mergeRetryInitialInterval = previous
+	})
+}
+
+// isolateWarehouseMergeLimiters starts the test without warehouse merge limiters, and restores
+// the ones of other tests after it.
+func isolateWarehouseMergeLimiters(t *testing.T) {
+	warehouseMergeLimitersLock.Lock()
+	previous := warehouseMergeLimiters
+	warehouseMergeLimiters = make(map[string]*warehouseMergeLimiter)
+	warehouseMergeLimitersLock.Unlock()
+	t.Cleanup(func() {
+		warehouseMergeLimitersLock.Lock()
+		warehouseMergeLimiters = previous
+		warehouseMergeLimitersLock.Unlock()
+	})
+}
+
+func (w *fakeWarehouse) Connect(context.Context) (driver.Conn, error) {
+	return &fakeWarehouseConn{warehouse: w}, nil
+}
+
+func (w *fakeWarehouse) Driver() driver.Driver {
+	return nil
+}
+
+type fakeWarehouseConn struct {
+	warehouse        *fakeWarehouse
+	broken           bool
+	pendingMerges    []string
+	normalizeBatchID *int64
+	syncBatchID      *int64
+	pendingRawRows   map[int64]int
+	pendingRawValues [][]driver.Value
+	jobMetadata      []driver.Value
+	inTx             bool
+}
+
+func (c *fakeWarehouseConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c *fakeWarehouseConn) Close() error {
+	return nil
+}
+
+func (c *fakeWarehouseConn) Begin() (driver.Tx, error) {
+	if c.broken {
+		return nil, driver.ErrBadConn
+	}
+	c.inTx = true
+	return c, nil
+}
+
+func (c *fakeWarehouseConn) IsValid() bool {
+	return !c.broken
+}
+
+func (c *fakeWarehouseConn) Commit() error {
+	if c.broken {
+		return driver.ErrBadConn
+	}
+	w := c.warehouse
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.committedMerges += len(c.pendingMerges)
+	for _, merge := range c.pendingMerges {
+		w.mergedBatchRanges = append(w.mergedBatchRanges, mergeBatchRange.FindString(merge))
+		w.mergedRawRows = append(w.mergedRawRows, w.mergedRawRowsLocked(merge))
+	}
+	if c.jobMetadata != nil {
+		w.noJobMetadata = false
+		w.syncBatchID = c.jobMetadata[2].(int64)
+		w.normalizeBatchIDNull = c.jobMetadata[3] == nil
+		if !w.normalizeBatchIDNull {
+			w.normalizeBatchID = c.jobMetadata[3].(int64)
+		}
+	}
+	if c.normalizeBatchID != nil {
+		w.normalizeBatchID = *c.normalizeBatchID
+		w.normalizeBatchIDNull = false
+	}
+	if c.syncBatchID != nil {
+		w.syncBatchID = *c.syncBatchID
+	}
+	for batchID, rows := range c.pendingRawRows {
+		w.rawRows[batchID] += rows
+	}
+	w.rawRowValues = append(w.rawRowValues, c.pendingRawValues...)
+	c.pendingMerges, c.normalizeBatchID, c.syncBatchID, c.pendingRawRows = nil, nil, nil, nil
+	c.pendingRawValues, c.jobMetadata, c.inTx = nil, nil, false
+	c.releaseMetadataLockLocked()
+	if w.afterCommit != nil {
+		w.afterCommit(w)
+	}
+	if w.commitDisconnects > 0 {
+		w.commitDisconnects--
+		c.broken = true
+		return driver.ErrBadConn
+	}
+	return nil
+}
+
+func (c *fakeWarehouseConn) Rollback() error {
+	c.pendingMerges, c.normalizeBatchID, c.syncBatchID, c.pendingRawRows = nil, nil, nil, nil
+	c.pendingRawValues, c.jobMetadata, c.inTx = nil, nil, false
+	c.warehouse.mu.Lock()
+	c.releaseMetadataLockLocked()
+	c.warehouse.mu.Unlock()
+	if c.broken {
+		return driver.ErrBadConn
+	}
+	return nil
+}
+
+// ExecContext runs a statement, routed by its text:
+//   - MERGE is counted on commit, with the raw table rows of rawRows it reads.
+//   - COPY INTO loads the staged rows into the raw table. DELETE FROM ... WHERE _PEERDB_BATCH_ID = ?
+//     deletes the raw table rows of a batch and INSERT INTO _PEERDB_INTERNAL._PEERDB_RAW inserts raw
+//     table rows, committing on its own outside a transaction. TRUNCATE TABLE is recorded.
+//   - the metadata table insert and updates record the sync and normalize batch IDs on commit, guarded
+//     sync batch ID updates only match the last recorded one.
+//   - CREATE TABLE IF NOT EXISTS creates a table in tables, CREATE TRANSIENT SCHEMA creates the
+//     internal schema and CREATE OR REPLACE STAGE a stage.
+//   - tag, materialized view hook and QRep metadata statements are recorded.
+func (c *fakeWarehouseConn) ExecContext(_ context.Context, query string,
+	args []driver.NamedValue) (driver.Result, error) {
+	if c.broken {
+		return nil, driver.ErrBadConn
+	}
+	w := c.warehouse
+	err := w.runStatement()
+	if err != nil {
+		return nil, err
+	}
+	defer w.runningStatements.Add(-1)
+
+	switch {
+	case strings.HasPrefix(query, "MERGE"):
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if w.mergeDisconnects > 0 {
+			w.mergeDisconnects--
+			c.broken = true
+			return nil, driver.ErrBadConn
+		}
+		if w.noActiveWarehouse {
+			return nil, &gosnowflake.SnowflakeError{
+				Number:  noActiveWarehouseErrorNumber,
+				Message: "No active warehouse selected in the current session.",
+			}
+		}
+		batchRange := mergeBatchRange.FindString(query)
+		if w.mergeTimeoutRows > 0 && w.rawRowsInRangeLocked(batchRange) > w.mergeTimeoutRows {
+			return nil, &gosnowflake.SnowflakeError{Number: statementTimeoutErrorNumber}
+		}
+		c.pendingMerges = append(c.pendingMerges, query)
+		return driver.RowsAffected(1), nil
+	case strings.HasPrefix(query, "COPY INTO"):
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.rawRows[w.syncBatchID+1] += w.stagedRows
+		return driver.RowsAffected(w.stagedRows), nil
+	case strings.HasPrefix(query, "TRUNCATE TABLE"):
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.truncatedTables = append(w.truncatedTables, strings.TrimPrefix(query, "TRUNCATE TABLE "))
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(query, "DELETE FROM") && strings.HasSuffix(query, "WHERE _PEERDB_BATCH_ID = ?"):
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		batchID := args[0].Value.(int64)
+		deleted := w.rawRows[batchID]
+		delete(w.rawRows, batchID)
+		return driver.RowsAffected(deleted), nil
+	case strings.HasPrefix(query, "INSERT INTO _PEERDB_INTERNAL._PEERDB_RAW"):
+		if w.rawInsertBarrier != nil {
+			w.rawInsertBarrier.Done()
+			w.rawInsertBarrier.Wait()
+		}
+		if c.pendingRawRows == nil {
+			c.pendingRawRows = make(map[int64]int)
+		}
+		pendingRawRows, pendingRawValues := c.pendingRawRows, &c.pendingRawValues
+		if !c.inTx {
+			// the insert commits on its own
+			w.mu.Lock()
+			defer w.mu.Unlock()
+			pendingRawRows, pendingRawValues = w.rawRows, &w.rawRowValues
+			w.rawInsertsOutsideTx++
+		}
+		for i := 0; i+8 <= len(args); i += 8 {
+			row := make([]driver.Value, 8)
+			for j := range row {
+				row[j] = args[i+j].Value
+			}
+			// the batch ID is the 7th of the 8 values of each row
+			pendingRawRows[row[6].(int64)]++
+			*pendingRawValues = append(*pendingRawValues, row)
+		}
+		return driver.RowsAffected(len(args) / 8), nil
+	case strings.HasPrefix(query, "INSERT INTO _PEERDB_INTERNAL.PEERDB_MIRROR_JOBS VALUES"):
+		c.jobMetadata = make([]driver.Value, len(args))
+		for i, arg := range args {
+			c.jobMetadata[i] = arg.Value
+		}
+		return driver.RowsAffected(1), nil
+	case strings.Contains(query, "SET OFFSET=?, SYNC_BATCH_ID=?"):
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if w.syncMetadataDisconnects > 0 {
+			w.syncMetadataDisconnects--
+			c.broken = true
+			return nil, driver.ErrBadConn
+		}
+		if w.metadataUnlocked == nil {
+			w.metadataUnlocked = sync.NewCond(&w.mu)
+		}
+		for w.metadataLock != nil && w.metadataLock != c {
+			w.metadataUnlocked.Wait()
+		}
+		w.metadataLock = c
+		if strings.Contains(query, "AND SYNC_BATCH_ID=?") && args[3].Value.(int64) != w.syncBatchID {
+			return driver.RowsAffected(0), nil
+		}
+		syncBatchID := args[1].Value.(int64)
+		c.syncBatchID = &syncBatchID
+		return driver.RowsAffected(1), nil
+	case strings.HasPrefix(query, "CREATE TABLE IF NOT EXISTS"):
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.createTableLocked(query)
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(query, "CREATE TRANSIENT SCHEMA"):
+		if w.noCreateSchemaPrivilege {
+			return nil, &gosnowflake.SnowflakeError{
+				Number:   insufficientPrivilegesErrorNumber,
+				SQLState: "42501",
+				Message:  "SQL access control error: Insufficient privileges to operate on database 'PEERDB_DB'",
+			}
+		}
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(query, "ALTER") && strings.Contains(query, " SET TAG "):
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.tagStatements = append(w.tagStatements, query)
+		return driver.RowsAffected(0), nil
+	case strings.Contains(query, "CREATE OR REPLACE STAGE"), strings.HasPrefix(query, "PUT file://"):
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(query, "INSERT INTO public._peerdb_query_replication_metadata"):
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.syncedPartitions++
+		return driver.RowsAffected(1), nil
+	case strings.HasPrefix(query, "ALTER MATERIALIZED VIEW"):
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if c.inTx {
+			return nil, fmt.Errorf("hook ran in a transaction: %s", query)
+		}
+		if strings.Contains(query, "MISSING") {
+			return nil, errors.New("materialized view MISSING does not exist")
+		}
+		w.hookStatements = append(w.hookStatements, fmt.Sprintf("%s after %d merges", query, w.committedMerges))
+		return driver.RowsAffected(0), nil
+	case strings.Contains(query, "SET NORMALIZE_BATCH_ID"):
+		normalizeBatchID := args[0].Value.(int64)
+		c.normalizeBatchID = &normalizeBatchID
+		return driver.RowsAffected(1), nil
+	}
+	return nil, fmt.Errorf("unexpected statement: %s", query)
+}
+
+// runStatement counts a statement as running until the caller decrements runningStatements, and
+// fails it if that makes more than statementLimit run at once.
+func (w *fakeWarehouse) runStatement() error {
+	running := w.runningStatements.Add(1)
+	for {
+		maxRunning := w.maxRunningStatements.Load()
+		if running <= maxRunning || w.maxRunningStatements.CompareAndSwap(maxRunning, running) {
+			break
+		}
+	}
+	if w.statementLimit > 0 && running > w.statementLimit {
+		w.runningStatements.Add(-1)
+		return errors.New("too many statements running for session")
+	}
+	time.Sleep(w.statementDuration)
+	return nil
+}
+
+func (c *fakeWarehouseConn) releaseMetadataLockLocked() {
+	w := c.warehouse
+	if w.metadataLock == c {
+		w.metadataLock = nil
+		w.metadataUnlocked.Broadcast()
+	}
+}
+
+// QueryContext answers a query, routed by its text:
+//   - the metadata table queries read the sync and normalize batch IDs, which are missing with
+//     noJobMetadata.
+//   - the raw table queries read the tables, batches, record types, row counts and merge row ranges in a
+//     batch range of rawRows.
+//   - SELECT ... LIMIT 0 and information_schema.columns read the columns of a table in tables, the
+//     INFORMATION_SCHEMA queries find the raw table of mirror TEST_FLOW and no NOT NULL columns.
+//   - SHOW WAREHOUSES has warehouse WH, of warehouseSize with maxClusterCount clusters.
+func (c *fakeWarehouseConn) QueryContext(_ context.Context, query string,
+	args []driver.NamedValue) (driver.Rows, error) {
+	if c.broken {
+		return nil, driver.ErrBadConn
+	}
+	w := c.warehouse
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	switch {
+	case query == checkSchemaExistsSQL:
+		return &fakeRows{rows: [][]driver.Value{{!w.noInternalSchema}}}, nil
+	case w.noJobMetadata && (strings.HasPrefix(query, "SELECT SYNC_BATCH_ID") ||
+		strings.HasPrefix(query, "SELECT NORMALIZE_BATCH_ID")):
+		return &fakeRows{}, nil
+	case w.noJobMetadata && strings.HasPrefix(query, "SELECT TO_BOOLEAN(COUNT(1))"):
+		return &fakeRows{rows: [][]driver.Value{{false}}}, nil
+	case strings.HasPrefix(query, "SELECT SYNC_BATCH_ID"):
+		return &fakeRows{rows: [][]driver.Value{{w.syncBatchID}}}, nil
+	case strings.HasPrefix(query, "SELECT NORMALIZE_BATCH_ID") && w.normalizeBatchIDNull:
+		return &fakeRows{rows: [][]driver.Value{{nil}}}, nil
+	case strings.HasPrefix(query, "SELECT NORMALIZE_BATCH_ID"):
+		return &fakeRows{rows: [][]driver.Value{{w.normalizeBatchID}}}, nil
+	case strings.HasPrefix(query, "SELECT TO_BOOLEAN(COUNT(1))"):
+		return &fakeRows{rows: [][]driver.Value{{true}}}, nil
+	case strings.HasPrefix(query, "SELECT DISTINCT _PEERDB_DESTINATION_TABLE_NAME"):
+		// without raw rows, every batch has records of the table.
+		if w.rawRows != nil && w.rawRowsInRangeLocked(mergeBatchRange.FindString(query)) == 0 {
+			return &fakeRows{}, nil
+		}
+		return &fakeRows{rows: [][]driver.Value{{"PUBLIC.T"}}}, nil
+	case strings.Contains(query, "ARRAY_AGG"):
+		return &fakeRows{rows: [][]driver.Value{{"PUBLIC.T", `[""]`}}}, nil
+	case strings.Contains(query, "FROM _peerdb_query_replication_metadata"):
+		return &fakeRows{rows: [][]driver.Value{{int64(w.syncedPartitions)}}}, nil
+	case strings.Contains(query, "LIMIT 0"):
+		return w.tableColumnsLocked(query)
+	case strings.Contains(query, "FROM information_schema.columns"):
+		return &fakeRows{rows: w.tableColumnTypesLocked(fmt.Sprintf("%s.%s", args[1].Value, args[0].Value))}, nil
+	case strings.HasPrefix(query, "SELECT _PEERDB_DESTINATION_TABLE_NAME, _PEERDB_RECORD_TYPE, COUNT(*)"):
+		return &fakeRows{rows: w.unknownRecordTypeCountsLocked(mergeBatchRange.FindString(query))}, nil
+	case strings.HasPrefix(query, "SELECT _PEERDB_BATCH_ID, COUNT(*)"):
+		return &fakeRows{rows: w.batchRowCountsLocked(mergeBatchRange.FindString(query))}, nil
+	case strings.HasPrefix(query, "SELECT _PEERDB_TIMESTAMP, _PEERDB_UID"):
+		return &fakeRows{rows: w.mergeRowBoundariesLocked(query)}, nil
+	case strings.HasPrefix(query, "SELECT COUNT(*)") && strings.HasSuffix(query, "_PEERDB_BATCH_ID <= ?"):
+		batchRange := fmt.Sprintf("_PEERDB_BATCH_ID > %d AND _PEERDB_BATCH_ID <= %d", args[0].Value, args[1].Value)
+		return &fakeRows{rows: [][]driver.Value{{int64(w.rawRowsInRangeLocked(batchRange))}}}, nil
+	case strings.HasPrefix(query, "SELECT COUNT(*)"):
+		return &fakeRows{rows: [][]driver.Value{{int64(1)}}}, nil
+	case strings.HasPrefix(query, "SELECT COLUMN_NAME, DATA_TYPE FROM INFORMATION_SCHEMA.COLUMNS"):
+		// only the raw table of the mirror exists, under its uppercase name.
+		if args[0].Value != peerDBInternalSchema || args[1].Value != "_PEERDB_RAW_TEST_FLOW" {
+			return &fakeRows{}, nil
+		}
+		return &fakeRows{rows: [][]driver.Value{{"_PEERDB_UID", "TEXT"}, {"_PEERDB_DATA", "VARIANT"}}}, nil
+	case query == getNotNullColumnsSQL:
+		return &fakeRows{}, nil
+	case strings.HasPrefix(query, "SHOW WAREHOUSES"):
+		return &fakeRows{
+			columns: []string{"name", "state", "size", "min_cluster_count", "max_cluster_count"},
+			rows: [][]driver.Value{
+				{"WH_2", "STARTED", "X-Small", "1", "1"},
+				{"WH", "STARTED", w.warehouseSize, "1", w.maxClusterCount},
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("unexpected query: %s", query)
+}
+
+// parseBatchRange returns the batch IDs a merge's batch range starts after and ends at.
+func parseBatchRange(batchRange string) (int64, int64) {
+	var startBatchID, endBatchID int64
+	_, err := fmt.Sscanf(batchRange, "_PEERDB_BATCH_ID > %d AND _PEERDB_BATCH_ID <= %d",
+		&startBatchID, &endBatchID)
+	if err != nil {
+		panic(err)
+	}
+	return startBatchID, endBatchID
+}
+
+// parseRowBound returns the raw row key a merge's bound of the pattern compares with, nil without one.
+func parseRowBound(pattern *regexp.Regexp, merge string) *rawRowKey {
+	match := pattern.FindStringSubmatch(merge)
+	if match == nil {
+		return nil
+	}
+	timestamp, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	return &rawRowKey{timestamp: timestamp, uid: match[2]}
+}
+
+func rawRowKeyLess(a rawRowKey, b rawRowKey) bool {
+	return a.timestamp < b.timestamp || (a.timestamp == b.timestamp && a.uid < b.uid)
+}
+
+// mergedRawRowsLocked counts the raw table rows a merge reads, those of its batch range in its row range.
+func (w *fakeWarehouse) mergedRawRowsLocked(merge string) int {
+	startBatchID, endBatchID := parseBatchRange(mergeBatchRange.FindString(merge))
+	after, upTo := parseRowBound(mergeRowsAfter, merge), parseRowBound(mergeRowsUpTo, merge)
+	rows := 0
+	for batchID := startBatchID + 1; batchID <= endBatchID; batchID++ {
+		for i := 0; i < w.rawRows[batchID]; i++ {
+			key := fakeRawRowKey(batchID, i)
+			if (after == nil || rawRowKeyLess(*after, key)) && (upTo == nil || !rawRowKeyLess(*upTo, key)) {
+				rows++
+			}
+		}
+	}
+	return rows
+}
+
+// mergeRowBoundariesLocked answers the query for the last row of every run of raw table rows in a batch
+// range, leaving out the last row of the range.
+func (w *fakeWarehouse) mergeRowBoundariesLocked(query string) [][]driver.Value {
+	startBatchID, endBatchID := parseBatchRange(mergeBatchRange.FindString(query))
+	every, err := strconv.Atoi(mergeRowBoundaryEvery.FindStringSubmatch(query)[1])
+	if err != nil {
+		panic(err)
+	}
+	keys := make([]rawRowKey, 0)
+	for batchID := startBatchID + 1; batchID <= endBatchID; batchID++ {
+		for i := 0; i < w.rawRows[batchID]; i++ {
+			keys = append(keys, fakeRawRowKey(batchID, i))
+		}
+	}
+	rows := make([][]driver.Value, 0)
+	for i := every; i < len(keys); i += every {
+		rows = append(rows, []driver.Value{keys[i-1].timestamp, keys[i-1].uid})
+	}
+	return rows
+}
+
+// rawRowsInRangeLocked counts the raw table rows of the batches in a batch range.
+func (w *fakeWarehouse) rawRowsInRangeLocked(batchRange string) int {
+	startBatchID, endBatchID := parseBatchRange(batchRange)
+	rows := 0
+	for batchID := startBatchID + 1; batchID <= endBatchID; batchID++ {
+		rows += w.rawRows[batchID]
+	}
+	return rows
+}
+
+// batchRowCountsLocked counts the raw table rows of each batch with rows in a batch range.
+func (w *fakeWarehouse) batchRowCountsLocked(batchRange string) [][]driver.Value {
+	startBatchID, endBatchID := parseBatchRange(batchRange)
+	batchIDs := make([]int64, 0, len(w.rawRows))
+	for batchID, rows := range w.rawRows {
+		if batchID > startBatchID && batchID <= endBatchID && rows > 0 {
+			batchIDs = append(batchIDs, batchID)
+		}
+	}
+	sort.Slice(batchIDs, func(i, j int) bool { return batchIDs[i] < batchIDs[j] })
+	rows := make([][]driver.Value, 0, len(batchIDs))
+	for _, batchID := range batchIDs {
+		rows = append(rows, []driver.Value{batchID, int64(w.rawRows[batchID])})
+	}
+	return rows
+}
+
+// unknownRecordTypeCountsLocked counts the raw table rows of each unknown record type in a batch range.
+func (w *fakeWarehouse) unknownRecordTypeCountsLocked(batchRange string) [][]driver.Value {
+	startBatchID, endBatchID := parseBatchRange(batchRange)
+	counts := make(map[int64]int64)
+	for batchID := startBatchID + 1; batchID <= endBatchID; batchID++ {
+		for recordType, rows := range w.unknownRecordTypes[batchID] {
+			counts[recordType] += int64(rows)
+		}
+	}
+	rows := make([][]driver.Value, 0, len(counts))
+	for recordType, count := range counts {
+		rows = append(rows, []driver.Value{"PUBLIC.T", recordType, count})
+	}
+	return rows
+}
+
+// createTableLocked creates the table of a CREATE TABLE IF NOT EXISTS statement, unless it exists.
+func (w *fakeWarehouse) createTableLocked(query string) {
+	table := createTableStatement.FindStringSubmatch(query)[1]
+	if _, ok := w.tables[table]; ok {
+		return
+	}
+	columns := make([]string, 0)
+	for _, column := range createTableColumn.FindAllStringSubmatch(query, -1) {
+		columns = append(columns, fmt.Sprintf("%s %s", column[1], column[2]))
+	}
+	w.tables[table] = columns
+}
+
+// tableColumnsLocked answers the query for the schema of a table with its column names.
+func (w *fakeWarehouse) tableColumnsLocked(query string) (driver.Rows, error) {
+	table := selectTableSchema.FindStringSubmatch(query)[1]
+	columns, ok := w.tables[table]
+	if !ok {
+		return nil, fmt.Errorf("table %s does not exist", table)
+	}
+	names := make([]string, 0, len(columns))
+	for _, column := range columns {
+		names = append(names, strings.Fields(column)[0])
+	}
+	return &fakeRows{columns: names}, nil
+}
+
+// tableColumnTypesLocked returns the name and data type of each column of a table in tables, with
+// the data types Snowflake reports for the types the table was created with.
+func (w *fakeWarehouse) tableColumnTypesLocked(table string) [][]driver.Value {
+	rows := make([][]driver.Value, 0)
+	for _, column := range w.tables[table] {
+		fields := strings.Fields(column)
+		switch fields[1] {
+		case "INTEGER":
+			fields[1] = "NUMBER"
+		case "STRING":
+			fields[1] = "TEXT"
+		}
+		rows = append(rows, []driver.Value{fields[0], fields[1]})
+	}
+	return rows
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	if r.columns != nil {
+		return r.columns
+	}
+	if len(r.rows) == 0 {
+		return nil
+	}
+	return make([]string, len(r.rows[0]))
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,44 @@
+package connsnowflake
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlongDestinationTableNameIsTruncatedConsistently(t *testing.T) {
+	longTable := "SOURCE_SCHEMA_" + strings.Repeat("VERY_LONG_TABLE_NAME_", 15)
+	mappings := []*protos.TableMapping{
+		{SourceTableIdentifier: "public.t", DestinationTableIdentifier: "PUBLIC." + longTable},
+	}
+	require.NoError(t, utils.FitDestinationIdentifiers(mappings, protos.DBType_SNOWFLAKE,
+		protos.IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_TRUNCATE))
+	dstTable := mappings[0].DestinationTableIdentifier
+	require.Equal(t, "PUBLIC."+utils.TruncateIdentifier(longTable, protos.DBType_SNOWFLAKE), dstTable)
+
+	components, err := parseTableName(dstTable)
+	require.NoError(t, err)
+	require.Len(t, components.tableIdentifier, 255)
+
+	tableSchema := &protos.TableSchema{
+		TableIdentifier:   "public.t",
+		Columns:           map[string]string{"ID": string(qvalue.QValueKindInt64)},
+		PrimaryKeyColumns: []string{"ID"},
+	}
+	createTableSQL := generateCreateTableSQLForNormalizedTable(dstTable, tableSchema, false)
+	require.Contains(t, createTableSQL, "CREATE TABLE IF NOT EXISTS "+dstTable+"(")
+
+	c := &SnowflakeConnector{
+		tableSchemaMapping: map[string]*protos.TableSchema{dstTable: tableSchema},
+	}
+	mergeStatement, err := c.generateMergeStatement(dstTable, []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(mergeStatement, "MERGE INTO "+dstTable+" TARGET"))
+}
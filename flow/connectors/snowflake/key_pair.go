@@ -0,0 +1,96 @@
+package connsnowflake
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+const (
+	getCurrentUserSQL = "SELECT CURRENT_USER()"
+	describeUserSQL   = `DESC USER "%s"`
+
+	rsaPublicKeyFingerprintProperty  = "RSA_PUBLIC_KEY_FP"
+	rsaPublicKey2FingerprintProperty = "RSA_PUBLIC_KEY_2_FP"
+)
+
+// publicKeyFingerprint returns the fingerprint of the private key's public key,
+// in the form Snowflake shows for the public keys registered to a user.
+func publicKeyFingerprint(privateKey *rsa.PrivateKey) (string, error) {
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal public key: %w", err)
+	}
+	digest := sha256.Sum256(publicKeyDER)
+	return "SHA256:" + base64.StdEncoding.EncodeToString(digest[:]), nil
+}
+
+// checkKeyRotation compares the configured key's fingerprint with the two public keys Snowflake
+// lets a user register for rotation. It fails if the configured key is registered as neither,
+// and warns if another key is registered alongside it, as that usually means a rotation is
+// underway and the configured key is about to be removed.
+func checkKeyRotation(user string, fingerprint string, registeredFingerprints map[string]string) (string, error) {
+	primary := registeredFingerprints[rsaPublicKeyFingerprintProperty]
+	secondary := registeredFingerprints[rsaPublicKey2FingerprintProperty]
+	switch fingerprint {
+	case primary:
+		if secondary != "" {
+			return fmt.Sprintf("user %s has a second public key registered, the configured private key "+
+				"will stop working once the user's RSA_PUBLIC_KEY is replaced", user), nil
+		}
+	case secondary:
+		if primary != "" {
+			return fmt.Sprintf("user %s has a second public key registered, the configured private key "+
+				"will stop working once the user's RSA_PUBLIC_KEY_2 is unset", user), nil
+		}
+	default:
+		return "", fmt.Errorf("the configured private key (public key fingerprint %s) "+
+			"is not registered for Snowflake user %s", fingerprint, user)
+	}
+	return "", nil
+}
+
+// ValidateKeyPair checks that the configured private key is still registered for the Snowflake user,
+// returning a warning if another key is registered alongside it and it is likely to be rotated out.
+func (c *SnowflakeConnector) ValidateKeyPair() (string, error) {
+	if c.privateKey == nil {
+		return "", nil
+	}
+	fingerprint, err := publicKeyFingerprint(c.privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	var user string
+	err = c.database.QueryRowContext(c.ctx, getCurrentUserSQL).Scan(&user)
+	if err != nil {
+		return "", fmt.Errorf("failed to get current Snowflake user: %w", err)
+	}
+
+	rows, err := c.database.QueryContext(c.ctx, fmt.Sprintf(describeUserSQL, strings.ReplaceAll(user, `"`, `""`)))
+	if err != nil {
+		return "", fmt.Errorf("failed to describe Snowflake user %s: %w", user, err)
+	}
+	defer rows.Close()
+
+	registeredFingerprints := make(map[string]string)
+	for rows.Next() {
+		var property, value, defaultValue, description sql.NullString
+		err = rows.Scan(&property, &value, &defaultValue, &description)
+		if err != nil {
+			return "", fmt.Errorf("failed to read properties of Snowflake user %s: %w", user, err)
+		}
+		if value.Valid && value.String != "null" {
+			registeredFingerprints[property.String] = value.String
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("failed to read properties of Snowflake user %s: %w", user, err)
+	}
+
+	return checkKeyRotation(user, fingerprint, registeredFingerprints)
+}
@@ -0,0 +1,48 @@
+package connsnowflake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+// generateCurrentKeySQL returns the condition of the raw records to merge when records of an older primary
+// key are skipped or re-keyed, those with every column of the current primary key. Source key columns are
+// never NULL, so a NULL one is missing from a record synced before the key changed.
+func generateCurrentKeySQL(primaryKeyColumns []string) string {
+	conditions := make([]string, 0, len(primaryKeyColumns))
+	for _, pkeyColName := range primaryKeyColumns {
+		conditions = append(conditions, fmt.Sprintf(`"%s" IS NOT NULL`, strings.ToUpper(pkeyColName)))
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// generateRekeyedSQL returns the REKEYED CTE of the merge, which fills each current primary key column
+// a flattened raw record is missing from the latest earlier record of the merge that has the same values
+// for the other key columns. Records are ordered like the merge deduplicates them, latest first.
+func generateRekeyedSQL(primaryKeyColumns []string, nullsOrdering protos.DedupNullsOrdering) string {
+	quotedKeyColumns := make([]string, 0, len(primaryKeyColumns))
+	for _, pkeyColName := range primaryKeyColumns {
+		quotedKeyColumns = append(quotedKeyColumns, fmt.Sprintf(`"%s"`, strings.ToUpper(pkeyColName)))
+	}
+
+	rekeyedColumns := make([]string, 0, len(quotedKeyColumns))
+	for i, keyColumn := range quotedKeyColumns {
+		otherKeyColumns := make([]string, 0, len(quotedKeyColumns)-1)
+		otherKeyColumns = append(otherKeyColumns, quotedKeyColumns[:i]...)
+		otherKeyColumns = append(otherKeyColumns, quotedKeyColumns[i+1:]...)
+		// with a single key column there is nothing to re-key by, the record stays without a key.
+		if len(otherKeyColumns) == 0 {
+			rekeyedColumns = append(rekeyedColumns, keyColumn)
+			continue
+		}
+		rekeyedColumns = append(rekeyedColumns, fmt.Sprintf("COALESCE(%[1]s,FIRST_VALUE(%[1]s) IGNORE NULLS "+
+			"OVER (PARTITION BY %[2]s ORDER BY %[3]s ROWS BETWEEN 1 FOLLOWING AND UNBOUNDED FOLLOWING))"+
+			" AS %[1]s", keyColumn, strings.Join(otherKeyColumns, ","),
+			utils.DedupOrderSQL("_PEERDB_TIMESTAMP", nullsOrdering)))
+	}
+	return fmt.Sprintf(", REKEYED AS (SELECT * EXCLUDE (%s),%s FROM FLATTENED)",
+		strings.Join(quotedKeyColumns, ","), strings.Join(rekeyedColumns, ","))
+}
@@ -0,0 +1,67 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateMergeStatement_RecordsOfAnOlderPrimaryKey(t *testing.T) {
+	// the key of the table went from (ID) to (ID, REGION). The raw records of the merge span both keys,
+	// deletes synced before the change only have ID.
+	c := &SnowflakeConnector{
+		tableSchemaMapping: map[string]*protos.TableSchema{
+			"PUBLIC.T": {
+				TableIdentifier: "PUBLIC.T",
+				Columns: map[string]string{
+					"ID":     string(qvalue.QValueKindInt64),
+					"REGION": string(qvalue.QValueKindString),
+					"VALUE":  string(qvalue.QValueKindString),
+				},
+				PrimaryKeyColumns: []string{"ID", "REGION"},
+			},
+		},
+	}
+	currentKey := removeSpacesTabsNewlines(`WHERE "ID" IS NOT NULL AND "REGION" IS NOT NULL)`)
+
+	// by default every record is merged as it is
+	mergeStatement, err := c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_MERGE_ALL_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	mergeStatement = removeSpacesTabsNewlines(mergeStatement)
+	require.NotContains(t, mergeStatement, "REKEYED")
+	require.NotContains(t, mergeStatement, "ISNOTNULL")
+	require.Contains(t, mergeStatement, "*FROMFLATTENED)")
+
+	// records missing REGION are skipped, the others are deduplicated and merged on the current key.
+	mergeStatement, err = c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	mergeStatement = removeSpacesTabsNewlines(mergeStatement)
+	require.NotContains(t, mergeStatement, "REKEYED")
+	require.Contains(t, mergeStatement, "(PARTITIONBY(ID,REGION)")
+	require.Contains(t, mergeStatement, "*FROMFLATTENED"+currentKey)
+	require.Contains(t, mergeStatement, "SOURCEONTARGET.ID=SOURCE.IDANDTARGET.REGION=SOURCE.REGION")
+
+	// an older delete of ID takes the REGION of the latest earlier record of that ID, the row it deletes,
+	// before the records are deduplicated on the current key. Records still missing it are skipped.
+	mergeStatement, err = c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_REKEY_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	mergeStatement = removeSpacesTabsNewlines(mergeStatement)
+	require.Contains(t, mergeStatement, removeSpacesTabsNewlines(`REKEYED AS (SELECT * EXCLUDE ("ID","REGION"),
+		COALESCE("ID",FIRST_VALUE("ID") IGNORE NULLS OVER (PARTITION BY "REGION"
+		ORDER BY _PEERDB_TIMESTAMP DESC NULLS LAST ROWS BETWEEN 1 FOLLOWING AND UNBOUNDED FOLLOWING)) AS "ID",
+		COALESCE("REGION",FIRST_VALUE("REGION") IGNORE NULLS OVER (PARTITION BY "ID"
+		ORDER BY _PEERDB_TIMESTAMP DESC NULLS LAST ROWS BETWEEN 1 FOLLOWING AND UNBOUNDED FOLLOWING)) AS "REGION"
+		FROM FLATTENED)`))
+	require.Contains(t, mergeStatement, "*FROMREKEYED"+currentKey)
+}
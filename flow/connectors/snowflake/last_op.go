@@ -0,0 +1,29 @@
+package connsnowflake
+
+import (
+	"fmt"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"google.golang.org/protobuf/proto"
+)
+
+// lastOpColumnName is the normalized table column holding the operation of the latest change merged into a row.
+const lastOpColumnName = "_PEERDB_LAST_OP"
+
+// lastOpSQL names the operation of a raw record from its record type.
+const lastOpSQL = `DECODE(_PEERDB_RECORD_TYPE,0,'INSERT',1,'UPDATE',2,'DELETE')`
+
+// withLastOpColumn returns a copy of the table schema with the _PEERDB_LAST_OP column added, for the DDL
+// of the normalized table. The merge doesn't read the column from the raw data, so it isn't added there.
+func withLastOpColumn(tableSchema *protos.TableSchema) (*protos.TableSchema, error) {
+	withColumn, ok := proto.Clone(tableSchema).(*protos.TableSchema)
+	if !ok {
+		return nil, fmt.Errorf("failed to copy schema of table %s", tableSchema.TableIdentifier)
+	}
+	if withColumn.Columns == nil {
+		withColumn.Columns = make(map[string]string)
+	}
+	withColumn.Columns[lastOpColumnName] = string(qvalue.QValueKindString)
+	return withColumn, nil
+}
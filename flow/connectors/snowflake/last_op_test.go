@@ -0,0 +1,56 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateMergeStatement_LastOpColumn(t *testing.T) {
+	schema := &protos.TableSchema{
+		TableIdentifier: "PUBLIC.T",
+		Columns: map[string]string{
+			"ID":    string(qvalue.QValueKindInt64),
+			"VALUE": string(qvalue.QValueKindString),
+		},
+		PrimaryKeyColumns: []string{"ID"},
+	}
+	c := &SnowflakeConnector{
+		tableSchemaMapping: map[string]*protos.TableSchema{"PUBLIC.T": schema},
+	}
+
+	ddl, err := generateNormalizedTableDDL(&protos.SetupNormalizedTableBatchInput{
+		FlowJobName:  "test_last_op",
+		LastOpColumn: true,
+	}, "PUBLIC.T", schema, "", "")
+	require.NoError(t, err)
+	require.Contains(t, ddl, `"_PEERDB_LAST_OP" STRING,`)
+	require.NotContains(t, schema.Columns, lastOpColumnName)
+
+	// the operation of the latest record of each row is merged into the column, inserts as INSERT and
+	// updates as UPDATE.
+	mergeStatement, err := c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, true, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, true, rawRowRange{})
+	require.NoError(t, err)
+	mergeStatement = removeSpacesTabsNewlines(mergeStatement)
+	require.Contains(t, mergeStatement,
+		removeSpacesTabsNewlines(`DECODE(_PEERDB_RECORD_TYPE,0,'INSERT',1,'UPDATE',2,'DELETE') AS "_PEERDB_LAST_OP"`))
+	require.Regexp(t, `INSERT\([^)]*"_PEERDB_LAST_OP"[^)]*\)VALUES\([^)]*SOURCE."_PEERDB_LAST_OP"`, mergeStatement)
+	require.Regexp(t, `THENUPDATESET[^W]*"_PEERDB_LAST_OP"=SOURCE."_PEERDB_LAST_OP"`, mergeStatement)
+
+	// soft deleted rows are left with the delete op.
+	require.Contains(t, mergeStatement, removeSpacesTabsNewlines(`WHEN MATCHED AND (SOURCE._PEERDB_RECORD_TYPE = 2)
+		THEN UPDATE SET _PEERDB_IS_DELETED = TRUE, "_PEERDB_LAST_OP" = SOURCE."_PEERDB_LAST_OP"`))
+
+	// without the column, the merge doesn't touch it.
+	mergeStatement, err = c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, true, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	require.NotContains(t, mergeStatement, lastOpColumnName)
+}
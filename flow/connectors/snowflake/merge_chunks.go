@@ -0,0 +1,123 @@
+package connsnowflake
+
+import (
+	"fmt"
+	"strings"
+)
+
+const getBatchRowCountsSQL = `SELECT _PEERDB_BATCH_ID, COUNT(*) FROM %s.%s WHERE
+	 _PEERDB_BATCH_ID > %d AND _PEERDB_BATCH_ID <= %d GROUP BY _PEERDB_BATCH_ID ORDER BY _PEERDB_BATCH_ID`
+
+// getMergeRowBoundariesSQL reads the last raw table row of every run of %d rows of a batch range, in the
+// order the merge deduplicates them in, leaving out the last row of the range.
+const getMergeRowBoundariesSQL = `SELECT _PEERDB_TIMESTAMP, _PEERDB_UID FROM %s.%s WHERE
+	 _PEERDB_BATCH_ID > %d AND _PEERDB_BATCH_ID <= %d
+	 QUALIFY MOD(ROW_NUMBER() OVER (ORDER BY _PEERDB_TIMESTAMP, _PEERDB_UID), %d) = 0
+	 AND ROW_NUMBER() OVER (ORDER BY _PEERDB_TIMESTAMP, _PEERDB_UID) < COUNT(*) OVER ()
+	 ORDER BY _PEERDB_TIMESTAMP, _PEERDB_UID`
+
+// batchRowCount is the number of raw table rows of a batch.
+type batchRowCount struct {
+	batchID int64
+	rows    int64
+}
+
+// getBatchRowCounts returns the number of raw table rows of each batch in (normalizeBatchID, syncBatchID]
+// with rows, in batch order.
+func (c *SnowflakeConnector) getBatchRowCounts(flowJobName string, syncBatchID int64,
+	normalizeBatchID int64) ([]batchRowCount, error) {
+	rows, err := c.database.QueryContext(c.ctx, fmt.Sprintf(getBatchRowCountsSQL, peerDBInternalSchema,
+		getRawTableIdentifier(flowJobName), normalizeBatchID, syncBatchID))
+	if err != nil {
+		return nil, fmt.Errorf("error while counting raw table rows for normalization: %w", err)
+	}
+	defer rows.Close()
+
+	batchRowCounts := make([]batchRowCount, 0)
+	for rows.Next() {
+		var count batchRowCount
+		err = rows.Scan(&count.batchID, &count.rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read row: %w", err)
+		}
+		batchRowCounts = append(batchRowCounts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error while counting raw table rows for normalization: %w", err)
+	}
+	return batchRowCounts, nil
+}
+
+// normalizeChunkEndBatchIDs splits the batches up to syncBatchID into chunks of consecutive batches with
+// at most maxRecordsPerMerge rows between them, returning the last batch of each chunk. A batch with more
+// rows is a chunk of its own, which getMergeRowRanges splits further, and the last chunk ends at
+// syncBatchID, taking in any batches without rows.
+func normalizeChunkEndBatchIDs(batchRowCounts []batchRowCount, syncBatchID int64,
+	maxRecordsPerMerge uint32) []int64 {
+	chunkEndBatchIDs := make([]int64, 0)
+	var chunkRows int64
+	for i, count := range batchRowCounts {
+		if chunkRows > 0 && chunkRows+count.rows > int64(maxRecordsPerMerge) {
+			chunkEndBatchIDs = append(chunkEndBatchIDs, batchRowCounts[i-1].batchID)
+			chunkRows = 0
+		}
+		chunkRows += count.rows
+	}
+	return append(chunkEndBatchIDs, syncBatchID)
+}
+
+// rawRowKey orders the raw table rows of a batch range like the merge, by timestamp and then by uid.
+type rawRowKey struct {
+	timestamp int64
+	uid       string
+}
+
+// rawRowRange bounds the raw table rows a merge reads to those after after and up to upTo, either of
+// which may be nil for no bound.
+type rawRowRange struct {
+	after *rawRowKey
+	upTo  *rawRowKey
+}
+
+// filterSQL returns the conditions on the raw table rows of the range, to append to the WHERE clause.
+func (r rawRowRange) filterSQL() string {
+	filter := ""
+	if r.after != nil {
+		filter += fmt.Sprintf(" AND (_PEERDB_TIMESTAMP > %d OR (_PEERDB_TIMESTAMP = %d AND _PEERDB_UID > '%s'))",
+			r.after.timestamp, r.after.timestamp, strings.ReplaceAll(r.after.uid, "'", "''"))
+	}
+	if r.upTo != nil {
+		filter += fmt.Sprintf(" AND (_PEERDB_TIMESTAMP < %d OR (_PEERDB_TIMESTAMP = %d AND _PEERDB_UID <= '%s'))",
+			r.upTo.timestamp, r.upTo.timestamp, strings.ReplaceAll(r.upTo.uid, "'", "''"))
+	}
+	return filter
+}
+
+// getMergeRowRanges splits the raw table rows in (normalizeBatchID, syncBatchID] into ranges of at most
+// maxRecordsPerMerge rows, oldest first, so a batch with more rows is merged by several bounded merges.
+// A batch range with no more rows has a single unbounded range.
+func (c *SnowflakeConnector) getMergeRowRanges(flowJobName string, syncBatchID int64, normalizeBatchID int64,
+	maxRecordsPerMerge uint32) ([]rawRowRange, error) {
+	rows, err := c.database.QueryContext(c.ctx, fmt.Sprintf(getMergeRowBoundariesSQL, peerDBInternalSchema,
+		getRawTableIdentifier(flowJobName), normalizeBatchID, syncBatchID, maxRecordsPerMerge))
+	if err != nil {
+		return nil, fmt.Errorf("error while splitting raw table rows for normalization: %w", err)
+	}
+	defer rows.Close()
+
+	rowRanges := make([]rawRowRange, 0)
+	var after *rawRowKey
+	for rows.Next() {
+		var upTo rawRowKey
+		err = rows.Scan(&upTo.timestamp, &upTo.uid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read row: %w", err)
+		}
+		rowRanges = append(rowRanges, rawRowRange{after: after, upTo: &upTo})
+		after = &upTo
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error while splitting raw table rows for normalization: %w", err)
+	}
+	return append(rowRanges, rawRowRange{after: after}), nil
+}
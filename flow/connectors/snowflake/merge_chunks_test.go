@@ -0,0 +1,89 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeChunkEndBatchIDs(t *testing.T) {
+	counts := []batchRowCount{{1, 40}, {2, 40}, {3, 40}, {5, 250}, {6, 10}}
+	// batch 4 has no rows, batch 5 is over the cap and merged on its own, batch 7 has no rows either
+	require.Equal(t, []int64{2, 3, 5, 7}, normalizeChunkEndBatchIDs(counts, 7, 100))
+	require.Equal(t, []int64{7}, normalizeChunkEndBatchIDs(counts, 7, 1000))
+	require.Equal(t, []int64{7}, normalizeChunkEndBatchIDs(nil, 7, 100))
+}
+
+func TestNormalizeLargeBacklogInBoundedMerges(t *testing.T) {
+	const numBatches = 50
+	const rowsPerBatch = 31
+	const maxRecordsPerMerge = 100
+
+	rawRows := make(map[int64]int, numBatches)
+	for batchID := int64(1); batchID <= numBatches; batchID++ {
+		rawRows[batchID] = rowsPerBatch
+	}
+
+	normalize := func(maxRecordsPerMerge uint32) *fakeWarehouse {
+		w := &fakeWarehouse{syncBatchID: numBatches, rawRows: rawRows}
+		c := newFakeWarehouseConnector(w)
+		defer c.database.Close()
+		res, err := c.NormalizeRecords(&model.NormalizeRecordsRequest{
+			FlowJobName:        "test",
+			MaxRecordsPerMerge: maxRecordsPerMerge,
+		})
+		require.NoError(t, err)
+		require.True(t, res.Done)
+		require.Equal(t, int64(1), res.StartBatchID)
+		require.Equal(t, int64(numBatches), res.EndBatchID)
+		require.Equal(t, int64(numBatches), w.normalizeBatchID)
+		return w
+	}
+
+	unbounded := normalize(0)
+	require.Equal(t, []int{numBatches * rowsPerBatch}, unbounded.mergedRawRows)
+
+	// every row is merged once, by merges of at most maxRecordsPerMerge rows
+	bounded := normalize(maxRecordsPerMerge)
+	require.Len(t, bounded.mergedRawRows, numBatches/3+1)
+	mergedRows := 0
+	for _, rows := range bounded.mergedRawRows {
+		require.LessOrEqual(t, rows, maxRecordsPerMerge)
+		mergedRows += rows
+	}
+	require.Equal(t, numBatches*rowsPerBatch, mergedRows)
+}
+
+func TestNormalizeOversizedBatchInBoundedMerges(t *testing.T) {
+	const numRows = 25
+	const maxRecordsPerMerge = 10
+
+	w := &fakeWarehouse{
+		syncBatchID:      2,
+		normalizeBatchID: 1,
+		rawRows:          map[int64]int{2: numRows},
+	}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+	res, err := c.NormalizeRecords(&model.NormalizeRecordsRequest{
+		FlowJobName:        "test",
+		MaxRecordsPerMerge: maxRecordsPerMerge,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	// the batch is merged by bounded merges of consecutive row ranges, oldest rows first, committed
+	// together with the batch
+	require.Equal(t, []int{10, 10, 5}, w.mergedRawRows)
+	require.Equal(t, []string{"_PEERDB_BATCH_ID > 1 AND _PEERDB_BATCH_ID <= 2",
+		"_PEERDB_BATCH_ID > 1 AND _PEERDB_BATCH_ID <= 2", "_PEERDB_BATCH_ID > 1 AND _PEERDB_BATCH_ID <= 2"},
+		w.mergedBatchRanges)
+	require.Equal(t, int64(2), w.normalizeBatchID)
+}
+
+func TestRawRowRangeFilterSQL(t *testing.T) {
+	require.Empty(t, rawRowRange{}.filterSQL())
+	require.Equal(t, " AND (_PEERDB_TIMESTAMP > 5 OR (_PEERDB_TIMESTAMP = 5 AND _PEERDB_UID > 'a'))"+
+		" AND (_PEERDB_TIMESTAMP < 9 OR (_PEERDB_TIMESTAMP = 9 AND _PEERDB_UID <= 'b''c'))",
+		rawRowRange{after: &rawRowKey{5, "a"}, upTo: &rawRowKey{9, "b'c"}}.filterSQL())
+}
@@ -4,6 +4,10 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGenerateUpdateStatement_WithUnchangedToastCols(t *testing.T) {
@@ -22,7 +26,7 @@ func TestGenerateUpdateStatement_WithUnchangedToastCols(t *testing.T) {
 		THEN UPDATE SET "COL1" = SOURCE."COL1", "COL2" = SOURCE."COL2"`,
 	}
 
-	result := c.generateUpdateStatement(allCols, unchangedToastCols)
+	result := c.generateUpdateStatement(allCols, unchangedToastCols, "")
 
 	for i := range expected {
 		expected[i] = removeSpacesTabsNewlines(expected[i])
@@ -44,7 +48,7 @@ func TestGenerateUpdateStatement_EmptyColumns(t *testing.T) {
 		THEN UPDATE SET "COL1" = SOURCE."COL1", "COL2" = SOURCE."COL2", "COL3" = SOURCE."COL3"`,
 	}
 
-	result := c.generateUpdateStatement(allCols, unchangedToastCols)
+	result := c.generateUpdateStatement(allCols, unchangedToastCols, "")
 
 	for i := range expected {
 		expected[i] = removeSpacesTabsNewlines(expected[i])
@@ -56,6 +60,92 @@ func TestGenerateUpdateStatement_EmptyColumns(t *testing.T) {
 	}
 }
 
+func TestGenerateMergeStatement_DedupBySourceLSN(t *testing.T) {
+	c := &SnowflakeConnector{
+		tableSchemaMapping: map[string]*protos.TableSchema{
+			"PUBLIC.T": {
+				TableIdentifier:   "PUBLIC.T",
+				Columns:           map[string]string{"ID": string(qvalue.QValueKindInt64)},
+				PrimaryKeyColumns: []string{"ID"},
+			},
+		},
+	}
+	lsnCondition := removeSpacesTabsNewlines(`AND (TARGET."_PEERDB_SOURCE_LSN" IS NULL OR
+		SOURCE."_PEERDB_SOURCE_LSN" > TARGET."_PEERDB_SOURCE_LSN")`)
+
+	mergeStatement, err := c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, true, true, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	mergeStatement = removeSpacesTabsNewlines(mergeStatement)
+	// a change merged in an earlier batch is neither updated nor deleted again
+	require.Contains(t, mergeStatement, "_PEERDB_UNCHANGED_TOAST_COLUMNS=''"+lsnCondition+"THENUPDATESET")
+	require.Contains(t, mergeStatement, "(SOURCE._PEERDB_RECORD_TYPE=2)"+lsnCondition+"THENDELETE")
+
+	mergeStatement, err = c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, true, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	require.NotContains(t, removeSpacesTabsNewlines(mergeStatement), lsnCondition)
+
+	_, err = c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, true, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.Error(t, err)
+}
+
+func TestGenerateMergeStatement_UUIDArray(t *testing.T) {
+	c := &SnowflakeConnector{
+		tableSchemaMapping: map[string]*protos.TableSchema{
+			"PUBLIC.T": {
+				TableIdentifier: "PUBLIC.T",
+				Columns: map[string]string{
+					"ID":  string(qvalue.QValueKindInt64),
+					"IDS": string(qvalue.QValueKindArrayUUID),
+				},
+				PrimaryKeyColumns: []string{"ID"},
+			},
+		},
+	}
+
+	mergeStatement, err := c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	// the JSON array of uuids in the raw record is flattened into an ARRAY column
+	require.Contains(t, mergeStatement, `CAST(STRIP_NULL_VALUE(VAR_COLS:"IDS") AS ARRAY) AS "IDS"`)
+	require.Equal(t, "ARRAY", qValueKindToSnowflakeType(qvalue.QValueKindArrayUUID))
+}
+
+func TestGenerateMergeStatement_DedupNullsOrdering(t *testing.T) {
+	c := &SnowflakeConnector{
+		tableSchemaMapping: map[string]*protos.TableSchema{
+			"PUBLIC.T": {
+				TableIdentifier:   "PUBLIC.T",
+				Columns:           map[string]string{"ID": string(qvalue.QValueKindInt64)},
+				PrimaryKeyColumns: []string{"ID"},
+			},
+		},
+	}
+
+	// records without a timestamp never win over records with one unless configured to
+	for nullsOrdering, expectedOrderBy := range map[protos.DedupNullsOrdering]string{
+		protos.DedupNullsOrdering_DEDUP_NULLS_LAST:  "ORDER BY _PEERDB_TIMESTAMP DESC NULLS LAST)",
+		protos.DedupNullsOrdering_DEDUP_NULLS_FIRST: "ORDER BY _PEERDB_TIMESTAMP DESC NULLS FIRST)",
+	} {
+		mergeStatement, err := c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+			2, 1, false, false, false, false, nil, nullsOrdering,
+			protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+			protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+		require.NoError(t, err)
+		require.Contains(t, mergeStatement, "(PARTITION BY (ID) "+expectedOrderBy+" AS _PEERDB_RANK")
+	}
+}
+
 func removeSpacesTabsNewlines(s string) string {
 	s = strings.ReplaceAll(s, " ", "")
 	s = strings.ReplaceAll(s, "\t", "")
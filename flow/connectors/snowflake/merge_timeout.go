@@ -0,0 +1,65 @@
+package connsnowflake
+
+import (
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// Snowflake error number of a statement cancelled for running longer than STATEMENT_TIMEOUT_IN_SECONDS.
+const statementTimeoutErrorNumber = 630
+
+func isStatementTimeoutError(err error) bool {
+	return isSnowflakeError(err, statementTimeoutErrorNumber)
+}
+
+// mergeTimeoutSplitEndBatchIDs splits the batches in (normalizeBatchID, syncBatchID] of a merge that timed
+// out into smaller ranges per the policy, returning the last batch of each. It returns nil if the merge
+// is not to be split, as the policy fails it or it has a single batch.
+func mergeTimeoutSplitEndBatchIDs(policy protos.MergeTimeoutSplitPolicy, syncBatchID int64,
+	normalizeBatchID int64) []int64 {
+	if syncBatchID-normalizeBatchID <= 1 {
+		return nil
+	}
+	switch policy {
+	case protos.MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_HALVE:
+		return []int64{normalizeBatchID + (syncBatchID-normalizeBatchID)/2, syncBatchID}
+	case protos.MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_PER_BATCH:
+		endBatchIDs := make([]int64, 0, syncBatchID-normalizeBatchID)
+		for batchID := normalizeBatchID + 1; batchID <= syncBatchID; batchID++ {
+			endBatchIDs = append(endBatchIDs, batchID)
+		}
+		return endBatchIDs
+	default:
+		return nil
+	}
+}
+
+// normalizeBatchRangeSplittingTimeouts merges the batches in (normalizeBatchID, syncBatchID] like
+// normalizeBatchRange. If the merge runs into the statement timeout, which rolls it back, the batches are
+// split per the TimeoutSplitPolicy of the request and the smaller ranges are merged one after the other,
+// splitting them again if they time out too.
+func (c *SnowflakeConnector) normalizeBatchRangeSplittingTimeouts(req *model.NormalizeRecordsRequest,
+	syncBatchID int64, normalizeBatchID int64) error {
+	err := c.normalizeBatchRange(req, syncBatchID, normalizeBatchID)
+	if err == nil || !isStatementTimeoutError(err) {
+		return err
+	}
+	splitEndBatchIDs := mergeTimeoutSplitEndBatchIDs(req.TimeoutSplitPolicy, syncBatchID, normalizeBatchID)
+	if splitEndBatchIDs == nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"flowName": req.FlowJobName,
+	}).Warnf("merge of batches %d to %d ran into the statement timeout, merging them again in %d parts: %v",
+		normalizeBatchID+1, syncBatchID, len(splitEndBatchIDs), err)
+	startBatchID := normalizeBatchID
+	for _, endBatchID := range splitEndBatchIDs {
+		if err := c.normalizeBatchRangeSplittingTimeouts(req, endBatchID, startBatchID); err != nil {
+			return err
+		}
+		startBatchID = endBatchID
+	}
+	return nil
+}
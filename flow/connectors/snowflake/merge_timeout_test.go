@@ -0,0 +1,68 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeTimeoutSplitEndBatchIDs(t *testing.T) {
+	halve := protos.MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_HALVE
+	require.Equal(t, []int64{6, 10}, mergeTimeoutSplitEndBatchIDs(halve, 10, 2))
+	require.Equal(t, []int64{3, 4}, mergeTimeoutSplitEndBatchIDs(halve, 4, 2))
+	require.Nil(t, mergeTimeoutSplitEndBatchIDs(halve, 3, 2))
+
+	perBatch := protos.MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_PER_BATCH
+	require.Equal(t, []int64{3, 4, 5}, mergeTimeoutSplitEndBatchIDs(perBatch, 5, 2))
+	require.Nil(t, mergeTimeoutSplitEndBatchIDs(protos.MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_FAIL, 5, 2))
+}
+
+func TestNormalizeSplitsMergesThatTimeOut(t *testing.T) {
+	const numBatches = 8
+	const rowsPerBatch = 31
+	const mergeTimeoutRows = 100
+
+	rawRows := make(map[int64]int, numBatches)
+	for batchID := int64(1); batchID <= numBatches; batchID++ {
+		rawRows[batchID] = rowsPerBatch
+	}
+
+	normalize := func(policy protos.MergeTimeoutSplitPolicy) (*fakeWarehouse, error) {
+		w := &fakeWarehouse{
+			syncBatchID:      numBatches,
+			rawRows:          rawRows,
+			mergeTimeoutRows: mergeTimeoutRows,
+		}
+		c := newFakeWarehouseConnector(w)
+		defer c.database.Close()
+		_, err := c.NormalizeRecords(&model.NormalizeRecordsRequest{
+			FlowJobName:        "test",
+			TimeoutSplitPolicy: policy,
+		})
+		return w, err
+	}
+
+	// all batches time out, so do their halves, the quarters are merged
+	halved, err := normalize(protos.MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_HALVE)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"_PEERDB_BATCH_ID > 0 AND _PEERDB_BATCH_ID <= 2",
+		"_PEERDB_BATCH_ID > 2 AND _PEERDB_BATCH_ID <= 4",
+		"_PEERDB_BATCH_ID > 4 AND _PEERDB_BATCH_ID <= 6",
+		"_PEERDB_BATCH_ID > 6 AND _PEERDB_BATCH_ID <= 8",
+	}, halved.mergedBatchRanges)
+	require.Equal(t, int64(numBatches), halved.normalizeBatchID)
+	require.Equal(t, []int{62, 62, 62, 62}, halved.mergedRawRows)
+
+	perBatch, err := normalize(protos.MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_PER_BATCH)
+	require.NoError(t, err)
+	require.Len(t, perBatch.mergedBatchRanges, numBatches)
+	require.Equal(t, int64(numBatches), perBatch.normalizeBatchID)
+
+	failed, err := normalize(protos.MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_FAIL)
+	require.True(t, isStatementTimeoutError(err))
+	require.Empty(t, failed.mergedBatchRanges)
+	require.Equal(t, int64(0), failed.normalizeBatchID)
+}
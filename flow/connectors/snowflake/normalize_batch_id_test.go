@@ -0,0 +1,98 @@
+package connsnowflake
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirstNormalizeOfNewMirror(t *testing.T) {
+	setMergeRetryInitialInterval(t, time.Millisecond)
+
+	// before the first sync there is nothing to normalize, even when forced
+	w := &fakeWarehouse{noJobMetadata: true}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+	res, err := c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test", Force: true})
+	require.NoError(t, err)
+	require.False(t, res.Done)
+	require.Empty(t, w.mergedBatchRanges)
+
+	// the first sync records its batch with a NULL normalize batch id, not a normalized batch 0
+	w.rawRows = make(map[int64]int)
+	syncRes, err := c.SyncRecords(&model.SyncRecordsRequest{
+		FlowJobName: "test",
+		SyncMode:    protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT,
+		Records: &model.RecordBatch{
+			Records: []model.Record{&model.InsertRecord{
+				DestinationTableName: "PUBLIC.T",
+				CheckPointID:         10,
+				Items: model.NewRecordItemWithData([]string{"ID"}, []*qvalue.QValue{
+					{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+				}),
+			}},
+			LastCheckPointID: 10,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, model.FirstSyncBatchID, syncRes.CurrentSyncBatchID)
+	require.Equal(t, model.FirstSyncBatchID, w.syncBatchID)
+	require.True(t, w.normalizeBatchIDNull)
+	normalizeBatchID, err := c.GetLastNormalizeBatchID("test")
+	require.NoError(t, err)
+	require.Equal(t, model.NoNormalizedBatchID, normalizeBatchID)
+
+	// the first normalize merges the first batch
+	res, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test"})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	require.Equal(t, model.FirstSyncBatchID, res.StartBatchID)
+	require.Equal(t, model.FirstSyncBatchID, res.EndBatchID)
+	require.Equal(t, []string{"_PEERDB_BATCH_ID > 0 AND _PEERDB_BATCH_ID <= 1"}, w.mergedBatchRanges)
+	require.Equal(t, model.FirstSyncBatchID, w.normalizeBatchID)
+	require.False(t, w.normalizeBatchIDNull)
+
+	// the first batch is not merged a second time
+	res, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test"})
+	require.NoError(t, err)
+	require.False(t, res.Done)
+	require.Len(t, w.mergedBatchRanges, 1)
+
+	// unless forced, which merges exactly the first batch again
+	res, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test", Force: true})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	require.Equal(t, []string{
+		"_PEERDB_BATCH_ID > 0 AND _PEERDB_BATCH_ID <= 1",
+		"_PEERDB_BATCH_ID > 0 AND _PEERDB_BATCH_ID <= 1",
+	}, w.mergedBatchRanges)
+	require.Equal(t, model.FirstSyncBatchID, w.normalizeBatchID)
+}
+
+func TestNormalizeAdvancesPastBatchesWithoutRawRecords(t *testing.T) {
+	// batches 2 and 3 were synced, but left no raw records of the table to merge.
+	w := &fakeWarehouse{
+		syncBatchID:      3,
+		normalizeBatchID: 1,
+		rawRows:          map[int64]int{1: 1},
+	}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+	res, err := c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test"})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	require.Equal(t, int64(2), res.StartBatchID)
+	require.Equal(t, int64(3), res.EndBatchID)
+	require.Empty(t, w.mergedBatchRanges)
+	require.Equal(t, int64(3), w.normalizeBatchID)
+
+	// normalize has caught up, the batches are not normalized again.
+	res, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test"})
+	require.NoError(t, err)
+	require.False(t, res.Done)
+	require.Equal(t, int64(3), w.normalizeBatchID)
+}
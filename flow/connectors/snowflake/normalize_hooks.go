@@ -0,0 +1,39 @@
+package connsnowflake
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// runNormalizeHooks runs the PreNormalizeSQL of the request, then merge, then the PostNormalizeSQL, each
+// statement on its own outside the transactions of merge. A failing pre-normalize statement fails the
+// normalize without merging. The post-normalize statement runs even if merge failed, so that whatever
+// the pre-normalize statement suspended is resumed, and its error is returned along with that of merge.
+// Once the batches are merged a failing post-normalize statement is only logged: a retry would find
+// nothing to merge and skip both statements, so failing the normalize wouldn't run it again. The next
+// normalize with batches to merge runs it again.
+func (c *SnowflakeConnector) runNormalizeHooks(req *model.NormalizeRecordsRequest, merge func() error) error {
+	if req.PreNormalizeSQL != "" {
+		if _, err := c.database.ExecContext(c.ctx, req.PreNormalizeSQL); err != nil {
+			return fmt.Errorf("pre-normalize sql failed, nothing was merged: %w", err)
+		}
+	}
+
+	mergeErr := merge()
+
+	if req.PostNormalizeSQL != "" {
+		if _, err := c.database.ExecContext(c.ctx, req.PostNormalizeSQL); err != nil {
+			log.WithFields(log.Fields{
+				"flowName": req.FlowJobName,
+			}).Errorf("post-normalize sql failed: %v", err)
+			if mergeErr == nil {
+				return nil
+			}
+			return errors.Join(mergeErr, fmt.Errorf("post-normalize sql failed: %w", err))
+		}
+	}
+	return mergeErr
+}
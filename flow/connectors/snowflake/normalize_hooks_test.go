@@ -0,0 +1,78 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeHooksRunAroundMerges(t *testing.T) {
+	const suspend = "ALTER MATERIALIZED VIEW MV SUSPEND"
+	const resume = "ALTER MATERIALIZED VIEW MV RESUME"
+	rawRows := map[int64]int{2: 1, 3: 1}
+
+	// the merges of both chunks run between the hooks.
+	w := &fakeWarehouse{syncBatchID: 3, normalizeBatchID: 1, rawRows: rawRows}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+	res, err := c.NormalizeRecords(&model.NormalizeRecordsRequest{
+		FlowJobName:        "test",
+		MaxRecordsPerMerge: 1,
+		PreNormalizeSQL:    suspend,
+		PostNormalizeSQL:   resume,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	require.Equal(t, 2, w.committedMerges)
+	require.Equal(t, []string{suspend + " after 0 merges", resume + " after 2 merges"}, w.hookStatements)
+
+	// with nothing to merge, the hooks don't run either.
+	res, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{
+		FlowJobName:      "test",
+		PreNormalizeSQL:  suspend,
+		PostNormalizeSQL: resume,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Done)
+	require.Len(t, w.hookStatements, 2)
+
+	// a failing pre-normalize statement fails the normalize before merging.
+	w = &fakeWarehouse{syncBatchID: 3, normalizeBatchID: 1, rawRows: rawRows}
+	c = newFakeWarehouseConnector(w)
+	defer c.database.Close()
+	_, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{
+		FlowJobName:      "test",
+		PreNormalizeSQL:  "ALTER MATERIALIZED VIEW MISSING SUSPEND",
+		PostNormalizeSQL: resume,
+	})
+	require.ErrorContains(t, err, "pre-normalize sql failed")
+	require.Zero(t, w.committedMerges)
+	require.Empty(t, w.hookStatements)
+
+	// a failing post-normalize statement after the batches were merged doesn't fail the normalize, a
+	// retry would have nothing to merge.
+	res, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{
+		FlowJobName:      "test",
+		PreNormalizeSQL:  suspend,
+		PostNormalizeSQL: "ALTER MATERIALIZED VIEW MISSING RESUME",
+	})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	require.Equal(t, 1, w.committedMerges)
+	require.Equal(t, int64(3), w.normalizeBatchID)
+	require.Equal(t, []string{suspend + " after 0 merges"}, w.hookStatements)
+
+	// the post-normalize statement still runs after a merge failed.
+	w = &fakeWarehouse{syncBatchID: 3, normalizeBatchID: 1, rawRows: rawRows, mergeDisconnects: 1}
+	c = newFakeWarehouseConnector(w)
+	defer c.database.Close()
+	_, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{
+		FlowJobName:      "test",
+		PreNormalizeSQL:  suspend,
+		PostNormalizeSQL: resume,
+	})
+	require.Error(t, err)
+	require.Zero(t, w.committedMerges)
+	require.Equal(t, []string{suspend + " after 0 merges", resume + " after 0 merges"}, w.hookStatements)
+}
@@ -0,0 +1,80 @@
+package connsnowflake
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func testNormalizedTableSchema() *protos.TableSchema {
+	return &protos.TableSchema{
+		TableIdentifier: "public.test_table",
+		Columns: map[string]string{
+			"id":   string(qvalue.QValueKindInt64),
+			"name": string(qvalue.QValueKindString),
+		},
+		PrimaryKeyColumns: []string{"id"},
+	}
+}
+
+func TestGenerateCreateTableSQLForNormalizedTable_Permanent(t *testing.T) {
+	createSQL := generateCreateTableSQLForNormalizedTable("PUBLIC.TEST_TABLE", testNormalizedTableSchema(), false)
+	require.True(t, strings.HasPrefix(createSQL, "CREATE TABLE IF NOT EXISTS PUBLIC.TEST_TABLE("))
+	require.NotContains(t, createSQL, "TRANSIENT")
+}
+
+func TestGenerateCreateTableSQLForNormalizedTable_Transient(t *testing.T) {
+	createSQL := generateCreateTableSQLForNormalizedTable("PUBLIC.TEST_TABLE", testNormalizedTableSchema(), true)
+	require.True(t, strings.HasPrefix(createSQL, "CREATE TRANSIENT TABLE IF NOT EXISTS PUBLIC.TEST_TABLE("))
+	require.Contains(t, createSQL, `"ID" INTEGER`)
+	require.Contains(t, createSQL, `"_PEERDB_IS_DELETED" BOOLEAN DEFAULT FALSE`)
+	require.Contains(t, createSQL, `PRIMARY KEY("ID")`)
+}
+
+func TestGenerateDDLMatchesSetupNormalizedTables(t *testing.T) {
+	tableSchema := testNormalizedTableSchema()
+	ddlOutput, err := GenerateDDL(&protos.SnowflakeConfig{}, &protos.SetupNormalizedTableBatchInput{
+		TableNameSchemaMapping: map[string]*protos.TableSchema{"PUBLIC.TEST_TABLE": tableSchema},
+		TransientTables:        true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"PUBLIC.TEST_TABLE": generateCreateTableSQLForNormalizedTable("PUBLIC.TEST_TABLE", tableSchema, true),
+	}, ddlOutput.TableDdlMapping)
+	require.Equal(t, `CREATE TRANSIENT TABLE IF NOT EXISTS PUBLIC.TEST_TABLE("ID" INTEGER,"NAME" STRING,`+
+		`"_PEERDB_IS_DELETED" BOOLEAN DEFAULT FALSE,PRIMARY KEY("ID"))`, ddlOutput.TableDdlMapping["PUBLIC.TEST_TABLE"])
+}
+
+func TestGenerateDDLWithSourceLSNColumn(t *testing.T) {
+	tableSchema := testNormalizedTableSchema()
+	ddlOutput, err := GenerateDDL(&protos.SnowflakeConfig{}, &protos.SetupNormalizedTableBatchInput{
+		TableNameSchemaMapping: map[string]*protos.TableSchema{"PUBLIC.TEST_TABLE": tableSchema},
+		SourceLsnColumn:        true,
+	})
+	require.NoError(t, err)
+	require.Contains(t, ddlOutput.TableDdlMapping["PUBLIC.TEST_TABLE"], `"_PEERDB_SOURCE_LSN" INTEGER`)
+	// the schema shared with the rest of the flow is left as is
+	require.NotContains(t, tableSchema.Columns, sourceLSNColumnName)
+}
+
+func TestAddSourceLSNColumn(t *testing.T) {
+	insertItems := model.NewRecordItems()
+	insertItems.AddColumn("id", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)})
+	updateItems := model.NewRecordItems()
+	updateItems.AddColumn("id", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)})
+	records := []model.Record{
+		&model.InsertRecord{CheckPointID: 10, Items: insertItems},
+		&model.UpdateRecord{CheckPointID: 20, NewItems: updateItems},
+	}
+
+	addSourceLSNColumn(records)
+	for _, record := range records {
+		lsn, err := record.GetItems().GetValueByColName(sourceLSNColumnName)
+		require.NoError(t, err)
+		require.Equal(t, record.GetCheckPointID(), lsn.Value)
+	}
+}
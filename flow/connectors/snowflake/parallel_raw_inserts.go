@@ -0,0 +1,38 @@
+package connsnowflake
+
+import (
+	"errors"
+	"sync"
+)
+
+// insertRecordsInRawTableInParallel inserts the records into the raw table syncRecordsChunkSize at a time
+// over up to maxParallelInserts connections at once. Each chunk commits on its own, ahead of the sync
+// transaction recording the batch, so a failed sync leaves chunks behind, which SyncRecords clears before
+// the batch is synced again. Normalize only reads batches recorded as synced, so it never sees them.
+func (c *SnowflakeConnector) insertRecordsInRawTableInParallel(rawTableIdentifier string,
+	records []snowflakeRawRecord, rawDataAsVariant bool, maxParallelInserts int) error {
+	numChunks := (len(records) + syncRecordsChunkSize - 1) / syncRecordsChunkSize
+	errs := make([]error, numChunks)
+	sem := make(chan struct{}, maxParallelInserts)
+	var wg sync.WaitGroup
+	for i := 0; i < numChunks; i++ {
+		begin := i * syncRecordsChunkSize
+		end := begin + syncRecordsChunkSize
+		if end > len(records) {
+			end = len(records)
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, chunk []snowflakeRawRecord) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = c.insertRecordsInRawTable(rawTableIdentifier, chunk, rawDataAsVariant, c.database)
+		}(i, records[begin:end])
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
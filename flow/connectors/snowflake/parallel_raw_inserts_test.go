@@ -0,0 +1,67 @@
+package connsnowflake
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelRawInsertsMatchSerialInserts(t *testing.T) {
+	const numRecords = 2*syncRecordsChunkSize + 100
+
+	records := make([]model.Record, 0, numRecords)
+	for i := 0; i < numRecords; i++ {
+		items := model.NewRecordItemWithData([]string{"ID"}, []*qvalue.QValue{
+			{Kind: qvalue.QValueKindInt64, Value: int64(i)},
+		})
+		tableName := fmt.Sprintf("PUBLIC.T%d", i%3)
+		switch i % 4 {
+		case 0, 1:
+			records = append(records, &model.InsertRecord{DestinationTableName: tableName, Items: items})
+		case 2:
+			records = append(records, &model.UpdateRecord{DestinationTableName: tableName, NewItems: items,
+				OldItems: model.NewRecordItems()})
+		case 3:
+			records = append(records, &model.DeleteRecord{DestinationTableName: tableName, Items: items})
+		}
+	}
+
+	// sync returns the rows the sync of the records left in the raw table, without their uid and
+	// timestamp, which differ between syncs.
+	sync := func(parallelRawInserts uint32) (*fakeWarehouse, []string) {
+		w := &fakeWarehouse{syncBatchID: 1, rawRows: make(map[int64]int)}
+		c := newFakeWarehouseConnector(w)
+		defer c.database.Close()
+		res, err := c.SyncRecords(&model.SyncRecordsRequest{
+			FlowJobName:        "test",
+			SyncMode:           protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT,
+			Records:            &model.RecordBatch{Records: records, LastCheckPointID: 10},
+			ParallelRawInserts: parallelRawInserts,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(numRecords), res.NumRecordsSynced)
+		require.Equal(t, int64(2), w.syncBatchID)
+		require.Equal(t, map[int64]int{2: numRecords}, w.rawRows)
+
+		rows := make([]string, 0, len(w.rawRowValues))
+		for _, row := range w.rawRowValues {
+			rows = append(rows, fmt.Sprint([]driver.Value(row[2:])))
+		}
+		sort.Strings(rows)
+		return w, rows
+	}
+
+	serial, serialRows := sync(0)
+	require.Zero(t, serial.rawInsertsOutsideTx)
+	parallel, parallelRows := sync(4)
+	// each of the 3 chunks committed on its own ahead of the transaction recording the batch
+	require.Equal(t, 3, parallel.rawInsertsOutsideTx)
+	require.Len(t, parallelRows, numRecords)
+	require.Equal(t, serialRows, parallelRows)
+}
@@ -0,0 +1,103 @@
+package connsnowflake
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+const (
+	// Snowflake error numbers for the access control errors PeerDB knows how to explain.
+	insufficientPrivilegesErrorNumber = 3001
+	noActiveWarehouseErrorNumber      = 606
+
+	resumeWarehouseSQL = `ALTER WAREHOUSE IF EXISTS "%s" RESUME IF SUSPENDED`
+)
+
+func isSnowflakeError(err error, number int) bool {
+	var snowflakeErr *gosnowflake.SnowflakeError
+	return errors.As(err, &snowflakeErr) && snowflakeErr.Number == number
+}
+
+func isInsufficientPrivilegesError(err error) bool {
+	return isSnowflakeError(err, insufficientPrivilegesErrorNumber)
+}
+
+// roleDescription names the role in error messages, the user's default role is used if none is configured.
+func (c *SnowflakeConnector) roleDescription() string {
+	if c.role == "" {
+		return "the user's default role"
+	}
+	return "role " + c.role
+}
+
+// grantee is the role to name in suggested GRANT statements.
+func (c *SnowflakeConnector) grantee() string {
+	if c.role == "" {
+		return "<default role>"
+	}
+	return c.role
+}
+
+// createPeerDBInternalSchema creates the schema PeerDB keeps its metadata and raw tables in. Roles that may
+// not create schemas can still be used if the schema was created for them beforehand.
+func (c *SnowflakeConnector) createPeerDBInternalSchema(createSchemaTx *sql.Tx) error {
+	_, err := createSchemaTx.ExecContext(c.ctx, fmt.Sprintf(createPeerDBInternalSchemaSQL, peerDBInternalSchema))
+	if err == nil {
+		return nil
+	}
+	if !isInsufficientPrivilegesError(err) {
+		return fmt.Errorf("error while creating internal schema for PeerDB: %w", err)
+	}
+
+	var schemaExists bool
+	existsErr := createSchemaTx.QueryRowContext(c.ctx, checkSchemaExistsSQL, peerDBInternalSchema).Scan(&schemaExists)
+	if existsErr != nil {
+		return fmt.Errorf("unable to check if internal schema exists: %w", existsErr)
+	}
+	if schemaExists {
+		return nil
+	}
+	return fmt.Errorf("%s lacks the CREATE SCHEMA privilege on database %s needed to create schema %s, "+
+		"grant it with GRANT CREATE SCHEMA ON DATABASE %s TO ROLE %s or create the schema beforehand: %w",
+		c.roleDescription(), c.databaseName, peerDBInternalSchema, c.databaseName, c.grantee(), err)
+}
+
+// resumeWarehouse resumes the configured warehouse if it is suspended. Resuming needs the OPERATE
+// privilege, without it the warehouse is left to resume on its own when queried.
+func (c *SnowflakeConnector) resumeWarehouse() error {
+	if c.warehouse == "" {
+		return nil
+	}
+	_, err := c.database.ExecContext(c.ctx, fmt.Sprintf(resumeWarehouseSQL, c.warehouse))
+	if err == nil {
+		return nil
+	}
+	if isInsufficientPrivilegesError(err) {
+		log.Warnf("%s lacks the OPERATE privilege on warehouse %s, relying on the warehouse to auto-resume: %v",
+			c.roleDescription(), c.warehouse, err)
+		return nil
+	}
+	return fmt.Errorf("failed to resume warehouse %s: %w", c.warehouse, err)
+}
+
+// explainWarehouseError says what to do when a statement failed because the session had no usable
+// warehouse: none is configured, or the configured one is suspended and could not be resumed. Other
+// errors are returned as is.
+func (c *SnowflakeConnector) explainWarehouseError(err error) error {
+	if !isSnowflakeError(err, noActiveWarehouseErrorNumber) {
+		return err
+	}
+	if c.warehouse == "" {
+		return fmt.Errorf("no warehouse is configured for the peer and %s has no default warehouse, "+
+			"set the warehouse of the peer or GRANT USAGE ON WAREHOUSE <warehouse> TO ROLE %s "+
+			"and make it the default warehouse of the user: %w", c.roleDescription(), c.grantee(), err)
+	}
+	return fmt.Errorf("warehouse %s is suspended or %s cannot use it, grant it with "+
+		"GRANT USAGE, OPERATE ON WAREHOUSE %s TO ROLE %s or enable resuming it with "+
+		"ALTER WAREHOUSE %s SET AUTO_RESUME = TRUE: %w",
+		c.warehouse, c.roleDescription(), c.warehouse, c.grantee(), c.warehouse, err)
+}
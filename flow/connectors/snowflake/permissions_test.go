@@ -0,0 +1,58 @@
+package connsnowflake
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+)
+
+// createInternalSchemaWithRestrictedRole creates the internal schema for a role that may not create schemas.
+func createInternalSchemaWithRestrictedRole(t *testing.T, schemaExists bool) error {
+	c := newFakeWarehouseConnector(&fakeWarehouse{noCreateSchemaPrivilege: true, noInternalSchema: !schemaExists})
+	defer c.database.Close()
+	c.databaseName = "PEERDB_DB"
+	c.role = "PEERDB_ROLE"
+
+	tx, err := c.database.BeginTx(c.ctx, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, tx.Rollback())
+	}()
+	return c.createPeerDBInternalSchema(tx)
+}
+
+func TestCreateInternalSchemaWithoutPrivilege(t *testing.T) {
+	err := createInternalSchemaWithRestrictedRole(t, false)
+	require.ErrorContains(t, err, "role PEERDB_ROLE lacks the CREATE SCHEMA privilege on database PEERDB_DB")
+	require.ErrorContains(t, err, "GRANT CREATE SCHEMA ON DATABASE PEERDB_DB TO ROLE PEERDB_ROLE")
+	require.True(t, isInsufficientPrivilegesError(err))
+}
+
+func TestCreateInternalSchemaWithoutPrivilegeUsesExistingSchema(t *testing.T) {
+	require.NoError(t, createInternalSchemaWithRestrictedRole(t, true))
+}
+
+func TestExplainWarehouseError(t *testing.T) {
+	// the merge of a normalize fails on the suspended warehouse
+	c := newFakeWarehouseConnector(&fakeWarehouse{syncBatchID: 2, normalizeBatchID: 1, noActiveWarehouse: true})
+	defer c.database.Close()
+	c.warehouse = "PEERDB_WH"
+	_, err := c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test"})
+	require.ErrorContains(t, err, "warehouse PEERDB_WH is suspended or the user's default role cannot use it")
+	require.ErrorContains(t, err, "GRANT USAGE, OPERATE ON WAREHOUSE PEERDB_WH TO ROLE <default role>")
+	require.ErrorContains(t, err, "ALTER WAREHOUSE PEERDB_WH SET AUTO_RESUME = TRUE")
+	require.True(t, isSnowflakeError(err, noActiveWarehouseErrorNumber))
+
+	// without a configured warehouse the error says to configure one
+	c.warehouse = ""
+	c.role = "PEERDB_ROLE"
+	_, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{FlowJobName: "test"})
+	require.ErrorContains(t, err,
+		"no warehouse is configured for the peer and role PEERDB_ROLE has no default warehouse")
+	require.ErrorContains(t, err, "set the warehouse of the peer")
+
+	otherErr := errors.New("some other error")
+	require.Equal(t, otherErr, c.explainWarehouseError(otherErr))
+}
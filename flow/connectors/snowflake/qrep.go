@@ -25,6 +25,11 @@ func (c *SnowflakeConnector) SyncQRepRecords(
 ) (int, error) {
 	// Ensure the destination table is available.
 	destTable := config.DestinationTableIdentifier
+	if partition.SchemaOnly {
+		if err := c.createQRepDestinationTable(destTable, stream); err != nil {
+			return 0, err
+		}
+	}
 
 	tblSchema, err := c.getTableSchema(destTable)
 	if err != nil {
@@ -85,6 +90,30 @@ func (c *SnowflakeConnector) createMetadataInsertStatement(
 	return insertMetadataStmt, nil
 }
 
+// createQRepDestinationTable creates the destination table of a schema only partition if it is missing,
+// with a column for each field of the schema of the source query.
+func (c *SnowflakeConnector) createQRepDestinationTable(destTable string, stream *model.QRecordStream) error {
+	schema, err := stream.Schema()
+	if err != nil {
+		return fmt.Errorf("failed to get schema from stream: %w", err)
+	}
+
+	_, err = c.database.ExecContext(c.ctx, generateCreateTableSQLForQRecordSchema(destTable, schema))
+	if err != nil {
+		return fmt.Errorf("failed to create destination table %s: %w", destTable, err)
+	}
+	return nil
+}
+
+func generateCreateTableSQLForQRecordSchema(tableIdentifier string, schema *model.QRecordSchema) string {
+	columns := make([]string, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		columns = append(columns, fmt.Sprintf(`"%s" %s`, strings.ToUpper(field.Name),
+			qValueKindToSnowflakeType(field.Type)))
+	}
+	return fmt.Sprintf(createNormalizedTableSQL, "", tableIdentifier, strings.Join(columns, ","))
+}
+
 func (c *SnowflakeConnector) getTableSchema(tableName string) ([]*sql.ColumnType, error) {
 	//nolint:gosec
 	queryString := fmt.Sprintf(`
@@ -182,9 +211,9 @@ func (c *SnowflakeConnector) createStage(stageName string, config *protos.QRepCo
 	} else {
 		stageStatement := `
 			CREATE OR REPLACE STAGE %s
-			FILE_FORMAT = (TYPE = AVRO);
+			%s;
 			`
-		createStageStmt = fmt.Sprintf(stageStatement, stageName)
+		createStageStmt = fmt.Sprintf(stageStatement, stageName, stagingFileFormatSQL(config.StagingFileFormat))
 	}
 
 	// Execute the query
@@ -230,15 +259,17 @@ func (c *SnowflakeConnector) createExternalStage(stageName string, config *proto
 		CREATE OR REPLACE STAGE %s
 		URL = '%s'
 		%s
-		FILE_FORMAT = (TYPE = AVRO);`
-		return fmt.Sprintf(stageStatement, stageName, cleanURL, credsStr), nil
+		%s;`
+		return fmt.Sprintf(stageStatement, stageName, cleanURL, credsStr,
+			stagingFileFormatSQL(config.StagingFileFormat)), nil
 	} else {
 		stageStatement := `
 		CREATE OR REPLACE STAGE %s
 		URL = '%s'
 		STORAGE_INTEGRATION = %s
-		FILE_FORMAT = (TYPE = AVRO);`
-		return fmt.Sprintf(stageStatement, stageName, cleanURL, s3Int), nil
+		%s;`
+		return fmt.Sprintf(stageStatement, stageName, cleanURL, s3Int,
+			stagingFileFormatSQL(config.StagingFileFormat)), nil
 	}
 }
 
@@ -262,7 +293,9 @@ func (c *SnowflakeConnector) ConsolidateQRepPartitions(config *protos.QRepConfig
 		}
 
 		allCols := colInfo.Columns
-		err = CopyStageToDestination(c, config, destTable, stageName, allCols)
+		// the stage is dropped with its files once consolidated, keeping them until then lets
+		// consolidation be run again if it fails.
+		err = CopyStageToDestination(c, config, destTable, stageName, allCols, true)
 		if err != nil {
 			log.WithFields(log.Fields{
 				"flowName": config.FlowJobName,
@@ -291,18 +324,13 @@ func (c *SnowflakeConnector) getColsFromTable(tableName string) (*model.ColumnIn
 		return nil, fmt.Errorf("failed to parse table name: %w", err)
 	}
 
-	// convert tableIdentifier and schemaIdentifier to upper case
-	components.tableIdentifier = strings.ToUpper(components.tableIdentifier)
-	components.schemaIdentifier = strings.ToUpper(components.schemaIdentifier)
-
-	//nolint:gosec
-	queryString := fmt.Sprintf(`
+	queryString := `
 	SELECT column_name, data_type
 	FROM information_schema.columns
-	WHERE UPPER(table_name) = '%s' AND UPPER(table_schema) = '%s'
-	`, components.tableIdentifier, components.schemaIdentifier)
+	WHERE table_name = ? AND table_schema = ?
+	`
 
-	rows, err := c.database.Query(queryString)
+	rows, err := c.database.Query(queryString, components.tableIdentifier, components.schemaIdentifier)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
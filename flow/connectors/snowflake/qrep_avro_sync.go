@@ -93,7 +93,8 @@ func (s *SnowflakeAvroSyncMethod) SyncRecords(
 		"destinationTable": dstTableName,
 	}).Infof("pushed avro file to stage")
 
-	err = CopyStageToDestination(s.connector, s.config, s.config.DestinationTableIdentifier, stage, allCols)
+	err = CopyStageToDestination(s.connector, s.config, s.config.DestinationTableIdentifier, stage, allCols,
+		false)
 	if err != nil {
 		return 0, err
 	}
@@ -122,6 +123,15 @@ func (s *SnowflakeAvroSyncMethod) SyncQRepRecords(
 		"partitionID": partition.PartitionId,
 	}).Infof("sync function called and schema acquired")
 
+	dstColumns := make([]string, 0, len(dstTableSchema))
+	for _, column := range dstTableSchema {
+		dstColumns = append(dstColumns, column.Name())
+	}
+	err = validateStagingSchema(config.StagingFileFormat, schema, dstColumns)
+	if err != nil {
+		return 0, fmt.Errorf("failed to stage records for table %s: %w", dstTableName, err)
+	}
+
 	avroSchema, err := s.getAvroSchema(dstTableName, schema, config.FlowJobName)
 	if err != nil {
 		return 0, err
@@ -192,6 +202,10 @@ func (s *SnowflakeAvroSyncMethod) writeToAvroFile(
 	partitionID string,
 	flowJobName string,
 ) (int, string, error) {
+	if s.config.StagingFileFormat != protos.StagingFileFormat_STAGING_FILE_FORMAT_AVRO {
+		return s.writeToStagingFile(stream, partitionID, flowJobName)
+	}
+
 	var numRecords int
 	if s.config.StagingPath == "" {
 		ocfWriter := avro.NewPeerDBOCFWriterWithCompression(s.connector.ctx, stream, avroSchema)
@@ -234,6 +248,60 @@ func (s *SnowflakeAvroSyncMethod) writeToAvroFile(
 	return 0, "", fmt.Errorf("unsupported staging path: %s", s.config.StagingPath)
 }
 
+// writeToStagingFile writes the records to a file in the configured staging format, either locally to be
+// put to the stage or straight to the S3 staging path.
+func (s *SnowflakeAvroSyncMethod) writeToStagingFile(
+	stream *model.QRecordStream,
+	partitionID string,
+	flowJobName string,
+) (int, string, error) {
+	format := s.config.StagingFileFormat
+	writer := newStagingFileWriter(s.connector.ctx, stream, format)
+	fileName := fmt.Sprintf("%s.%s", partitionID, stagingFileExtension(format))
+	if s.config.StagingPath == "" {
+		tmpDir, err := os.MkdirTemp("", "peerdb-staging")
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to create temp dir: %w", err)
+		}
+
+		localFilePath := fmt.Sprintf("%s/%s", tmpDir, fileName)
+		log.WithFields(log.Fields{
+			"flowName":    flowJobName,
+			"partitionID": partitionID,
+		}).Infof("writing records to local file %s", localFilePath)
+		file, err := os.Create(localFilePath)
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to create file: %w", err)
+		}
+		defer file.Close()
+		numRecords, err := writer.write(file)
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to write records to %s file: %w", format, err)
+		}
+
+		return numRecords, localFilePath, nil
+	} else if strings.HasPrefix(s.config.StagingPath, "s3://") {
+		s3o, err := utils.NewS3BucketAndPrefix(s.config.StagingPath)
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to parse staging path: %w", err)
+		}
+
+		s3FileKey := fmt.Sprintf("%s/%s/%s", s3o.Prefix, s.config.FlowJobName, fileName)
+		log.WithFields(log.Fields{
+			"flowName":    flowJobName,
+			"partitionID": partitionID,
+		}).Infof("writing %s records to S3", format)
+		numRecords, err := writer.writeToS3(s3o.Bucket, s3FileKey)
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to write records to S3: %w", err)
+		}
+
+		return numRecords, "", nil
+	}
+
+	return 0, "", fmt.Errorf("unsupported staging path: %s", s.config.StagingPath)
+}
+
 func (s *SnowflakeAvroSyncMethod) putFileToStage(localFilePath string, stage string) error {
 	if localFilePath == "" {
 		log.Infof("no file to put to stage")
@@ -259,57 +327,75 @@ func (s *SnowflakeAvroSyncMethod) putFileToStage(localFilePath string, stage str
 	return nil
 }
 
-func (sc *SnowflakeConnector) GetCopyTransformation(dstTableName string) (*CopyInfo, error) {
+func (sc *SnowflakeConnector) GetCopyTransformation(dstTableName string,
+	format protos.StagingFileFormat) (*CopyInfo, error) {
 	colInfo, colsErr := sc.getColsFromTable(dstTableName)
 	if colsErr != nil {
 		return nil, fmt.Errorf("failed to get columns from  destination table: %w", colsErr)
 	}
+	return generateCopyTransformation(colInfo.ColumnMap, format), nil
+}
 
-	var transformations []string
-	var columnOrder []string
-	for colName, colType := range colInfo.ColumnMap {
+// generateCopyTransformation returns the columns a COPY loads from the staged files and how it reads
+// each of them.
+func generateCopyTransformation(columnMap map[string]string, format protos.StagingFileFormat) *CopyInfo {
+	loadedColumns := make([]string, 0, len(columnMap))
+	for colName := range columnMap {
 		if colName == "_PEERDB_IS_DELETED" {
 			continue
 		}
+		loadedColumns = append(loadedColumns, colName)
+	}
+	loadedColumns = csvColumnOrder(loadedColumns)
+
+	var transformations []string
+	var columnOrder []string
+	for i, colName := range loadedColumns {
+		colType := columnMap[colName]
+		ref := stagedColumnRef(format, colName, i+1)
 		columnOrder = append(columnOrder, fmt.Sprintf("\"%s\"", colName))
 		switch colType {
 		case "GEOGRAPHY":
 			transformations = append(transformations,
-				fmt.Sprintf("TO_GEOGRAPHY($1:\"%s\"::string, true) AS \"%s\"", strings.ToLower(colName), colName))
+				fmt.Sprintf("TO_GEOGRAPHY(%s::string, true) AS \"%s\"", ref, colName))
 		case "GEOMETRY":
 			transformations = append(transformations,
-				fmt.Sprintf("TO_GEOMETRY($1:\"%s\"::string, true) AS \"%s\"", strings.ToLower(colName), colName))
+				fmt.Sprintf("TO_GEOMETRY(%s::string, true) AS \"%s\"", ref, colName))
 		case "NUMBER":
 			transformations = append(transformations,
-				fmt.Sprintf("$1:\"%s\" AS \"%s\"", strings.ToLower(colName), colName))
+				fmt.Sprintf("%s AS \"%s\"", ref, colName))
+		case "VARIANT":
+			// raw tables can store _PEERDB_DATA as a VARIANT, which is staged as a JSON string.
+			if colName == "_PEERDB_DATA" {
+				transformations = append(transformations,
+					fmt.Sprintf("PARSE_JSON(%s::string) AS \"%s\"", ref, colName))
+			} else {
+				transformations = append(transformations,
+					fmt.Sprintf("(%s)::%s AS \"%s\"", ref, colType, colName))
+			}
 		default:
 			transformations = append(transformations,
-				fmt.Sprintf("($1:\"%s\")::%s AS \"%s\"", strings.ToLower(colName), colType, colName))
+				fmt.Sprintf("(%s)::%s AS \"%s\"", ref, colType, colName))
 		}
 	}
 	transformationSQL := strings.Join(transformations, ",")
 	columnsSQL := strings.Join(columnOrder, ",")
-	return &CopyInfo{transformationSQL, columnsSQL}, nil
+	return &CopyInfo{transformationSQL, columnsSQL}
 }
 
+// CopyStageToDestination copies the files in the stage to the destination table. Files copied for upserts
+// are purged unless keepUpsertedFiles is set, in which case the caller removes them once merged.
 func CopyStageToDestination(
 	connector *SnowflakeConnector,
 	config *protos.QRepConfig,
 	dstTableName string,
 	stage string,
 	allCols []string,
+	keepUpsertedFiles bool,
 ) error {
 	log.WithFields(log.Fields{
 		"flowName": config.FlowJobName,
 	}).Infof("Copying stage to destination %s", dstTableName)
-	copyOpts := []string{
-		"FILE_FORMAT = (TYPE = AVRO)",
-		"PURGE = TRUE",
-		"ON_ERROR = 'CONTINUE'",
-	}
-
-	writeHandler := NewSnowflakeAvroWriteHandler(connector, dstTableName, stage, copyOpts)
-
 	appendMode := true
 	if config.WriteMode != nil {
 		writeType := config.WriteMode.WriteType
@@ -318,7 +404,20 @@ func CopyStageToDestination(
 		}
 	}
 
-	copyTransformation, err := connector.GetCopyTransformation(dstTableName)
+	copyOpts := []string{
+		stagingFileFormatSQL(config.StagingFileFormat),
+		"ON_ERROR = 'CONTINUE'",
+	}
+	// the load history of the destination table keeps a repeated copy from loading files twice,
+	// so appended files can be purged right away. Upserts copy into a new temp table every time,
+	// so a failed merge can only be run again if the files are kept.
+	if appendMode || !keepUpsertedFiles {
+		copyOpts = append(copyOpts, "PURGE = TRUE")
+	}
+
+	writeHandler := NewSnowflakeAvroWriteHandler(connector, dstTableName, stage, copyOpts)
+
+	copyTransformation, err := connector.GetCopyTransformation(dstTableName, config.StagingFileFormat)
 	if err != nil {
 		return fmt.Errorf("failed to get copy transformation: %w", err)
 	}
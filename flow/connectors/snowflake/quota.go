@@ -0,0 +1,20 @@
+package connsnowflake
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// IsQuotaError returns true if err is Snowflake refusing to run statements because a resource monitor of
+// the warehouse used up its credit quota, which holds until the quota is raised or resets.
+func IsQuotaError(err error) bool {
+	var snowflakeErr *gosnowflake.SnowflakeError
+	if !errors.As(err, &snowflakeErr) {
+		return false
+	}
+	// the warehouse is suspended by the resource monitor and cannot be resumed, the error only tells apart
+	// from other suspended warehouses by its message.
+	return strings.Contains(strings.ToLower(snowflakeErr.Message), "exceeded its quota")
+}
@@ -24,7 +24,7 @@ var qValueKindToSnowflakeTypeMap = map[qvalue.QValueKind]string{
 	qvalue.QValueKindBytes:       "BINARY",
 	qvalue.QValueKindStruct:      "STRING",
 	qvalue.QValueKindUUID:        "STRING",
-	qvalue.QValueKindTimeTZ:      "STRING",
+	qvalue.QValueKindTimeTZ:      "TIME",
 	qvalue.QValueKindInvalid:     "STRING",
 	qvalue.QValueKindHStore:      "STRING",
 	qvalue.QValueKindGeography:   "GEOGRAPHY",
@@ -37,6 +37,8 @@ var qValueKindToSnowflakeTypeMap = map[qvalue.QValueKind]string{
 	qvalue.QValueKindArrayInt32:   "VARIANT",
 	qvalue.QValueKindArrayInt64:   "VARIANT",
 	qvalue.QValueKindArrayString:  "VARIANT",
+	// uuid arrays are typed as an ARRAY of their string form
+	qvalue.QValueKindArrayUUID: "ARRAY",
 }
 
 var snowflakeTypeToQValueKindMap = map[string]qvalue.QValueKind{
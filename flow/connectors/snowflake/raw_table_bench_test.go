@@ -0,0 +1,135 @@
+package connsnowflake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+)
+
+const benchMergeRecords = 10000
+
+// BenchmarkMergeRawData compares merging raw records stored as JSON strings, which every merge has to
+// parse, with raw records stored as VARIANT. It needs a Snowflake peer, read from the JSON config at
+// TEST_SF_CREDS like the e2e tests.
+func BenchmarkMergeRawData(b *testing.B) {
+	jsonPath := os.Getenv("TEST_SF_CREDS")
+	if jsonPath == "" {
+		b.Skip("TEST_SF_CREDS env var not set")
+	}
+	content, err := os.ReadFile(jsonPath)
+	if err != nil {
+		b.Fatalf("failed to read Snowflake config: %v", err)
+	}
+	var config protos.SnowflakeConfig
+	err = json.Unmarshal(content, &config)
+	if err != nil {
+		b.Fatalf("failed to unmarshal Snowflake config: %v", err)
+	}
+
+	c, err := NewSnowflakeConnector(context.Background(), &config)
+	if err != nil {
+		b.Fatalf("failed to create Snowflake connector: %v", err)
+	}
+	defer c.Close()
+	err = c.SetupMetadataTables()
+	if err != nil {
+		b.Fatalf("failed to set up metadata tables: %v", err)
+	}
+
+	b.Run("string", func(b *testing.B) {
+		benchmarkMergeRawData(b, c, false)
+	})
+	b.Run("variant", func(b *testing.B) {
+		benchmarkMergeRawData(b, c, true)
+	})
+}
+
+func benchmarkMergeRawData(b *testing.B, c *SnowflakeConnector, rawDataAsVariant bool) {
+	flowJobName := fmt.Sprintf("bench_merge_raw_data_%t", rawDataAsVariant)
+	destinationTableName := strings.ToUpper("PUBLIC." + flowJobName)
+	tableSchema := &protos.TableSchema{
+		TableIdentifier: destinationTableName,
+		Columns: map[string]string{
+			"id":      string(qvalue.QValueKindInt64),
+			"payload": string(qvalue.QValueKindString),
+			"amount":  string(qvalue.QValueKindFloat64),
+		},
+		PrimaryKeyColumns: []string{"id"},
+	}
+	tableNameSchemaMapping := map[string]*protos.TableSchema{destinationTableName: tableSchema}
+	defer func() {
+		_, err := c.database.ExecContext(c.ctx, fmt.Sprintf("DROP TABLE IF EXISTS %s", destinationTableName))
+		if err != nil {
+			b.Errorf("failed to drop normalized table: %v", err)
+		}
+		err = c.SyncFlowCleanup(flowJobName)
+		if err != nil {
+			b.Errorf("failed to clean up raw table: %v", err)
+		}
+	}()
+
+	_, err := c.CreateRawTable(&protos.CreateRawTableInput{
+		FlowJobName:      flowJobName,
+		RawDataAsVariant: rawDataAsVariant,
+	})
+	if err != nil {
+		b.Fatalf("failed to create raw table: %v", err)
+	}
+	_, err = c.SetupNormalizedTables(&protos.SetupNormalizedTableBatchInput{
+		FlowJobName:            flowJobName,
+		TableNameSchemaMapping: tableNameSchemaMapping,
+	})
+	if err != nil {
+		b.Fatalf("failed to create normalized table: %v", err)
+	}
+	err = c.InitializeTableSchema(tableNameSchemaMapping)
+	if err != nil {
+		b.Fatalf("failed to initialize table schema: %v", err)
+	}
+
+	records := make([]model.Record, 0, benchMergeRecords)
+	for i := 0; i < benchMergeRecords; i++ {
+		records = append(records, &model.InsertRecord{
+			DestinationTableName: destinationTableName,
+			CheckPointID:         int64(i + 1),
+			Items: model.NewRecordItemWithData([]string{"id", "payload", "amount"}, []*qvalue.QValue{
+				{Kind: qvalue.QValueKindInt64, Value: int64(i)},
+				{Kind: qvalue.QValueKindString, Value: strings.Repeat("x", 256)},
+				{Kind: qvalue.QValueKindFloat64, Value: float64(i) / 3},
+			}),
+		})
+	}
+	_, err = c.SyncRecords(&model.SyncRecordsRequest{
+		Records:          &model.RecordBatch{Records: records, LastCheckPointID: benchMergeRecords},
+		FlowJobName:      flowJobName,
+		RawDataAsVariant: rawDataAsVariant,
+	})
+	if err != nil {
+		b.Fatalf("failed to sync records: %v", err)
+	}
+	normalizeReq := &model.NormalizeRecordsRequest{
+		FlowJobName:      flowJobName,
+		RawDataAsVariant: rawDataAsVariant,
+	}
+	_, err = c.NormalizeRecords(normalizeReq)
+	if err != nil {
+		b.Fatalf("failed to normalize records: %v", err)
+	}
+
+	// every iteration merges the same batch again, which is all parsing and no new rows
+	normalizeReq.Force = true
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err = c.NormalizeRecords(normalizeReq)
+		if err != nil {
+			b.Fatalf("failed to merge records again: %v", err)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package connsnowflake
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateMultiValueInsertSQL_String(t *testing.T) {
+	insertSQL := generateMultiValueInsertSQL("_PEERDB_RAW_TEST", 2, false)
+	require.Equal(t, "INSERT INTO _PEERDB_INTERNAL._PEERDB_RAW_TEST VALUES"+
+		"(?,?,?,?,?,?,?,?),(?,?,?,?,?,?,?,?)", insertSQL)
+}
+
+func TestGenerateMultiValueInsertSQL_Variant(t *testing.T) {
+	insertSQL := generateMultiValueInsertSQL("_PEERDB_RAW_TEST", 2, true)
+	require.Equal(t, "INSERT INTO _PEERDB_INTERNAL._PEERDB_RAW_TEST SELECT "+
+		"column1,column2,column3,PARSE_JSON(column4),column5,column6,column7,column8 FROM VALUES"+
+		"(?,?,?,?,?,?,?,?),(?,?,?,?,?,?,?,?)", insertSQL)
+}
+
+func TestCreateRawTableSQL_DataColumnType(t *testing.T) {
+	createSQL := fmt.Sprintf(createRawTableSQL, peerDBInternalSchema, "_PEERDB_RAW_TEST", rawDataColumnType(true))
+	require.Contains(t, createSQL, "_PEERDB_DATA VARIANT NOT NULL")
+	createSQL = fmt.Sprintf(createRawTableSQL, peerDBInternalSchema, "_PEERDB_RAW_TEST", rawDataColumnType(false))
+	require.Contains(t, createSQL, "_PEERDB_DATA STRING NOT NULL")
+}
+
+func TestRawDataToVariantSQL(t *testing.T) {
+	// VARIANT raw data is read as is, string raw data has to be parsed on every merge.
+	require.Equal(t, "_PEERDB_DATA", rawDataToVariantSQL(true))
+	require.Equal(t, "TO_VARIANT(PARSE_JSON(_PEERDB_DATA))", rawDataToVariantSQL(false))
+}
+
+func TestIsOverInlineRecordSize(t *testing.T) {
+	recordOfSize := func(size int) snowflakeRawRecord {
+		return snowflakeRawRecord{data: strings.Repeat("x", size)}
+	}
+	// records up to the threshold are inserted inline, larger ones are staged
+	require.False(t, isOverInlineRecordSize(recordOfSize(99), 100))
+	require.False(t, isOverInlineRecordSize(recordOfSize(100), 100))
+	require.True(t, isOverInlineRecordSize(recordOfSize(101), 100))
+	// the old values of updates and deletes count towards the size
+	require.True(t, isOverInlineRecordSize(snowflakeRawRecord{data: "{}", matchData: strings.Repeat("x", 99)}, 100))
+	// no threshold inlines everything
+	require.False(t, isOverInlineRecordSize(recordOfSize(1<<20), 0))
+}
+
+func TestGetRawTableSchemaOfLowercaseJobName(t *testing.T) {
+	c := newFakeWarehouseConnector(&fakeWarehouse{})
+	defer c.database.Close()
+	res, err := c.GetRawTableSchema("test_flow")
+	require.NoError(t, err)
+	require.Equal(t, "_PEERDB_INTERNAL._PEERDB_RAW_TEST_FLOW", res.TableIdentifier)
+	require.Equal(t, []*protos.RawTableColumn{
+		{Name: "_PEERDB_UID", Type: "TEXT"},
+		{Name: "_PEERDB_DATA", Type: "VARIANT"},
+	}, res.Columns)
+}
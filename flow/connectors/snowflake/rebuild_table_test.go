@@ -0,0 +1,88 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebuildNormalizedTableInChunks(t *testing.T) {
+	w := &fakeWarehouse{
+		syncBatchID:      5,
+		normalizeBatchID: 5,
+	}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+
+	res, err := c.RebuildNormalizedTable(&model.RebuildNormalizedTableRequest{
+		Normalize:                  &model.NormalizeRecordsRequest{FlowJobName: "test"},
+		DestinationTableIdentifier: "PUBLIC.T",
+		BatchesPerMerge:            2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), res.LastBatchId)
+	require.Equal(t, []string{"PUBLIC.T"}, w.truncatedTables)
+	require.Equal(t, []string{
+		"_PEERDB_BATCH_ID > 0 AND _PEERDB_BATCH_ID <= 2",
+		"_PEERDB_BATCH_ID > 2 AND _PEERDB_BATCH_ID <= 4",
+		"_PEERDB_BATCH_ID > 4 AND _PEERDB_BATCH_ID <= 5",
+	}, w.mergedBatchRanges)
+}
+
+func TestRebuildNormalizedTableRefusesTablesTheRawTableCantRestore(t *testing.T) {
+	// the initial copy loaded the snapshot rows straight into the table, they are not in the raw table
+	for name, req := range map[string]*model.RebuildNormalizedTableRequest{
+		"initial copy": {
+			Normalize:                  &model.NormalizeRecordsRequest{FlowJobName: "test"},
+			DestinationTableIdentifier: "PUBLIC.T",
+			InitialCopy:                true,
+		},
+		"dynamic tables": {
+			Normalize:                  &model.NormalizeRecordsRequest{FlowJobName: "test", DynamicTables: true},
+			DestinationTableIdentifier: "PUBLIC.T",
+		},
+		"only kept in the raw table": {
+			Normalize: &model.NormalizeRecordsRequest{
+				FlowJobName:   "test",
+				RawOnlyTables: map[string]bool{"PUBLIC.T": true},
+			},
+			DestinationTableIdentifier: "PUBLIC.T",
+		},
+		"not a table of mirror": {
+			Normalize:                  &model.NormalizeRecordsRequest{FlowJobName: "test"},
+			DestinationTableIdentifier: "PUBLIC.OTHER",
+		},
+	} {
+		w := &fakeWarehouse{
+			syncBatchID:      2,
+			normalizeBatchID: 2,
+		}
+		c := newFakeWarehouseConnector(w)
+		_, err := c.RebuildNormalizedTable(req)
+		require.ErrorContains(t, err, name)
+		require.Empty(t, w.truncatedTables)
+		require.Zero(t, w.committedMerges)
+		c.database.Close()
+	}
+}
+
+func TestRebuildNormalizedTableFailsIfMirrorNormalizedMeanwhile(t *testing.T) {
+	w := &fakeWarehouse{
+		syncBatchID:      3,
+		normalizeBatchID: 2,
+		// a normalize of batch 3 commits after the first chunk of the rebuild
+		afterCommit: func(w *fakeWarehouse) {
+			w.normalizeBatchID = 3
+		},
+	}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+
+	_, err := c.RebuildNormalizedTable(&model.RebuildNormalizedTableRequest{
+		Normalize:                  &model.NormalizeRecordsRequest{FlowJobName: "test"},
+		DestinationTableIdentifier: "PUBLIC.T",
+		BatchesPerMerge:            1,
+	})
+	require.ErrorContains(t, err, "normalized up to batch 3 while table PUBLIC.T was rebuilt up to batch 2")
+}
@@ -0,0 +1,63 @@
+package connsnowflake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// getUnknownRecordTypeCountsSQL counts the raw records of each table and record type in a batch range
+// that are not inserts, updates or deletes, which the merge leaves out.
+const getUnknownRecordTypeCountsSQL = `SELECT _PEERDB_DESTINATION_TABLE_NAME, _PEERDB_RECORD_TYPE, COUNT(*)
+	FROM %s.%s WHERE _PEERDB_BATCH_ID > %d AND _PEERDB_BATCH_ID <= %d AND _PEERDB_RECORD_TYPE NOT IN (0,1,2)
+	GROUP BY _PEERDB_DESTINATION_TABLE_NAME, _PEERDB_RECORD_TYPE`
+
+// checkUnknownRecordTypes looks for raw records of an unknown record type in the batches to be merged,
+// failing the normalize if there are any unless the policy of the request skips them.
+// Raw only tables are not merged and are left out.
+func (c *SnowflakeConnector) checkUnknownRecordTypes(req *model.NormalizeRecordsRequest, syncBatchID int64,
+	normalizeBatchID int64) error {
+	rows, err := c.database.QueryContext(c.ctx, fmt.Sprintf(getUnknownRecordTypeCountsSQL, peerDBInternalSchema,
+		getRawTableIdentifier(req.FlowJobName), normalizeBatchID, syncBatchID))
+	if err != nil {
+		return fmt.Errorf("failed to check record types of batches %d to %d: %w",
+			normalizeBatchID+1, syncBatchID, err)
+	}
+	defer rows.Close()
+
+	unknownRecordTypes := make([]string, 0)
+	for rows.Next() {
+		var tableName string
+		var recordType, count int64
+		if err := rows.Scan(&tableName, &recordType, &count); err != nil {
+			return fmt.Errorf("failed to read row: %w", err)
+		}
+		if req.RawOnlyTables[tableName] {
+			continue
+		}
+		unknownRecordTypes = append(unknownRecordTypes,
+			fmt.Sprintf("%d records of type %d for table %s", count, recordType, tableName))
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to check record types of batches %d to %d: %w",
+			normalizeBatchID+1, syncBatchID, err)
+	}
+	if len(unknownRecordTypes) == 0 {
+		return nil
+	}
+
+	if req.RecordTypePolicy == protos.UnknownRecordTypePolicy_UNKNOWN_RECORD_TYPE_POLICY_SKIP {
+		log.WithFields(log.Fields{
+			"flowName": req.FlowJobName,
+		}).Warnf("skipping raw records of unknown record types in batches %d to %d: %s",
+			normalizeBatchID+1, syncBatchID, strings.Join(unknownRecordTypes, ", "))
+		return nil
+	}
+	return fmt.Errorf("raw table %s has records of unknown record types in batches %d to %d: %s. "+
+		"Remove them from the raw table or set the unknown record type policy to skip them",
+		getRawTableIdentifier(req.FlowJobName), normalizeBatchID+1, syncBatchID,
+		strings.Join(unknownRecordTypes, ", "))
+}
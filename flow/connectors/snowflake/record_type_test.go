@@ -0,0 +1,52 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeDetectsUnknownRecordTypes(t *testing.T) {
+	normalize := func(policy protos.UnknownRecordTypePolicy) (*fakeWarehouse, error) {
+		w := &fakeWarehouse{
+			syncBatchID: 1,
+			// one of the two rows of the batch has record type 9
+			rawRows:            map[int64]int{1: 2},
+			unknownRecordTypes: map[int64]map[int64]int{1: {9: 1}},
+		}
+		c := newFakeWarehouseConnector(w)
+		defer c.database.Close()
+		_, err := c.NormalizeRecords(&model.NormalizeRecordsRequest{
+			FlowJobName:      "test",
+			RecordTypePolicy: policy,
+		})
+		return w, err
+	}
+
+	// by default the normalize fails before merging anything
+	w, err := normalize(protos.UnknownRecordTypePolicy_UNKNOWN_RECORD_TYPE_POLICY_FAIL)
+	require.ErrorContains(t, err, "1 records of type 9 for table PUBLIC.T")
+	require.Equal(t, int64(0), w.normalizeBatchID)
+	require.Zero(t, w.committedMerges)
+
+	// skipping merges the batch, whose merge statement only reads the known record types, see
+	// TestGenerateMergeStatement_OnlyMergesKnownRecordTypes
+	w, err = normalize(protos.UnknownRecordTypePolicy_UNKNOWN_RECORD_TYPE_POLICY_SKIP)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), w.normalizeBatchID)
+	require.Equal(t, 1, w.committedMerges)
+}
+
+func TestGenerateMergeStatement_OnlyMergesKnownRecordTypes(t *testing.T) {
+	c := newFakeWarehouseConnector(&fakeWarehouse{})
+	defer c.database.Close()
+	mergeStatement, err := c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	require.Contains(t, removeSpacesTabsNewlines(mergeStatement),
+		"_PEERDB_DESTINATION_TABLE_NAME=?AND_PEERDB_RECORD_TYPEIN(0,1,2))")
+}
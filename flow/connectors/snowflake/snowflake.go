@@ -2,11 +2,13 @@ package connsnowflake
 
 import (
 	"context"
+	"crypto/rsa"
 	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,7 +20,6 @@ import (
 	util "github.com/PeerDB-io/peer-flow/utils"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
-	"github.com/snowflakedb/gosnowflake"
 	"golang.org/x/exp/maps"
 )
 
@@ -28,32 +29,38 @@ const (
 	peerDBInternalSchema      = "_PEERDB_INTERNAL"
 	mirrorJobsTableIdentifier = "PEERDB_MIRROR_JOBS"
 	createMirrorJobsTableSQL  = `CREATE TABLE IF NOT EXISTS %s.%s(MIRROR_JOB_NAME STRING NOT NULL,OFFSET INT NOT NULL,
-		SYNC_BATCH_ID INT NOT NULL,NORMALIZE_BATCH_ID INT NOT NULL)`
+		SYNC_BATCH_ID INT NOT NULL,NORMALIZE_BATCH_ID INT)`
 	rawTablePrefix                = "_PEERDB_RAW"
 	createPeerDBInternalSchemaSQL = "CREATE TRANSIENT SCHEMA IF NOT EXISTS %s"
 	createRawTableSQL             = `CREATE TABLE IF NOT EXISTS %s.%s(_PEERDB_UID STRING NOT NULL,
-		_PEERDB_TIMESTAMP INT NOT NULL,_PEERDB_DESTINATION_TABLE_NAME STRING NOT NULL,_PEERDB_DATA STRING NOT NULL,
+		_PEERDB_TIMESTAMP INT NOT NULL,_PEERDB_DESTINATION_TABLE_NAME STRING NOT NULL,_PEERDB_DATA %s NOT NULL,
 		_PEERDB_RECORD_TYPE INTEGER NOT NULL, _PEERDB_MATCH_DATA STRING,_PEERDB_BATCH_ID INT,
 		_PEERDB_UNCHANGED_TOAST_COLUMNS STRING)`
+	// kept out of createRawTableSQL, the raw table width is inferred from its column list.
+	clusterRawTableSQL          = "ALTER TABLE %s.%s CLUSTER BY(_PEERDB_DESTINATION_TABLE_NAME,_PEERDB_BATCH_ID)"
 	rawTableMultiValueInsertSQL = "INSERT INTO %s.%s VALUES%s"
-	createNormalizedTableSQL    = "CREATE TABLE IF NOT EXISTS %s(%s)"
-	toVariantColumnName         = "VAR_COLS"
-	mergeStatementSQL           = `MERGE INTO %s TARGET USING (WITH VARIANT_CONVERTED AS (SELECT _PEERDB_UID,
+	// PARSE_JSON is not allowed in a VALUES clause, so VARIANT data is parsed in a SELECT over it.
+	rawTableMultiValueParseInsertSQL = "INSERT INTO %s.%s SELECT %s FROM VALUES%s"
+	// 1-based position of _PEERDB_DATA in the raw table
+	rawTableDataColumnPosition = 4
+	createNormalizedTableSQL   = "CREATE %sTABLE IF NOT EXISTS %s(%s)"
+	toVariantColumnName        = "VAR_COLS"
+	mergeStatementSQL          = `MERGE INTO %s TARGET USING (WITH VARIANT_CONVERTED AS (SELECT _PEERDB_UID,
 		_PEERDB_TIMESTAMP,
-		TO_VARIANT(PARSE_JSON(_PEERDB_DATA)) %s,_PEERDB_RECORD_TYPE,_PEERDB_MATCH_DATA,_PEERDB_BATCH_ID,
+		%s %s,_PEERDB_RECORD_TYPE,_PEERDB_MATCH_DATA,_PEERDB_BATCH_ID,
 		_PEERDB_UNCHANGED_TOAST_COLUMNS FROM
 		 _PEERDB_INTERNAL.%s WHERE _PEERDB_BATCH_ID > %d AND _PEERDB_BATCH_ID <= %d AND
-		 _PEERDB_DESTINATION_TABLE_NAME = ? ), FLATTENED AS
+		 _PEERDB_DESTINATION_TABLE_NAME = ? AND _PEERDB_RECORD_TYPE IN (0,1,2)%s), FLATTENED AS
 		 (SELECT _PEERDB_UID,_PEERDB_TIMESTAMP,_PEERDB_RECORD_TYPE,_PEERDB_MATCH_DATA,_PEERDB_BATCH_ID,
 			_PEERDB_UNCHANGED_TOAST_COLUMNS,%s
-		 FROM VARIANT_CONVERTED), DEDUPLICATED_FLATTENED AS (SELECT _PEERDB_RANKED.* FROM
+		 FROM VARIANT_CONVERTED)%s, DEDUPLICATED_FLATTENED AS (SELECT _PEERDB_RANKED.* FROM
 		 (SELECT RANK() OVER
-		 (PARTITION BY %s ORDER BY _PEERDB_TIMESTAMP DESC) AS _PEERDB_RANK, * FROM FLATTENED)
+		 (PARTITION BY %s ORDER BY %s) AS _PEERDB_RANK, * FROM %s%s)
 		 _PEERDB_RANKED WHERE _PEERDB_RANK = 1)
 		 SELECT * FROM DEDUPLICATED_FLATTENED) SOURCE ON %s
 		 WHEN NOT MATCHED AND (SOURCE._PEERDB_RECORD_TYPE != 2) THEN INSERT (%s) VALUES(%s)
 		 %s
-		 WHEN MATCHED AND (SOURCE._PEERDB_RECORD_TYPE = 2) THEN %s`
+		 WHEN MATCHED AND (SOURCE._PEERDB_RECORD_TYPE = 2)%s THEN %s`
 	getDistinctDestinationTableNames = `SELECT DISTINCT _PEERDB_DESTINATION_TABLE_NAME FROM %s.%s WHERE
 	 _PEERDB_BATCH_ID > %d AND _PEERDB_BATCH_ID <= %d`
 	getTableNametoUnchangedColsSQL = `SELECT _PEERDB_DESTINATION_TABLE_NAME,
@@ -61,26 +68,53 @@ const (
 	 _PEERDB_BATCH_ID > %d AND _PEERDB_BATCH_ID <= %d GROUP BY _PEERDB_DESTINATION_TABLE_NAME`
 	getTableSchemaSQL = `SELECT COLUMN_NAME, DATA_TYPE FROM INFORMATION_SCHEMA.COLUMNS
 	 WHERE TABLE_SCHEMA=? AND TABLE_NAME=?`
+	getRawTableSchemaSQL = `SELECT COLUMN_NAME, DATA_TYPE FROM INFORMATION_SCHEMA.COLUMNS
+	 WHERE TABLE_SCHEMA=? AND TABLE_NAME=? ORDER BY ORDINAL_POSITION`
+	listRawTablesSQL = `SELECT TABLE_NAME, LAST_ALTERED FROM INFORMATION_SCHEMA.TABLES
+	 WHERE TABLE_SCHEMA=? AND STARTSWITH(TABLE_NAME, ?)`
 
 	insertJobMetadataSQL = "INSERT INTO %s.%s VALUES (?,?,?,?)"
+	// NORMALIZE_BATCH_ID is NULL until the first normalize of a mirror, which mirror jobs tables created
+	// before have to allow.
+	dropNormalizeBatchIDNotNullSQL = "ALTER TABLE %s.%s ALTER COLUMN NORMALIZE_BATCH_ID DROP NOT NULL"
 
 	updateMetadataForSyncRecordsSQL      = "UPDATE %s.%s SET OFFSET=?, SYNC_BATCH_ID=? WHERE MIRROR_JOB_NAME=?"
+	updateSyncBatchIDSQL                 = "UPDATE %s.%s SET SYNC_BATCH_ID=? WHERE MIRROR_JOB_NAME=?"
 	updateMetadataForNormalizeRecordsSQL = "UPDATE %s.%s SET NORMALIZE_BATCH_ID=? WHERE MIRROR_JOB_NAME=?"
 
+	// guarded variants of the sync metadata updates, only taking effect if no other sync recorded a
+	// batch since the sync batch id was read. MERGE locks the table, unlike INSERT.
+	insertJobMetadataIfAbsentSQL = `MERGE INTO %s.%s METADATA USING (SELECT ? AS MIRROR_JOB_NAME) SOURCE
+	 ON METADATA.MIRROR_JOB_NAME = SOURCE.MIRROR_JOB_NAME WHEN NOT MATCHED THEN INSERT VALUES (?,?,?,?)`
+	guardedUpdateMetadataForSyncRecordsSQL = `UPDATE %s.%s SET OFFSET=?, SYNC_BATCH_ID=?
+	 WHERE MIRROR_JOB_NAME=? AND SYNC_BATCH_ID=?`
+	guardedUpdateSyncBatchIDSQL = `UPDATE %s.%s SET SYNC_BATCH_ID=?
+	 WHERE MIRROR_JOB_NAME=? AND SYNC_BATCH_ID=?`
+
 	checkIfTableExistsSQL = `SELECT TO_BOOLEAN(COUNT(1)) FROM INFORMATION_SCHEMA.TABLES
 	 WHERE TABLE_SCHEMA=? and TABLE_NAME=?`
+	checkIfTableExistsInDatabaseSQL = `SELECT TO_BOOLEAN(COUNT(1)) FROM INFORMATION_SCHEMA.TABLES
+	 WHERE TABLE_CATALOG=? and TABLE_SCHEMA=? and TABLE_NAME=?`
 	checkIfJobMetadataExistsSQL = "SELECT TO_BOOLEAN(COUNT(1)) FROM %s.%s WHERE MIRROR_JOB_NAME=?"
 	getLastOffsetSQL            = "SELECT OFFSET FROM %s.%s WHERE MIRROR_JOB_NAME=?"
 	getLastSyncBatchID_SQL      = "SELECT SYNC_BATCH_ID FROM %s.%s WHERE MIRROR_JOB_NAME=?"
 	getLastNormalizeBatchID_SQL = "SELECT NORMALIZE_BATCH_ID FROM %s.%s WHERE MIRROR_JOB_NAME=?"
 	dropTableIfExistsSQL        = "DROP TABLE IF EXISTS %s.%s"
+	truncateTableSQL            = "TRUNCATE TABLE %s"
 	deleteJobMetadataSQL        = "DELETE FROM %s.%s WHERE MIRROR_JOB_NAME=?"
 	isDeletedColumnName         = "_PEERDB_IS_DELETED"
 	checkSchemaExistsSQL        = "SELECT TO_BOOLEAN(COUNT(1)) FROM INFORMATION_SCHEMA.SCHEMATA WHERE SCHEMA_NAME=?"
 
+	countRawTableRowsInBatchesSQL = "SELECT COUNT(*) FROM %s.%s WHERE _PEERDB_BATCH_ID > ? AND _PEERDB_BATCH_ID <= ?"
+	deleteRawTableBatchSQL        = "DELETE FROM %s.%s WHERE _PEERDB_BATCH_ID = ?"
+
 	syncRecordsChunkSize = 1024
+	// number of batches merged per statement while rebuilding a normalized table
+	defaultRebuildBatchesPerMerge = 100
 )
 
+// tableNameComponents are the schema and table of a table name the way Snowflake stores them,
+// see normalizeSnowflakeIdentifier.
 type tableNameComponents struct {
 	schemaIdentifier string
 	tableIdentifier  string
@@ -89,7 +123,14 @@ type tableNameComponents struct {
 type SnowflakeConnector struct {
 	ctx                context.Context
 	database           *sql.DB
+	databaseName       string
+	role               string
+	privateKey         *rsa.PrivateKey
+	warehouse          string
+	accountID          string
 	tableSchemaMapping map[string]*protos.TableSchema
+	// mergesPerWarehouseNode caps concurrent merges on the warehouse by its size, 0 for the worker's cap.
+	mergesPerWarehouseNode uint32
 }
 
 type snowflakeRawRecord struct {
@@ -127,26 +168,15 @@ func NewSnowflakeConnector(ctx context.Context,
 	PrivateKeyRSA, err := util.DecodePKCS8PrivateKey([]byte(snowflakeProtoConfig.PrivateKey),
 		snowflakeProtoConfig.Password)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid private key for Snowflake user %s: %w", snowflakeProtoConfig.Username, err)
 	}
 
-	snowflakeConfig := gosnowflake.Config{
-		Account:          snowflakeProtoConfig.AccountId,
-		User:             snowflakeProtoConfig.Username,
-		Authenticator:    gosnowflake.AuthTypeJwt,
-		PrivateKey:       PrivateKeyRSA,
-		Database:         snowflakeProtoConfig.Database,
-		Warehouse:        snowflakeProtoConfig.Warehouse,
-		Role:             snowflakeProtoConfig.Role,
-		RequestTimeout:   time.Duration(snowflakeProtoConfig.QueryTimeout),
-		DisableTelemetry: true,
-	}
-	snowflakeConfigDSN, err := gosnowflake.DSN(&snowflakeConfig)
+	snowflakeConfigDSN, err := getSnowflakeDSN(snowflakeProtoConfig, PrivateKeyRSA)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get DSN from Snowflake config: %w", err)
+		return nil, err
 	}
 
-	database, err := sql.Open("snowflake", snowflakeConfigDSN)
+	database, err := openSnowflakeDatabase(snowflakeConfigDSN, snowflakeProtoConfig.MaxConcurrentStatements)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open connection to Snowflake peer: %w", err)
 	}
@@ -160,7 +190,14 @@ func NewSnowflakeConnector(ctx context.Context,
 	return &SnowflakeConnector{
 		ctx:                ctx,
 		database:           database,
+		databaseName:       snowflakeProtoConfig.Database,
+		role:               snowflakeProtoConfig.Role,
+		privateKey:         PrivateKeyRSA,
+		warehouse:          snowflakeProtoConfig.Warehouse,
+		accountID:          snowflakeProtoConfig.AccountId,
 		tableSchemaMapping: nil,
+
+		mergesPerWarehouseNode: snowflakeProtoConfig.MergesPerWarehouseNode,
 	}, nil
 }
 
@@ -184,14 +221,28 @@ func (c *SnowflakeConnector) ConnectionActive() bool {
 }
 
 func (c *SnowflakeConnector) NeedsSetupMetadataTables() bool {
-	result, err := c.checkIfTableExists(peerDBInternalSchema, mirrorJobsTableIdentifier)
+	result, err := c.MetadataTablesExist()
 	if err != nil {
 		return true
 	}
 	return !result
 }
 
+func (c *SnowflakeConnector) MetadataTablesExist() (bool, error) {
+	return c.checkIfTableExists(peerDBInternalSchema, mirrorJobsTableIdentifier)
+}
+
+func (c *SnowflakeConnector) RawTableExists(flowJobName string) (bool, error) {
+	// the raw table is created unquoted, so Snowflake keeps its name in uppercase
+	return c.checkIfTableExists(peerDBInternalSchema, strings.ToUpper(getRawTableIdentifier(flowJobName)))
+}
+
 func (c *SnowflakeConnector) SetupMetadataTables() error {
+	err := c.resumeWarehouse()
+	if err != nil {
+		return err
+	}
+
 	// NOTE that Snowflake does not support transactional DDL
 	createMetadataTablesTx, err := c.database.BeginTx(c.ctx, nil)
 	if err != nil {
@@ -206,6 +257,11 @@ func (c *SnowflakeConnector) SetupMetadataTables() error {
 	if err != nil {
 		return fmt.Errorf("error while setting up mirror jobs table: %w", err)
 	}
+	_, err = createMetadataTablesTx.ExecContext(c.ctx, fmt.Sprintf(dropNormalizeBatchIDNotNullSQL,
+		peerDBInternalSchema, mirrorJobsTableIdentifier))
+	if err != nil {
+		return fmt.Errorf("error while making the normalize batch id of mirror jobs table nullable: %w", err)
+	}
 	err = createMetadataTablesTx.Commit()
 	if err != nil {
 		return fmt.Errorf("unable to commit transaction for creating metadata tables: %w", err)
@@ -273,6 +329,89 @@ func (c *SnowflakeConnector) getTableSchemaForTable(tableName string) (*protos.T
 	return res, nil
 }
 
+// GetRawTableSchema returns the live columns of the raw table of a mirror, for debugging.
+func (c *SnowflakeConnector) GetRawTableSchema(flowJobName string) (*protos.GetRawTableSchemaOutput, error) {
+	// raw table identifiers are unquoted, so Snowflake stores them in uppercase.
+	rawTableIdentifier := strings.ToUpper(getRawTableIdentifier(flowJobName))
+	rows, err := c.database.QueryContext(c.ctx, getRawTableSchemaSQL, peerDBInternalSchema, rawTableIdentifier)
+	if err != nil {
+		return nil, fmt.Errorf("error querying schema of raw table %s: %w", rawTableIdentifier, err)
+	}
+	defer rows.Close()
+
+	res := &protos.GetRawTableSchemaOutput{
+		TableIdentifier: fmt.Sprintf("%s.%s", peerDBInternalSchema, rawTableIdentifier),
+	}
+	var columnName, columnType string
+	for rows.Next() {
+		err = rows.Scan(&columnName, &columnType)
+		if err != nil {
+			return nil, fmt.Errorf("error reading row for schema of raw table %s: %w", rawTableIdentifier, err)
+		}
+		res.Columns = append(res.Columns, &protos.RawTableColumn{
+			Name: columnName,
+			Type: columnType,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over schema of raw table %s: %w", rawTableIdentifier, err)
+	}
+	if len(res.Columns) == 0 {
+		return nil, fmt.Errorf("raw table %s does not exist", res.TableIdentifier)
+	}
+
+	return res, nil
+}
+
+// ListRawTables lists the raw tables in the internal schema, matching each to the mirror among
+// flowJobNames it belongs to by the raw table naming convention.
+func (c *SnowflakeConnector) ListRawTables(flowJobNames []string) ([]*model.RawTable, error) {
+	rawTableMirrors := make(map[string]string, len(flowJobNames))
+	for _, flowJobName := range flowJobNames {
+		// raw table identifiers are unquoted, so Snowflake stores them in uppercase.
+		rawTableMirrors[strings.ToUpper(getRawTableIdentifier(flowJobName))] = flowJobName
+	}
+
+	rows, err := c.database.QueryContext(c.ctx, listRawTablesSQL, peerDBInternalSchema, rawTablePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("error listing raw tables: %w", err)
+	}
+	defer rows.Close()
+
+	var rawTables []*model.RawTable
+	var tableName string
+	var lastAltered time.Time
+	for rows.Next() {
+		err = rows.Scan(&tableName, &lastAltered)
+		if err != nil {
+			return nil, fmt.Errorf("error reading raw table row: %w", err)
+		}
+		rawTables = append(rawTables, &model.RawTable{
+			TableIdentifier: tableName,
+			MirrorJobName:   rawTableMirrors[strings.ToUpper(tableName)],
+			LastAltered:     lastAltered,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over raw tables: %w", err)
+	}
+
+	return rawTables, nil
+}
+
+// DropRawTable drops a raw table from the internal schema.
+func (c *SnowflakeConnector) DropRawTable(rawTableIdentifier string) error {
+	if !strings.HasPrefix(strings.ToUpper(rawTableIdentifier), rawTablePrefix) {
+		return fmt.Errorf("%s is not a raw table", rawTableIdentifier)
+	}
+	_, err := c.database.ExecContext(c.ctx, fmt.Sprintf(dropTableIfExistsSQL, peerDBInternalSchema,
+		rawTableIdentifier))
+	if err != nil {
+		return fmt.Errorf("unable to drop raw table %s: %w", rawTableIdentifier, err)
+	}
+	return nil
+}
+
 func (c *SnowflakeConnector) GetLastOffset(jobName string) (*protos.LastSyncState, error) {
 	rows, err := c.database.QueryContext(c.ctx, fmt.Sprintf(getLastOffsetSQL,
 		peerDBInternalSchema, mirrorJobsTableIdentifier), jobName)
@@ -331,20 +470,49 @@ func (c *SnowflakeConnector) GetLastNormalizeBatchID(jobName string) (int64, err
 		return 0, fmt.Errorf("error querying Snowflake peer for last normalizeBatchId: %w", err)
 	}
 
-	var result int64
+	var result sql.NullInt64
 	if !rows.Next() {
-		log.Warnf("No row found for job %s, returning 0", jobName)
-		return 0, nil
+		log.Warnf("No row found for job %s, no batch was normalized yet", jobName)
+		return model.NoNormalizedBatchID, nil
 	}
 	err = rows.Scan(&result)
 	if err != nil {
 		return 0, fmt.Errorf("error while reading result row: %w", err)
 	}
-	return result, nil
+	if !result.Valid {
+		log.Infof("no batch of job %s was normalized yet", jobName)
+		return model.NoNormalizedBatchID, nil
+	}
+	return result.Int64, nil
+}
+
+// GetNormalizeBacklog counts the raw table rows synced since the last normalize.
+func (c *SnowflakeConnector) GetNormalizeBacklog(flowJobName string) (int64, error) {
+	syncBatchID, err := c.GetLastSyncBatchID(flowJobName)
+	if err != nil {
+		return 0, err
+	}
+	normalizeBatchID, err := c.GetLastNormalizeBatchID(flowJobName)
+	if err != nil {
+		return 0, err
+	}
+	if syncBatchID == normalizeBatchID {
+		return 0, nil
+	}
+
+	var backlog int64
+	err = c.database.QueryRowContext(c.ctx, fmt.Sprintf(countRawTableRowsInBatchesSQL, peerDBInternalSchema,
+		getRawTableIdentifier(flowJobName)), normalizeBatchID, syncBatchID).Scan(&backlog)
+	if err != nil {
+		return 0, fmt.Errorf("error counting rows pending normalization for job %s: %w", flowJobName, err)
+	}
+	return backlog, nil
 }
 
+// getDistinctTableNamesInBatch returns the destination tables with records in the batches,
+// except for raw-only tables, which are not normalized.
 func (c *SnowflakeConnector) getDistinctTableNamesInBatch(flowJobName string, syncBatchID int64,
-	normalizeBatchID int64) ([]string, error) {
+	normalizeBatchID int64, rawOnlyTables map[string]bool) ([]string, error) {
 	rawTableIdentifier := getRawTableIdentifier(flowJobName)
 
 	rows, err := c.database.QueryContext(c.ctx, fmt.Sprintf(getDistinctDestinationTableNames, peerDBInternalSchema,
@@ -360,6 +528,9 @@ func (c *SnowflakeConnector) getDistinctTableNamesInBatch(flowJobName string, sy
 		if err != nil {
 			return nil, fmt.Errorf("failed to read row: %w", err)
 		}
+		if rawOnlyTables[result] {
+			continue
+		}
 		destinationTableNames = append(destinationTableNames, result)
 	}
 	return destinationTableNames, nil
@@ -399,22 +570,44 @@ func (c *SnowflakeConnector) SetupNormalizedTables(
 		if err != nil {
 			return nil, fmt.Errorf("error while parsing table schema and name: %w", err)
 		}
+		if variantColumn := variantColumnName(tableSchema); variantColumn != toVariantColumnName {
+			log.WithFields(log.Fields{
+				"flowName": req.FlowJobName,
+			}).Infof("table %s has a column named %s, normalizing it with the raw data aliased as %s instead",
+				tableIdentifier, toVariantColumnName, variantColumn)
+		}
 		tableAlreadyExists, err := c.checkIfTableExists(normalizedTableNameComponents.schemaIdentifier,
 			normalizedTableNameComponents.tableIdentifier)
 		if err != nil {
 			return nil, fmt.Errorf("error occurred while checking if normalized table exists: %w", err)
 		}
 		if tableAlreadyExists {
-			tableExistsMapping[tableIdentifier] = true
-			continue
+			err = c.checkNotNullColumnsCovered(tableIdentifier, normalizedTableNameComponents,
+				tableSchema.Columns, req.ColumnDefaults[tableIdentifier].GetDefaults())
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			normalizedTableCreateSQL, err := generateNormalizedTableDDL(req, tableIdentifier, tableSchema,
+				c.databaseName, c.warehouse)
+			if err != nil {
+				return nil, err
+			}
+			_, err = c.database.ExecContext(c.ctx, normalizedTableCreateSQL)
+			if err != nil {
+				return nil, fmt.Errorf("[sf] error while creating normalized table: %w", err)
+			}
 		}
-
-		normalizedTableCreateSQL := generateCreateTableSQLForNormalizedTable(tableIdentifier, tableSchema)
-		_, err = c.database.ExecContext(c.ctx, normalizedTableCreateSQL)
+		// tables that already exist, such as tables of a mirror created again, are tagged as well.
+		tableKind := "TABLE"
+		if req.DynamicTables {
+			tableKind = "DYNAMIC TABLE"
+		}
+		err = c.setTags(c.database, tableKind, tableIdentifier, req.Tags)
 		if err != nil {
-			return nil, fmt.Errorf("[sf] error while creating normalized table: %w", err)
+			return nil, err
 		}
-		tableExistsMapping[tableIdentifier] = false
+		tableExistsMapping[tableIdentifier] = tableAlreadyExists
 	}
 
 	return &protos.SetupNormalizedTableBatchOutput{
@@ -422,6 +615,53 @@ func (c *SnowflakeConnector) SetupNormalizedTables(
 	}, nil
 }
 
+// GenerateDDL returns the statements SetupNormalizedTables would execute to create the given
+// normalized tables, keyed by table identifier, without running them. The statements are only
+// formatted, so no connection to the peer is opened.
+func GenerateDDL(config *protos.SnowflakeConfig,
+	req *protos.SetupNormalizedTableBatchInput) (*protos.GenerateDDLOutput, error) {
+	tableDDLMapping := make(map[string]string, len(req.TableNameSchemaMapping))
+	for tableIdentifier, tableSchema := range req.TableNameSchemaMapping {
+		_, err := parseTableName(tableIdentifier)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing table schema and name: %w", err)
+		}
+		tableDDLMapping[tableIdentifier], err = generateNormalizedTableDDL(req, tableIdentifier, tableSchema,
+			config.Database, config.Warehouse)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &protos.GenerateDDLOutput{
+		TableDdlMapping: tableDDLMapping,
+	}, nil
+}
+
+func generateNormalizedTableDDL(req *protos.SetupNormalizedTableBatchInput,
+	tableIdentifier string, tableSchema *protos.TableSchema, database string, warehouse string) (string, error) {
+	if req.SourceLsnColumn {
+		var err error
+		tableSchema, err = withSourceLSNColumn(tableSchema)
+		if err != nil {
+			return "", err
+		}
+	}
+	if req.LastOpColumn {
+		var err error
+		tableSchema, err = withLastOpColumn(tableSchema)
+		if err != nil {
+			return "", err
+		}
+	}
+	if req.DynamicTables {
+		return generateCreateDynamicTableSQL(tableIdentifier, tableSchema, getRawTableIdentifier(req.FlowJobName),
+			database, req.DynamicTableTargetLag, warehouse, req.SoftDelete, req.RawDataAsVariant,
+			req.DedupNullsOrdering, req.VariantNullPolicy)
+	}
+	return generateCreateTableSQLForNormalizedTable(tableIdentifier, tableSchema, req.TransientTables), nil
+}
+
 func (c *SnowflakeConnector) InitializeTableSchema(req map[string]*protos.TableSchema) error {
 	c.tableSchemaMapping = req
 	return nil
@@ -487,6 +727,15 @@ func (c *SnowflakeConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.
 
 	rawTableIdentifier := getRawTableIdentifier(req.FlowJobName)
 	log.Printf("pushing %d records to Snowflake table %s", len(req.Records.Records), rawTableIdentifier)
+	if req.SourceLSNColumn {
+		addSourceLSNColumn(req.Records.Records)
+	}
+
+	// rows loaded outside of the sync transaction land in the batch even if the guard fails the sync, and
+	// the losing sync would clear the rows of the batch the winning sync recorded.
+	if req.GuardSyncBatchID && (req.LoadsRawRowsOutsideTx() || req.IdempotentStagedInserts) {
+		return nil, fmt.Errorf("syncs loading raw rows outside of the sync transaction cannot guard the sync batch id")
+	}
 
 	syncBatchID, err := c.GetLastSyncBatchID(req.FlowJobName)
 	if err != nil {
@@ -494,6 +743,14 @@ func (c *SnowflakeConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.
 	}
 	syncBatchID = syncBatchID + 1
 
+	// rows loaded outside of the sync transaction by a failed attempt to sync the batch would be loaded twice
+	if req.LoadsRawRowsOutsideTx() || req.IdempotentStagedInserts {
+		err = c.clearUnsyncedRawBatch(req.FlowJobName, rawTableIdentifier, syncBatchID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// transaction for SyncRecords
 	syncRecordsTx, err := c.database.BeginTx(c.ctx, nil)
 	if err != nil {
@@ -514,17 +771,18 @@ func (c *SnowflakeConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.
 	if req.SyncMode == protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO {
 		res, err = c.syncRecordsViaAvro(req, rawTableIdentifier, syncBatchID)
 		if err != nil {
-			return nil, err
+			return nil, c.explainWarehouseError(err)
 		}
 	} else if req.SyncMode == protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT {
 		res, err = c.syncRecordsViaSQL(req, rawTableIdentifier, syncBatchID, syncRecordsTx)
 		if err != nil {
-			return nil, err
+			return nil, c.explainWarehouseError(err)
 		}
 	}
 
 	// updating metadata with new offset and syncBatchID
-	err = c.updateSyncMetadata(req.FlowJobName, res.LastSyncedCheckPointID, syncBatchID, syncRecordsTx)
+	err = c.updateSyncMetadata(req.FlowJobName, res.LastSyncedCheckPointID, syncBatchID, req.DeferCheckpoint,
+		req.GuardSyncBatchID, syncRecordsTx)
 	if err != nil {
 		return nil, err
 	}
@@ -537,27 +795,58 @@ func (c *SnowflakeConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.
 	return res, nil
 }
 
+// clearUnsyncedRawBatch deletes the raw table rows of a batch whose sync was never recorded. Staged files
+// are copied into the raw table outside of the transaction recording the batch as synced, so a sync that
+// failed after the copy leaves its rows behind, and syncing the batch again would load them a second time.
+// Normalize only reads batches recorded as synced, so it never saw these rows.
+func (c *SnowflakeConnector) clearUnsyncedRawBatch(flowJobName string, rawTableIdentifier string,
+	syncBatchID int64) error {
+	res, err := c.database.ExecContext(c.ctx, fmt.Sprintf(deleteRawTableBatchSQL, peerDBInternalSchema,
+		rawTableIdentifier), syncBatchID)
+	if err != nil {
+		return fmt.Errorf("failed to clear rows of unsynced batch %d: %w", syncBatchID, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get number of rows cleared from unsynced batch %d: %w", syncBatchID, err)
+	}
+	if rowsAffected > 0 {
+		log.WithFields(log.Fields{
+			"flowName":    flowJobName,
+			"syncBatchID": syncBatchID,
+		}).Warnf("cleared %d rows a failed sync left behind before syncing the batch again", rowsAffected)
+	}
+	return nil
+}
+
 func (c *SnowflakeConnector) syncRecordsViaSQL(req *model.SyncRecordsRequest, rawTableIdentifier string,
 	syncBatchID int64, syncRecordsTx *sql.Tx) (*model.SyncResponse, error) {
 
 	records := make([]snowflakeRawRecord, 0)
+	// records too large to be inserted inline, staged as a file instead
+	stagedRecords := make([]snowflakeRawRecord, 0)
 	tableNameRowsMapping := make(map[string]uint32)
 
-	first := true
-	var firstCP int64 = 0
-	lastCP := req.Records.LastCheckPointID
+	toJSONOpts := model.NewToJSONOptions(nil)
+	toJSONOpts.NullFloatSpecialValues = req.NullFloatSpecialValues
+	toJSONOpts.EmptyStringsAsNull = req.EmptyStringsAsNull
+	toJSONOpts.TableNameSchemaMapping = c.tableSchemaMapping
+
+	firstCP, lastCP := req.Records.CheckPointRange()
 
 	for _, record := range req.Records.Records {
+		var rawRecord snowflakeRawRecord
+		recordJSONOpts := toJSONOpts.ForRecord(record)
 		switch typedRecord := record.(type) {
 		case *model.InsertRecord:
 			// json.Marshal converts bytes in Hex automatically to BASE64 string.
-			itemsJSON, err := typedRecord.Items.ToJSON()
+			itemsJSON, err := typedRecord.Items.ToJSONWithOpts(recordJSONOpts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to serialize insert record items to JSON: %w", err)
 			}
 
 			// add insert record to the raw table
-			records = append(records, snowflakeRawRecord{
+			rawRecord = snowflakeRawRecord{
 				uid:                   uuid.New().String(),
 				timestamp:             time.Now().UnixNano(),
 				destinationTableName:  typedRecord.DestinationTableName,
@@ -566,20 +855,19 @@ func (c *SnowflakeConnector) syncRecordsViaSQL(req *model.SyncRecordsRequest, ra
 				matchData:             "",
 				batchID:               syncBatchID,
 				unchangedToastColumns: "",
-			})
-			tableNameRowsMapping[typedRecord.DestinationTableName] += 1
+			}
 		case *model.UpdateRecord:
-			newItemsJSON, err := typedRecord.NewItems.ToJSON()
+			newItemsJSON, err := typedRecord.NewItems.ToJSONWithOpts(recordJSONOpts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to serialize update record new items to JSON: %w", err)
 			}
-			oldItemsJSON, err := typedRecord.OldItems.ToJSON()
+			oldItemsJSON, err := typedRecord.OldItems.ToJSONWithOpts(recordJSONOpts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to serialize update record old items to JSON: %w", err)
 			}
 
 			// add update record to the raw table
-			records = append(records, snowflakeRawRecord{
+			rawRecord = snowflakeRawRecord{
 				uid:                   uuid.New().String(),
 				timestamp:             time.Now().UnixNano(),
 				destinationTableName:  typedRecord.DestinationTableName,
@@ -588,16 +876,15 @@ func (c *SnowflakeConnector) syncRecordsViaSQL(req *model.SyncRecordsRequest, ra
 				matchData:             oldItemsJSON,
 				batchID:               syncBatchID,
 				unchangedToastColumns: utils.KeysToString(typedRecord.UnchangedToastColumns),
-			})
-			tableNameRowsMapping[typedRecord.DestinationTableName] += 1
+			}
 		case *model.DeleteRecord:
-			itemsJSON, err := typedRecord.Items.ToJSON()
+			itemsJSON, err := typedRecord.Items.ToJSONWithOpts(recordJSONOpts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to serialize delete record items to JSON: %w", err)
 			}
 
 			// append delete record to the raw table
-			records = append(records, snowflakeRawRecord{
+			rawRecord = snowflakeRawRecord{
 				uid:                   uuid.New().String(),
 				timestamp:             time.Now().UnixNano(),
 				destinationTableName:  typedRecord.DestinationTableName,
@@ -606,58 +893,134 @@ func (c *SnowflakeConnector) syncRecordsViaSQL(req *model.SyncRecordsRequest, ra
 				matchData:             itemsJSON,
 				batchID:               syncBatchID,
 				unchangedToastColumns: "",
-			})
-			tableNameRowsMapping[typedRecord.DestinationTableName] += 1
+			}
 		default:
 			return nil, fmt.Errorf("record type %T not supported in Snowflake flow connector", typedRecord)
 		}
 
-		if first {
-			firstCP = record.GetCheckPointID()
-			first = false
+		if isOverInlineRecordSize(rawRecord, req.MaxInlineRecordSize) {
+			stagedRecords = append(stagedRecords, rawRecord)
+		} else {
+			records = append(records, rawRecord)
 		}
+		tableNameRowsMapping[rawRecord.destinationTableName] += 1
 	}
 
 	// inserting records into raw table.
 	numRecords := len(records)
 	startTime := time.Now()
-	for begin := 0; begin < numRecords; begin += syncRecordsChunkSize {
-		end := begin + syncRecordsChunkSize
+	if req.ParallelRawInserts > 1 {
+		err := c.insertRecordsInRawTableInParallel(rawTableIdentifier, records, req.RawDataAsVariant,
+			int(req.ParallelRawInserts))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for begin := 0; begin < numRecords; begin += syncRecordsChunkSize {
+			end := begin + syncRecordsChunkSize
 
-		if end > numRecords {
-			end = numRecords
+			if end > numRecords {
+				end = numRecords
+			}
+			err := c.insertRecordsInRawTable(rawTableIdentifier, records[begin:end], req.RawDataAsVariant,
+				syncRecordsTx)
+			if err != nil {
+				return nil, err
+			}
 		}
-		err := c.insertRecordsInRawTable(rawTableIdentifier, records[begin:end], syncRecordsTx)
+	}
+	if len(stagedRecords) > 0 {
+		log.WithFields(log.Fields{
+			"flowName": req.FlowJobName,
+		}).Infof("staging %d records larger than %d bytes", len(stagedRecords), req.MaxInlineRecordSize)
+		err := c.stageRawRecords(req.FlowJobName, rawTableIdentifier, stagedRecords)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to stage large records: %w", err)
 		}
+		numRecords += len(stagedRecords)
 	}
 	metrics.LogSyncMetrics(c.ctx, req.FlowJobName, int64(numRecords), time.Since(startTime))
 
 	return &model.SyncResponse{
 		FirstSyncedCheckPointID: firstCP,
 		LastSyncedCheckPointID:  lastCP,
-		NumRecordsSynced:        int64(len(records)),
+		NumRecordsSynced:        int64(numRecords),
 		CurrentSyncBatchID:      syncBatchID,
 		TableNameRowsMapping:    tableNameRowsMapping,
 	}, nil
 }
 
+// isOverInlineRecordSize returns if a record is larger than maxInlineRecordSize bytes and
+// has to be staged as a file instead of inserted inline. 0 means no limit.
+func isOverInlineRecordSize(record snowflakeRawRecord, maxInlineRecordSize int64) bool {
+	if maxInlineRecordSize <= 0 {
+		return false
+	}
+	return int64(len(record.data)+len(record.matchData)+len(record.unchangedToastColumns)) > maxInlineRecordSize
+}
+
+// stageRawRecords loads raw records into the raw table through a staged Avro file,
+// keeping their timestamps so that they are merged in order with the records inserted inline.
+func (c *SnowflakeConnector) stageRawRecords(flowJobName string, rawTableIdentifier string,
+	records []snowflakeRawRecord) error {
+	recordStream := model.NewQRecordStream(len(records))
+	err := recordStream.SetSchema(utils.RawTableRecordSchema())
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		recordStream.Records <- &model.QRecordOrError{
+			Record: &model.QRecord{
+				NumEntries: 8,
+				Entries: []qvalue.QValue{
+					{Kind: qvalue.QValueKindString, Value: record.uid},
+					{Kind: qvalue.QValueKindInt64, Value: record.timestamp},
+					{Kind: qvalue.QValueKindString, Value: record.destinationTableName},
+					{Kind: qvalue.QValueKindString, Value: record.data},
+					{Kind: qvalue.QValueKindInt64, Value: record.recordType},
+					{Kind: qvalue.QValueKindString, Value: record.matchData},
+					{Kind: qvalue.QValueKindInt64, Value: record.batchID},
+					{Kind: qvalue.QValueKindString, Value: record.unchangedToastColumns},
+				},
+			},
+		}
+	}
+	close(recordStream.Records)
+
+	qrepConfig := &protos.QRepConfig{
+		StagingPath: "",
+		FlowJobName: flowJobName,
+		DestinationTableIdentifier: fmt.Sprintf("%s.%s", peerDBInternalSchema,
+			rawTableIdentifier),
+	}
+	avroSyncer := NewSnowflakeAvroSyncMethod(qrepConfig, c)
+	destinationTableSchema, err := c.getTableSchema(qrepConfig.DestinationTableIdentifier)
+	if err != nil {
+		return err
+	}
+	_, err = avroSyncer.SyncRecords(destinationTableSchema, recordStream, flowJobName)
+	return err
+}
+
 func (c *SnowflakeConnector) syncRecordsViaAvro(req *model.SyncRecordsRequest, rawTableIdentifier string,
 	syncBatchID int64) (*model.SyncResponse, error) {
 
-	lastCP := req.Records.LastCheckPointID
+	firstCP, lastCP := req.Records.CheckPointRange()
 	tableNameRowsMapping := make(map[string]uint32)
 	streamRes, err := utils.RecordsToRawTableStream(model.RecordsToStreamRequest{
 		Records:      req.Records.Records,
 		TableMapping: tableNameRowsMapping,
 		CP:           0,
 		BatchID:      syncBatchID,
+		ToJSONOptions: &model.ToJSONOptions{
+			NullFloatSpecialValues: req.NullFloatSpecialValues,
+			EmptyStringsAsNull:     req.EmptyStringsAsNull,
+			TableNameSchemaMapping: c.tableSchemaMapping,
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert records to raw table stream: %w", err)
 	}
-	firstCP := streamRes.CP
 	recordStream := streamRes.Stream
 	qrepConfig := &protos.QRepConfig{
 		StagingPath: "",
@@ -689,6 +1052,77 @@ func (c *SnowflakeConnector) syncRecordsViaAvro(req *model.SyncRecordsRequest, r
 
 // NormalizeRecords normalizes raw table to destination table.
 func (c *SnowflakeConnector) NormalizeRecords(req *model.NormalizeRecordsRequest) (*model.NormalizeResponse, error) {
+	if req.DynamicTables {
+		return c.recordDynamicTablesNormalized(req.FlowJobName)
+	}
+
+	var res *model.NormalizeResponse
+	err := c.retryMergeDisconnects(req.FlowJobName, req.MaxDisconnectRetries, func() error {
+		var err error
+		res, err = c.normalizeRecordsOnce(req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// recordDynamicTablesNormalized records the batches synced since the last normalize as normalized, without
+// merging them, for a mirror whose dynamic tables refresh from the raw table on their own. This keeps the
+// normalize backlog from counting every raw table row and lets the batches be marked as done.
+func (c *SnowflakeConnector) recordDynamicTablesNormalized(flowJobName string) (*model.NormalizeResponse, error) {
+	syncBatchID, err := c.GetLastSyncBatchID(flowJobName)
+	if err != nil {
+		return nil, err
+	}
+	normalizeBatchID, err := c.GetLastNormalizeBatchID(flowJobName)
+	if err != nil {
+		return nil, err
+	}
+	if syncBatchID == normalizeBatchID {
+		return &model.NormalizeResponse{
+			Done:         false,
+			StartBatchID: normalizeBatchID,
+			EndBatchID:   syncBatchID,
+		}, nil
+	}
+
+	normalizeRecordsTx, err := c.database.BeginTx(c.ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to begin transaction for normalize metadata: %w", err)
+	}
+	defer func() {
+		deferErr := normalizeRecordsTx.Rollback()
+		if deferErr != sql.ErrTxDone && deferErr != nil {
+			log.WithFields(log.Fields{
+				"flowName": flowJobName,
+			}).Errorf("unexpected error while rolling back transaction for normalize metadata: %v", deferErr)
+		}
+	}()
+	err = c.updateNormalizeMetadata(flowJobName, syncBatchID, normalizeRecordsTx)
+	if err != nil {
+		return nil, err
+	}
+	err = normalizeRecordsTx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("unable to commit transaction for normalize metadata: %w", err)
+	}
+
+	return &model.NormalizeResponse{
+		Done:         true,
+		StartBatchID: normalizeBatchID + 1,
+		EndBatchID:   syncBatchID,
+	}, nil
+}
+
+// normalizeRecordsOnce merges the batches synced since the last normalize in a single transaction,
+// or in a transaction for each chunk of them with MaxRecordsPerMerge set, each recording its batches
+// as normalized. A merge that runs into the statement timeout is split into smaller ones. It reads which
+// batches to merge itself, so running it again after the connection dropped while committing finds them
+// normalized instead of merging them twice.
+func (c *SnowflakeConnector) normalizeRecordsOnce(
+	req *model.NormalizeRecordsRequest) (*model.NormalizeResponse, error) {
 	syncBatchID, err := c.GetLastSyncBatchID(req.FlowJobName)
 	if err != nil {
 		return nil, err
@@ -697,6 +1131,13 @@ func (c *SnowflakeConnector) NormalizeRecords(req *model.NormalizeRecordsRequest
 	if err != nil {
 		return nil, err
 	}
+	// a forced normalize merges the last normalized batch again.
+	if req.Force && syncBatchID == normalizeBatchID && normalizeBatchID > model.NoNormalizedBatchID {
+		log.WithFields(log.Fields{
+			"flowName": req.FlowJobName,
+		}).Warnf("forcing normalize of batch %d, which was normalized already", normalizeBatchID)
+		normalizeBatchID--
+	}
 	// normalize has caught up with sync, chill until more records are loaded.
 	if syncBatchID == normalizeBatchID {
 		return &model.NormalizeResponse{
@@ -716,20 +1157,83 @@ func (c *SnowflakeConnector) NormalizeRecords(req *model.NormalizeRecordsRequest
 			Done: false,
 		}, nil
 	}
-	destinationTableNames, err := c.getDistinctTableNamesInBatch(req.FlowJobName, syncBatchID, normalizeBatchID)
+	if normalizeBatchID == model.NoNormalizedBatchID {
+		log.WithFields(log.Fields{
+			"flowName": req.FlowJobName,
+		}).Infof("first normalize of the mirror, normalizing batches %d to %d", model.FirstSyncBatchID, syncBatchID)
+	}
+
+	chunkEndBatchIDs := []int64{syncBatchID}
+	if req.MaxRecordsPerMerge > 0 {
+		batchRowCounts, err := c.getBatchRowCounts(req.FlowJobName, syncBatchID, normalizeBatchID)
+		if err != nil {
+			return nil, err
+		}
+		chunkEndBatchIDs = normalizeChunkEndBatchIDs(batchRowCounts, syncBatchID, req.MaxRecordsPerMerge)
+	}
+	err = c.runNormalizeHooks(req, func() error {
+		chunkStartBatchID := normalizeBatchID
+		for _, chunkEndBatchID := range chunkEndBatchIDs {
+			if len(chunkEndBatchIDs) > 1 {
+				log.WithFields(log.Fields{
+					"flowName": req.FlowJobName,
+				}).Infof("normalizing batches %d to %d of %d", chunkStartBatchID+1, chunkEndBatchID, syncBatchID)
+			}
+			err := c.normalizeBatchRangeSplittingTimeouts(req, chunkEndBatchID, chunkStartBatchID)
+			if err != nil {
+				return err
+			}
+			chunkStartBatchID = chunkEndBatchID
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	return &model.NormalizeResponse{
+		Done:         true,
+		StartBatchID: normalizeBatchID + 1,
+		EndBatchID:   syncBatchID,
+	}, nil
+}
+
+// normalizeBatchRange merges the batches in (normalizeBatchID, syncBatchID] in a single transaction,
+// which also records them as normalized. With MaxRecordsPerMerge set, each table is merged by a statement
+// for every range of at most that many raw table rows, see getMergeRowRanges.
+func (c *SnowflakeConnector) normalizeBatchRange(req *model.NormalizeRecordsRequest, syncBatchID int64,
+	normalizeBatchID int64) error {
+	if err := c.checkUnknownRecordTypes(req, syncBatchID, normalizeBatchID); err != nil {
+		return err
+	}
+	destinationTableNames, err := c.getDistinctTableNamesInBatch(req.FlowJobName, syncBatchID, normalizeBatchID,
+		req.RawOnlyTables)
+	if err != nil {
+		return err
+	}
+
 	tableNametoUnchangedToastCols, err := c.getTableNametoUnchangedCols(req.FlowJobName, syncBatchID, normalizeBatchID)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't tablename to unchanged cols mapping: %w", err)
+		return fmt.Errorf("couldn't tablename to unchanged cols mapping: %w", err)
+	}
+	rowRanges := []rawRowRange{{}}
+	if req.MaxRecordsPerMerge > 0 && len(destinationTableNames) > 0 {
+		rowRanges, err = c.getMergeRowRanges(req.FlowJobName, syncBatchID, normalizeBatchID, req.MaxRecordsPerMerge)
+		if err != nil {
+			return err
+		}
+		if len(rowRanges) > 1 {
+			log.WithFields(log.Fields{
+				"flowName": req.FlowJobName,
+			}).Infof("merging batches %d to %d in %d parts of at most %d records",
+				normalizeBatchID+1, syncBatchID, len(rowRanges), req.MaxRecordsPerMerge)
+		}
 	}
 
 	// transaction for NormalizeRecords
 	normalizeRecordsTx, err := c.database.BeginTx(c.ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("unable to begin transactions for NormalizeRecords: %w", err)
+		return fmt.Errorf("unable to begin transactions for NormalizeRecords: %w", err)
 	}
 	// in case we return after error, ensure transaction is rolled back
 	defer func() {
@@ -743,24 +1247,24 @@ func (c *SnowflakeConnector) NormalizeRecords(req *model.NormalizeRecordsRequest
 
 	var totalRowsAffected int64 = 0
 	startTime := time.Now()
+	// batches without raw records of normalized tables, such as batches of raw only tables, leave nothing
+	// to merge. they are still recorded as normalized below, otherwise normalize would never catch up.
 	// execute merge statements per table that uses CTEs to merge data into the normalized table
 	for _, destinationTableName := range destinationTableNames {
-		rowsAffected, err := c.generateAndExecuteMergeStatement(
-			destinationTableName,
-			tableNametoUnchangedToastCols[destinationTableName],
-			getRawTableIdentifier(req.FlowJobName),
-			syncBatchID, normalizeBatchID,
-			req.SoftDelete,
-			normalizeRecordsTx)
-		if err != nil {
-			return nil, err
+		for _, rowRange := range rowRanges {
+			rowsAffected, err := c.executeLimitedMergeStatement(req, destinationTableName,
+				tableNametoUnchangedToastCols[destinationTableName], syncBatchID, normalizeBatchID, rowRange,
+				normalizeRecordsTx)
+			if err != nil {
+				return c.explainWarehouseError(err)
+			}
+			totalRowsAffected += rowsAffected
 		}
-		totalRowsAffected += rowsAffected
 	}
 	if totalRowsAffected > 0 {
 		totalRowsAtTarget, err := c.getTableCounts(destinationTableNames)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		metrics.LogNormalizeMetrics(c.ctx, req.FlowJobName, totalRowsAffected, time.Since(startTime),
 			totalRowsAtTarget)
@@ -768,21 +1272,182 @@ func (c *SnowflakeConnector) NormalizeRecords(req *model.NormalizeRecordsRequest
 	// updating metadata with new normalizeBatchID
 	err = c.updateNormalizeMetadata(req.FlowJobName, syncBatchID, normalizeRecordsTx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	// transaction commits
-	err = normalizeRecordsTx.Commit()
+	return normalizeRecordsTx.Commit()
+}
+
+// executeLimitedMergeStatement merges a single table, waiting for a slot from the merge limiter
+// first if one is configured, see getMergeLimiter.
+func (c *SnowflakeConnector) executeLimitedMergeStatement(
+	req *model.NormalizeRecordsRequest,
+	destinationTableName string,
+	unchangedToastColumns []string,
+	syncBatchID int64,
+	normalizeBatchID int64,
+	rowRange rawRowRange,
+	normalizeRecordsTx *sql.Tx,
+) (int64, error) {
+	if mergeLimiter := c.getMergeLimiter(req); mergeLimiter != nil {
+		err := mergeLimiter.Acquire(c.ctx, 1*time.Minute, func() string {
+			return fmt.Sprintf("normalize of table %s for flow %s", destinationTableName, req.FlowJobName)
+		})
+		if err != nil {
+			return 0, err
+		}
+		defer mergeLimiter.Release()
+	}
+
+	return c.generateAndExecuteMergeStatement(
+		destinationTableName,
+		unchangedToastColumns,
+		getRawTableIdentifier(req.FlowJobName),
+		syncBatchID, normalizeBatchID,
+		req.SoftDelete,
+		req.RawDataAsVariant,
+		req.SourceLSNColumn,
+		req.DedupBySourceLSN,
+		req.ColumnDefaults[destinationTableName].GetDefaults(),
+		req.DedupNullsOrdering,
+		req.VariantNullPolicy,
+		req.KeyVersionPolicy,
+		req.LastOpColumn,
+		rowRange,
+		normalizeRecordsTx)
+}
+
+// RebuildNormalizedTable truncates a normalized table and re-merges every batch normalized so far
+// from the raw table. Batches are merged a chunk at a time, each in its own transaction, so no normalize
+// of the mirror may run until the rebuild is done.
+func (c *SnowflakeConnector) RebuildNormalizedTable(
+	req *model.RebuildNormalizedTableRequest) (*protos.RebuildNormalizedTableOutput, error) {
+	flowJobName := req.Normalize.FlowJobName
+	// the table is checked before it is truncated, rows that the raw table can't restore would be lost
+	if req.InitialCopy {
+		return nil, fmt.Errorf("cannot rebuild table %s of mirror %s, the rows of its initial copy "+
+			"are not in the raw table", req.DestinationTableIdentifier, flowJobName)
+	}
+	if req.Normalize.DynamicTables {
+		return nil, fmt.Errorf("cannot rebuild table %s of mirror %s, dynamic tables refresh "+
+			"from the raw table on their own", req.DestinationTableIdentifier, flowJobName)
+	}
+	if req.Normalize.RawOnlyTables[req.DestinationTableIdentifier] {
+		return nil, fmt.Errorf("cannot rebuild table %s of mirror %s, its records are only kept "+
+			"in the raw table", req.DestinationTableIdentifier, flowJobName)
+	}
+	if _, ok := c.tableSchemaMapping[req.DestinationTableIdentifier]; !ok {
+		return nil, fmt.Errorf("table %s is not a table of mirror %s", req.DestinationTableIdentifier, flowJobName)
+	}
+
+	normalizeBatchID, err := c.GetLastNormalizeBatchID(flowJobName)
 	if err != nil {
 		return nil, err
 	}
 
-	return &model.NormalizeResponse{
-		Done:         true,
-		StartBatchID: normalizeBatchID + 1,
-		EndBatchID:   syncBatchID,
+	batchesPerMerge := req.BatchesPerMerge
+	if batchesPerMerge <= 0 {
+		batchesPerMerge = defaultRebuildBatchesPerMerge
+	}
+
+	_, err = c.database.ExecContext(c.ctx, fmt.Sprintf(truncateTableSQL, req.DestinationTableIdentifier))
+	if err != nil {
+		return nil, fmt.Errorf("failed to truncate table %s: %w", req.DestinationTableIdentifier, err)
+	}
+
+	var totalRowsAffected int64 = 0
+	for startBatchID := model.NoNormalizedBatchID; startBatchID < normalizeBatchID; startBatchID += batchesPerMerge {
+		endBatchID := startBatchID + batchesPerMerge
+		if endBatchID > normalizeBatchID {
+			endBatchID = normalizeBatchID
+		}
+
+		rowsAffected, err := c.rebuildNormalizedTableChunk(req, startBatchID, endBatchID)
+		if err != nil {
+			return nil, err
+		}
+		totalRowsAffected += rowsAffected
+
+		progressMessage := fmt.Sprintf("rebuilt table %s up to batch %d of %d",
+			req.DestinationTableIdentifier, endBatchID, normalizeBatchID)
+		log.WithFields(log.Fields{
+			"flowName": flowJobName,
+		}).Info(progressMessage)
+		utils.RecordHeartbeatWithRecover(c.ctx, progressMessage)
+	}
+
+	// a normalize that ran anyway merged rows the truncate or older chunks may have replaced
+	lastNormalizeBatchID, err := c.GetLastNormalizeBatchID(flowJobName)
+	if err != nil {
+		return nil, err
+	}
+	if lastNormalizeBatchID != normalizeBatchID {
+		return nil, fmt.Errorf("mirror %s normalized up to batch %d while table %s was rebuilt up to batch %d, "+
+			"rebuild it again", flowJobName, lastNormalizeBatchID, req.DestinationTableIdentifier, normalizeBatchID)
+	}
+
+	return &protos.RebuildNormalizedTableOutput{
+		RowsAffected: totalRowsAffected,
+		LastBatchId:  normalizeBatchID,
 	}, nil
 }
 
+// rebuildNormalizedTableChunk merges batches in (startBatchID, endBatchID] into the table being rebuilt.
+func (c *SnowflakeConnector) rebuildNormalizedTableChunk(req *model.RebuildNormalizedTableRequest,
+	startBatchID int64, endBatchID int64) (int64, error) {
+	normalize := req.Normalize
+	tableNametoUnchangedToastCols, err := c.getTableNametoUnchangedCols(normalize.FlowJobName, endBatchID,
+		startBatchID)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't tablename to unchanged cols mapping: %w", err)
+	}
+	unchangedToastCols, ok := tableNametoUnchangedToastCols[req.DestinationTableIdentifier]
+	// no records for this table in these batches
+	if !ok {
+		return 0, nil
+	}
+
+	rebuildTx, err := c.database.BeginTx(c.ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("unable to begin transaction for rebuilding normalized table: %w", err)
+	}
+	defer func() {
+		deferErr := rebuildTx.Rollback()
+		if deferErr != sql.ErrTxDone && deferErr != nil {
+			log.WithFields(log.Fields{
+				"flowName": normalize.FlowJobName,
+			}).Errorf("unexpected error while rolling back transaction for rebuilding normalized table: %v",
+				deferErr)
+		}
+	}()
+
+	rowsAffected, err := c.generateAndExecuteMergeStatement(
+		req.DestinationTableIdentifier,
+		unchangedToastCols,
+		getRawTableIdentifier(normalize.FlowJobName),
+		endBatchID, startBatchID,
+		normalize.SoftDelete,
+		normalize.RawDataAsVariant,
+		normalize.SourceLSNColumn,
+		false,
+		normalize.ColumnDefaults[req.DestinationTableIdentifier].GetDefaults(),
+		normalize.DedupNullsOrdering,
+		normalize.VariantNullPolicy,
+		normalize.KeyVersionPolicy,
+		normalize.LastOpColumn,
+		rawRowRange{},
+		rebuildTx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = rebuildTx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("unable to commit transaction for rebuilding normalized table: %w", err)
+	}
+	return rowsAffected, nil
+}
+
 func (c *SnowflakeConnector) CreateRawTable(req *protos.CreateRawTableInput) (*protos.CreateRawTableOutput, error) {
 	rawTableIdentifier := getRawTableIdentifier(req.FlowJobName)
 
@@ -797,10 +1462,25 @@ func (c *SnowflakeConnector) CreateRawTable(req *protos.CreateRawTableInput) (*p
 	// there is no easy way to check if a table has the same schema in Snowflake,
 	// so just executing the CREATE TABLE IF NOT EXISTS blindly.
 	_, err = createRawTableTx.ExecContext(c.ctx,
-		fmt.Sprintf(createRawTableSQL, peerDBInternalSchema, rawTableIdentifier))
+		fmt.Sprintf(createRawTableSQL, peerDBInternalSchema, rawTableIdentifier,
+			rawDataColumnType(req.RawDataAsVariant)))
 	if err != nil {
 		return nil, fmt.Errorf("unable to create raw table: %w", err)
 	}
+	// clustering by destination table lets the per-table merges prune micro-partitions
+	// of the raw table instead of scanning all of it.
+	if req.ClusterRawTable {
+		_, err = createRawTableTx.ExecContext(c.ctx,
+			fmt.Sprintf(clusterRawTableSQL, peerDBInternalSchema, rawTableIdentifier))
+		if err != nil {
+			return nil, fmt.Errorf("unable to set clustering key on raw table: %w", err)
+		}
+	}
+	err = c.setTags(createRawTableTx, "TABLE", fmt.Sprintf("%s.%s", peerDBInternalSchema, rawTableIdentifier),
+		req.Tags)
+	if err != nil {
+		return nil, err
+	}
 	err = createRawTableTx.Commit()
 	if err != nil {
 		return nil, fmt.Errorf("unable to commit transaction for creation of raw table: %w", err)
@@ -866,8 +1546,30 @@ func (c *SnowflakeConnector) SyncFlowCleanup(jobName string) error {
 	return nil
 }
 
+// normalizeSnowflakeIdentifier returns an identifier the way Snowflake stores it,
+// uppercased unless it is quoted, in which case the quotes are dropped and the case is kept.
+func normalizeSnowflakeIdentifier(identifier string) string {
+	if len(identifier) >= 2 && strings.HasPrefix(identifier, `"`) && strings.HasSuffix(identifier, `"`) {
+		return strings.ReplaceAll(identifier[1:len(identifier)-1], `""`, `"`)
+	}
+	return strings.ToUpper(identifier)
+}
+
+// generateCheckIfTableExistsQuery returns the query and arguments checking if a table exists,
+// scoped to databaseName unless it is empty. The schema and table are expected the way Snowflake
+// stores them, as parseTableName returns them.
+func generateCheckIfTableExistsQuery(databaseName string, schemaName string,
+	tableName string) (string, []interface{}) {
+	if databaseName == "" {
+		return checkIfTableExistsSQL, []interface{}{schemaName, tableName}
+	}
+	return checkIfTableExistsInDatabaseSQL,
+		[]interface{}{normalizeSnowflakeIdentifier(databaseName), schemaName, tableName}
+}
+
 func (c *SnowflakeConnector) checkIfTableExists(schemaIdentifier string, tableIdentifier string) (bool, error) {
-	rows, err := c.database.QueryContext(c.ctx, checkIfTableExistsSQL, schemaIdentifier, tableIdentifier)
+	query, args := generateCheckIfTableExistsQuery(c.databaseName, schemaIdentifier, tableIdentifier)
+	rows, err := c.database.QueryContext(c.ctx, query, args...)
 	if err != nil {
 		return false, err
 	}
@@ -885,9 +1587,14 @@ func (c *SnowflakeConnector) checkIfTableExists(schemaIdentifier string, tableId
 func generateCreateTableSQLForNormalizedTable(
 	sourceTableIdentifier string,
 	sourceTableSchema *protos.TableSchema,
+	transient bool,
 ) string {
+	// iterate columns in a stable order so that the generated DDL is reproducible
+	columnNames := maps.Keys(sourceTableSchema.Columns)
+	sort.Strings(columnNames)
 	createTableSQLArray := make([]string, 0, len(sourceTableSchema.Columns))
-	for columnName, genericColumnType := range sourceTableSchema.Columns {
+	for _, columnName := range columnNames {
+		genericColumnType := sourceTableSchema.Columns[columnName]
 		columnNameUpper := strings.ToUpper(columnName)
 		createTableSQLArray = append(createTableSQLArray, fmt.Sprintf(`"%s" %s,`, columnNameUpper,
 			qValueKindToSnowflakeType(qvalue.QValueKind(genericColumnType))))
@@ -907,17 +1614,53 @@ func generateCreateTableSQLForNormalizedTable(
 	createTableSQLArray = append(createTableSQLArray, fmt.Sprintf("PRIMARY KEY(%s),",
 		strings.TrimSuffix(strings.Join(primaryKeyColsUpperQuoted, ","), ",")))
 
-	return fmt.Sprintf(createNormalizedTableSQL, sourceTableIdentifier,
+	// transient tables have no fail-safe period, which makes them cheaper for non-critical mirrors
+	tableKind := ""
+	if transient {
+		tableKind = "TRANSIENT "
+	}
+
+	return fmt.Sprintf(createNormalizedTableSQL, tableKind, sourceTableIdentifier,
 		strings.TrimSuffix(strings.Join(createTableSQLArray, ""), ","))
 }
 
-func generateMultiValueInsertSQL(tableIdentifier string, chunkSize int) string {
+func generateMultiValueInsertSQL(tableIdentifier string, chunkSize int, rawDataAsVariant bool) string {
 	// inferring the width of the raw table from the create table statement
 	rawTableWidth := strings.Count(createRawTableSQL, ",") + 1
+	valuesSQL := strings.TrimSuffix(strings.Repeat(fmt.Sprintf("(%s),",
+		strings.TrimSuffix(strings.Repeat("?,", rawTableWidth), ",")), chunkSize), ",")
+
+	if !rawDataAsVariant {
+		return fmt.Sprintf(rawTableMultiValueInsertSQL, peerDBInternalSchema, tableIdentifier, valuesSQL)
+	}
 
-	return fmt.Sprintf(rawTableMultiValueInsertSQL, peerDBInternalSchema, tableIdentifier,
-		strings.TrimSuffix(strings.Repeat(fmt.Sprintf("(%s),",
-			strings.TrimSuffix(strings.Repeat("?,", rawTableWidth), ",")), chunkSize), ","))
+	selectColumns := make([]string, 0, rawTableWidth)
+	for i := 1; i <= rawTableWidth; i++ {
+		if i == rawTableDataColumnPosition {
+			selectColumns = append(selectColumns, fmt.Sprintf("PARSE_JSON(column%d)", i))
+		} else {
+			selectColumns = append(selectColumns, fmt.Sprintf("column%d", i))
+		}
+	}
+	return fmt.Sprintf(rawTableMultiValueParseInsertSQL, peerDBInternalSchema, tableIdentifier,
+		strings.Join(selectColumns, ","), valuesSQL)
+}
+
+// rawDataColumnType is the type of _PEERDB_DATA in the raw table.
+func rawDataColumnType(rawDataAsVariant bool) string {
+	if rawDataAsVariant {
+		return "VARIANT"
+	}
+	return "STRING"
+}
+
+// rawDataToVariantSQL is how normalize reads _PEERDB_DATA as a VARIANT,
+// which only needs parsing if the raw table stores it as a JSON string.
+func rawDataToVariantSQL(rawDataAsVariant bool) string {
+	if rawDataAsVariant {
+		return "_PEERDB_DATA"
+	}
+	return "TO_VARIANT(PARSE_JSON(_PEERDB_DATA))"
 }
 
 func getRawTableIdentifier(jobName string) string {
@@ -926,7 +1669,7 @@ func getRawTableIdentifier(jobName string) string {
 }
 
 func (c *SnowflakeConnector) insertRecordsInRawTable(rawTableIdentifier string,
-	snowflakeRawRecords []snowflakeRawRecord, syncRecordsTx *sql.Tx) error {
+	snowflakeRawRecords []snowflakeRawRecord, rawDataAsVariant bool, syncRecordsTx sqlExecer) error {
 	rawRecordsData := make([]any, 0)
 
 	for _, record := range snowflakeRawRecords {
@@ -934,7 +1677,7 @@ func (c *SnowflakeConnector) insertRecordsInRawTable(rawTableIdentifier string,
 			record.data, record.recordType, record.matchData, record.batchID, record.unchangedToastColumns)
 	}
 	_, err := syncRecordsTx.ExecContext(c.ctx,
-		generateMultiValueInsertSQL(rawTableIdentifier, len(snowflakeRawRecords)), rawRecordsData...)
+		generateMultiValueInsertSQL(rawTableIdentifier, len(snowflakeRawRecords), rawDataAsVariant), rawRecordsData...)
 	if err != nil {
 		return fmt.Errorf("failed to insert record into raw table: %w", err)
 	}
@@ -948,36 +1691,83 @@ func (c *SnowflakeConnector) generateAndExecuteMergeStatement(
 	syncBatchID int64,
 	normalizeBatchID int64,
 	softDelete bool,
+	rawDataAsVariant bool,
+	sourceLSNColumn bool,
+	dedupBySourceLSN bool,
+	columnDefaults map[string]string,
+	nullsOrdering protos.DedupNullsOrdering,
+	variantNullPolicy protos.VariantNullPolicy,
+	keyVersionPolicy protos.PrimaryKeyVersionPolicy,
+	lastOpColumn bool,
+	rowRange rawRowRange,
 	normalizeRecordsTx *sql.Tx,
 ) (int64, error) {
+	mergeStatement, err := c.generateMergeStatement(destinationTableIdentifier, unchangedToastColumns,
+		rawTableIdentifier, syncBatchID, normalizeBatchID, softDelete, rawDataAsVariant,
+		sourceLSNColumn, dedupBySourceLSN, columnDefaults, nullsOrdering, variantNullPolicy, keyVersionPolicy,
+		lastOpColumn, rowRange)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := normalizeRecordsTx.ExecContext(c.ctx, mergeStatement, destinationTableIdentifier)
+	if err != nil {
+		return 0, fmt.Errorf("failed to merge records into %s (statement: %s): %w",
+			destinationTableIdentifier, mergeStatement, err)
+	}
+
+	return result.RowsAffected()
+}
+
+func (c *SnowflakeConnector) generateMergeStatement(
+	destinationTableIdentifier string,
+	unchangedToastColumns []string,
+	rawTableIdentifier string,
+	syncBatchID int64,
+	normalizeBatchID int64,
+	softDelete bool,
+	rawDataAsVariant bool,
+	sourceLSNColumn bool,
+	dedupBySourceLSN bool,
+	columnDefaults map[string]string,
+	nullsOrdering protos.DedupNullsOrdering,
+	variantNullPolicy protos.VariantNullPolicy,
+	keyVersionPolicy protos.PrimaryKeyVersionPolicy,
+	lastOpColumn bool,
+	rowRange rawRowRange,
+) (string, error) {
 	normalizedTableSchema := c.tableSchemaMapping[destinationTableIdentifier]
+	if sourceLSNColumn {
+		var err error
+		normalizedTableSchema, err = withSourceLSNColumn(normalizedTableSchema)
+		if err != nil {
+			return "", err
+		}
+	}
+	matchedCondition := ""
+	if dedupBySourceLSN {
+		if !sourceLSNColumn {
+			return "", fmt.Errorf("deduplicating by source LSN requires the source LSN column")
+		}
+		matchedCondition = sourceLSNMatchedCondition
+	}
 	columnNames := maps.Keys(normalizedTableSchema.Columns)
+	variantColumn := variantColumnName(normalizedTableSchema)
 
 	flattenedCastsSQLArray := make([]string, 0, len(normalizedTableSchema.Columns))
 	for columnName, genericColumnType := range normalizedTableSchema.Columns {
-		sfType := qValueKindToSnowflakeType(qvalue.QValueKind(genericColumnType))
 		targetColumnName := fmt.Sprintf(`"%s"`, strings.ToUpper(columnName))
-		switch qvalue.QValueKind(genericColumnType) {
-		case qvalue.QValueKindBytes, qvalue.QValueKindBit:
-			flattenedCastsSQLArray = append(flattenedCastsSQLArray, fmt.Sprintf("BASE64_DECODE_BINARY(%s:\"%s\") "+
-				"AS %s,", toVariantColumnName, columnName, targetColumnName))
-		case qvalue.QValueKindGeography:
-			flattenedCastsSQLArray = append(flattenedCastsSQLArray,
-				fmt.Sprintf("TO_GEOGRAPHY(CAST(%s:\"%s\" AS STRING),true) AS %s,",
-					toVariantColumnName, columnName, targetColumnName))
-		case qvalue.QValueKindGeometry:
-			flattenedCastsSQLArray = append(flattenedCastsSQLArray,
-				fmt.Sprintf("TO_GEOMETRY(CAST(%s:\"%s\" AS STRING),true) AS %s,",
-					toVariantColumnName, columnName, targetColumnName))
-		// TODO: https://github.com/PeerDB-io/peerdb/issues/189 - handle time types and interval types
-		// case model.ColumnTypeTime:
-		// 	flattenedCastsSQLArray = append(flattenedCastsSQLArray, fmt.Sprintf("TIME_FROM_PARTS(0,0,0,%s:%s:"+
-		// 		"Microseconds*1000) "+
-		// 		"AS %s,", toVariantColumnName, columnName, columnName))
-		default:
-			flattenedCastsSQLArray = append(flattenedCastsSQLArray, fmt.Sprintf("CAST(%s:\"%s\" AS %s) AS %s,",
-				toVariantColumnName, columnName, sfType, targetColumnName))
-		}
+		flattenedCastsSQLArray = append(flattenedCastsSQLArray, fmt.Sprintf("%s AS %s,",
+			castVariantSQL(fmt.Sprintf("%s:\"%s\"", variantColumn, columnName), genericColumnType,
+				variantNullPolicy),
+			targetColumnName))
+	}
+	if lastOpColumn {
+		// the operation comes from the record type rather than the raw data, inserts and updates set it
+		// like any other column.
+		flattenedCastsSQLArray = append(flattenedCastsSQLArray, fmt.Sprintf(`%s AS "%s",`, lastOpSQL,
+			lastOpColumnName))
+		columnNames = append(columnNames, lastOpColumnName)
 	}
 	flattenedCastsSQL := strings.TrimSuffix(strings.Join(flattenedCastsSQLArray, ""), ",")
 
@@ -985,16 +1775,19 @@ func (c *SnowflakeConnector) generateAndExecuteMergeStatement(
 	for _, columnName := range columnNames {
 		quotedUpperColNames = append(quotedUpperColNames, fmt.Sprintf(`"%s"`, strings.ToUpper(columnName)))
 	}
-	insertColumnsSQL := strings.TrimSuffix(strings.Join(quotedUpperColNames, ","), ",")
-
 	insertValuesSQLArray := make([]string, 0, len(columnNames))
 	for _, columnName := range columnNames {
 		quotedUpperColumnName := fmt.Sprintf(`"%s"`, strings.ToUpper(columnName))
-		insertValuesSQLArray = append(insertValuesSQLArray, fmt.Sprintf("SOURCE.%s,", quotedUpperColumnName))
+		insertValuesSQLArray = append(insertValuesSQLArray, fmt.Sprintf("SOURCE.%s", quotedUpperColumnName))
 	}
-	insertValuesSQL := strings.TrimSuffix(strings.Join(insertValuesSQLArray, ""), ",")
+	// columns of the destination table that are not replicated get their configured defaults
+	defaultColumns, defaultValues := generateColumnDefaultsInsertSQL(columnDefaults)
+	quotedUpperColNames = append(quotedUpperColNames, defaultColumns...)
+	insertValuesSQLArray = append(insertValuesSQLArray, defaultValues...)
+	insertColumnsSQL := strings.Join(quotedUpperColNames, ",")
+	insertValuesSQL := strings.Join(insertValuesSQLArray, ",")
 
-	updateStatementsforToastCols := c.generateUpdateStatement(columnNames, unchangedToastColumns)
+	updateStatementsforToastCols := c.generateUpdateStatement(columnNames, unchangedToastColumns, matchedCondition)
 	updateStringToastCols := strings.Join(updateStatementsforToastCols, " ")
 
 	pkeySelectSQLArray := make([]string, 0, len(normalizedTableSchema.PrimaryKeyColumns))
@@ -1008,35 +1801,120 @@ func (c *SnowflakeConnector) generateAndExecuteMergeStatement(
 	deletePart := "DELETE"
 	if softDelete {
 		deletePart = fmt.Sprintf("UPDATE SET %s = TRUE", isDeletedColumnName)
+		if sourceLSNColumn {
+			deletePart += fmt.Sprintf(`, "%s" = SOURCE."%s"`, sourceLSNColumnName, sourceLSNColumnName)
+		}
+		if lastOpColumn {
+			deletePart += fmt.Sprintf(`, "%s" = SOURCE."%s"`, lastOpColumnName, lastOpColumnName)
+		}
+	}
+
+	rekeyedSQL, dedupSourceSQL, currentKeySQL := "", "FLATTENED", ""
+	switch keyVersionPolicy {
+	case protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS:
+		currentKeySQL = " WHERE " + generateCurrentKeySQL(normalizedTableSchema.PrimaryKeyColumns)
+	case protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_REKEY_OLDER_RECORDS:
+		rekeyedSQL, dedupSourceSQL = generateRekeyedSQL(normalizedTableSchema.PrimaryKeyColumns, nullsOrdering),
+			"REKEYED"
+		currentKeySQL = " WHERE " + generateCurrentKeySQL(normalizedTableSchema.PrimaryKeyColumns)
 	}
 
-	mergeStatement := fmt.Sprintf(mergeStatementSQL, destinationTableIdentifier, toVariantColumnName,
-		rawTableIdentifier, normalizeBatchID, syncBatchID, flattenedCastsSQL,
+	mergeStatement := fmt.Sprintf(mergeStatementSQL, destinationTableIdentifier,
+		rawDataToVariantSQL(rawDataAsVariant), variantColumn,
+		rawTableIdentifier, normalizeBatchID, syncBatchID, rowRange.filterSQL(), flattenedCastsSQL, rekeyedSQL,
 		fmt.Sprintf("(%s)", strings.Join(normalizedTableSchema.PrimaryKeyColumns, ",")),
-		pkeySelectSQL, insertColumnsSQL, insertValuesSQL, updateStringToastCols, deletePart)
+		utils.DedupOrderSQL("_PEERDB_TIMESTAMP", nullsOrdering), dedupSourceSQL,
+		currentKeySQL, pkeySelectSQL, insertColumnsSQL,
+		insertValuesSQL, updateStringToastCols, matchedCondition, deletePart)
+	return mergeStatement, nil
+}
 
-	result, err := normalizeRecordsTx.ExecContext(c.ctx, mergeStatement, destinationTableIdentifier)
-	if err != nil {
-		return 0, fmt.Errorf("failed to merge records into %s (statement: %s): %w",
-			destinationTableIdentifier, mergeStatement, err)
+// castVariantSQL casts a value extracted from the raw data VARIANT to the Snowflake type of its column.
+// A column missing from the raw data extracts as SQL NULL, while a column that is json null extracts as
+// a VARIANT null, which CAST keeps as a json null in VARIANT columns. Unless the policy keeps json nulls,
+// they are stripped to SQL NULL first so both land as NULL.
+func castVariantSQL(variantSQL string, genericColumnType string, variantNullPolicy protos.VariantNullPolicy) string {
+	if variantNullPolicy == protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL {
+		variantSQL = fmt.Sprintf("STRIP_NULL_VALUE(%s)", variantSQL)
+	}
+	switch qvalue.QValueKind(genericColumnType) {
+	case qvalue.QValueKindBytes, qvalue.QValueKindBit:
+		return fmt.Sprintf("BASE64_DECODE_BINARY(%s)", variantSQL)
+	case qvalue.QValueKindGeography:
+		return fmt.Sprintf("TO_GEOGRAPHY(CAST(%s AS STRING),true)", variantSQL)
+	case qvalue.QValueKindGeometry:
+		return fmt.Sprintf("TO_GEOMETRY(CAST(%s AS STRING),true)", variantSQL)
+	// TODO: https://github.com/PeerDB-io/peerdb/issues/189 - handle time types and interval types
+	// case model.ColumnTypeTime:
+	// 	return fmt.Sprintf("TIME_FROM_PARTS(0,0,0,%s:Microseconds*1000)", variantSQL)
+	default:
+		return fmt.Sprintf("CAST(%s AS %s)", variantSQL,
+			qValueKindToSnowflakeType(qvalue.QValueKind(genericColumnType)))
 	}
-
-	return result.RowsAffected()
 }
 
-// parseTableName parses a table name into schema and table name.
+// parseTableName parses a table name into schema and table name, unquoting quoted identifiers
+// and uppercasing unquoted ones. Dots within quoted identifiers don't separate the schema and table.
 func parseTableName(tableName string) (*tableNameComponents, error) {
-	parts := strings.Split(tableName, ".")
+	parts, err := splitTableName(tableName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid table name %s: %w", tableName, err)
+	}
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("invalid table name: %s", tableName)
 	}
 
 	return &tableNameComponents{
-		schemaIdentifier: parts[0],
-		tableIdentifier:  parts[1],
+		schemaIdentifier: normalizeSnowflakeIdentifier(parts[0]),
+		tableIdentifier:  normalizeSnowflakeIdentifier(parts[1]),
 	}, nil
 }
 
+// splitTableName splits a table name on the dots outside of quoted identifiers,
+// quoted identifiers are returned with their quotes.
+func splitTableName(tableName string) ([]string, error) {
+	var parts []string
+	var part strings.Builder
+	inQuotes := false
+	for i := 0; i < len(tableName); i++ {
+		ch := tableName[i]
+		switch {
+		case inQuotes && ch == '"' && i+1 < len(tableName) && tableName[i+1] == '"':
+			// an escaped quote
+			part.WriteString(`""`)
+			i++
+		case inQuotes && ch == '"':
+			part.WriteByte(ch)
+			inQuotes = false
+			if i+1 < len(tableName) && tableName[i+1] != '.' {
+				return nil, fmt.Errorf("unexpected character after quoted identifier %s", part.String())
+			}
+		case ch == '"':
+			if part.Len() > 0 {
+				return nil, fmt.Errorf("unexpected quote in identifier %s", part.String())
+			}
+			part.WriteByte(ch)
+			inQuotes = true
+		case !inQuotes && ch == '.':
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(ch)
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quoted identifier %s", part.String())
+	}
+	parts = append(parts, part.String())
+
+	for _, p := range parts {
+		if p == "" || p == `""` {
+			return nil, fmt.Errorf("empty identifier")
+		}
+	}
+	return parts, nil
+}
+
 func (c *SnowflakeConnector) jobMetadataExists(jobName string) (bool, error) {
 	rows, err := c.database.QueryContext(c.ctx,
 		fmt.Sprintf(checkIfJobMetadataExistsSQL, peerDBInternalSchema, mirrorJobsTableIdentifier), jobName)
@@ -1054,19 +1932,30 @@ func (c *SnowflakeConnector) jobMetadataExists(jobName string) (bool, error) {
 }
 
 func (c *SnowflakeConnector) updateSyncMetadata(flowJobName string, lastCP int64,
-	syncBatchID int64, syncRecordsTx *sql.Tx) error {
+	syncBatchID int64, deferCheckpoint bool, guardSyncBatchID bool, syncRecordsTx *sql.Tx) error {
 	jobMetadataExists, err := c.jobMetadataExists(flowJobName)
 	if err != nil {
 		return fmt.Errorf("failed to get sync status for flow job: %w", err)
 	}
 
+	if guardSyncBatchID {
+		return c.updateSyncMetadataGuarded(flowJobName, lastCP, syncBatchID, deferCheckpoint,
+			jobMetadataExists, syncRecordsTx)
+	}
 	if !jobMetadataExists {
 		_, err := syncRecordsTx.ExecContext(c.ctx,
 			fmt.Sprintf(insertJobMetadataSQL, peerDBInternalSchema, mirrorJobsTableIdentifier),
-			flowJobName, lastCP, syncBatchID, 0)
+			flowJobName, lastCP, syncBatchID, nil)
 		if err != nil {
 			return fmt.Errorf("failed to insert flow job status: %w", err)
 		}
+	} else if deferCheckpoint {
+		_, err := syncRecordsTx.ExecContext(c.ctx,
+			fmt.Sprintf(updateSyncBatchIDSQL, peerDBInternalSchema, mirrorJobsTableIdentifier),
+			syncBatchID, flowJobName)
+		if err != nil {
+			return fmt.Errorf("failed to update flow job status: %w", err)
+		}
 	} else {
 		_, err := syncRecordsTx.ExecContext(c.ctx,
 			fmt.Sprintf(updateMetadataForSyncRecordsSQL, peerDBInternalSchema, mirrorJobsTableIdentifier),
@@ -1079,6 +1968,40 @@ func (c *SnowflakeConnector) updateSyncMetadata(flowJobName string, lastCP int64
 	return nil
 }
 
+// updateSyncMetadataGuarded records the batch only if the sync batch ID is still the one before it,
+// so that of two syncs of the mirror running at once, the one committing second fails with
+// ErrSyncBatchIDConflict instead of recording the same batch ID again.
+func (c *SnowflakeConnector) updateSyncMetadataGuarded(flowJobName string, lastCP int64, syncBatchID int64,
+	deferCheckpoint bool, jobMetadataExists bool, syncRecordsTx *sql.Tx) error {
+	var res sql.Result
+	var err error
+	if !jobMetadataExists {
+		res, err = syncRecordsTx.ExecContext(c.ctx,
+			fmt.Sprintf(insertJobMetadataIfAbsentSQL, peerDBInternalSchema, mirrorJobsTableIdentifier),
+			flowJobName, flowJobName, lastCP, syncBatchID, nil)
+	} else if deferCheckpoint {
+		res, err = syncRecordsTx.ExecContext(c.ctx,
+			fmt.Sprintf(guardedUpdateSyncBatchIDSQL, peerDBInternalSchema, mirrorJobsTableIdentifier),
+			syncBatchID, flowJobName, syncBatchID-1)
+	} else {
+		res, err = syncRecordsTx.ExecContext(c.ctx,
+			fmt.Sprintf(guardedUpdateMetadataForSyncRecordsSQL, peerDBInternalSchema, mirrorJobsTableIdentifier),
+			lastCP, syncBatchID, flowJobName, syncBatchID-1)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update flow job status: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected by updating flow job status: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: batch %d of mirror %s", model.ErrSyncBatchIDConflict, syncBatchID, flowJobName)
+	}
+	return nil
+}
+
 func (c *SnowflakeConnector) updateNormalizeMetadata(flowJobName string,
 	normalizeBatchID int64, normalizeRecordsTx *sql.Tx) error {
 	jobMetadataExists, err := c.jobMetadataExists(flowJobName)
@@ -1099,14 +2022,6 @@ func (c *SnowflakeConnector) updateNormalizeMetadata(flowJobName string,
 	return nil
 }
 
-func (c *SnowflakeConnector) createPeerDBInternalSchema(createSchemaTx *sql.Tx) error {
-	_, err := createSchemaTx.ExecContext(c.ctx, fmt.Sprintf(createPeerDBInternalSchemaSQL, peerDBInternalSchema))
-	if err != nil {
-		return fmt.Errorf("error while creating internal schema for PeerDB: %w", err)
-	}
-	return nil
-}
-
 /*
 This function generates UPDATE statements for a MERGE operation based on the provided inputs.
 
@@ -1129,7 +2044,8 @@ and updating the other columns.
 6. Repeat steps 1-5 for each unique set of unchanged toast column groups.
 7. Return the list of generated update statements.
 */
-func (c *SnowflakeConnector) generateUpdateStatement(allCols []string, unchangedToastCols []string) []string {
+func (c *SnowflakeConnector) generateUpdateStatement(allCols []string, unchangedToastCols []string,
+	matchedCondition string) []string {
 	updateStmts := make([]string, 0)
 
 	for _, cols := range unchangedToastCols {
@@ -1142,8 +2058,8 @@ func (c *SnowflakeConnector) generateUpdateStatement(allCols []string, unchanged
 		}
 		ssep := strings.Join(tmpArray, ", ")
 		updateStmt := fmt.Sprintf(`WHEN MATCHED AND
-		(SOURCE._PEERDB_RECORD_TYPE != 2) AND _PEERDB_UNCHANGED_TOAST_COLUMNS='%s'
-		THEN UPDATE SET %s `, cols, ssep)
+		(SOURCE._PEERDB_RECORD_TYPE != 2) AND _PEERDB_UNCHANGED_TOAST_COLUMNS='%s'%s
+		THEN UPDATE SET %s `, cols, matchedCondition, ssep)
 		updateStmts = append(updateStmts, updateStmt)
 	}
 	return updateStmts
@@ -0,0 +1,47 @@
+package connsnowflake
+
+import (
+	"fmt"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"google.golang.org/protobuf/proto"
+)
+
+// sourceLSNColumnName is the normalized table column holding the LSN of the latest change applied to a row.
+const sourceLSNColumnName = "_PEERDB_SOURCE_LSN"
+
+// sourceLSNMatchedCondition limits the merge to changes newer than the latest one applied to the row,
+// rows from the initial copy have no LSN and take any change.
+const sourceLSNMatchedCondition = ` AND (TARGET."_PEERDB_SOURCE_LSN" IS NULL OR
+		 SOURCE."_PEERDB_SOURCE_LSN" > TARGET."_PEERDB_SOURCE_LSN")`
+
+// addSourceLSNColumn adds the checkpoint of each record to its data, so that the merge
+// picks it up like any other column of the record.
+func addSourceLSNColumn(records []model.Record) {
+	for _, record := range records {
+		items := record.GetItems()
+		if items == nil {
+			continue
+		}
+		items.AddColumn(sourceLSNColumnName, &qvalue.QValue{
+			Kind:  qvalue.QValueKindInt64,
+			Value: record.GetCheckPointID(),
+		})
+	}
+}
+
+// withSourceLSNColumn returns a copy of the table schema with the _PEERDB_SOURCE_LSN column added.
+// The schema itself is left as is, as it is shared with the rest of the flow.
+func withSourceLSNColumn(tableSchema *protos.TableSchema) (*protos.TableSchema, error) {
+	withColumn, ok := proto.Clone(tableSchema).(*protos.TableSchema)
+	if !ok {
+		return nil, fmt.Errorf("failed to copy schema of table %s", tableSchema.TableIdentifier)
+	}
+	if withColumn.Columns == nil {
+		withColumn.Columns = make(map[string]string)
+	}
+	withColumn.Columns[sourceLSNColumnName] = string(qvalue.QValueKindInt64)
+	return withColumn, nil
+}
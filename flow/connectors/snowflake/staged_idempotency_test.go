@@ -0,0 +1,86 @@
+package connsnowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestRetriedSyncDoesNotDuplicateRawRows(t *testing.T) {
+	// each sync loads its raw rows ahead of the transaction recording the batch
+	for _, tc := range []struct {
+		name string
+		req  *model.SyncRecordsRequest
+	}{
+		{"avro", &model.SyncRecordsRequest{SyncMode: protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO}},
+		{"parallel raw inserts", &model.SyncRecordsRequest{
+			SyncMode:           protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT,
+			ParallelRawInserts: 2,
+		}},
+		{"max inline record size", &model.SyncRecordsRequest{
+			SyncMode:            protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT,
+			MaxInlineRecordSize: 1,
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// the first attempt to record batch 2 loses the connection
+			w := &fakeWarehouse{
+				syncBatchID:             1,
+				rawRows:                 map[int64]int{1: 5},
+				stagedRows:              2,
+				syncMetadataDisconnects: 1,
+				tables: map[string][]string{
+					"_PEERDB_INTERNAL._PEERDB_RAW_test": {"_PEERDB_UID STRING", "_PEERDB_DATA STRING",
+						"_PEERDB_BATCH_ID INTEGER"},
+				},
+			}
+			c := newFakeWarehouseConnector(w)
+			defer c.database.Close()
+
+			req := tc.req
+			req.FlowJobName = "test"
+			req.Records = &model.RecordBatch{LastCheckPointID: 11}
+			for id := int64(1); id <= 2; id++ {
+				req.Records.Records = append(req.Records.Records, &model.InsertRecord{
+					DestinationTableName: "PUBLIC.T",
+					CheckPointID:         9 + id,
+					Items: model.NewRecordItemWithData([]string{"ID"}, []*qvalue.QValue{
+						{Kind: qvalue.QValueKindInt64, Value: id},
+					}),
+				})
+			}
+			// staged syncs heartbeat, so the syncs run as an activity.
+			syncRecords := func(ctx context.Context) (int64, error) {
+				c.ctx = ctx
+				res, err := c.SyncRecords(req)
+				if err != nil {
+					return 0, err
+				}
+				return res.CurrentSyncBatchID, nil
+			}
+			var suite testsuite.WorkflowTestSuite
+			env := suite.NewTestActivityEnvironment()
+			env.RegisterActivity(syncRecords)
+
+			_, err := env.ExecuteActivity(syncRecords)
+			require.Error(t, err)
+			require.Equal(t, int64(1), w.syncBatchID)
+			// the failed sync left its rows behind
+			require.Equal(t, map[int64]int{1: 5, 2: 2}, w.rawRows)
+
+			res, err := env.ExecuteActivity(syncRecords)
+			require.NoError(t, err)
+			var syncBatchID int64
+			require.NoError(t, res.Get(&syncBatchID))
+			require.Equal(t, int64(2), syncBatchID)
+			require.Equal(t, int64(2), w.syncBatchID)
+			// the rows of the synced batch are left alone, the batch has its rows once
+			require.Equal(t, map[int64]int{1: 5, 2: 2}, w.rawRows)
+		})
+	}
+}
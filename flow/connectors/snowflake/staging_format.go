@@ -0,0 +1,522 @@
+package connsnowflake
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/decimal128"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	log "github.com/sirupsen/logrus"
+	uber_atomic "go.uber.org/atomic"
+)
+
+// numeric columns are created as NUMBER(38, 9), values are staged with as many decimals.
+const (
+	stagedNumericPrecision = 38
+	stagedNumericScale     = 9
+)
+
+// timestamps are staged in CSV and NDJSON files with microseconds, which is what Postgres has.
+const stagedTimestampLayout = "2006-01-02T15:04:05.999999"
+
+// number of rows buffered before they are written to the Parquet file as a row group.
+const parquetRowGroupSize = 8192
+
+// maxStagedNumeric is the exclusive bound of the unscaled value of a NUMBER(38, 9).
+var maxStagedNumeric = new(big.Int).Exp(big.NewInt(10), big.NewInt(stagedNumericPrecision), nil)
+
+// stagingFileFormatSQL returns the FILE_FORMAT option of the stage and of the COPY loading it.
+func stagingFileFormatSQL(format protos.StagingFileFormat) string {
+	switch format {
+	case protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV:
+		// nulls are unquoted empty fields and every other value is quoted, so empty strings stay empty
+		return `FILE_FORMAT = (TYPE = CSV FIELD_OPTIONALLY_ENCLOSED_BY = '"' EMPTY_FIELD_AS_NULL = TRUE ` +
+			`NULL_IF = () ESCAPE_UNENCLOSED_FIELD = NONE)`
+	case protos.StagingFileFormat_STAGING_FILE_FORMAT_NDJSON:
+		return "FILE_FORMAT = (TYPE = JSON)"
+	case protos.StagingFileFormat_STAGING_FILE_FORMAT_PARQUET:
+		// read timestamps, dates and decimals as what they are rather than as their physical type
+		return "FILE_FORMAT = (TYPE = PARQUET USE_LOGICAL_TYPE = TRUE)"
+	default:
+		return "FILE_FORMAT = (TYPE = AVRO)"
+	}
+}
+
+// stagingFileExtension returns the extension of the files staged in the format.
+func stagingFileExtension(format protos.StagingFileFormat) string {
+	switch format {
+	case protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV:
+		return "csv"
+	case protos.StagingFileFormat_STAGING_FILE_FORMAT_NDJSON:
+		return "json"
+	case protos.StagingFileFormat_STAGING_FILE_FORMAT_PARQUET:
+		return "parquet"
+	default:
+		return "avro"
+	}
+}
+
+// stagedColumnRef returns the expression a COPY transformation reads a staged column with. CSV files
+// have no column names, their columns are staged in the order of their lower-cased names instead.
+func stagedColumnRef(format protos.StagingFileFormat, columnName string, position int) string {
+	if format == protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV {
+		return fmt.Sprintf("$%d", position)
+	}
+	return fmt.Sprintf("$1:\"%s\"", strings.ToLower(columnName))
+}
+
+// csvColumnOrder returns the names of the columns in the order they are staged in CSV files.
+func csvColumnOrder(columnNames []string) []string {
+	ordered := make([]string, len(columnNames))
+	copy(ordered, columnNames)
+	sort.Slice(ordered, func(i, j int) bool {
+		return strings.ToLower(ordered[i]) < strings.ToLower(ordered[j])
+	})
+	return ordered
+}
+
+// validateStagingSchema checks that every column of the schema can be staged in the format. As CSV
+// columns are matched by position, the staged columns of a CSV file must be exactly the columns of the
+// destination table that are loaded.
+func validateStagingSchema(format protos.StagingFileFormat, schema *model.QRecordSchema,
+	dstColumns []string) error {
+	if format == protos.StagingFileFormat_STAGING_FILE_FORMAT_AVRO {
+		return nil
+	}
+
+	for _, field := range schema.Fields {
+		switch {
+		case field.Type == qvalue.QValueKindStruct || field.Type == qvalue.QValueKindHStore:
+			return fmt.Errorf("column %s of type %s cannot be staged", field.Name, field.Type)
+		case qvalue.QValueKindIsArray(field.Type) && format != protos.StagingFileFormat_STAGING_FILE_FORMAT_NDJSON:
+			return fmt.Errorf("column %s of type %s cannot be staged as %s, use AVRO or NDJSON instead",
+				field.Name, field.Type, format)
+		}
+	}
+
+	if format != protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV {
+		return nil
+	}
+	staged := make(map[string]struct{}, len(schema.Fields))
+	for _, field := range schema.Fields {
+		staged[strings.ToLower(field.Name)] = struct{}{}
+	}
+	loaded := make(map[string]struct{}, len(dstColumns))
+	for _, column := range dstColumns {
+		if column == isDeletedColumnName {
+			continue
+		}
+		loaded[strings.ToLower(column)] = struct{}{}
+		if _, ok := staged[strings.ToLower(column)]; !ok {
+			return fmt.Errorf("destination column %s is not in the source, which CSV staging requires", column)
+		}
+	}
+	for _, field := range schema.Fields {
+		if _, ok := loaded[strings.ToLower(field.Name)]; !ok {
+			return fmt.Errorf("source column %s is not in the destination, which CSV staging requires", field.Name)
+		}
+	}
+	return nil
+}
+
+// stagedTextValue converts a value to what it is staged as in CSV and NDJSON files, following the
+// conventions of the Avro files staged for Snowflake. Timestamps and dates are staged as ISO-8601 text
+// though, as Snowflake reads an integer string as seconds, milliseconds or microseconds by its magnitude.
+func stagedTextValue(value *qvalue.QValue) (interface{}, error) {
+	if value.Value == nil {
+		return nil, nil
+	}
+
+	switch value.Kind {
+	case qvalue.QValueKindTimestamp, qvalue.QValueKindTimestampTZ, qvalue.QValueKindDate:
+		t, ok := value.Value.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s value %v", value.Kind, value.Value)
+		}
+		switch value.Kind {
+		case qvalue.QValueKindTimestamp:
+			return t.Format(stagedTimestampLayout), nil
+		case qvalue.QValueKindTimestampTZ:
+			return t.UTC().Format(stagedTimestampLayout + "Z"), nil
+		default:
+			return t.Format("2006-01-02"), nil
+		}
+	}
+
+	converted, err := qvalue.NewQValueAvroConverter(value, qvalue.QDWHTypeSnowflake, false).ToAvroValue()
+	if err != nil {
+		return nil, err
+	}
+	switch v := converted.(type) {
+	case *big.Rat:
+		return v.FloatString(stagedNumericScale), nil
+	case []byte:
+		// BINARY columns are read from hex
+		return hex.EncodeToString(v), nil
+	case float32:
+		return stagedFloat(float64(v)), nil
+	case float64:
+		return stagedFloat(v), nil
+	}
+	return converted, nil
+}
+
+// stagedFloat writes the floats JSON has no numbers for as the strings Snowflake reads them from.
+func stagedFloat(f float64) interface{} {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+	return f
+}
+
+// stagedNumeric converts a numeric to the unscaled value of a NUMBER(38, 9), rounding extra decimals.
+func stagedNumeric(num *big.Rat) (decimal128.Num, error) {
+	scaled := new(big.Rat).Mul(num, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10),
+		big.NewInt(stagedNumericScale), nil)))
+	unscaled, ok := new(big.Int).SetString(scaled.FloatString(0), 10)
+	if !ok {
+		return decimal128.Num{}, fmt.Errorf("invalid numeric %s", num.String())
+	}
+	if new(big.Int).Abs(unscaled).Cmp(maxStagedNumeric) >= 0 {
+		return decimal128.Num{}, fmt.Errorf("numeric %s does not fit in NUMBER(%d, %d)", num.FloatString(
+			stagedNumericScale), stagedNumericPrecision, stagedNumericScale)
+	}
+	return decimal128.FromBigInt(unscaled), nil
+}
+
+// stagingFileWriter writes the records of a stream to a file in one of the formats that are not Avro.
+type stagingFileWriter struct {
+	ctx    context.Context
+	stream *model.QRecordStream
+	format protos.StagingFileFormat
+}
+
+func newStagingFileWriter(ctx context.Context, stream *model.QRecordStream,
+	format protos.StagingFileFormat) *stagingFileWriter {
+	return &stagingFileWriter{
+		ctx:    ctx,
+		stream: stream,
+		format: format,
+	}
+}
+
+// write writes all records of the stream to w, returning the number of records written.
+func (s *stagingFileWriter) write(w io.Writer) (int, error) {
+	schema, err := s.stream.Schema()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get schema from stream: %w", err)
+	}
+
+	var numRows uber_atomic.Uint32
+	if s.ctx != nil {
+		shutdown := utils.HeartbeatRoutine(s.ctx, 30*time.Second, func() string {
+			return fmt.Sprintf("[%s] written %d rows to staging file", s.format, numRows.Load())
+		})
+		defer func() {
+			shutdown <- true
+		}()
+	}
+
+	switch s.format {
+	case protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV:
+		err = s.writeCSV(w, schema, &numRows)
+	case protos.StagingFileFormat_STAGING_FILE_FORMAT_NDJSON:
+		err = s.writeNDJSON(w, schema, &numRows)
+	case protos.StagingFileFormat_STAGING_FILE_FORMAT_PARQUET:
+		err = s.writeParquet(w, schema, &numRows)
+	default:
+		err = fmt.Errorf("unsupported staging file format: %s", s.format)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int(numRows.Load()), nil
+}
+
+func (s *stagingFileWriter) writeCSV(w io.Writer, schema *model.QRecordSchema, numRows *uber_atomic.Uint32) error {
+	colNames := schema.GetColumnNames()
+	colIndexes := make(map[string]int, len(colNames))
+	for i, colName := range colNames {
+		colIndexes[colName] = i
+	}
+	orderedColNames := csvColumnOrder(colNames)
+
+	bw := bufio.NewWriter(w)
+	fields := make([]string, len(orderedColNames))
+	for qRecordOrErr := range s.stream.Records {
+		if qRecordOrErr.Err != nil {
+			return fmt.Errorf("failed to get record from stream: %w", qRecordOrErr.Err)
+		}
+		for i, colName := range orderedColNames {
+			value, err := stagedTextValue(&qRecordOrErr.Record.Entries[colIndexes[colName]])
+			if err != nil {
+				return fmt.Errorf("failed to convert column %s: %w", colName, err)
+			}
+			if value == nil {
+				fields[i] = ""
+				continue
+			}
+			text, ok := value.(string)
+			if !ok {
+				text = fmt.Sprint(value)
+			}
+			fields[i] = `"` + strings.ReplaceAll(text, `"`, `""`) + `"`
+		}
+		if _, err := bw.WriteString(strings.Join(fields, ",") + "\n"); err != nil {
+			return fmt.Errorf("failed to write record to CSV: %w", err)
+		}
+		numRows.Inc()
+	}
+	return bw.Flush()
+}
+
+func (s *stagingFileWriter) writeNDJSON(w io.Writer, schema *model.QRecordSchema,
+	numRows *uber_atomic.Uint32) error {
+	colNames := schema.GetColumnNames()
+
+	bw := bufio.NewWriter(w)
+	encoder := json.NewEncoder(bw)
+	encoder.SetEscapeHTML(false)
+	for qRecordOrErr := range s.stream.Records {
+		if qRecordOrErr.Err != nil {
+			return fmt.Errorf("failed to get record from stream: %w", qRecordOrErr.Err)
+		}
+		row := make(map[string]interface{}, len(colNames))
+		for i, colName := range colNames {
+			value, err := stagedTextValue(&qRecordOrErr.Record.Entries[i])
+			if err != nil {
+				return fmt.Errorf("failed to convert column %s: %w", colName, err)
+			}
+			row[colName] = value
+		}
+		if err := encoder.Encode(row); err != nil {
+			return fmt.Errorf("failed to write record to NDJSON: %w", err)
+		}
+		numRows.Inc()
+	}
+	return bw.Flush()
+}
+
+// parquetType returns the Arrow type a column is staged as in Parquet files.
+func parquetType(kind qvalue.QValueKind) (arrow.DataType, error) {
+	switch kind {
+	case qvalue.QValueKindInt16, qvalue.QValueKindInt32, qvalue.QValueKindInt64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case qvalue.QValueKindFloat32:
+		return arrow.PrimitiveTypes.Float32, nil
+	case qvalue.QValueKindFloat64:
+		return arrow.PrimitiveTypes.Float64, nil
+	case qvalue.QValueKindBoolean:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case qvalue.QValueKindTimestamp:
+		return &arrow.TimestampType{Unit: arrow.Microsecond}, nil
+	case qvalue.QValueKindTimestampTZ:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}, nil
+	case qvalue.QValueKindDate:
+		return arrow.FixedWidthTypes.Date32, nil
+	case qvalue.QValueKindNumeric:
+		return &arrow.Decimal128Type{Precision: stagedNumericPrecision, Scale: stagedNumericScale}, nil
+	case qvalue.QValueKindBytes, qvalue.QValueKindBit:
+		return arrow.BinaryTypes.Binary, nil
+	case qvalue.QValueKindString, qvalue.QValueKindJSON, qvalue.QValueKindUUID, qvalue.QValueKindTime,
+		qvalue.QValueKindTimeTZ, qvalue.QValueKindGeography, qvalue.QValueKindGeometry, qvalue.QValueKindPoint,
+		qvalue.QValueKindInvalid:
+		return arrow.BinaryTypes.String, nil
+	default:
+		return nil, fmt.Errorf("unsupported QValueKind for Parquet: %s", kind)
+	}
+}
+
+func appendParquetValue(builder array.Builder, value *qvalue.QValue) error {
+	if value.Value == nil {
+		builder.AppendNull()
+		return nil
+	}
+
+	switch b := builder.(type) {
+	case *array.Int64Builder:
+		switch v := value.Value.(type) {
+		case int16:
+			b.Append(int64(v))
+		case int32:
+			b.Append(int64(v))
+		case int64:
+			b.Append(v)
+		default:
+			return fmt.Errorf("invalid integer value %v", value.Value)
+		}
+	case *array.Float32Builder:
+		v, ok := value.Value.(float32)
+		if !ok {
+			return fmt.Errorf("invalid float32 value %v", value.Value)
+		}
+		b.Append(v)
+	case *array.Float64Builder:
+		switch v := value.Value.(type) {
+		case float32:
+			b.Append(float64(v))
+		case float64:
+			b.Append(v)
+		default:
+			return fmt.Errorf("invalid float64 value %v", value.Value)
+		}
+	case *array.BooleanBuilder:
+		v, ok := value.Value.(bool)
+		if !ok {
+			return fmt.Errorf("invalid boolean value %v", value.Value)
+		}
+		b.Append(v)
+	case *array.TimestampBuilder:
+		t, ok := value.Value.(time.Time)
+		if !ok {
+			return fmt.Errorf("invalid timestamp value %v", value.Value)
+		}
+		b.Append(arrow.Timestamp(t.UnixMicro()))
+	case *array.Date32Builder:
+		t, ok := value.Value.(time.Time)
+		if !ok {
+			return fmt.Errorf("invalid date value %v", value.Value)
+		}
+		b.Append(arrow.Date32FromTime(t))
+	case *array.Decimal128Builder:
+		num, ok := value.Value.(*big.Rat)
+		if !ok {
+			return fmt.Errorf("invalid numeric value: expected *big.Rat, got %T", value.Value)
+		}
+		unscaled, err := stagedNumeric(num)
+		if err != nil {
+			return err
+		}
+		b.Append(unscaled)
+	case *array.BinaryBuilder:
+		v, ok := value.Value.([]byte)
+		if !ok {
+			return fmt.Errorf("invalid bytes value %v", value.Value)
+		}
+		b.Append(v)
+	case *array.StringBuilder:
+		v, err := stagedTextValue(value)
+		if err != nil {
+			return err
+		}
+		text, ok := v.(string)
+		if !ok {
+			text = fmt.Sprint(v)
+		}
+		b.Append(text)
+	default:
+		return fmt.Errorf("unsupported Arrow builder %T", builder)
+	}
+	return nil
+}
+
+func (s *stagingFileWriter) writeParquet(w io.Writer, schema *model.QRecordSchema,
+	numRows *uber_atomic.Uint32) error {
+	fields := make([]arrow.Field, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		dataType, err := parquetType(field.Type)
+		if err != nil {
+			return fmt.Errorf("failed to stage column %s: %w", field.Name, err)
+		}
+		fields = append(fields, arrow.Field{Name: field.Name, Type: dataType, Nullable: true})
+	}
+	arrowSchema := arrow.NewSchema(fields, nil)
+
+	// the file writer closes a writer it is given once closed, hiding Close keeps it from closing the
+	// pipe to S3 before the error of a failed write is passed on.
+	fileWriter, err := pqarrow.NewFileWriter(arrowSchema, struct{ io.Writer }{w}, parquet.NewWriterProperties(),
+		pqarrow.DefaultWriterProps())
+	if err != nil {
+		return fmt.Errorf("failed to create Parquet writer: %w", err)
+	}
+	// closing is a no-op once the file is written, it only releases the writer when a write fails.
+	defer fileWriter.Close()
+	recordBuilder := array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema)
+	defer recordBuilder.Release()
+
+	flush := func() error {
+		record := recordBuilder.NewRecord()
+		defer record.Release()
+		if record.NumRows() == 0 {
+			return nil
+		}
+		return fileWriter.Write(record)
+	}
+
+	buffered := 0
+	for qRecordOrErr := range s.stream.Records {
+		if qRecordOrErr.Err != nil {
+			return fmt.Errorf("failed to get record from stream: %w", qRecordOrErr.Err)
+		}
+		for i, field := range schema.Fields {
+			if err := appendParquetValue(recordBuilder.Field(i), &qRecordOrErr.Record.Entries[i]); err != nil {
+				return fmt.Errorf("failed to convert column %s: %w", field.Name, err)
+			}
+		}
+		numRows.Inc()
+		buffered++
+		if buffered == parquetRowGroupSize {
+			if err := flush(); err != nil {
+				return fmt.Errorf("failed to write records to Parquet: %w", err)
+			}
+			buffered = 0
+		}
+	}
+	if err := flush(); err != nil {
+		return fmt.Errorf("failed to write records to Parquet: %w", err)
+	}
+	return fileWriter.Close()
+}
+
+// writeToS3 streams the staging file to the given key of the bucket.
+func (s *stagingFileWriter) writeToS3(bucketName, key string) (int, error) {
+	s3svc, err := utils.CreateS3Client(utils.S3PeerCredentials{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	r, w := io.Pipe()
+	numRowsWritten := make(chan int, 1)
+	go func() {
+		numRows, err := s.write(w)
+		w.CloseWithError(err)
+		numRowsWritten <- numRows
+	}()
+
+	result, err := s3manager.NewUploaderWithClient(s3svc).Upload(&s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+		Body:   r,
+	})
+	if err != nil {
+		r.CloseWithError(err)
+		return 0, fmt.Errorf("failed to upload file: %w", err)
+	}
+	log.Infof("file uploaded to, %s", result.Location)
+
+	return <-numRowsWritten, nil
+}
@@ -0,0 +1,212 @@
+package connsnowflake
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// before 1971, where Snowflake would read microseconds since the epoch as seconds
+	stagedCreatedAt = time.Date(1969, 7, 20, 20, 17, 40, 123456000, time.UTC)
+	// quotes, a comma and a line break, all of which CSV has to escape
+	stagedDoc = `{"note": "a, \"quoted\"` + "\n" + `text"}`
+)
+
+var stagedSchema = model.NewQRecordSchema([]*model.QField{
+	{Name: "id", Type: qvalue.QValueKindInt64},
+	{Name: "amount", Type: qvalue.QValueKindNumeric, Nullable: true},
+	{Name: "created_at", Type: qvalue.QValueKindTimestamp, Nullable: true},
+	{Name: "doc", Type: qvalue.QValueKindJSON, Nullable: true},
+})
+
+// stagedRecords returns a stream of a row with a value in every column and a row of nulls.
+func stagedRecords(t *testing.T) *model.QRecordStream {
+	t.Helper()
+
+	stream := model.NewQRecordStream(2)
+	require.NoError(t, stream.SetSchema(stagedSchema))
+	stream.Records <- &model.QRecordOrError{Record: &model.QRecord{NumEntries: 4, Entries: []qvalue.QValue{
+		{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+		{Kind: qvalue.QValueKindNumeric, Value: big.NewRat(123456, 1000)},
+		{Kind: qvalue.QValueKindTimestamp, Value: stagedCreatedAt},
+		{Kind: qvalue.QValueKindJSON, Value: stagedDoc},
+	}}}
+	stream.Records <- &model.QRecordOrError{Record: &model.QRecord{NumEntries: 4, Entries: []qvalue.QValue{
+		{Kind: qvalue.QValueKindInt64, Value: int64(2)},
+		{Kind: qvalue.QValueKindNumeric},
+		{Kind: qvalue.QValueKindTimestamp},
+		{Kind: qvalue.QValueKindJSON},
+	}}}
+	close(stream.Records)
+	return stream
+}
+
+func writeStagedRecords(t *testing.T, format protos.StagingFileFormat) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	numRecords, err := newStagingFileWriter(nil, stagedRecords(t), format).write(&buf)
+	require.NoError(t, err)
+	require.Equal(t, 2, numRecords)
+	return buf.Bytes()
+}
+
+func TestStagingRoundTrip_CSV(t *testing.T) {
+	staged := writeStagedRecords(t, protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV)
+
+	rows, err := csv.NewReader(bytes.NewReader(staged)).ReadAll()
+	require.NoError(t, err)
+	// columns are staged in the order of their names
+	require.Equal(t, [][]string{
+		{"123.456000000", "1969-07-20T20:17:40.123456", stagedDoc, "1"},
+		{"", "", "", "2"},
+	}, rows)
+	// nulls are left unquoted, which tells them apart from empty strings
+	require.Contains(t, string(staged), "\n,,,\"2\"\n")
+
+	copyInfo := generateCopyTransformation(map[string]string{
+		"ID": "NUMBER", "AMOUNT": "NUMBER", "CREATED_AT": "TIMESTAMP_NTZ", "DOC": "VARIANT",
+		isDeletedColumnName: "BOOLEAN",
+	}, protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV)
+	require.Equal(t, `"AMOUNT","CREATED_AT","DOC","ID"`, copyInfo.columnsSQL)
+	require.Equal(t, `$1 AS "AMOUNT",($2)::TIMESTAMP_NTZ AS "CREATED_AT",($3)::VARIANT AS "DOC",$4 AS "ID"`,
+		copyInfo.transformationSQL)
+}
+
+func TestStagingRoundTrip_NDJSON(t *testing.T) {
+	staged := writeStagedRecords(t, protos.StagingFileFormat_STAGING_FILE_FORMAT_NDJSON)
+
+	rows := make([]map[string]interface{}, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(staged))
+	for scanner.Scan() {
+		decoder := json.NewDecoder(bytes.NewReader(scanner.Bytes()))
+		decoder.UseNumber()
+		var row map[string]interface{}
+		require.NoError(t, decoder.Decode(&row))
+		rows = append(rows, row)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []map[string]interface{}{
+		{
+			"id":         json.Number("1"),
+			"amount":     "123.456000000",
+			"created_at": "1969-07-20T20:17:40.123456",
+			"doc":        stagedDoc,
+		},
+		{"id": json.Number("2"), "amount": nil, "created_at": nil, "doc": nil},
+	}, rows)
+
+	copyInfo := generateCopyTransformation(map[string]string{"ID": "NUMBER", "CREATED_AT": "TIMESTAMP_NTZ"},
+		protos.StagingFileFormat_STAGING_FILE_FORMAT_NDJSON)
+	require.Equal(t, `($1:"created_at")::TIMESTAMP_NTZ AS "CREATED_AT",$1:"id" AS "ID"`, copyInfo.transformationSQL)
+}
+
+func TestStagingRoundTrip_Parquet(t *testing.T) {
+	staged := writeStagedRecords(t, protos.StagingFileFormat_STAGING_FILE_FORMAT_PARQUET)
+
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(staged),
+		parquet.NewReaderProperties(memory.DefaultAllocator), pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	defer table.Release()
+	require.Equal(t, int64(2), table.NumRows())
+
+	ids := table.Column(0).Data().Chunk(0).(*array.Int64)
+	require.Equal(t, []int64{1, 2}, ids.Int64Values())
+
+	amounts := table.Column(1).Data().Chunk(0).(*array.Decimal128)
+	require.Equal(t, &arrow.Decimal128Type{Precision: 38, Scale: 9}, amounts.DataType())
+	require.Equal(t, "123.456000000", amounts.Value(0).ToString(stagedNumericScale))
+	require.True(t, amounts.IsNull(1))
+
+	createdAts := table.Column(2).Data().Chunk(0).(*array.Timestamp)
+	require.Equal(t, arrow.Microsecond, createdAts.DataType().(*arrow.TimestampType).Unit)
+	require.Equal(t, arrow.Timestamp(stagedCreatedAt.UnixMicro()), createdAts.Value(0))
+	require.True(t, createdAts.IsNull(1))
+
+	docs := table.Column(3).Data().Chunk(0).(*array.String)
+	require.Equal(t, stagedDoc, docs.Value(0))
+	require.True(t, docs.IsNull(1))
+}
+
+func TestStagingNumericOutOfRange(t *testing.T) {
+	_, err := stagedNumeric(new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)))
+	require.ErrorContains(t, err, "does not fit in NUMBER(38, 9)")
+
+	num, err := stagedNumeric(big.NewRat(-1, 3))
+	require.NoError(t, err)
+	require.Equal(t, "-0.333333333", num.ToString(stagedNumericScale))
+}
+
+func TestValidateStagingSchema(t *testing.T) {
+	arraySchema := model.NewQRecordSchema([]*model.QField{
+		{Name: "id", Type: qvalue.QValueKindInt64},
+		{Name: "tags", Type: qvalue.QValueKindArrayString},
+	})
+	require.NoError(t, validateStagingSchema(protos.StagingFileFormat_STAGING_FILE_FORMAT_AVRO, arraySchema, nil))
+	require.NoError(t, validateStagingSchema(protos.StagingFileFormat_STAGING_FILE_FORMAT_NDJSON, arraySchema, nil))
+	require.ErrorContains(t, validateStagingSchema(protos.StagingFileFormat_STAGING_FILE_FORMAT_PARQUET,
+		arraySchema, nil), "column tags of type array_string cannot be staged as STAGING_FILE_FORMAT_PARQUET")
+
+	dstColumns := []string{"ID", "AMOUNT", "CREATED_AT", "DOC", isDeletedColumnName}
+	require.NoError(t, validateStagingSchema(protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV,
+		stagedSchema, dstColumns))
+	require.ErrorContains(t, validateStagingSchema(protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV,
+		stagedSchema, append(dstColumns, "EXTRA")), "destination column EXTRA is not in the source")
+	require.ErrorContains(t, validateStagingSchema(protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV,
+		stagedSchema, dstColumns[1:]), "source column id is not in the destination")
+}
+
+func TestGenerateCopyTransformation_StagingFormats(t *testing.T) {
+	columnMap := map[string]string{
+		"ID":                "NUMBER",
+		"CREATED_AT":        "TIMESTAMP_NTZ",
+		"DOC":               "VARIANT",
+		isDeletedColumnName: "BOOLEAN",
+	}
+	for format, expected := range map[protos.StagingFileFormat]struct {
+		fileFormat     string
+		transformation string
+	}{
+		protos.StagingFileFormat_STAGING_FILE_FORMAT_AVRO: {
+			fileFormat: "FILE_FORMAT = (TYPE = AVRO)",
+			transformation: `($1:"created_at")::TIMESTAMP_NTZ AS "CREATED_AT",($1:"doc")::VARIANT AS "DOC",` +
+				`$1:"id" AS "ID"`,
+		},
+		protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV: {
+			fileFormat: `FILE_FORMAT = (TYPE = CSV FIELD_OPTIONALLY_ENCLOSED_BY = '"' EMPTY_FIELD_AS_NULL = TRUE ` +
+				`NULL_IF = () ESCAPE_UNENCLOSED_FIELD = NONE)`,
+			transformation: `($1)::TIMESTAMP_NTZ AS "CREATED_AT",($2)::VARIANT AS "DOC",$3 AS "ID"`,
+		},
+		protos.StagingFileFormat_STAGING_FILE_FORMAT_NDJSON: {
+			fileFormat: "FILE_FORMAT = (TYPE = JSON)",
+			transformation: `($1:"created_at")::TIMESTAMP_NTZ AS "CREATED_AT",($1:"doc")::VARIANT AS "DOC",` +
+				`$1:"id" AS "ID"`,
+		},
+		protos.StagingFileFormat_STAGING_FILE_FORMAT_PARQUET: {
+			fileFormat: "FILE_FORMAT = (TYPE = PARQUET USE_LOGICAL_TYPE = TRUE)",
+			transformation: `($1:"created_at")::TIMESTAMP_NTZ AS "CREATED_AT",($1:"doc")::VARIANT AS "DOC",` +
+				`$1:"id" AS "ID"`,
+		},
+	} {
+		require.Equal(t, expected.fileFormat, stagingFileFormatSQL(format), format.String())
+		copyInfo := generateCopyTransformation(columnMap, format)
+		require.Equal(t, `"CREATED_AT","DOC","ID"`, copyInfo.columnsSQL, format.String())
+		require.Equal(t, expected.transformation, copyInfo.transformationSQL, format.String())
+	}
+}
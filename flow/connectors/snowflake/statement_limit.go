@@ -0,0 +1,172 @@
+package connsnowflake
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// statementLimitedConnector wraps a connector so that at most cap(slots) statements run at once across
+// all connections it opens, statements beyond that wait for a running one to finish.
+// Snowflake fails statements once too many are running for a session, which parallel merges and
+// inserts can otherwise run into.
+type statementLimitedConnector struct {
+	driver.Connector
+	slots chan struct{}
+}
+
+// statementLimitedConn gates the statements run on a connection on the slots of its connector.
+// Transaction control is not gated, a transaction holds on to its connection and shouldn't wait
+// on statements run over other connections to be committed or rolled back.
+type statementLimitedConn struct {
+	driver.Conn
+	slots chan struct{}
+}
+
+type statementLimitedStmt struct {
+	driver.Stmt
+	slots chan struct{}
+}
+
+func newStatementLimitedConnector(connector driver.Connector,
+	maxConcurrentStatements uint32) *statementLimitedConnector {
+	return &statementLimitedConnector{
+		Connector: connector,
+		slots:     make(chan struct{}, maxConcurrentStatements),
+	}
+}
+
+func (c *statementLimitedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &statementLimitedConn{Conn: conn, slots: c.slots}, nil
+}
+
+// acquireStatementSlot waits for a statement slot to free up, or for the context to be done.
+func acquireStatementSlot(ctx context.Context, slots chan struct{}) error {
+	select {
+	case slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func releaseStatementSlot(slots chan struct{}) {
+	<-slots
+}
+
+func (c *statementLimitedConn) ExecContext(ctx context.Context, query string,
+	args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		// database/sql falls back to preparing the statement, which is gated as well
+		return nil, driver.ErrSkip
+	}
+	if err := acquireStatementSlot(ctx, c.slots); err != nil {
+		return nil, err
+	}
+	defer releaseStatementSlot(c.slots)
+	return execer.ExecContext(ctx, query, args)
+}
+
+func (c *statementLimitedConn) QueryContext(ctx context.Context, query string,
+	args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if err := acquireStatementSlot(ctx, c.slots); err != nil {
+		return nil, err
+	}
+	defer releaseStatementSlot(c.slots)
+	return queryer.QueryContext(ctx, query, args)
+}
+
+func (c *statementLimitedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var stmt driver.Stmt
+	var err error
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.Conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &statementLimitedStmt{Stmt: stmt, slots: c.slots}, nil
+}
+
+func (c *statementLimitedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	//nolint:staticcheck
+	return c.Conn.Begin()
+}
+
+func (c *statementLimitedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *statementLimitedConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+func (s *statementLimitedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	if err := acquireStatementSlot(ctx, s.slots); err != nil {
+		return nil, err
+	}
+	defer releaseStatementSlot(s.slots)
+	if execer, ok := s.Stmt.(driver.StmtExecContext); ok {
+		return execer.ExecContext(ctx, args)
+	}
+	//nolint:staticcheck
+	return s.Stmt.Exec(namedValuesToValues(args))
+}
+
+func (s *statementLimitedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	if err := acquireStatementSlot(ctx, s.slots); err != nil {
+		return nil, err
+	}
+	defer releaseStatementSlot(s.slots)
+	if queryer, ok := s.Stmt.(driver.StmtQueryContext); ok {
+		return queryer.QueryContext(ctx, args)
+	}
+	//nolint:staticcheck
+	return s.Stmt.Query(namedValuesToValues(args))
+}
+
+func namedValuesToValues(args []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, 0, len(args))
+	for _, arg := range args {
+		values = append(values, arg.Value)
+	}
+	return values
+}
+
+// openSnowflakeDatabase opens a database for the DSN, queueing statements beyond maxConcurrentStatements
+// if it is set.
+func openSnowflakeDatabase(dsn string, maxConcurrentStatements uint32) (*sql.DB, error) {
+	if maxConcurrentStatements == 0 {
+		return sql.Open("snowflake", dsn)
+	}
+
+	config, err := gosnowflake.ParseDSN(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Snowflake DSN: %w", err)
+	}
+	connector := gosnowflake.NewConnector(gosnowflake.SnowflakeDriver{}, *config)
+	return sql.OpenDB(newStatementLimitedConnector(connector, maxConcurrentStatements)), nil
+}
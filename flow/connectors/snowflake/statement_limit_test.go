@@ -0,0 +1,53 @@
+package connsnowflake
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementLimitedConnectorQueuesStatements(t *testing.T) {
+	w := &fakeWarehouse{statementLimit: 2, statementDuration: 10 * time.Millisecond}
+	database := sql.OpenDB(newStatementLimitedConnector(w, 2))
+	defer database.Close()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := database.ExecContext(context.Background(), "MERGE INTO T USING S ON T.ID = S.ID")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.LessOrEqual(t, w.maxRunningStatements.Load(), int64(2))
+	require.Greater(t, w.maxRunningStatements.Load(), int64(0))
+}
+
+func TestStatementLimitedConnectorWaitRespectsContext(t *testing.T) {
+	w := &fakeWarehouse{statementLimit: 1}
+	limited := newStatementLimitedConnector(w, 1)
+	database := sql.OpenDB(limited)
+	defer database.Close()
+
+	// occupy the only slot, as a long running statement would
+	limited.slots <- struct{}{}
+	defer func() { <-limited.slots }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := database.ExecContext(ctx, "MERGE INTO T USING S ON T.ID = S.ID")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, int64(0), w.maxRunningStatements.Load())
+}
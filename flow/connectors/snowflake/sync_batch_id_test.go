@@ -0,0 +1,105 @@
+package connsnowflake
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+// syncConcurrently runs a sync of the same batch of records on each of two workers at once, both
+// reading the sync batch ID before either records its batch.
+func syncConcurrently(t *testing.T, w *fakeWarehouse, guardSyncBatchID bool) ([]*model.SyncResponse, []error) {
+	t.Helper()
+
+	w.rawInsertBarrier = &sync.WaitGroup{}
+	w.rawInsertBarrier.Add(2)
+	responses := make([]*model.SyncResponse, 2)
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for worker := 0; worker < 2; worker++ {
+		worker := worker
+		c := newFakeWarehouseConnector(w)
+		defer c.database.Close()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[worker], errs[worker] = c.SyncRecords(&model.SyncRecordsRequest{
+				FlowJobName: "test",
+				SyncMode:    protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT,
+				Records: &model.RecordBatch{
+					Records: []model.Record{&model.InsertRecord{
+						DestinationTableName: "PUBLIC.T",
+						CheckPointID:         10,
+						Items: model.NewRecordItemWithData([]string{"ID"}, []*qvalue.QValue{
+							{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+						}),
+					}},
+					LastCheckPointID: 10,
+				},
+				GuardSyncBatchID: guardSyncBatchID,
+			})
+		}()
+	}
+	wg.Wait()
+	return responses, errs
+}
+
+func TestConcurrentSyncsOfMirrorDoNotShareBatchID(t *testing.T) {
+	w := &fakeWarehouse{syncBatchID: 1, rawRows: make(map[int64]int)}
+	responses, errs := syncConcurrently(t, w, true)
+
+	// one sync records batch 2, the other fails without recording anything
+	winner, loser := 0, 1
+	if errs[0] != nil {
+		winner, loser = 1, 0
+	}
+	require.NoError(t, errs[winner])
+	require.Equal(t, int64(2), responses[winner].CurrentSyncBatchID)
+	require.ErrorIs(t, errs[loser], model.ErrSyncBatchIDConflict)
+	require.Equal(t, int64(2), w.syncBatchID)
+	require.Equal(t, map[int64]int{2: 1}, w.rawRows)
+
+	// without the guard both record batch 2, loading its records twice
+	w = &fakeWarehouse{syncBatchID: 1, rawRows: make(map[int64]int)}
+	responses, errs = syncConcurrently(t, w, false)
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.Equal(t, responses[0].CurrentSyncBatchID, responses[1].CurrentSyncBatchID)
+	require.Equal(t, map[int64]int{2: 2}, w.rawRows)
+}
+
+func TestGuardedSyncRejectsRawRowsLoadedOutsideTransaction(t *testing.T) {
+	// the staged file of an avro sync and the rows of idempotent staged, parallel or oversized record
+	// inserts land in the batch before the guard could fail the sync, so the guard is refused before
+	// anything is loaded.
+	for _, req := range []*model.SyncRecordsRequest{
+		{SyncMode: protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO},
+		{SyncMode: protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT, IdempotentStagedInserts: true},
+		{SyncMode: protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT, ParallelRawInserts: 4},
+		{SyncMode: protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT, MaxInlineRecordSize: 1},
+	} {
+		w := &fakeWarehouse{syncBatchID: 1, rawRows: map[int64]int{2: 1}}
+		c := newFakeWarehouseConnector(w)
+		defer c.database.Close()
+		req.FlowJobName = "test"
+		req.GuardSyncBatchID = true
+		req.Records = &model.RecordBatch{
+			Records: []model.Record{&model.InsertRecord{
+				DestinationTableName: "PUBLIC.T",
+				CheckPointID:         10,
+				Items: model.NewRecordItemWithData([]string{"ID"}, []*qvalue.QValue{
+					{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+				}),
+			}},
+			LastCheckPointID: 10,
+		}
+		_, err := c.SyncRecords(req)
+		require.ErrorContains(t, err, "cannot guard the sync batch id")
+		require.Equal(t, int64(1), w.syncBatchID)
+		require.Equal(t, map[int64]int{2: 1}, w.rawRows)
+	}
+}
@@ -0,0 +1,27 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSnowflakeIdentifier(t *testing.T) {
+	require.Equal(t, "PUBLIC", normalizeSnowflakeIdentifier("public"))
+	require.Equal(t, "MixedCase", normalizeSnowflakeIdentifier(`"MixedCase"`))
+	require.Equal(t, `with"quote`, normalizeSnowflakeIdentifier(`"with""quote"`))
+}
+
+func TestCheckIfTableExistsQuotedMixedCaseTable(t *testing.T) {
+	// a table created as public."MixedCase" is stored as PUBLIC.MixedCase
+	components, err := parseTableName(`public."MixedCase"`)
+	require.NoError(t, err)
+	query, args := generateCheckIfTableExistsQuery("", components.schemaIdentifier, components.tableIdentifier)
+	require.Equal(t, checkIfTableExistsSQL, query)
+	require.Equal(t, []interface{}{"PUBLIC", "MixedCase"}, args)
+
+	query, args = generateCheckIfTableExistsQuery("peerdb_db", components.schemaIdentifier,
+		components.tableIdentifier)
+	require.Equal(t, checkIfTableExistsInDatabaseSQL, query)
+	require.Equal(t, []interface{}{"PEERDB_DB", "PUBLIC", "MixedCase"}, args)
+}
@@ -0,0 +1,47 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTableName(t *testing.T) {
+	testCases := []struct {
+		tableName string
+		schema    string
+		table     string
+	}{
+		{"public.users", "PUBLIC", "USERS"},
+		{"Public.MixedCase", "PUBLIC", "MIXEDCASE"},
+		{`"MySchema".users`, "MySchema", "USERS"},
+		{`public."MixedCase"`, "PUBLIC", "MixedCase"},
+		{`"my.schema".tbl`, "my.schema", "TBL"},
+		{`"my.schema"."my.table"`, "my.schema", "my.table"},
+		{`"with""quote".tbl`, `with"quote`, "TBL"},
+		{`"with"".dot".tbl`, `with".dot`, "TBL"},
+	}
+	for _, tc := range testCases {
+		components, err := parseTableName(tc.tableName)
+		require.NoError(t, err, tc.tableName)
+		require.Equal(t, tc.schema, components.schemaIdentifier, tc.tableName)
+		require.Equal(t, tc.table, components.tableIdentifier, tc.tableName)
+	}
+}
+
+func TestParseInvalidTableName(t *testing.T) {
+	for _, tableName := range []string{
+		"users",
+		"db.public.users",
+		`"my.schema"`,
+		`"unterminated.tbl`,
+		`"quoted"suffix.tbl`,
+		`pre"quoted".tbl`,
+		".tbl",
+		`"".tbl`,
+		"public.",
+	} {
+		_, err := parseTableName(tableName)
+		require.Error(t, err, tableName)
+	}
+}
@@ -0,0 +1,60 @@
+package connsnowflake
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// tagNameRe matches tag names, which may be qualified by their database and schema.
+var tagNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*(\.[A-Za-z_][A-Za-z0-9_$]*){0,2}$`)
+
+// sqlExecer runs statements on either the database or a transaction.
+type sqlExecer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// generateSetTagsSQL builds the statement setting tags on a table, tableKind is the kind of table
+// in the ALTER statement (TABLE or DYNAMIC TABLE). Returns an empty statement if there are no tags.
+func generateSetTagsSQL(tableKind string, tableIdentifier string, tags map[string]string) (string, error) {
+	if len(tags) == 0 {
+		return "", nil
+	}
+
+	// sorted so that the statement is the same for the same tags
+	tagNames := make([]string, 0, len(tags))
+	for tagName := range tags {
+		if !tagNameRe.MatchString(tagName) {
+			return "", fmt.Errorf("invalid tag name %s", tagName)
+		}
+		tagNames = append(tagNames, tagName)
+	}
+	sort.Strings(tagNames)
+
+	tagAssignments := make([]string, 0, len(tagNames))
+	for _, tagName := range tagNames {
+		tagAssignments = append(tagAssignments, fmt.Sprintf("%s = '%s'", tagName,
+			strings.ReplaceAll(tags[tagName], "'", "''")))
+	}
+	return fmt.Sprintf("ALTER %s %s SET TAG %s", tableKind, tableIdentifier,
+		strings.Join(tagAssignments, ", ")), nil
+}
+
+// setTags sets the tags configured for the mirror on one of its tables.
+func (c *SnowflakeConnector) setTags(execer sqlExecer, tableKind string, tableIdentifier string,
+	tags map[string]string) error {
+	setTagsSQL, err := generateSetTagsSQL(tableKind, tableIdentifier, tags)
+	if err != nil || setTagsSQL == "" {
+		return err
+	}
+
+	_, err = execer.ExecContext(c.ctx, setTagsSQL)
+	if err != nil {
+		return fmt.Errorf("unable to set tags on table %s, this needs the APPLY privilege on the tags: %w",
+			tableIdentifier, err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package connsnowflake
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSetTagsSQL(t *testing.T) {
+	setTagsSQL, err := generateSetTagsSQL("TABLE", "PUBLIC.TEST", map[string]string{
+		"GOVERNANCE.TAGS.TEAM": "data's platform",
+		"COST_CENTER":          "1234",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ALTER TABLE PUBLIC.TEST SET TAG COST_CENTER = '1234', "+
+		"GOVERNANCE.TAGS.TEAM = 'data''s platform'", setTagsSQL)
+
+	setTagsSQL, err = generateSetTagsSQL("DYNAMIC TABLE", "PUBLIC.TEST", map[string]string{"MIRROR": "m1"})
+	require.NoError(t, err)
+	require.Equal(t, "ALTER DYNAMIC TABLE PUBLIC.TEST SET TAG MIRROR = 'm1'", setTagsSQL)
+
+	// tagging is optional, nothing is set without tags
+	setTagsSQL, err = generateSetTagsSQL("TABLE", "PUBLIC.TEST", nil)
+	require.NoError(t, err)
+	require.Empty(t, setTagsSQL)
+
+	_, err = generateSetTagsSQL("TABLE", "PUBLIC.TEST", map[string]string{"TEAM = 'x'; DROP TABLE T; --": "y"})
+	require.Error(t, err)
+}
+
+func TestSetupNormalizedTablesTagsExistingTable(t *testing.T) {
+	w := &fakeWarehouse{}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+
+	// the table exists already, as for a mirror created again
+	res, err := c.SetupNormalizedTables(&protos.SetupNormalizedTableBatchInput{
+		FlowJobName: "test",
+		TableNameSchemaMapping: map[string]*protos.TableSchema{
+			"PUBLIC.T": {
+				TableIdentifier:   "PUBLIC.T",
+				Columns:           map[string]string{"ID": string(qvalue.QValueKindInt64)},
+				PrimaryKeyColumns: []string{"ID"},
+			},
+		},
+		Tags: map[string]string{"MIRROR": "m1"},
+	})
+	require.NoError(t, err)
+	require.True(t, res.TableExistsMapping["PUBLIC.T"])
+	require.Equal(t, []string{"ALTER TABLE PUBLIC.T SET TAG MIRROR = 'm1'"}, w.tagStatements)
+}
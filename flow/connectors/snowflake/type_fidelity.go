@@ -0,0 +1,206 @@
+package connsnowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	util "github.com/PeerDB-io/peer-flow/utils"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+)
+
+// typeFidelityIDColumnName is the primary key of the table a type fidelity test writes to,
+// so that the test doesn't depend on the primary key of the tested table.
+const typeFidelityIDColumnName = "_PEERDB_TYPE_FIDELITY_ID"
+
+// typeFidelityReadSQL reads a column back as text, in the format utils.CheckTypeFidelity expects.
+func typeFidelityReadSQL(columnName string, kind qvalue.QValueKind) string {
+	column := fmt.Sprintf(`"%s"`, strings.ToUpper(columnName))
+	switch kind {
+	case qvalue.QValueKindTimestamp:
+		return fmt.Sprintf("TO_VARCHAR(%s, 'YYYY-MM-DD HH24:MI:SS.FF6')", column)
+	case qvalue.QValueKindTimestampTZ:
+		return fmt.Sprintf("TO_VARCHAR(CONVERT_TIMEZONE('UTC', %s), 'YYYY-MM-DD HH24:MI:SS.FF6')", column)
+	case qvalue.QValueKindDate:
+		return fmt.Sprintf("TO_VARCHAR(%s, 'YYYY-MM-DD')", column)
+	case qvalue.QValueKindTime, qvalue.QValueKindTimeTZ:
+		return fmt.Sprintf("TO_VARCHAR(%s, 'HH24:MI:SS.FF6')", column)
+	case qvalue.QValueKindBytes, qvalue.QValueKindBit:
+		return fmt.Sprintf("TO_VARCHAR(%s, 'HEX')", column)
+	case qvalue.QValueKindJSON, qvalue.QValueKindArrayFloat32, qvalue.QValueKindArrayFloat64,
+		qvalue.QValueKindArrayInt32, qvalue.QValueKindArrayInt64, qvalue.QValueKindArrayString,
+		qvalue.QValueKindArrayUUID:
+		return fmt.Sprintf("TO_JSON(%s)", column)
+	default:
+		return fmt.Sprintf("TO_VARCHAR(%s)", column)
+	}
+}
+
+// TestTypeFidelity writes a value of each column type of the table through the sync and normalize
+// path into a temporary table and reads it back, reporting whether each value survived the round trip.
+// The options of the mirror that change how values are stored are applied: the sync mode, raw data as
+// VARIANT, special float values, empty strings and VARIANT nulls. Options that leave values alone, such as
+// soft deletes, and options tied to the destination tables, such as column defaults, are not.
+// It replaces the table schemas the connector was initialized with.
+func (c *SnowflakeConnector) TestTypeFidelity(config *protos.FlowConnectionConfigs,
+	tableSchema *protos.TableSchema) ([]*protos.TypeFidelityResult, error) {
+	runID, err := util.RandomUInt64()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate random uint64: %w", err)
+	}
+	flowJobName := fmt.Sprintf("peerdb_type_fidelity_%d", runID)
+
+	testSchema := &protos.TableSchema{
+		TableIdentifier:   fmt.Sprintf("%s.%s", peerDBInternalSchema, strings.ToUpper(flowJobName)),
+		Columns:           map[string]string{typeFidelityIDColumnName: string(qvalue.QValueKindInt64)},
+		PrimaryKeyColumns: []string{typeFidelityIDColumnName},
+	}
+	items := model.NewRecordItems()
+	items.AddColumn(typeFidelityIDColumnName, &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)})
+
+	columnNames := maps.Keys(tableSchema.Columns)
+	sort.Strings(columnNames)
+	writtenValues := make(map[string]*qvalue.QValue, len(columnNames))
+	testedColumnNames := make([]string, 0, len(columnNames))
+	for _, columnName := range columnNames {
+		value, ok := utils.TypeFidelityValue(qvalue.QValueKind(tableSchema.Columns[columnName]))
+		if !ok {
+			continue
+		}
+		testSchema.Columns[columnName] = tableSchema.Columns[columnName]
+		items.AddColumn(columnName, value)
+		writtenValues[columnName] = value
+		testedColumnNames = append(testedColumnNames, columnName)
+	}
+
+	readValues := make(map[string]*string, len(testedColumnNames))
+	if len(testedColumnNames) > 0 {
+		readRow, err := c.roundTripTypeFidelityRow(config, flowJobName, testSchema, items, testedColumnNames)
+		if err != nil {
+			return nil, fmt.Errorf("failed to test type fidelity of table %s: %w", tableSchema.TableIdentifier, err)
+		}
+		for i, columnName := range testedColumnNames {
+			readValues[columnName] = readRow[i]
+		}
+	}
+
+	results := make([]*protos.TypeFidelityResult, 0, len(columnNames))
+	for _, columnName := range columnNames {
+		var result *protos.TypeFidelityResult
+		if writtenValue, ok := writtenValues[columnName]; ok {
+			result = utils.CheckTypeFidelity(columnName, writtenValue, readValues[columnName])
+		} else {
+			result = &protos.TypeFidelityResult{
+				ColumnName: columnName,
+				ColumnType: tableSchema.Columns[columnName],
+			}
+		}
+		result.TableIdentifier = tableSchema.TableIdentifier
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// roundTripTypeFidelityRow syncs and normalizes a single row into a transient table, returning the values
+// of the given columns read back as text, nil for NULL. The raw and normalized tables are dropped after.
+func (c *SnowflakeConnector) roundTripTypeFidelityRow(config *protos.FlowConnectionConfigs, flowJobName string,
+	testSchema *protos.TableSchema, items *model.RecordItems, columnNames []string) ([]*string, error) {
+	if c.NeedsSetupMetadataTables() {
+		err := c.SetupMetadataTables()
+		if err != nil {
+			return nil, err
+		}
+	}
+	_, err := c.CreateRawTable(&protos.CreateRawTableInput{
+		FlowJobName:      flowJobName,
+		TableNameMapping: map[string]string{testSchema.TableIdentifier: testSchema.TableIdentifier},
+		RawDataAsVariant: config.RawDataAsVariant,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := c.SyncFlowCleanup(flowJobName)
+		if err != nil {
+			log.Errorf("failed to clean up after type fidelity test %s: %v", flowJobName, err)
+		}
+	}()
+
+	_, err = c.database.ExecContext(c.ctx,
+		generateCreateTableSQLForNormalizedTable(testSchema.TableIdentifier, testSchema, true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create table %s: %w", testSchema.TableIdentifier, err)
+	}
+	defer func() {
+		_, err := c.database.ExecContext(c.ctx,
+			fmt.Sprintf(dropTableIfExistsSQL, peerDBInternalSchema, strings.ToUpper(flowJobName)))
+		if err != nil {
+			log.Errorf("failed to drop table %s: %v", testSchema.TableIdentifier, err)
+		}
+	}()
+
+	err = c.InitializeTableSchema(map[string]*protos.TableSchema{testSchema.TableIdentifier: testSchema})
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.SyncRecords(&model.SyncRecordsRequest{
+		Records: &model.RecordBatch{
+			Records: []model.Record{&model.InsertRecord{
+				SourceTableName:      testSchema.TableIdentifier,
+				DestinationTableName: testSchema.TableIdentifier,
+				CheckPointID:         1,
+				Items:                items,
+			}},
+		},
+		FlowJobName:            flowJobName,
+		SyncMode:               config.CdcSyncMode,
+		StagingPath:            config.CdcStagingPath,
+		NullFloatSpecialValues: config.NullFloatSpecialValues,
+		EmptyStringsAsNull:     config.EmptyStringsAsNull,
+		RawDataAsVariant:       config.RawDataAsVariant,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.NormalizeRecords(&model.NormalizeRecordsRequest{
+		FlowJobName:        flowJobName,
+		RawDataAsVariant:   config.RawDataAsVariant,
+		DedupNullsOrdering: config.DedupNullsOrdering,
+		VariantNullPolicy:  config.VariantNullPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	readSQLs := make([]string, 0, len(columnNames))
+	for _, columnName := range columnNames {
+		readSQLs = append(readSQLs, typeFidelityReadSQL(columnName, qvalue.QValueKind(testSchema.Columns[columnName])))
+	}
+	readValues := make([]sql.NullString, len(columnNames))
+	scanDest := make([]interface{}, len(columnNames))
+	for i := range readValues {
+		scanDest[i] = &readValues[i]
+	}
+	//nolint:gosec
+	err = c.database.QueryRowContext(c.ctx, fmt.Sprintf("SELECT %s FROM %s",
+		strings.Join(readSQLs, ","), testSchema.TableIdentifier)).Scan(scanDest...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read back row: %w", err)
+	}
+
+	readRow := make([]*string, 0, len(readValues))
+	for i := range readValues {
+		if readValues[i].Valid {
+			readRow = append(readRow, &readValues[i].String)
+		} else {
+			readRow = append(readRow, nil)
+		}
+	}
+	return readRow, nil
+}
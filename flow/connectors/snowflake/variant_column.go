@@ -0,0 +1,31 @@
+package connsnowflake
+
+import (
+	"strings"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+// variantColumnName returns the alias of the raw data VARIANT in the statements normalizing a table.
+// It is toVariantColumnName, unless the table has a column of that name: the columns flattened out of
+// the VARIANT are aliased to their names, and Snowflake resolves later references to the VARIANT in the
+// same select to the flattened column instead. The alias is then prefixed with underscores until no
+// column of the table has it.
+func variantColumnName(tableSchema *protos.TableSchema) string {
+	name := toVariantColumnName
+	for hasColumnNamed(tableSchema, name) {
+		name = "_" + name
+	}
+	return name
+}
+
+// hasColumnNamed returns true if the table has a column that is name once uppercased, like the
+// columns of normalized tables.
+func hasColumnNamed(tableSchema *protos.TableSchema, name string) bool {
+	for columnName := range tableSchema.Columns {
+		if strings.ToUpper(columnName) == name {
+			return true
+		}
+	}
+	return false
+}
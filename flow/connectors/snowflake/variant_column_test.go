@@ -0,0 +1,51 @@
+package connsnowflake
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+// bareVariantColumnReference matches a path into a VARIANT aliased VAR_COLS, but not _VAR_COLS.
+var bareVariantColumnReference = regexp.MustCompile(`(^|[^_])VAR_COLS:`)
+
+func TestNormalizeTableWithVariantColumnNamedColumn(t *testing.T) {
+	schema := &protos.TableSchema{
+		TableIdentifier: "PUBLIC.T",
+		Columns: map[string]string{
+			"ID":       string(qvalue.QValueKindInt64),
+			"var_cols": string(qvalue.QValueKindString),
+		},
+		PrimaryKeyColumns: []string{"ID"},
+	}
+	require.Equal(t, "_VAR_COLS", variantColumnName(schema))
+	require.Equal(t, toVariantColumnName, variantColumnName(dynamicTableTestSchema()))
+
+	// the raw data is aliased _VAR_COLS, so the flattened VAR_COLS column doesn't shadow it for the
+	// columns flattened after it.
+	c := &SnowflakeConnector{
+		tableSchemaMapping: map[string]*protos.TableSchema{"PUBLIC.T": schema},
+	}
+	mergeStatement, err := c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	require.Contains(t, mergeStatement, "TO_VARIANT(PARSE_JSON(_PEERDB_DATA)) _VAR_COLS,")
+	require.Contains(t, mergeStatement, `CAST(STRIP_NULL_VALUE(_VAR_COLS:"ID") AS INTEGER) AS "ID"`)
+	require.Contains(t, mergeStatement, `CAST(STRIP_NULL_VALUE(_VAR_COLS:"var_cols") AS STRING) AS "VAR_COLS"`)
+	require.NotRegexp(t, bareVariantColumnReference, mergeStatement)
+	require.Regexp(t, `VALUES\([^)]*SOURCE."VAR_COLS"`, mergeStatement)
+
+	// a dynamic table of the table aliases the raw data the same way.
+	createSQL, err := generateCreateDynamicTableSQL("PUBLIC.T", schema, "_PEERDB_RAW_T", "", "", "TEST_WH",
+		false, false, protos.DedupNullsOrdering_DEDUP_NULLS_LAST, protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL)
+	require.NoError(t, err)
+	require.Contains(t, createSQL, "TO_VARIANT(PARSE_JSON(_PEERDB_DATA)) _VAR_COLS,")
+	require.Contains(t, createSQL, `QUALIFY RANK() OVER (PARTITION BY _VAR_COLS:"ID" `)
+	require.Contains(t, createSQL, `AS STRING) AS "VAR_COLS"`)
+	require.NotRegexp(t, bareVariantColumnReference, createSQL)
+}
@@ -0,0 +1,69 @@
+package connsnowflake
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateMergeStatement_JSONNullAndMissingColumnsMatch(t *testing.T) {
+	columns := map[string]string{
+		"ID":       string(qvalue.QValueKindInt64),
+		"SCORE":    string(qvalue.QValueKindInt64),
+		"DOC":      string(qvalue.QValueKindJSON),
+		"PAYLOAD":  string(qvalue.QValueKindBytes),
+		"LOCATION": string(qvalue.QValueKindGeography),
+	}
+	c := &SnowflakeConnector{
+		tableSchemaMapping: map[string]*protos.TableSchema{
+			"PUBLIC.T": {
+				TableIdentifier:   "PUBLIC.T",
+				Columns:           columns,
+				PrimaryKeyColumns: []string{"ID"},
+			},
+		},
+	}
+
+	// SCORE is json null in the raw data of the first record and missing from the second
+	nullScore := model.NewRecordItems()
+	nullScore.AddColumn("ID", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)})
+	nullScore.AddColumn("SCORE", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: nil})
+	nullScoreJSON, err := nullScore.ToJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"ID": 1, "SCORE": null}`, nullScoreJSON)
+	missingScore := model.NewRecordItems()
+	missingScore.AddColumn("ID", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(2)})
+	missingScoreJSON, err := missingScore.ToJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"ID": 2}`, missingScoreJSON)
+
+	// by default json null is stripped to SQL NULL before every cast, as a missing column extracts,
+	// so both records land with a NULL SCORE.
+	mergeStatement, err := c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	for column, expectedCast := range map[string]string{
+		"ID":       `CAST(STRIP_NULL_VALUE(VAR_COLS:"ID") AS INTEGER)`,
+		"SCORE":    `CAST(STRIP_NULL_VALUE(VAR_COLS:"SCORE") AS INTEGER)`,
+		"DOC":      `CAST(STRIP_NULL_VALUE(VAR_COLS:"DOC") AS VARIANT)`,
+		"PAYLOAD":  `BASE64_DECODE_BINARY(STRIP_NULL_VALUE(VAR_COLS:"PAYLOAD"))`,
+		"LOCATION": `TO_GEOGRAPHY(CAST(STRIP_NULL_VALUE(VAR_COLS:"LOCATION") AS STRING),true)`,
+	} {
+		require.Contains(t, mergeStatement, fmt.Sprintf(`%s AS "%s"`, expectedCast, column))
+	}
+
+	// keeping json nulls casts the extracted value as it is, so a VARIANT column keeps the json null.
+	mergeStatement, err = c.generateMergeStatement("PUBLIC.T", []string{""}, "_PEERDB_RAW_T",
+		2, 1, false, false, false, false, nil, protos.DedupNullsOrdering_DEDUP_NULLS_LAST,
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_KEEP_JSON_NULL,
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS, false, rawRowRange{})
+	require.NoError(t, err)
+	require.NotContains(t, mergeStatement, "STRIP_NULL_VALUE")
+	require.Contains(t, mergeStatement, `CAST(VAR_COLS:"DOC" AS VARIANT) AS "DOC"`)
+}
@@ -0,0 +1,196 @@
+package connsnowflake
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/model"
+	log "github.com/sirupsen/logrus"
+)
+
+const showWarehousesSQL = "SHOW WAREHOUSES LIKE '%s'"
+
+// the derived merge concurrency of a warehouse is looked up again after this long, to follow resizes.
+const warehouseConcurrencyRefreshInterval = 5 * time.Minute
+
+// warehouseNodesBySize is the number of nodes in a cluster of each warehouse size, keyed by the size
+// with dashes and underscores removed.
+var warehouseNodesBySize = map[string]uint{
+	"XSMALL":   1,
+	"SMALL":    2,
+	"MEDIUM":   4,
+	"LARGE":    8,
+	"XLARGE":   16,
+	"2XLARGE":  32,
+	"XXLARGE":  32,
+	"3XLARGE":  64,
+	"XXXLARGE": 64,
+	"4XLARGE":  128,
+	"5XLARGE":  256,
+	"6XLARGE":  512,
+}
+
+// warehouseMergeLimiter is the merge limiter shared by all mirrors normalizing on a warehouse.
+type warehouseMergeLimiter struct {
+	limiter     *utils.MergeLimiter
+	refreshedAt time.Time
+}
+
+var (
+	warehouseMergeLimitersLock sync.Mutex
+	// warehouseMergeLimiters are keyed by account and warehouse.
+	warehouseMergeLimiters = make(map[string]*warehouseMergeLimiter)
+)
+
+// warehouseMergeConcurrency is the number of merges to run at once on a warehouse of the given size,
+// with mergesPerNode merges for each node of each of its clusters.
+func warehouseMergeConcurrency(size string, maxClusterCount uint, mergesPerNode uint32) (uint, error) {
+	normalizedSize := strings.NewReplacer("-", "", "_", "", " ", "").Replace(strings.ToUpper(size))
+	nodes, ok := warehouseNodesBySize[normalizedSize]
+	if !ok {
+		return 0, fmt.Errorf("unknown warehouse size %s", size)
+	}
+	if maxClusterCount == 0 {
+		maxClusterCount = 1
+	}
+	return nodes * maxClusterCount * uint(mergesPerNode), nil
+}
+
+// getWarehouseSize returns the size and maximum cluster count of the connector's warehouse.
+func (c *SnowflakeConnector) getWarehouseSize() (string, uint, error) {
+	rows, err := c.database.QueryContext(c.ctx,
+		fmt.Sprintf(showWarehousesSQL, strings.ReplaceAll(c.warehouse, "'", "''")))
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to show warehouse %s: %w", c.warehouse, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to show warehouse %s: %w", c.warehouse, err)
+	}
+	values := make([]sql.NullString, len(columns))
+	valuePtrs := make([]interface{}, len(columns))
+	for i := range values {
+		valuePtrs[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return "", 0, fmt.Errorf("failed to read warehouse %s: %w", c.warehouse, err)
+		}
+		warehouse := make(map[string]string, len(columns))
+		for i, column := range columns {
+			warehouse[strings.ToLower(column)] = values[i].String
+		}
+		// LIKE also matches other warehouses when the name has wildcards such as _
+		if !strings.EqualFold(warehouse["name"], c.warehouse) {
+			continue
+		}
+
+		var maxClusterCount uint64
+		// standard edition warehouses have a single cluster and no cluster counts
+		if warehouse["max_cluster_count"] != "" {
+			maxClusterCount, err = strconv.ParseUint(warehouse["max_cluster_count"], 10, 32)
+			if err != nil {
+				return "", 0, fmt.Errorf("failed to parse cluster count of warehouse %s: %w", c.warehouse, err)
+			}
+		}
+		return warehouse["size"], uint(maxClusterCount), nil
+	}
+	if err := rows.Err(); err != nil {
+		return "", 0, fmt.Errorf("failed to read warehouse %s: %w", c.warehouse, err)
+	}
+	return "", 0, fmt.Errorf("warehouse %s not found", c.warehouse)
+}
+
+// stackedMergeLimiter makes a merge wait for a slot on each of its limiters, in order.
+type stackedMergeLimiter []model.ConcurrencyLimiter
+
+func (l stackedMergeLimiter) Acquire(ctx context.Context, heartbeatInterval time.Duration,
+	message func() string,
+) error {
+	for i, limiter := range l {
+		if err := limiter.Acquire(ctx, heartbeatInterval, message); err != nil {
+			l[:i].Release()
+			return err
+		}
+	}
+	return nil
+}
+
+func (l stackedMergeLimiter) Release() {
+	for i := len(l) - 1; i >= 0; i-- {
+		l[i].Release()
+	}
+}
+
+// getMergeLimiter returns the limiter the merges of a normalize wait on. With merges per warehouse
+// node configured, merges wait on the warehouse's limiter and then on the worker's fixed cap, if any.
+// Otherwise, or if the warehouse's cap can't be derived, only the worker's fixed cap applies.
+func (c *SnowflakeConnector) getMergeLimiter(req *model.NormalizeRecordsRequest) model.ConcurrencyLimiter {
+	warehouseLimiter := c.getWarehouseMergeLimiter(req)
+	if warehouseLimiter == nil {
+		return req.MergeLimiter
+	}
+	if req.MergeLimiter == nil {
+		return warehouseLimiter
+	}
+	return stackedMergeLimiter{warehouseLimiter, req.MergeLimiter}
+}
+
+// getWarehouseMergeLimiter returns the limiter shared by the mirrors on the connector's warehouse,
+// capped by the warehouse's size and looked up again every warehouseConcurrencyRefreshInterval.
+// It returns nil without merges per warehouse node, or if no cap was derived yet.
+func (c *SnowflakeConnector) getWarehouseMergeLimiter(req *model.NormalizeRecordsRequest) *utils.MergeLimiter {
+	if c.mergesPerWarehouseNode == 0 {
+		return nil
+	}
+
+	key := c.accountID + "." + strings.ToUpper(c.warehouse)
+	warehouseMergeLimitersLock.Lock()
+	entry, ok := warehouseMergeLimiters[key]
+	warehouseMergeLimitersLock.Unlock()
+	if ok && time.Since(entry.refreshedAt) < warehouseConcurrencyRefreshInterval {
+		return entry.limiter
+	}
+
+	// the size is looked up without holding the lock, so a slow lookup doesn't hold up other warehouses
+	size, maxClusterCount, err := c.getWarehouseSize()
+	var concurrency uint
+	if err == nil {
+		concurrency, err = warehouseMergeConcurrency(size, maxClusterCount, c.mergesPerWarehouseNode)
+	}
+
+	warehouseMergeLimitersLock.Lock()
+	defer warehouseMergeLimitersLock.Unlock()
+	// another normalize may have added the limiter while the size was looked up
+	entry, ok = warehouseMergeLimiters[key]
+	if err != nil {
+		log.WithFields(log.Fields{
+			"flowName": req.FlowJobName,
+		}).Warnf("failed to derive merge concurrency from warehouse size: %v", err)
+		if ok {
+			return entry.limiter
+		}
+		return nil
+	}
+
+	if !ok {
+		entry = &warehouseMergeLimiter{limiter: utils.NewMergeLimiter(concurrency)}
+		warehouseMergeLimiters[key] = entry
+	} else if entry.limiter.MaxConcurrentMerges() != concurrency {
+		log.WithFields(log.Fields{
+			"flowName": req.FlowJobName,
+		}).Infof("warehouse %s is now %s with %d clusters, merging %d tables at a time",
+			c.warehouse, size, maxClusterCount, concurrency)
+		entry.limiter.SetMaxConcurrentMerges(concurrency)
+	}
+	entry.refreshedAt = time.Now()
+	return entry.limiter
+}
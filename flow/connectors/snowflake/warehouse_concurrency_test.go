@@ -0,0 +1,99 @@
+package connsnowflake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarehouseMergeConcurrency(t *testing.T) {
+	for _, tc := range []struct {
+		size            string
+		maxClusterCount uint
+		mergesPerNode   uint32
+		concurrency     uint
+	}{
+		{"X-Small", 1, 1, 1},
+		{"XSMALL", 0, 1, 1},
+		{"Small", 1, 1, 2},
+		{"Medium", 1, 2, 8},
+		{"Large", 1, 1, 8},
+		{"X-Large", 1, 1, 16},
+		{"2X-Large", 1, 1, 32},
+		{"XXLARGE", 1, 1, 32},
+		{"3X-Large", 1, 1, 64},
+		{"4X-Large", 1, 1, 128},
+		{"5X-Large", 1, 1, 256},
+		{"6X-Large", 1, 1, 512},
+		// every cluster of a multi-cluster warehouse takes its share of merges
+		{"Medium", 3, 1, 12},
+	} {
+		concurrency, err := warehouseMergeConcurrency(tc.size, tc.maxClusterCount, tc.mergesPerNode)
+		require.NoError(t, err, tc.size)
+		require.Equal(t, tc.concurrency, concurrency, tc.size)
+	}
+
+	_, err := warehouseMergeConcurrency("Huge", 1, 1)
+	require.ErrorContains(t, err, "unknown warehouse size Huge")
+}
+
+func TestMergeLimiterFollowsWarehouseResize(t *testing.T) {
+	isolateWarehouseMergeLimiters(t)
+	workerLimiter := utils.NewMergeLimiter(3)
+	req := &model.NormalizeRecordsRequest{FlowJobName: "test", MergeLimiter: workerLimiter}
+
+	// without merges per warehouse node the worker's fixed cap applies
+	w := &fakeWarehouse{warehouseSize: "Small", maxClusterCount: "2"}
+	c := newFakeWarehouseConnector(w)
+	defer c.database.Close()
+	c.warehouse, c.accountID = "wh", "TestMergeLimiterFollowsWarehouseResize"
+	require.Equal(t, workerLimiter, c.getMergeLimiter(req))
+
+	c.mergesPerWarehouseNode = 1
+	limiter := c.getWarehouseMergeLimiter(req)
+	require.Equal(t, uint(4), limiter.MaxConcurrentMerges())
+	// merges wait on the warehouse's limiter and on the worker's
+	require.Equal(t, stackedMergeLimiter{limiter, workerLimiter}, c.getMergeLimiter(req))
+
+	// the resize is picked up once the size is looked up again, on the same limiter
+	w.warehouseSize = "Large"
+	require.Equal(t, uint(4), c.getWarehouseMergeLimiter(req).MaxConcurrentMerges())
+	warehouseMergeLimiters[c.accountID+".WH"].refreshedAt = time.Time{}
+	require.Same(t, limiter, c.getWarehouseMergeLimiter(req))
+	require.Equal(t, uint(16), limiter.MaxConcurrentMerges())
+
+	// a size that can't be understood keeps the last derived cap
+	w.warehouseSize = "Huge"
+	warehouseMergeLimiters[c.accountID+".WH"].refreshedAt = time.Time{}
+	require.Same(t, limiter, c.getWarehouseMergeLimiter(req))
+	require.Equal(t, uint(16), limiter.MaxConcurrentMerges())
+
+	// and before any cap was derived, falls back to the worker's
+	c.accountID = "TestMergeLimiterFollowsWarehouseResize_unknown"
+	require.Equal(t, workerLimiter, c.getMergeLimiter(req))
+}
+
+func TestStackedMergeLimiter(t *testing.T) {
+	warehouseLimiter, workerLimiter := utils.NewMergeLimiter(2), utils.NewMergeLimiter(1)
+	limiter := stackedMergeLimiter{warehouseLimiter, workerLimiter}
+	message := func() string { return "test" }
+
+	require.NoError(t, limiter.Acquire(context.Background(), time.Minute, message))
+	// the worker's cap is reached even though the warehouse has a free slot,
+	// and the warehouse slot taken while waiting is given back
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, limiter.Acquire(ctx, time.Minute, message), context.DeadlineExceeded)
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, warehouseLimiter.Acquire(ctx, time.Minute, message))
+	warehouseLimiter.Release()
+
+	limiter.Release()
+	require.NoError(t, limiter.Acquire(context.Background(), time.Minute, message))
+	limiter.Release()
+}
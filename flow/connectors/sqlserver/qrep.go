@@ -3,6 +3,8 @@ package connsqlserver
 import (
 	"bytes"
 	"fmt"
+	"regexp"
+	"strings"
 	"text/template"
 
 	utils "github.com/PeerDB-io/peer-flow/connectors/utils/partition"
@@ -152,12 +154,39 @@ func (c *SQLServerConnector) GetQRepPartitions(
 
 func (c *SQLServerConnector) PullQRepRecords(
 	config *protos.QRepConfig, partition *protos.QRepPartition) (*model.QRecordBatch, error) {
+	return c.pullQRepRecords(config, partition, 0)
+}
+
+// SampleQRepRecords pulls at most limit records of the partition, leaving the rest of it on the source.
+func (c *SQLServerConnector) SampleQRepRecords(
+	config *protos.QRepConfig, partition *protos.QRepPartition, limit int) (*model.QRecordBatch, error) {
+	return c.pullQRepRecords(config, partition, limit)
+}
+
+var selectPrefix = regexp.MustCompile(`(?i)^\s*SELECT(\s+DISTINCT)?\s`)
+
+// limitQuery limits the rows of a query to limit, a limit of 0 leaves the query as is. TOP goes
+// right after SELECT when it can, SQL Server rejects the ORDER BY of a query wrapped in a derived table.
+func limitQuery(query string, limit int) string {
+	if limit <= 0 {
+		return query
+	}
+	if loc := selectPrefix.FindStringIndex(query); loc != nil {
+		return fmt.Sprintf("%s TOP (%d) %s", strings.TrimRight(query[:loc[1]], " \t\r\n"), limit, query[loc[1]:])
+	}
+	return fmt.Sprintf("SELECT TOP (%d) * FROM (%s) AS peerdb_sample",
+		limit, strings.TrimRight(strings.TrimSpace(query), ";"))
+}
+
+func (c *SQLServerConnector) pullQRepRecords(
+	config *protos.QRepConfig, partition *protos.QRepPartition, limit int) (*model.QRecordBatch, error) {
 	// Build the query to pull records within the range from the source table
 	// Be sure to order the results by the watermark column to ensure consistency across pulls
 	query, err := BuildQuery(config.Query)
 	if err != nil {
 		return nil, err
 	}
+	query = limitQuery(query, limit)
 
 	if partition.FullTablePartition {
 		// this is a full table partition, so just run the query
@@ -0,0 +1,65 @@
+package connectors
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+var ErrSetupNotComplete = errors.New("setup of the mirror is not complete")
+
+// EnsureSyncSetup checks that the metadata tables and the raw table a sync relies on exist on the destination,
+// as syncs racing the setup otherwise fail on the missing tables. Depending on the policy, missing tables fail
+// with ErrSetupNotComplete or are set up. Destinations that can't check for the raw table only have their
+// metadata tables checked.
+func EnsureSyncSetup(conn CDCSyncConnector, config *protos.FlowConnectionConfigs) error {
+	metadataTablesExist, rawTableExists := !conn.NeedsSetupMetadataTables(), true
+	if setupConn, ok := conn.(SyncSetupConnector); ok {
+		var err error
+		metadataTablesExist, err = setupConn.MetadataTablesExist()
+		if err != nil {
+			return fmt.Errorf("failed to check if metadata tables exist: %w", err)
+		}
+		rawTableExists, err = setupConn.RawTableExists(config.FlowJobName)
+		if err != nil {
+			return fmt.Errorf("failed to check if raw table exists: %w", err)
+		}
+	}
+	if metadataTablesExist && rawTableExists {
+		return nil
+	}
+
+	if config.IncompleteSetupPolicy != protos.IncompleteSetupPolicy_INCOMPLETE_SETUP_POLICY_SETUP {
+		missing := fmt.Sprintf("raw table of mirror %s does not", config.FlowJobName)
+		if !metadataTablesExist {
+			missing = fmt.Sprintf("metadata tables of mirror %s do not", config.FlowJobName)
+		}
+		return fmt.Errorf("%w: %s exist on the destination yet", ErrSetupNotComplete, missing)
+	}
+
+	if !metadataTablesExist {
+		err := conn.SetupMetadataTables()
+		if err != nil {
+			return fmt.Errorf("failed to setup metadata tables: %w", err)
+		}
+	}
+
+	tableNameMapping := make(map[string]string, len(config.TableMappings))
+	for _, tableMapping := range config.TableMappings {
+		tableNameMapping[tableMapping.SourceTableIdentifier] = tableMapping.DestinationTableIdentifier
+	}
+	_, err := conn.CreateRawTable(&protos.CreateRawTableInput{
+		PeerConnectionConfig: config.Destination,
+		FlowJobName:          config.FlowJobName,
+		TableNameMapping:     tableNameMapping,
+		CdcSyncMode:          config.CdcSyncMode,
+		ClusterRawTable:      config.ClusterRawTable,
+		RawDataAsVariant:     config.RawDataAsVariant,
+		Tags:                 config.DestinationTags,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create raw table: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package connectors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/require"
+)
+
+// unsetupConnector is a destination whose setup has not run yet, until it is set up by the sync.
+type unsetupConnector struct {
+	CDCSyncConnector
+	metadataTablesExist bool
+	rawTableExists      bool
+	rawTableInput       *protos.CreateRawTableInput
+	checkErr            error
+}
+
+func (c *unsetupConnector) NeedsSetupMetadataTables() bool {
+	return !c.metadataTablesExist
+}
+
+func (c *unsetupConnector) MetadataTablesExist() (bool, error) {
+	return c.metadataTablesExist, c.checkErr
+}
+
+func (c *unsetupConnector) RawTableExists(string) (bool, error) {
+	return c.rawTableExists, c.checkErr
+}
+
+func (c *unsetupConnector) SetupMetadataTables() error {
+	c.metadataTablesExist = true
+	return nil
+}
+
+func (c *unsetupConnector) CreateRawTable(req *protos.CreateRawTableInput) (*protos.CreateRawTableOutput, error) {
+	c.rawTableExists = true
+	c.rawTableInput = req
+	return &protos.CreateRawTableOutput{}, nil
+}
+
+func TestEnsureSyncSetupBeforeSetup(t *testing.T) {
+	config := &protos.FlowConnectionConfigs{
+		FlowJobName: "test_sync_before_setup",
+		TableMappings: []*protos.TableMapping{{
+			SourceTableIdentifier:      "public.src",
+			DestinationTableIdentifier: "public.dst",
+		}},
+	}
+
+	conn := &unsetupConnector{}
+	err := EnsureSyncSetup(conn, config)
+	require.ErrorIs(t, err, ErrSetupNotComplete)
+	require.ErrorContains(t, err, "metadata tables of mirror test_sync_before_setup do not exist")
+	require.False(t, conn.metadataTablesExist)
+	require.Nil(t, conn.rawTableInput)
+
+	config.IncompleteSetupPolicy = protos.IncompleteSetupPolicy_INCOMPLETE_SETUP_POLICY_SETUP
+	require.NoError(t, EnsureSyncSetup(conn, config))
+	require.True(t, conn.metadataTablesExist)
+	require.Equal(t, "test_sync_before_setup", conn.rawTableInput.FlowJobName)
+	require.Equal(t, map[string]string{"public.src": "public.dst"}, conn.rawTableInput.TableNameMapping)
+
+	// once set up, syncs go ahead as is
+	conn.rawTableInput = nil
+	config.IncompleteSetupPolicy = protos.IncompleteSetupPolicy_INCOMPLETE_SETUP_POLICY_ERROR
+	require.NoError(t, EnsureSyncSetup(conn, config))
+	require.Nil(t, conn.rawTableInput)
+}
+
+func TestEnsureSyncSetupWithoutRawTable(t *testing.T) {
+	config := &protos.FlowConnectionConfigs{FlowJobName: "test_sync_without_raw_table"}
+
+	conn := &unsetupConnector{metadataTablesExist: true}
+	err := EnsureSyncSetup(conn, config)
+	require.ErrorIs(t, err, ErrSetupNotComplete)
+	require.ErrorContains(t, err, "raw table of mirror test_sync_without_raw_table does not exist")
+
+	config.IncompleteSetupPolicy = protos.IncompleteSetupPolicy_INCOMPLETE_SETUP_POLICY_SETUP
+	require.NoError(t, EnsureSyncSetup(conn, config))
+	require.True(t, conn.rawTableExists)
+}
+
+func TestEnsureSyncSetupCheckError(t *testing.T) {
+	config := &protos.FlowConnectionConfigs{
+		FlowJobName:           "test_sync_check_error",
+		IncompleteSetupPolicy: protos.IncompleteSetupPolicy_INCOMPLETE_SETUP_POLICY_SETUP,
+	}
+
+	// a failed check is not taken for missing tables
+	checkErr := errors.New("connection refused")
+	conn := &unsetupConnector{checkErr: checkErr}
+	err := EnsureSyncSetup(conn, config)
+	require.ErrorIs(t, err, checkErr)
+	require.NotErrorIs(t, err, ErrSetupNotComplete)
+	require.False(t, conn.metadataTablesExist)
+	require.Nil(t, conn.rawTableInput)
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ConnectionLimiter caps the connectors to a peer that QRep partitions hold open at the same time,
+// across every partition and mirror in the process. It limits connectors, not connections: a connector
+// may open several connections to the peer, which are not counted. A nil *ConnectionLimiter places no limit.
+type ConnectionLimiter struct {
+	mu             sync.Mutex
+	held           uint32
+	maxConnections uint32
+	// changed is closed and replaced whenever a slot frees up or the cap changes, waking up waiters.
+	changed chan struct{}
+}
+
+var (
+	connectionLimitersLock sync.Mutex
+	// connectionLimiters are keyed by peer and side.
+	connectionLimiters = make(map[string]*ConnectionLimiter)
+)
+
+// GetConnectionLimiter returns the limiter shared by the partitions connecting to a peer as the given
+// side, or nil (no limit) if maxConnections is 0. Mirrors asking for a different cap share the limiter,
+// which takes the cap asked for last. Sources and destinations have separate limiters, so that a
+// partition holding a destination connector never waits on itself for a source one.
+func GetConnectionLimiter(peerName string, side string, maxConnections uint32) *ConnectionLimiter {
+	if maxConnections == 0 {
+		return nil
+	}
+
+	connectionLimitersLock.Lock()
+	defer connectionLimitersLock.Unlock()
+	key := fmt.Sprintf("%s/%s", peerName, side)
+	limiter, ok := connectionLimiters[key]
+	if !ok {
+		limiter = &ConnectionLimiter{maxConnections: maxConnections, changed: make(chan struct{})}
+		connectionLimiters[key] = limiter
+	}
+	limiter.setMaxConnections(maxConnections)
+	return limiter
+}
+
+// Acquire blocks until a connector slot is free, heartbeating every heartbeatInterval while waiting.
+func (l *ConnectionLimiter) Acquire(ctx context.Context, heartbeatInterval time.Duration,
+	message func() string) error {
+	if l == nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	waitStart := time.Now()
+	for {
+		l.mu.Lock()
+		if l.held < l.maxConnections {
+			l.held++
+			l.mu.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ticker.C:
+			RecordHeartbeatWithRecover(ctx, fmt.Sprintf("waiting %s for a connector slot: %s",
+				time.Since(waitStart).Round(time.Second), message()))
+		case <-ctx.Done():
+			return fmt.Errorf("context done while waiting for a connector slot: %w", ctx.Err())
+		}
+	}
+}
+
+// Release frees a connector slot taken by Acquire.
+func (l *ConnectionLimiter) Release() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.held--
+	l.notifyLocked()
+}
+
+// setMaxConnections changes the cap. Connectors already held beyond a lowered cap stay open,
+// new ones wait until fewer than the cap are held.
+func (l *ConnectionLimiter) setMaxConnections(maxConnections uint32) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.maxConnections == maxConnections {
+		return
+	}
+	l.maxConnections = maxConnections
+	l.notifyLocked()
+}
+
+func (l *ConnectionLimiter) notifyLocked() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// openConnection counts a connection as open while it is held, keeping track of the most open at once.
+func openConnection(open *int32, maxOpen *int32) func() {
+	current := atomic.AddInt32(open, 1)
+	for {
+		seen := atomic.LoadInt32(maxOpen)
+		if current <= seen || atomic.CompareAndSwapInt32(maxOpen, seen, current) {
+			break
+		}
+	}
+	return func() { atomic.AddInt32(open, -1) }
+}
+
+func TestConnectionLimiterCapsPartitionConnections(t *testing.T) {
+	var srcOpen, srcMaxOpen, dstOpen, dstMaxOpen int32
+	var wg sync.WaitGroup
+	// two mirrors between the same peers, each replicating several partitions in parallel
+	errs := make(chan error, 16)
+	for mirror := 0; mirror < 2; mirror++ {
+		for partition := 0; partition < 8; partition++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				dstLimiter := GetConnectionLimiter("test_caps_dst", "destination", 3)
+				if err := dstLimiter.Acquire(context.Background(), time.Millisecond,
+					func() string { return "test" }); err != nil {
+					errs <- err
+					return
+				}
+				defer dstLimiter.Release()
+				closeDst := openConnection(&dstOpen, &dstMaxOpen)
+				defer closeDst()
+
+				srcLimiter := GetConnectionLimiter("test_caps_src", "source", 2)
+				if err := srcLimiter.Acquire(context.Background(), time.Millisecond,
+					func() string { return "test" }); err != nil {
+					errs <- err
+					return
+				}
+				defer srcLimiter.Release()
+				closeSrc := openConnection(&srcOpen, &srcMaxOpen)
+				defer closeSrc()
+
+				time.Sleep(10 * time.Millisecond)
+			}()
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, int32(2), srcMaxOpen)
+	require.LessOrEqual(t, dstMaxOpen, int32(3))
+}
+
+func TestConnectionLimiterSharedAcrossCaps(t *testing.T) {
+	// mirrors with different caps to the same peer share one limiter, which takes the last cap asked for.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	limiter := GetConnectionLimiter("test_shared_caps", "source", 2)
+	require.NoError(t, limiter.Acquire(ctx, time.Millisecond, func() string { return "test" }))
+	defer limiter.Release()
+	require.Same(t, limiter, GetConnectionLimiter("test_shared_caps", "source", 1))
+
+	waitCtx, cancelWait := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancelWait()
+	err := limiter.Acquire(waitCtx, time.Millisecond, func() string { return "test" })
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// raising the cap again frees a slot for a waiting partition
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- limiter.Acquire(ctx, time.Millisecond, func() string { return "test" })
+	}()
+	GetConnectionLimiter("test_shared_caps", "source", 2)
+	require.NoError(t, <-acquired)
+	limiter.Release()
+}
+
+func TestConnectionLimiterSamePeerOnBothSides(t *testing.T) {
+	// a partition copying within a peer holds a destination connection while it takes a source one,
+	// which must not wait on the destination cap.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	dstLimiter := GetConnectionLimiter("test_same_peer", "destination", 1)
+	require.NoError(t, dstLimiter.Acquire(ctx, time.Millisecond, func() string { return "test" }))
+	defer dstLimiter.Release()
+	srcLimiter := GetConnectionLimiter("test_same_peer", "source", 1)
+	require.NoError(t, srcLimiter.Acquire(ctx, time.Millisecond, func() string { return "test" }))
+	srcLimiter.Release()
+}
+
+func TestConnectionLimiterCancelledWhileWaiting(t *testing.T) {
+	limiter := GetConnectionLimiter("test_cancelled", "source", 1)
+	require.NoError(t, limiter.Acquire(context.Background(), time.Millisecond, func() string { return "test" }))
+	defer limiter.Release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := limiter.Acquire(ctx, time.Millisecond, func() string { return "test" })
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestNoConnectionLimiterWithoutCap(t *testing.T) {
+	limiter := GetConnectionLimiter("test_no_cap", "source", 0)
+	require.Nil(t, limiter)
+	require.NoError(t, limiter.Acquire(context.Background(), time.Millisecond, func() string { return "test" }))
+	limiter.Release()
+}
@@ -0,0 +1,12 @@
+package utils
+
+import "github.com/PeerDB-io/peer-flow/generated/protos"
+
+// DedupOrderSQL returns the ORDER BY of the window ranking the raw records of a primary key, latest
+// first. Where NULL timestamps sort is spelled out, destinations differ in where they put them.
+func DedupOrderSQL(timestampColumn string, nullsOrdering protos.DedupNullsOrdering) string {
+	if nullsOrdering == protos.DedupNullsOrdering_DEDUP_NULLS_FIRST {
+		return timestampColumn + " DESC NULLS FIRST"
+	}
+	return timestampColumn + " DESC NULLS LAST"
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/PeerDB-io/peer-flow/generated/protos"
 	log "github.com/sirupsen/logrus"
 	"go.temporal.io/sdk/activity"
 )
@@ -43,3 +44,35 @@ func RecordHeartbeatWithRecover(ctx context.Context, details ...interface{}) {
 	}()
 	activity.RecordHeartbeat(ctx, details...)
 }
+
+// EstimatePartitionRows estimates the number of rows in a qrep partition, returning 0 if it can't tell.
+// Partitions split by row count hold about NumRowsPerPartition rows, and integer ranges at most as many
+// rows as there are values in the range.
+func EstimatePartitionRows(config *protos.QRepConfig, partition *protos.QRepPartition) int64 {
+	if partition.FullTablePartition {
+		return 0
+	}
+	estimate := int64(config.NumRowsPerPartition)
+	if intRange := partition.GetRange().GetIntRange(); intRange != nil {
+		rangeSize := intRange.End - intRange.Start + 1
+		if estimate == 0 || rangeSize < estimate {
+			estimate = rangeSize
+		}
+	}
+	return estimate
+}
+
+// QRepPartitionProgress is the heartbeat message for a partition being synced, showing the rows synced
+// against the estimate as a percentage when there is one.
+func QRepPartitionProgress(partitionID string, idx int, total int, rowsSynced int64, rowsEstimate int64) string {
+	msg := fmt.Sprintf("syncing partition - %s: %d of %d total.", partitionID, idx, total)
+	if rowsEstimate <= 0 {
+		return fmt.Sprintf("%s %d rows", msg, rowsSynced)
+	}
+	// estimates can be off, don't go past 100%
+	percentage := rowsSynced * 100 / rowsEstimate
+	if percentage > 100 {
+		percentage = 100
+	}
+	return fmt.Sprintf("%s %d of %d rows (%d%%)", msg, rowsSynced, rowsEstimate, percentage)
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQRepPartitionProgress(t *testing.T) {
+	require.Equal(t, "syncing partition - p1: 2 of 4 total. 50 of 100 rows (50%)",
+		QRepPartitionProgress("p1", 2, 4, 50, 100))
+	// estimates can be exceeded
+	require.Equal(t, "syncing partition - p1: 2 of 4 total. 150 of 100 rows (100%)",
+		QRepPartitionProgress("p1", 2, 4, 150, 100))
+	require.Equal(t, "syncing partition - p1: 2 of 4 total. 50 rows",
+		QRepPartitionProgress("p1", 2, 4, 50, 0))
+}
+
+func TestEstimatePartitionRows(t *testing.T) {
+	intRangePartition := &protos.QRepPartition{
+		PartitionId: "p1",
+		Range: &protos.PartitionRange{
+			Range: &protos.PartitionRange_IntRange{
+				IntRange: &protos.IntPartitionRange{Start: 1, End: 500},
+			},
+		},
+	}
+	require.EqualValues(t, 500, EstimatePartitionRows(&protos.QRepConfig{}, intRangePartition))
+	require.EqualValues(t, 100, EstimatePartitionRows(&protos.QRepConfig{NumRowsPerPartition: 100}, intRangePartition))
+	require.EqualValues(t, 0, EstimatePartitionRows(&protos.QRepConfig{NumRowsPerPartition: 100},
+		&protos.QRepPartition{PartitionId: "p2", FullTablePartition: true}))
+}
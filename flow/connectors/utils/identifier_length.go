@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+// number of hex characters of the hash that replaces the end of a truncated identifier.
+const identifierHashLength = 8
+
+// MaxIdentifierLength returns the length a destination allows for each part of a table name, as
+// counted by IdentifierLength, or 0 if it has no limit worth checking.
+func MaxIdentifierLength(dbType protos.DBType) int {
+	switch dbType {
+	case protos.DBType_SNOWFLAKE:
+		return 255
+	case protos.DBType_BIGQUERY:
+		return 1024
+	case protos.DBType_POSTGRES:
+		return 63
+	default:
+		return 0
+	}
+}
+
+// IdentifierLength returns the length of an identifier the way the destination counts it, in
+// characters for Snowflake and in bytes for the others.
+func IdentifierLength(dbType protos.DBType, identifier string) int {
+	if dbType == protos.DBType_SNOWFLAKE {
+		return utf8.RuneCountInString(identifier)
+	}
+	return len(identifier)
+}
+
+// identifierLengthUnit names what IdentifierLength counts for the destination.
+func identifierLengthUnit(dbType protos.DBType) string {
+	if dbType == protos.DBType_SNOWFLAKE {
+		return "characters"
+	}
+	return "bytes"
+}
+
+// TruncateIdentifier shortens an identifier to the length the destination allows by keeping its
+// beginning and appending a hash of the whole identifier, so the same identifier is always shortened
+// the same way and identifiers sharing a long prefix stay distinct. Identifiers that fit are returned
+// as is.
+func TruncateIdentifier(identifier string, dbType protos.DBType) string {
+	maxLength := MaxIdentifierLength(dbType)
+	if maxLength == 0 || IdentifierLength(dbType, identifier) <= maxLength {
+		return identifier
+	}
+
+	hash := sha256.Sum256([]byte(identifier))
+	suffix := "_" + hex.EncodeToString(hash[:])[:identifierHashLength]
+	prefix := identifier
+	// drop whole characters, so a multi-byte character is never cut in half
+	for IdentifierLength(dbType, prefix)+len(suffix) > maxLength {
+		_, size := utf8.DecodeLastRuneInString(prefix)
+		prefix = prefix[:len(prefix)-size]
+	}
+	return prefix + suffix
+}
+
+// FitDestinationIdentifiers checks the destination table names of the mappings against the limit
+// of the destination. Depending on the policy, names that are too long are either rejected or
+// truncated in place, so every later step of the mirror uses the truncated name. By default they
+// are only truncated for Postgres, which would otherwise cut them off itself and lose the hash.
+func FitDestinationIdentifiers(tableMappings []*protos.TableMapping, dbType protos.DBType,
+	policy protos.IdentifierLengthPolicy) error {
+	maxLength := MaxIdentifierLength(dbType)
+	if maxLength == 0 {
+		return nil
+	}
+	truncate := policy == protos.IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_TRUNCATE ||
+		(policy == protos.IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_DEFAULT && dbType == protos.DBType_POSTGRES)
+
+	overlong := make([]string, 0)
+	for _, mapping := range tableMappings {
+		parts := strings.Split(mapping.DestinationTableIdentifier, ".")
+		fits := true
+		for i, part := range parts {
+			if IdentifierLength(dbType, part) > maxLength {
+				fits = false
+				parts[i] = TruncateIdentifier(part, dbType)
+			}
+		}
+		if fits {
+			continue
+		}
+
+		if !truncate {
+			overlong = append(overlong, mapping.DestinationTableIdentifier)
+			continue
+		}
+		mapping.DestinationTableIdentifier = strings.Join(parts, ".")
+	}
+
+	if len(overlong) > 0 {
+		return fmt.Errorf("destination table names %s are longer than the %d %s allowed by %s, "+
+			"shorten them or set identifier_length_policy to truncate them", strings.Join(overlong, ", "),
+			maxLength, identifierLengthUnit(dbType), dbType)
+	}
+	return nil
+}
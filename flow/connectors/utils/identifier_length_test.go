@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTruncateIdentifier(t *testing.T) {
+	require.Equal(t, "short_name", TruncateIdentifier("short_name", protos.DBType_POSTGRES))
+
+	long := strings.Repeat("a", 70)
+	truncated := TruncateIdentifier(long, protos.DBType_POSTGRES)
+	require.Len(t, truncated, 63)
+	require.True(t, strings.HasPrefix(truncated, strings.Repeat("a", 54)+"_"))
+	// the same name is always truncated the same way, names with a shared prefix stay distinct
+	require.Equal(t, truncated, TruncateIdentifier(long, protos.DBType_POSTGRES))
+	require.NotEqual(t, truncated, TruncateIdentifier(long+"b", protos.DBType_POSTGRES))
+
+	// postgres counts bytes, multi-byte characters are not cut in half
+	truncated = TruncateIdentifier(strings.Repeat("é", 40), protos.DBType_POSTGRES)
+	require.LessOrEqual(t, len(truncated), 63)
+	require.True(t, strings.HasPrefix(truncated, strings.Repeat("é", 27)+"_"))
+
+	// snowflake counts characters
+	require.Equal(t, strings.Repeat("é", 200), TruncateIdentifier(strings.Repeat("é", 200), protos.DBType_SNOWFLAKE))
+	truncated = TruncateIdentifier(strings.Repeat("é", 300), protos.DBType_SNOWFLAKE)
+	require.Equal(t, 255, utf8.RuneCountInString(truncated))
+	require.True(t, strings.HasPrefix(truncated, strings.Repeat("é", 246)+"_"))
+}
+
+func TestFitDestinationIdentifiers(t *testing.T) {
+	longTable := strings.Repeat("t", 300)
+	newMappings := func() []*protos.TableMapping {
+		return []*protos.TableMapping{
+			{SourceTableIdentifier: "public.a", DestinationTableIdentifier: "PUBLIC.A"},
+			{SourceTableIdentifier: "public.b", DestinationTableIdentifier: "PUBLIC." + longTable},
+		}
+	}
+
+	mappings := newMappings()
+	err := FitDestinationIdentifiers(mappings, protos.DBType_SNOWFLAKE,
+		protos.IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_ERROR)
+	require.ErrorContains(t, err, "destination table names PUBLIC."+longTable+" are longer than the 255 characters")
+	require.Equal(t, "PUBLIC."+longTable, mappings[1].DestinationTableIdentifier)
+
+	mappings = newMappings()
+	require.NoError(t, FitDestinationIdentifiers(mappings, protos.DBType_SNOWFLAKE,
+		protos.IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_TRUNCATE))
+	require.Equal(t, "PUBLIC.A", mappings[0].DestinationTableIdentifier)
+	require.Equal(t, "PUBLIC."+TruncateIdentifier(longTable, protos.DBType_SNOWFLAKE),
+		mappings[1].DestinationTableIdentifier)
+
+	// setup runs again on retries, truncating again gives the same names
+	require.NoError(t, FitDestinationIdentifiers(mappings, protos.DBType_SNOWFLAKE,
+		protos.IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_TRUNCATE))
+	require.Equal(t, "PUBLIC."+TruncateIdentifier(longTable, protos.DBType_SNOWFLAKE),
+		mappings[1].DestinationTableIdentifier)
+
+	// BigQuery allows longer names
+	mappings = newMappings()
+	require.NoError(t, FitDestinationIdentifiers(mappings, protos.DBType_BIGQUERY,
+		protos.IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_ERROR))
+
+	// postgres truncates by default, other destinations fail
+	mappings = []*protos.TableMapping{
+		{SourceTableIdentifier: "public.b", DestinationTableIdentifier: "public." + strings.Repeat("t", 70)},
+	}
+	require.NoError(t, FitDestinationIdentifiers(mappings, protos.DBType_POSTGRES,
+		protos.IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_DEFAULT))
+	require.Equal(t, "public."+TruncateIdentifier(strings.Repeat("t", 70), protos.DBType_POSTGRES),
+		mappings[0].DestinationTableIdentifier)
+	err = FitDestinationIdentifiers(newMappings(), protos.DBType_SNOWFLAKE,
+		protos.IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_DEFAULT)
+	require.ErrorContains(t, err, "are longer than the 255 characters allowed by SNOWFLAKE")
+	mappings = []*protos.TableMapping{
+		{SourceTableIdentifier: "public.b", DestinationTableIdentifier: "public." + strings.Repeat("t", 70)},
+	}
+	err = FitDestinationIdentifiers(mappings, protos.DBType_POSTGRES,
+		protos.IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_ERROR)
+	require.ErrorContains(t, err, "are longer than the 63 bytes allowed by POSTGRES")
+}
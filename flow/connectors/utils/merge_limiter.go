@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// MergeLimiter caps the number of merges running at the same time across every mirror in the
+// process, so that many mirrors sharing a warehouse cannot overload it together.
+// A nil *MergeLimiter places no limit.
+type MergeLimiter struct {
+	mu                  sync.Mutex
+	running             uint
+	maxConcurrentMerges uint
+	// changed is closed and replaced whenever a slot frees up or the cap changes, waking up waiters.
+	changed chan struct{}
+}
+
+// NewMergeLimiter returns a limiter allowing maxConcurrentMerges merges at a time,
+// or nil (no limit) if maxConcurrentMerges is 0.
+func NewMergeLimiter(maxConcurrentMerges uint) *MergeLimiter {
+	if maxConcurrentMerges == 0 {
+		return nil
+	}
+	return &MergeLimiter{
+		maxConcurrentMerges: maxConcurrentMerges,
+		changed:             make(chan struct{}),
+	}
+}
+
+// Acquire blocks until a merge slot is free, heartbeating every heartbeatInterval while waiting.
+func (l *MergeLimiter) Acquire(ctx context.Context, heartbeatInterval time.Duration, message func() string) error {
+	if l == nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	waitStart := time.Now()
+	for {
+		l.mu.Lock()
+		if l.running < l.maxConcurrentMerges {
+			l.running++
+			l.mu.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ticker.C:
+			RecordHeartbeatWithRecover(ctx, fmt.Sprintf("waiting %s for a merge slot: %s",
+				time.Since(waitStart).Round(time.Second), message()))
+		case <-ctx.Done():
+			return fmt.Errorf("context done while waiting for a merge slot: %w", ctx.Err())
+		}
+	}
+}
+
+// Release frees a merge slot taken by Acquire.
+func (l *MergeLimiter) Release() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.running--
+	l.notifyLocked()
+}
+
+// SetMaxConcurrentMerges changes the cap. Merges already running beyond a lowered cap finish,
+// new merges wait until fewer than the cap are running.
+func (l *MergeLimiter) SetMaxConcurrentMerges(maxConcurrentMerges uint) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.maxConcurrentMerges == maxConcurrentMerges {
+		return
+	}
+	l.maxConcurrentMerges = maxConcurrentMerges
+	l.notifyLocked()
+}
+
+// MaxConcurrentMerges returns the current cap.
+func (l *MergeLimiter) MaxConcurrentMerges() uint {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.maxConcurrentMerges
+}
+
+func (l *MergeLimiter) notifyLocked() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
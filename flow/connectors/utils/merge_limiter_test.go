@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeLimiterCapsAcrossMirrors(t *testing.T) {
+	limiter := NewMergeLimiter(2)
+
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	// two mirrors, each merging several tables concurrently
+	for mirror := 0; mirror < 2; mirror++ {
+		for table := 0; table < 4; table++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := limiter.Acquire(context.Background(), time.Millisecond, func() string { return "test" })
+				if err != nil {
+					errs <- err
+					return
+				}
+				defer limiter.Release()
+
+				current := atomic.AddInt32(&running, 1)
+				for {
+					seen := atomic.LoadInt32(&maxRunning)
+					if current <= seen || atomic.CompareAndSwapInt32(&maxRunning, seen, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+			}()
+		}
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(2), maxRunning)
+}
+
+func TestMergeLimiterCancelledWhileWaiting(t *testing.T) {
+	limiter := NewMergeLimiter(1)
+	require.NoError(t, limiter.Acquire(context.Background(), time.Millisecond, func() string { return "test" }))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := limiter.Acquire(ctx, time.Millisecond, func() string { return "test" })
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestNilMergeLimiterIsUnlimited(t *testing.T) {
+	limiter := NewMergeLimiter(0)
+	require.Nil(t, limiter)
+	require.NoError(t, limiter.Acquire(context.Background(), time.Millisecond, func() string { return "test" }))
+	limiter.Release()
+}
+
+func TestMergeLimiterResized(t *testing.T) {
+	limiter := NewMergeLimiter(1)
+	require.NoError(t, limiter.Acquire(context.Background(), time.Millisecond, func() string { return "test" }))
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- limiter.Acquire(context.Background(), time.Millisecond, func() string { return "test" })
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("acquired a slot beyond the cap")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// raising the cap lets the waiting merge start without any merge finishing
+	limiter.SetMaxConcurrentMerges(2)
+	require.NoError(t, <-acquired)
+
+	// lowering it lets running merges finish, but no new one starts until below the cap
+	limiter.SetMaxConcurrentMerges(1)
+	limiter.Release()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, limiter.Acquire(ctx, time.Millisecond, func() string { return "test" }),
+		context.DeadlineExceeded)
+	limiter.Release()
+	require.NoError(t, limiter.Acquire(context.Background(), time.Millisecond, func() string { return "test" }))
+}
@@ -3,13 +3,41 @@ package metrics
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/model"
 	"github.com/PeerDB-io/peer-flow/shared"
 	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
 )
 
+// ParseLabels parses metrics labels given as comma separated key=value pairs, like "env=prod,team=data".
+func ParseLabels(labels string) (map[string]string, error) {
+	parsed := make(map[string]string)
+	for _, label := range strings.Split(labels, ",") {
+		label = strings.TrimSpace(label)
+		if label == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(label, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid metrics label %q, expected key=value", label)
+		}
+		parsed[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return parsed, nil
+}
+
+// getMetricsHandler returns the activity's metrics handler, tagging every metric with the labels in ctx.
+func getMetricsHandler(ctx context.Context) client.MetricsHandler {
+	metricsHandler := activity.GetMetricsHandler(ctx)
+	if labels, ok := ctx.Value(shared.MetricsLabelsKey).(map[string]string); ok && len(labels) > 0 {
+		metricsHandler = metricsHandler.WithTags(labels)
+	}
+	return metricsHandler
+}
+
 func LogPullMetrics(
 	ctx context.Context,
 	flowJobName string,
@@ -21,7 +49,7 @@ func LogPullMetrics(
 		return
 	}
 
-	metricsHandler := activity.GetMetricsHandler(ctx)
+	metricsHandler := getMetricsHandler(ctx)
 	insertRecordsPulledGauge := metricsHandler.Gauge(fmt.Sprintf("cdcflow.%s.insert_records_pulled", flowJobName))
 	updateRecordsPulledGauge := metricsHandler.Gauge(fmt.Sprintf("cdcflow.%s.update_records_pulled", flowJobName))
 	deleteRecordsPulledGauge := metricsHandler.Gauge(fmt.Sprintf("cdcflow.%s.delete_records_pulled", flowJobName))
@@ -52,7 +80,7 @@ func LogSyncMetrics(ctx context.Context, flowJobName string, recordsCount int64,
 		return
 	}
 
-	metricsHandler := activity.GetMetricsHandler(ctx)
+	metricsHandler := getMetricsHandler(ctx)
 	recordsSyncedPerSecondGauge :=
 		metricsHandler.Gauge(fmt.Sprintf("cdcflow.%s.records_synced_per_second", flowJobName))
 	recordsSyncedPerSecondGauge.Update(float64(recordsCount) / duration.Seconds())
@@ -69,7 +97,7 @@ func LogNormalizeMetrics(
 		return
 	}
 
-	metricsHandler := activity.GetMetricsHandler(ctx)
+	metricsHandler := getMetricsHandler(ctx)
 	recordsNormalizedPerSecondGauge :=
 		metricsHandler.Gauge(fmt.Sprintf("cdcflow.%s.records_normalized_per_second", flowJobName))
 	totalRecordsAtTargetGauge :=
@@ -79,13 +107,23 @@ func LogNormalizeMetrics(
 	totalRecordsAtTargetGauge.Update(float64(totalRecordsAtTarget))
 }
 
+func LogNormalizeBacklogMetrics(ctx context.Context, flowJobName string, backlog int64) {
+	if ctx.Value(shared.EnableMetricsKey) != true {
+		return
+	}
+
+	metricsHandler := getMetricsHandler(ctx)
+	normalizeBacklogGauge := metricsHandler.Gauge(fmt.Sprintf("cdcflow.%s.normalize_backlog", flowJobName))
+	normalizeBacklogGauge.Update(float64(backlog))
+}
+
 func LogQRepPullMetrics(ctx context.Context, flowJobName string,
 	numRecords int, totalRecordsAtSource int64) {
 	if ctx.Value(shared.EnableMetricsKey) != true {
 		return
 	}
 
-	metricsHandler := activity.GetMetricsHandler(ctx)
+	metricsHandler := getMetricsHandler(ctx)
 	totalRecordsPulledGauge := metricsHandler.Gauge(fmt.Sprintf("qrepflow.%s.total_records_pulled", flowJobName))
 	totalRecordsAtSourceGauge := metricsHandler.Gauge(fmt.Sprintf("qrepflow.%s.records_at_source", flowJobName))
 
@@ -98,7 +136,7 @@ func LogQRepSyncMetrics(ctx context.Context, flowJobName string, recordsCount in
 		return
 	}
 
-	metricsHandler := activity.GetMetricsHandler(ctx)
+	metricsHandler := getMetricsHandler(ctx)
 	recordsSyncedPerSecondGauge :=
 		metricsHandler.Gauge(fmt.Sprintf("qrepflow.%s.records_synced_per_second", flowJobName))
 	recordsSyncedPerSecondGauge.Update(float64(recordsCount) / duration.Seconds())
@@ -110,7 +148,7 @@ func LogQRepNormalizeMetrics(ctx context.Context, flowJobName string,
 		return
 	}
 
-	metricsHandler := activity.GetMetricsHandler(ctx)
+	metricsHandler := getMetricsHandler(ctx)
 	recordsSyncedPerSecondGauge :=
 		metricsHandler.Gauge(fmt.Sprintf("qrepflow.%s.records_normalized_per_second", flowJobName))
 	totalRecordsAtTargetGauge :=
@@ -125,7 +163,7 @@ func LogCDCRawThroughputMetrics(ctx context.Context, flowJobName string, through
 		return
 	}
 
-	metricsHandler := activity.GetMetricsHandler(ctx)
+	metricsHandler := getMetricsHandler(ctx)
 	totalThroughputGauge :=
 		metricsHandler.Gauge(fmt.Sprintf("cdcflow.%s.records_throughput", flowJobName))
 	totalThroughputGauge.Update(throughput)
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/shared"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/testsuite"
+)
+
+// capturingHandler records the tags every gauge was created with.
+type capturingHandler struct {
+	client.MetricsHandler
+	tags      map[string]string
+	mutex     *sync.Mutex
+	gaugeTags map[string]map[string]string
+}
+
+func newCapturingHandler() *capturingHandler {
+	return &capturingHandler{
+		MetricsHandler: client.MetricsNopHandler,
+		tags:           map[string]string{},
+		mutex:          &sync.Mutex{},
+		gaugeTags:      map[string]map[string]string{},
+	}
+}
+
+func (h *capturingHandler) WithTags(tags map[string]string) client.MetricsHandler {
+	merged := make(map[string]string, len(h.tags)+len(tags))
+	for key, value := range h.tags {
+		merged[key] = value
+	}
+	for key, value := range tags {
+		merged[key] = value
+	}
+	return &capturingHandler{
+		MetricsHandler: h.MetricsHandler,
+		tags:           merged,
+		mutex:          h.mutex,
+		gaugeTags:      h.gaugeTags,
+	}
+}
+
+func (h *capturingHandler) Gauge(name string) client.MetricsGauge {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.gaugeTags[name] = h.tags
+	return h.MetricsHandler.Gauge(name)
+}
+
+func TestMetricsCarryLabels(t *testing.T) {
+	handler := newCapturingHandler()
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetMetricsHandler(handler)
+	env := testSuite.NewTestActivityEnvironment()
+
+	labels := map[string]string{
+		"environment":           "prod",
+		"team":                  "data",
+		"source_peer_type":      "postgres",
+		"destination_peer_type": "snowflake",
+	}
+	logSyncMetrics := func(ctx context.Context) error {
+		ctx = context.WithValue(ctx, shared.EnableMetricsKey, true)
+		ctx = context.WithValue(ctx, shared.MetricsLabelsKey, labels)
+		LogSyncMetrics(ctx, "test_flow", 100, time.Second)
+		return nil
+	}
+	env.RegisterActivity(logSyncMetrics)
+	_, err := env.ExecuteActivity(logSyncMetrics)
+	require.NoError(t, err)
+
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	tags, ok := handler.gaugeTags["cdcflow.test_flow.records_synced_per_second"]
+	require.True(t, ok)
+	for key, value := range labels {
+		require.Equal(t, value, tags[key])
+	}
+}
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels(" environment=prod, team = data ,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"environment": "prod", "team": "data"}, labels)
+
+	labels, err = ParseLabels("")
+	require.NoError(t, err)
+	require.Empty(t, labels)
+
+	_, err = ParseLabels("environment")
+	require.Error(t, err)
+}
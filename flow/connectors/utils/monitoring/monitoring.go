@@ -11,7 +11,9 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type CatalogMirrorMonitor struct {
@@ -24,6 +26,10 @@ type CDCBatchInfo struct {
 	BatchStartLSN pglogrepl.LSN
 	BatchEndlSN   pglogrepl.LSN
 	StartTime     time.Time
+	// TransactionsInBatch is the number of source transactions committed in the batch.
+	TransactionsInBatch int64
+	// LastCommitTime is the source commit time of the last transaction in the batch, zero if unknown.
+	LastCommitTime time.Time
 }
 
 func NewCatalogMirrorMonitor(catalogConn *pgxpool.Pool) *CatalogMirrorMonitor {
@@ -93,11 +99,16 @@ func (c *CatalogMirrorMonitor) AddCDCBatchForFlow(ctx context.Context, flowJobNa
 		return nil
 	}
 
+	lastCommitTime := pgtype.Timestamptz{
+		Time:  batchInfo.LastCommitTime,
+		Valid: !batchInfo.LastCommitTime.IsZero(),
+	}
 	_, err := c.catalogConn.Exec(ctx,
 		`INSERT INTO peerdb_stats.cdc_batches(flow_name,batch_id,rows_in_batch,batch_start_lsn,batch_end_lsn,
-		start_time) VALUES($1,$2,$3,$4,$5,$6) ON CONFLICT DO NOTHING`,
+		start_time,transactions_in_batch,last_commit_time) VALUES($1,$2,$3,$4,$5,$6,$7,$8) ON CONFLICT DO NOTHING`,
 		flowJobName, batchInfo.BatchID, batchInfo.RowsInBatch,
-		uint64(batchInfo.BatchStartLSN), uint64(batchInfo.BatchEndlSN), batchInfo.StartTime)
+		uint64(batchInfo.BatchStartLSN), uint64(batchInfo.BatchEndlSN), batchInfo.StartTime,
+		batchInfo.TransactionsInBatch, lastCommitTime)
 	if err != nil {
 		return fmt.Errorf("error while inserting batch into cdc_batch: %w", err)
 	}
@@ -232,6 +243,10 @@ func (c *CatalogMirrorMonitor) addPartitionToQRepRun(ctx context.Context, flowJo
 		log.Infof("partition %s is a full table partition. Metrics logging is skipped.", partition.PartitionId)
 		return nil
 	}
+	if partition.SchemaOnly {
+		log.Infof("partition %s is a schema only partition. Metrics logging is skipped.", partition.PartitionId)
+		return nil
+	}
 
 	var rangeStart, rangeEnd string
 	switch x := partition.Range.Range.(type) {
@@ -322,3 +337,185 @@ func (c *CatalogMirrorMonitor) UpdateEndTimeForPartition(ctx context.Context, ru
 	}
 	return nil
 }
+
+// AddSchemaDeltasForFlow records schema changes applied to the destination tables of a mirror,
+// so that they can be listed later on.
+func (c *CatalogMirrorMonitor) AddSchemaDeltasForFlow(ctx context.Context, flowJobName string,
+	tableSchemaDeltas []*protos.TableSchemaDelta) error {
+	if c == nil || c.catalogConn == nil {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, tableSchemaDelta := range tableSchemaDeltas {
+		deltaInfo, err := protojson.Marshal(tableSchemaDelta)
+		if err != nil {
+			return fmt.Errorf("unable to marshal schema delta: %w", err)
+		}
+		batch.Queue(`INSERT INTO peerdb_stats.schema_deltas_audit_log(flow_job_name,delta_info) VALUES($1,$2)`,
+			flowJobName, deltaInfo)
+	}
+	err := c.catalogConn.SendBatch(ctx, batch).Close()
+	if err != nil {
+		return fmt.Errorf("error while inserting schema deltas into schema_deltas_audit_log: %w", err)
+	}
+	return nil
+}
+
+// GetSchemaDeltaHistoryForFlow lists the schema changes applied to the destination tables of a mirror,
+// oldest first.
+func (c *CatalogMirrorMonitor) GetSchemaDeltaHistoryForFlow(ctx context.Context,
+	flowJobName string) ([]*protos.SchemaDeltaHistoryEntry, error) {
+	if c == nil || c.catalogConn == nil {
+		return nil, nil
+	}
+
+	rows, err := c.catalogConn.Query(ctx, `SELECT delta_info,applied_at FROM peerdb_stats.schema_deltas_audit_log
+	 WHERE flow_job_name=$1 ORDER BY id`, flowJobName)
+	if err != nil {
+		return nil, fmt.Errorf("error while querying schema_deltas_audit_log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*protos.SchemaDeltaHistoryEntry
+	for rows.Next() {
+		var deltaInfo []byte
+		var appliedAt time.Time
+		if err := rows.Scan(&deltaInfo, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		tableSchemaDelta := &protos.TableSchemaDelta{}
+		if err := protojson.Unmarshal(deltaInfo, tableSchemaDelta); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal schema delta: %w", err)
+		}
+		entries = append(entries, &protos.SchemaDeltaHistoryEntry{
+			TableSchemaDelta: tableSchemaDelta,
+			AppliedAt:        timestamppb.New(appliedAt),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error while iterating over schema_deltas_audit_log: %w", err)
+	}
+	return entries, nil
+}
+
+// MarkSnapshotCompleted records that the initial copy of a mirror completed, so that it isn't run again.
+func (c *CatalogMirrorMonitor) MarkSnapshotCompleted(ctx context.Context, flowJobName string) error {
+	if c == nil || c.catalogConn == nil {
+		return nil
+	}
+
+	_, err := c.catalogConn.Exec(ctx, `INSERT INTO peerdb_stats.snapshot_completions(flow_job_name) VALUES($1)
+	 ON CONFLICT(flow_job_name) DO UPDATE SET completed_at=now()`, flowJobName)
+	if err != nil {
+		return fmt.Errorf("error while inserting snapshot completion into snapshot_completions: %w", err)
+	}
+	return nil
+}
+
+// IsSnapshotCompleted checks if the initial copy of a mirror completed before.
+func (c *CatalogMirrorMonitor) IsSnapshotCompleted(ctx context.Context, flowJobName string) (bool, error) {
+	if c == nil || c.catalogConn == nil {
+		return false, nil
+	}
+
+	var completed bool
+	err := c.catalogConn.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM peerdb_stats.snapshot_completions
+	 WHERE flow_job_name=$1)`, flowJobName).Scan(&completed)
+	if err != nil {
+		return false, fmt.Errorf("error while querying snapshot_completions: %w", err)
+	}
+	return completed, nil
+}
+
+// ClearSnapshotCompletion forgets the initial copy of a dropped mirror, so that a mirror created again
+// under the same name copies its tables.
+func (c *CatalogMirrorMonitor) ClearSnapshotCompletion(ctx context.Context, flowJobName string) error {
+	if c == nil || c.catalogConn == nil {
+		return nil
+	}
+
+	_, err := c.catalogConn.Exec(ctx, `DELETE FROM peerdb_stats.snapshot_completions WHERE flow_job_name=$1`,
+		flowJobName)
+	if err != nil {
+		return fmt.Errorf("error while deleting snapshot completion from snapshot_completions: %w", err)
+	}
+	return nil
+}
+
+// GetFlowJobNames lists the names of the mirrors in the catalog.
+func (c *CatalogMirrorMonitor) GetFlowJobNames(ctx context.Context) ([]string, error) {
+	if c == nil || c.catalogConn == nil {
+		return nil, nil
+	}
+
+	rows, err := c.catalogConn.Query(ctx, "SELECT name FROM flows")
+	if err != nil {
+		return nil, fmt.Errorf("error while querying flows: %w", err)
+	}
+	defer rows.Close()
+
+	var flowJobNames []string
+	for rows.Next() {
+		var flowJobName string
+		if err := rows.Scan(&flowJobName); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		flowJobNames = append(flowJobNames, flowJobName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error while iterating over flows: %w", err)
+	}
+	return flowJobNames, nil
+}
+
+// LockNormalize takes the normalize lock of a CDC mirror, waiting for a running normalize to release it.
+// Table rebuilds hold it so that no normalize merges into a table while it is truncated and rebuilt.
+// The returned function releases the lock.
+func (c *CatalogMirrorMonitor) LockNormalize(ctx context.Context, flowJobName string) (func(), error) {
+	unlock, _, err := c.lockNormalize(ctx, flowJobName, true)
+	return unlock, err
+}
+
+// TryLockNormalize takes the normalize lock of a CDC mirror if no table rebuild holds it, returning false
+// otherwise. The returned function releases the lock.
+func (c *CatalogMirrorMonitor) TryLockNormalize(ctx context.Context, flowJobName string) (func(), bool, error) {
+	return c.lockNormalize(ctx, flowJobName, false)
+}
+
+func (c *CatalogMirrorMonitor) lockNormalize(ctx context.Context, flowJobName string,
+	wait bool) (func(), bool, error) {
+	if c == nil || c.catalogConn == nil {
+		return func() {}, true, nil
+	}
+
+	// session advisory locks belong to the connection, which is held until the lock is released
+	conn, err := c.catalogConn.Acquire(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("error while acquiring connection for normalize lock: %w", err)
+	}
+	lockKey := "normalize:" + flowJobName
+	locked := true
+	if wait {
+		_, err = conn.Exec(ctx, "SELECT pg_advisory_lock(hashtext($1))", lockKey)
+	} else {
+		err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", lockKey).Scan(&locked)
+	}
+	if err != nil || !locked {
+		conn.Release()
+		if err != nil {
+			return nil, false, fmt.Errorf("error while taking normalize lock: %w", err)
+		}
+		return nil, false, nil
+	}
+
+	return func() {
+		_, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", lockKey)
+		if err != nil {
+			// the lock would stay with the connection in the pool, closing it releases the lock
+			log.Warnf("failed to release normalize lock of %s: %v", flowJobName, err)
+			_ = conn.Conn().Close(context.Background())
+		}
+		conn.Release()
+	}, true, nil
+}
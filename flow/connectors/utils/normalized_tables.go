@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+// GetTableSchemaInput returns the input to fetch the schema of the given source tables of a mirror,
+// so every place fetching it maps the source column types the same way.
+func GetTableSchemaInput(cfg *protos.FlowConnectionConfigs, sourceTables []string) *protos.GetTableSchemaBatchInput {
+	return &protos.GetTableSchemaBatchInput{
+		PeerConnectionConfig: cfg.Source,
+		TableIdentifiers:     sourceTables,
+		JsonAsText:           cfg.JsonAsText,
+		RangesAsText:         cfg.RangesAsText,
+		MaxParallelFetches:   cfg.MaxParallelSchemaFetches,
+	}
+}
+
+// SetupNormalizedTablesInput returns the input to create the normalized tables of a mirror, given the
+// schema of each of its destination tables. Raw-only tables are left out, as they are never created.
+// Setup creates the tables from it and the DDL preview generates their statements, so the preview shows
+// what setup runs.
+func SetupNormalizedTablesInput(cfg *protos.FlowConnectionConfigs,
+	normalizedTableMapping map[string]*protos.TableSchema) *protos.SetupNormalizedTableBatchInput {
+	rawOnlyTables := RawOnlyTables(cfg.TableMappings)
+	tablesToCreate := make(map[string]*protos.TableSchema, len(normalizedTableMapping))
+	for normalizedTableName, tableSchema := range normalizedTableMapping {
+		if !rawOnlyTables[normalizedTableName] {
+			tablesToCreate[normalizedTableName] = tableSchema
+		}
+	}
+
+	return &protos.SetupNormalizedTableBatchInput{
+		PeerConnectionConfig:   cfg.Destination,
+		TableNameSchemaMapping: tablesToCreate,
+		TransientTables:        cfg.TransientNormalizedTables,
+		DynamicTables:          cfg.DynamicNormalizedTables,
+		DynamicTableTargetLag:  cfg.DynamicTableTargetLag,
+		FlowJobName:            cfg.FlowJobName,
+		SoftDelete:             cfg.SoftDelete,
+		RawDataAsVariant:       cfg.RawDataAsVariant,
+		SourceLsnColumn:        cfg.SourceLsnColumn,
+		Tags:                   cfg.DestinationTags,
+		ColumnDefaults:         cfg.DestinationColumnDefaults,
+		DedupNullsOrdering:     cfg.DedupNullsOrdering,
+		VariantNullPolicy:      cfg.VariantNullPolicy,
+		LastOpColumn:           cfg.LastOpColumn,
+	}
+}
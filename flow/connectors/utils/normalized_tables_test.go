@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupNormalizedTablesInput(t *testing.T) {
+	cfg := &protos.FlowConnectionConfigs{
+		FlowJobName: "test",
+		Destination: &protos.Peer{Name: "sf", Type: protos.DBType_SNOWFLAKE},
+		TableMappings: []*protos.TableMapping{
+			{SourceTableIdentifier: "public.a", DestinationTableIdentifier: "PUBLIC.A"},
+			{SourceTableIdentifier: "public.b", DestinationTableIdentifier: "PUBLIC.B", RawOnly: true},
+		},
+		DynamicNormalizedTables: true,
+		DynamicTableTargetLag:   "1 minute",
+		SoftDelete:              true,
+		RawDataAsVariant:        true,
+	}
+	tableSchema := &protos.TableSchema{TableIdentifier: "public.a"}
+
+	// the raw-only table is not created, the options of the mirror all reach the destination.
+	input := SetupNormalizedTablesInput(cfg, map[string]*protos.TableSchema{
+		"PUBLIC.A": tableSchema,
+		"PUBLIC.B": {TableIdentifier: "public.b"},
+	})
+	require.Equal(t, map[string]*protos.TableSchema{"PUBLIC.A": tableSchema}, input.TableNameSchemaMapping)
+	require.Equal(t, cfg.Destination, input.PeerConnectionConfig)
+	require.Equal(t, "test", input.FlowJobName)
+	require.True(t, input.DynamicTables)
+	require.Equal(t, "1 minute", input.DynamicTableTargetLag)
+	require.True(t, input.SoftDelete)
+	require.True(t, input.RawDataAsVariant)
+}
+
+func TestGetTableSchemaInput(t *testing.T) {
+	cfg := &protos.FlowConnectionConfigs{
+		Source:                   &protos.Peer{Name: "pg", Type: protos.DBType_POSTGRES},
+		JsonAsText:               true,
+		RangesAsText:             true,
+		MaxParallelSchemaFetches: 4,
+	}
+	input := GetTableSchemaInput(cfg, []string{"public.a"})
+	require.Equal(t, cfg.Source, input.PeerConnectionConfig)
+	require.Equal(t, []string{"public.a"}, input.TableIdentifiers)
+	require.True(t, input.JsonAsText)
+	require.True(t, input.RangesAsText)
+	require.Equal(t, uint32(4), input.MaxParallelFetches)
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+// WithDatabaseOverride returns a copy of the peer that connects to the given database instead of the
+// peer's default one, so that every object the mirror reads or creates is in that database.
+// The peer itself is left as is, as it may be shared by other mirrors.
+func WithDatabaseOverride(peer *protos.Peer, database string) (*protos.Peer, error) {
+	if database == "" {
+		return peer, nil
+	}
+
+	overridden, ok := proto.Clone(peer).(*protos.Peer)
+	if !ok {
+		return nil, fmt.Errorf("failed to copy peer %s", peer.Name)
+	}
+	switch config := overridden.Config.(type) {
+	case *protos.Peer_SnowflakeConfig:
+		config.SnowflakeConfig.Database = database
+	default:
+		return nil, fmt.Errorf("overriding the database of peer %s is only supported for snowflake peers", peer.Name)
+	}
+	return overridden, nil
+}
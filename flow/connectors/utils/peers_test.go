@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDatabaseOverride(t *testing.T) {
+	peer := &protos.Peer{
+		Name: "sf",
+		Type: protos.DBType_SNOWFLAKE,
+		Config: &protos.Peer_SnowflakeConfig{
+			SnowflakeConfig: &protos.SnowflakeConfig{Database: "DEFAULT_DB", Warehouse: "WH"},
+		},
+	}
+
+	overridden, err := WithDatabaseOverride(peer, "OTHER_DB")
+	require.NoError(t, err)
+	require.Equal(t, "OTHER_DB", overridden.GetSnowflakeConfig().Database)
+	require.Equal(t, "WH", overridden.GetSnowflakeConfig().Warehouse)
+	// the shared peer keeps its default database.
+	require.Equal(t, "DEFAULT_DB", peer.GetSnowflakeConfig().Database)
+
+	unchanged, err := WithDatabaseOverride(peer, "")
+	require.NoError(t, err)
+	require.Same(t, peer, unchanged)
+}
+
+func TestWithDatabaseOverrideUnsupportedPeer(t *testing.T) {
+	peer := &protos.Peer{
+		Name: "bq",
+		Type: protos.DBType_BIGQUERY,
+		Config: &protos.Peer_BigqueryConfig{
+			BigqueryConfig: &protos.BigqueryConfig{DatasetId: "dataset"},
+		},
+	}
+
+	_, err := WithDatabaseOverride(peer, "OTHER_DB")
+	require.Error(t, err)
+}
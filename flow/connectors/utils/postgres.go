@@ -47,3 +47,77 @@ func GetCustomDataTypes(ctx context.Context, pool *pgxpool.Pool) (map[uint32]str
 	}
 	return customTypeMap, nil
 }
+
+// GetDomainBaseTypes maps the oid of every domain to the oid of its underlying base type,
+// following domains defined over other domains down to the first non-domain type.
+func GetDomainBaseTypes(ctx context.Context, pool *pgxpool.Pool) (map[uint32]uint32, error) {
+	rows, err := pool.Query(ctx, `
+		WITH RECURSIVE domains AS (
+			SELECT t.oid, t.typbasetype FROM pg_type t WHERE t.typtype = 'd'
+			UNION ALL
+			SELECT d.oid, t.typbasetype FROM domains d
+			JOIN pg_type t ON t.oid = d.typbasetype WHERE t.typtype = 'd'
+		)
+		SELECT d.oid, d.typbasetype FROM domains d
+		JOIN pg_type t ON t.oid = d.typbasetype WHERE t.typtype <> 'd';
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain base types: %w", err)
+	}
+
+	domainBaseTypes := map[uint32]uint32{}
+	for rows.Next() {
+		var domainOID uint32
+		var baseTypeOID uint32
+		if err := rows.Scan(&domainOID, &baseTypeOID); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		domainBaseTypes[domainOID] = baseTypeOID
+	}
+	return domainBaseTypes, nil
+}
+
+// CompositeType is a composite type defined in the database, along with its array type.
+type CompositeType struct {
+	Name     string
+	ArrayOID uint32
+	Fields   []CompositeTypeField
+}
+
+type CompositeTypeField struct {
+	Name    string
+	TypeOID uint32
+}
+
+// GetCompositeTypes maps the oid of every user defined composite type to its name, array type and fields,
+// with the fields in the order of the type's definition.
+func GetCompositeTypes(ctx context.Context, pool *pgxpool.Pool) (map[uint32]*CompositeType, error) {
+	rows, err := pool.Query(ctx, `
+		SELECT t.oid, t.typname, t.typarray, a.attname, a.atttypid
+		FROM pg_type t
+		JOIN pg_catalog.pg_class c ON c.oid = t.typrelid AND c.relkind = 'c'
+		JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+		JOIN pg_catalog.pg_attribute a ON a.attrelid = t.typrelid AND a.attnum > 0 AND NOT a.attisdropped
+		WHERE n.nspname NOT IN ('pg_catalog', 'information_schema')
+		ORDER BY t.oid, a.attnum;
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get composite types: %w", err)
+	}
+
+	compositeTypes := map[uint32]*CompositeType{}
+	for rows.Next() {
+		var typeOID, arrayOID, fieldTypeOID uint32
+		var typeName, fieldName string
+		if err := rows.Scan(&typeOID, &typeName, &arrayOID, &fieldName, &fieldTypeOID); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		compositeType, ok := compositeTypes[typeOID]
+		if !ok {
+			compositeType = &CompositeType{Name: typeName, ArrayOID: arrayOID}
+			compositeTypes[typeOID] = compositeType
+		}
+		compositeType.Fields = append(compositeType.Fields, CompositeTypeField{Name: fieldName, TypeOID: fieldTypeOID})
+	}
+	return compositeTypes, nil
+}
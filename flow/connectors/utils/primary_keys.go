@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+)
+
+// riskyPrimaryKeyKinds are the column types that the merge cannot reliably match rows on,
+// along with why.
+var riskyPrimaryKeyKinds = map[qvalue.QValueKind]string{
+	qvalue.QValueKindFloat32: "floating point values lose precision on the way to the destination",
+	qvalue.QValueKindFloat64: "floating point values lose precision on the way to the destination",
+	qvalue.QValueKindJSON:    "equal documents can differ in key order and whitespace",
+	qvalue.QValueKindHStore:  "equal maps can differ in key order",
+}
+
+// CheckPrimaryKeyTypes looks for primary key columns of a table whose type does not compare exactly,
+// which may make the merge update the wrong row or duplicate rows. Depending on the policy, such
+// columns are returned as warnings or fail the check.
+func CheckPrimaryKeyTypes(
+	tableIdentifier string,
+	tableSchema *protos.TableSchema,
+	policy protos.RiskyPrimaryKeyPolicy,
+) ([]string, error) {
+	var warnings []string
+	for _, primaryKeyCol := range tableSchema.PrimaryKeyColumns {
+		kind := qvalue.QValueKind(tableSchema.Columns[primaryKeyCol])
+		reason, risky := riskyPrimaryKeyKinds[kind]
+		if !risky {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("primary key column %s of table %s is of type %s, "+
+			"which the merge may not match rows on since %s. consider a primary key or replica identity "+
+			"on an integer, numeric, text or uuid column instead", primaryKeyCol, tableIdentifier, kind, reason))
+	}
+
+	if len(warnings) > 0 && policy == protos.RiskyPrimaryKeyPolicy_RISKY_PRIMARY_KEY_POLICY_ERROR {
+		errs := make([]error, 0, len(warnings))
+		for _, warning := range warnings {
+			errs = append(errs, errors.New(warning))
+		}
+		return nil, errors.Join(errs...)
+	}
+	return warnings, nil
+}
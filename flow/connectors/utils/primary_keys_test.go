@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPrimaryKeyTypesFloatPrimaryKey(t *testing.T) {
+	tableSchema := &protos.TableSchema{
+		TableIdentifier: "public.measurements",
+		Columns: map[string]string{
+			"reading": string(qvalue.QValueKindFloat64),
+			"value":   string(qvalue.QValueKindString),
+		},
+		PrimaryKeyColumns: []string{"reading"},
+	}
+
+	warnings, err := CheckPrimaryKeyTypes("public.measurements", tableSchema,
+		protos.RiskyPrimaryKeyPolicy_RISKY_PRIMARY_KEY_POLICY_WARN)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0], "primary key column reading of table public.measurements is of type float64")
+	require.Contains(t, warnings[0], "consider a primary key or replica identity on an integer")
+
+	_, err = CheckPrimaryKeyTypes("public.measurements", tableSchema,
+		protos.RiskyPrimaryKeyPolicy_RISKY_PRIMARY_KEY_POLICY_ERROR)
+	require.ErrorContains(t, err, "primary key column reading of table public.measurements is of type float64")
+
+	tableSchema.PrimaryKeyColumns = []string{"value"}
+	warnings, err = CheckPrimaryKeyTypes("public.measurements", tableSchema,
+		protos.RiskyPrimaryKeyPolicy_RISKY_PRIMARY_KEY_POLICY_ERROR)
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+}
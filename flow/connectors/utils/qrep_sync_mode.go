@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+)
+
+const (
+	defaultAutoSyncModeRowSizeThresholdBytes = 1024
+	defaultAutoSyncModeSampleRows            = 1000
+)
+
+// SelectQRepSyncMode picks the sync mode for a qrep mirror with sync mode auto from a sample of
+// its rows: rows averaging above the configured size are synced through staged files, which load
+// wide rows faster, and narrower rows are inserted directly. It also returns the average row size.
+func SelectQRepSyncMode(config *protos.QRepConfig, records []*model.QRecord) (protos.QRepSyncMode, int64) {
+	threshold := int64(config.AutoSyncModeRowSizeThresholdBytes)
+	if threshold == 0 {
+		threshold = defaultAutoSyncModeRowSizeThresholdBytes
+	}
+	sampleRows := AutoSyncModeSampleRows(config)
+	if len(records) > sampleRows {
+		records = records[:sampleRows]
+	}
+	if len(records) == 0 {
+		return protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT, 0
+	}
+
+	var totalSize int64
+	for _, record := range records {
+		totalSize += estimateQRecordSize(record)
+	}
+	averageSize := totalSize / int64(len(records))
+	if averageSize > threshold {
+		return protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO, averageSize
+	}
+	return protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT, averageSize
+}
+
+// AutoSyncModeSampleRows is the number of rows sampled to pick the sync mode of a qrep mirror.
+func AutoSyncModeSampleRows(config *protos.QRepConfig) int {
+	if config.AutoSyncModeSampleRows == 0 {
+		return defaultAutoSyncModeSampleRows
+	}
+	return int(config.AutoSyncModeSampleRows)
+}
+
+// estimateQRecordSize approximates the size of a row in bytes, going by the length of its values
+// as text for anything that isn't a string or bytes.
+func estimateQRecordSize(record *model.QRecord) int64 {
+	var size int64
+	for _, entry := range record.Entries {
+		switch value := entry.Value.(type) {
+		case nil:
+		case string:
+			size += int64(len(value))
+		case []byte:
+			size += int64(len(value))
+		default:
+			size += int64(len(fmt.Sprint(value)))
+		}
+	}
+	return size
+}
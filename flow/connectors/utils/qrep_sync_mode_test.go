@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleRows(numRows int, textSize int) []*model.QRecord {
+	records := make([]*model.QRecord, 0, numRows)
+	for i := 0; i < numRows; i++ {
+		records = append(records, &model.QRecord{
+			NumEntries: 2,
+			Entries: []qvalue.QValue{
+				{Kind: qvalue.QValueKindInt64, Value: int64(i)},
+				{Kind: qvalue.QValueKindString, Value: strings.Repeat("x", textSize)},
+			},
+		})
+	}
+	return records
+}
+
+func TestSelectQRepSyncMode(t *testing.T) {
+	config := &protos.QRepConfig{}
+
+	syncMode, averageRowSize := SelectQRepSyncMode(config, sampleRows(10, 4096))
+	require.Equal(t, protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO, syncMode)
+	require.Greater(t, averageRowSize, int64(4096))
+
+	syncMode, _ = SelectQRepSyncMode(config, sampleRows(10, 16))
+	require.Equal(t, protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT, syncMode)
+
+	// a lower threshold makes the same narrow rows go through staged files
+	config.AutoSyncModeRowSizeThresholdBytes = 8
+	syncMode, _ = SelectQRepSyncMode(config, sampleRows(10, 16))
+	require.Equal(t, protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO, syncMode)
+
+	// only the sampled rows count
+	config = &protos.QRepConfig{AutoSyncModeSampleRows: 10}
+	syncMode, _ = SelectQRepSyncMode(config, append(sampleRows(10, 16), sampleRows(1000, 4096)...))
+	require.Equal(t, protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT, syncMode)
+}
@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model"
+)
+
+// StaleRawTables returns the raw tables that belong to no mirror and that have not been changed
+// within gracePeriod of now. The grace period covers a mirror that is still being created, whose raw
+// table can exist before the mirror is in the catalog.
+func StaleRawTables(rawTables []*model.RawTable, gracePeriod time.Duration, now time.Time) []*model.RawTable {
+	staleRawTables := make([]*model.RawTable, 0)
+	for _, rawTable := range rawTables {
+		if rawTable.MirrorJobName != "" {
+			continue
+		}
+		if now.Sub(rawTable.LastAltered) < gracePeriod {
+			continue
+		}
+		staleRawTables = append(staleRawTables, rawTable)
+	}
+	return staleRawTables
+}
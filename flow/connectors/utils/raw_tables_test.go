@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaleRawTables(t *testing.T) {
+	now := time.Now()
+	rawTables := []*model.RawTable{
+		{TableIdentifier: "_PEERDB_RAW_LIVE", MirrorJobName: "live", LastAltered: now.Add(-30 * 24 * time.Hour)},
+		{TableIdentifier: "_PEERDB_RAW_ORPHAN", LastAltered: now.Add(-30 * 24 * time.Hour)},
+		// a mirror still being created may not be in the catalog yet.
+		{TableIdentifier: "_PEERDB_RAW_NEW", LastAltered: now.Add(-time.Hour)},
+	}
+
+	staleRawTables := StaleRawTables(rawTables, 7*24*time.Hour, now)
+	require.Len(t, staleRawTables, 1)
+	require.Equal(t, "_PEERDB_RAW_ORPHAN", staleRawTables[0].TableIdentifier)
+
+	require.Empty(t, StaleRawTables(rawTables, 60*24*time.Hour, now))
+}
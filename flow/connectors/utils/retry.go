@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/jackc/pgx/v5/pgconn"
+	log "github.com/sirupsen/logrus"
+)
+
+// initial interval between attempts when retrying transient errors, overridden in tests.
+var (
+	pullRetryInitialInterval      = 5 * time.Second
+	transientRetryInitialInterval = 5 * time.Second
+)
+
+// PullError marks an error as having happened while pulling records from the source,
+// as opposed to while syncing them to the destination.
+type PullError struct {
+	Err error
+}
+
+func (e *PullError) Error() string {
+	return fmt.Sprintf("failed to pull records: %v", e.Err)
+}
+
+func (e *PullError) Unwrap() error {
+	return e.Err
+}
+
+// IsTransientPullError returns true if err happened while pulling records and is likely to
+// go away on retry, such as a dropped connection or a timeout.
+func IsTransientPullError(err error) bool {
+	var pullErr *PullError
+	if !errors.As(err, &pullErr) {
+		return false
+	}
+	return IsTransientError(pullErr.Err)
+}
+
+// IsTransientError returns true if err is likely to go away on retry, such as a dropped
+// connection or a timeout, regardless of where it happened.
+func IsTransientError(err error) bool {
+	// cancellation is how the activity is told to stop, never retry it.
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	if pgconn.Timeout(err) || pgconn.SafeToRetry(err) {
+		return true
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// class 08 is connection exceptions, the rest are serialization failures, deadlocks,
+		// statement timeouts, server shutdowns and too many connections.
+		if strings.HasPrefix(pgErr.Code, "08") {
+			return true
+		}
+		switch pgErr.Code {
+		case "40001", "40P01", "57014", "57P01", "57P02", "57P03", "53300":
+			return true
+		}
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// RetryTransientPullErrors runs op, retrying it with exponential backoff up to maxRetries times
+// as long as it fails with a transient pull error. Any other error is returned immediately.
+func RetryTransientPullErrors(ctx context.Context, maxRetries uint64, op func() error) error {
+	return RetryWithBackoff(ctx, pullRetryInitialInterval, maxRetries, IsTransientPullError,
+		"transient error while pulling records", op)
+}
+
+// RetryTransientErrors runs op, retrying it with exponential backoff up to maxRetries times
+// as long as it fails with a transient error. Any other error is returned immediately,
+// so op must be safe to run again after a transient failure.
+func RetryTransientErrors(ctx context.Context, maxRetries uint64, op func() error) error {
+	return RetryWithBackoff(ctx, transientRetryInitialInterval, maxRetries, IsTransientError,
+		"transient error", op)
+}
+
+// RetryWithBackoff runs op, retrying it with exponential backoff starting at initialInterval up to
+// maxRetries times as long as isRetryable returns true for its error. description prefixes the
+// warning logged before each retry.
+func RetryWithBackoff(ctx context.Context, initialInterval time.Duration, maxRetries uint64,
+	isTransient func(error) bool, description string, op func() error) error {
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = initialInterval
+	expBackoff.MaxInterval = 1 * time.Minute
+	expBackoff.MaxElapsedTime = 0
+
+	operation := func() error {
+		err := op()
+		if err != nil && !isTransient(err) {
+			// Permanent error will stop the retries
+			return backoff.Permanent(err)
+		}
+		return err
+	}
+
+	notify := func(err error, wait time.Duration) {
+		log.Warnf("%s, retrying in %v: %v", description, wait, err)
+	}
+
+	return backoff.RetryNotify(operation,
+		backoff.WithContext(backoff.WithMaxRetries(expBackoff, maxRetries), ctx), notify)
+}
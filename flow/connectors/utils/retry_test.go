@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTransientPullError(t *testing.T) {
+	require.True(t, IsTransientPullError(&PullError{Err: fmt.Errorf("fetch: %w", io.ErrUnexpectedEOF)}))
+	require.True(t, IsTransientPullError(&PullError{Err: &pgconn.PgError{Code: "08006"}}))
+	require.True(t, IsTransientPullError(&PullError{Err: &pgconn.PgError{Code: "57P01"}}))
+	require.False(t, IsTransientPullError(&PullError{Err: &pgconn.PgError{Code: "42P01"}}))
+	require.False(t, IsTransientPullError(&PullError{Err: context.Canceled}))
+	// errors while syncing are never retried here, even if they look transient.
+	require.False(t, IsTransientPullError(io.ErrUnexpectedEOF))
+}
+
+func TestRetryTransientPullErrors(t *testing.T) {
+	pullRetryInitialInterval = time.Millisecond
+
+	attempts := 0
+	err := RetryTransientPullErrors(context.Background(), 3, func() error {
+		attempts++
+		if attempts == 1 {
+			return &PullError{Err: &pgconn.PgError{Code: "08006", Message: "connection failure"}}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+
+	attempts = 0
+	fatalErr := &PullError{Err: &pgconn.PgError{Code: "42P01", Message: "relation does not exist"}}
+	err = RetryTransientPullErrors(context.Background(), 3, func() error {
+		attempts++
+		return fatalErr
+	})
+	require.ErrorIs(t, err, fatalErr)
+	require.Equal(t, 1, attempts)
+
+	attempts = 0
+	err = RetryTransientPullErrors(context.Background(), 2, func() error {
+		attempts++
+		return &PullError{Err: io.ErrUnexpectedEOF}
+	})
+	require.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+	require.Equal(t, 3, attempts)
+}
+
+func TestRetryTransientErrors(t *testing.T) {
+	transientRetryInitialInterval = time.Millisecond
+
+	// a flaky final merge of a consolidation, the retry finishes it
+	attempts := 0
+	err := RetryTransientErrors(context.Background(), 3, func() error {
+		attempts++
+		if attempts < 3 {
+			return fmt.Errorf("failed to merge data into destination table: %w", io.ErrUnexpectedEOF)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, attempts)
+
+	attempts = 0
+	fatalErr := errors.New("SQL compilation error: invalid identifier")
+	err = RetryTransientErrors(context.Background(), 3, func() error {
+		attempts++
+		return fatalErr
+	})
+	require.ErrorIs(t, err, fatalErr)
+	require.Equal(t, 1, attempts)
+
+	attempts = 0
+	err = RetryTransientErrors(context.Background(), 3, func() error {
+		attempts++
+		return context.Canceled
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, attempts)
+}
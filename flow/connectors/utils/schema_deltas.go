@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"sort"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+// AddedColumnDeltas compares the current schema of the source tables, keyed by source table name, with
+// the schema the mirror last synced, keyed by destination table name, returning a delta for each table
+// with added columns. Columns dropped at the source are left on the destination, like for replayed changes.
+func AddedColumnDeltas(tableNameMapping map[string]string, currentSchemas map[string]*protos.TableSchema,
+	syncedSchemas map[string]*protos.TableSchema) []*protos.TableSchemaDelta {
+	srcTableNames := make([]string, 0, len(currentSchemas))
+	for srcTableName := range currentSchemas {
+		srcTableNames = append(srcTableNames, srcTableName)
+	}
+	sort.Strings(srcTableNames)
+
+	tableSchemaDeltas := make([]*protos.TableSchemaDelta, 0)
+	for _, srcTableName := range srcTableNames {
+		dstTableName, ok := tableNameMapping[srcTableName]
+		if !ok {
+			continue
+		}
+		syncedSchema, ok := syncedSchemas[dstTableName]
+		if !ok {
+			continue
+		}
+		currentSchema := currentSchemas[srcTableName]
+		columnNames := make([]string, 0, len(currentSchema.Columns))
+		for columnName := range currentSchema.Columns {
+			if _, ok := syncedSchema.Columns[columnName]; !ok {
+				columnNames = append(columnNames, columnName)
+			}
+		}
+		if len(columnNames) == 0 {
+			continue
+		}
+		sort.Strings(columnNames)
+
+		tableSchemaDelta := &protos.TableSchemaDelta{
+			SrcTableName: srcTableName,
+			DstTableName: dstTableName,
+			AddedColumns: make([]*protos.DeltaAddedColumn, 0, len(columnNames)),
+		}
+		for _, columnName := range columnNames {
+			tableSchemaDelta.AddedColumns = append(tableSchemaDelta.AddedColumns, &protos.DeltaAddedColumn{
+				ColumnName: columnName,
+				ColumnType: currentSchema.Columns[columnName],
+			})
+		}
+		tableSchemaDeltas = append(tableSchemaDeltas, tableSchemaDelta)
+	}
+	return tableSchemaDeltas
+}
+
+// UnsyncedSchemaDeltas leaves out the added columns the mirror already synced, keyed by destination table
+// name, returning nil if no changes are left. Columns added while the mirror was idle are replayed right
+// away, and the change stream still sees them once rows of their table change.
+func UnsyncedSchemaDeltas(tableSchemaDeltas []*protos.TableSchemaDelta,
+	syncedSchemas map[string]*protos.TableSchema) []*protos.TableSchemaDelta {
+	var unsynced []*protos.TableSchemaDelta
+	for _, tableSchemaDelta := range tableSchemaDeltas {
+		syncedSchema, ok := syncedSchemas[tableSchemaDelta.DstTableName]
+		if !ok {
+			unsynced = append(unsynced, tableSchemaDelta)
+			continue
+		}
+		addedColumns := make([]*protos.DeltaAddedColumn, 0, len(tableSchemaDelta.AddedColumns))
+		for _, addedColumn := range tableSchemaDelta.AddedColumns {
+			if _, ok := syncedSchema.Columns[addedColumn.ColumnName]; !ok {
+				addedColumns = append(addedColumns, addedColumn)
+			}
+		}
+		if len(addedColumns) > 0 {
+			unsynced = append(unsynced, &protos.TableSchemaDelta{
+				SrcTableName: tableSchemaDelta.SrcTableName,
+				DstTableName: tableSchemaDelta.DstTableName,
+				AddedColumns: addedColumns,
+			})
+		}
+	}
+	return unsynced
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddedColumnDeltas(t *testing.T) {
+	tableNameMapping := map[string]string{"public.src": "public.dst", "public.other": "public.other_dst"}
+	syncedSchemas := map[string]*protos.TableSchema{
+		"public.dst": {Columns: map[string]string{
+			"id": string(qvalue.QValueKindInt64),
+			"c1": string(qvalue.QValueKindInt64),
+		}},
+		"public.other_dst": {Columns: map[string]string{"id": string(qvalue.QValueKindInt64)}},
+	}
+	// c1 was dropped and c2 and c3 were added to public.src, public.other did not change
+	currentSchemas := map[string]*protos.TableSchema{
+		"public.src": {Columns: map[string]string{
+			"id": string(qvalue.QValueKindInt64),
+			"c3": string(qvalue.QValueKindString),
+			"c2": string(qvalue.QValueKindInt64),
+		}},
+		"public.other": {Columns: map[string]string{"id": string(qvalue.QValueKindInt64)}},
+	}
+
+	require.Equal(t, []*protos.TableSchemaDelta{{
+		SrcTableName: "public.src",
+		DstTableName: "public.dst",
+		AddedColumns: []*protos.DeltaAddedColumn{
+			{ColumnName: "c2", ColumnType: string(qvalue.QValueKindInt64)},
+			{ColumnName: "c3", ColumnType: string(qvalue.QValueKindString)},
+		},
+	}}, AddedColumnDeltas(tableNameMapping, currentSchemas, syncedSchemas))
+	require.Empty(t, AddedColumnDeltas(tableNameMapping, currentSchemas, map[string]*protos.TableSchema{
+		"public.dst":       currentSchemas["public.src"],
+		"public.other_dst": currentSchemas["public.other"],
+	}))
+}
+
+func TestUnsyncedSchemaDeltas(t *testing.T) {
+	// c2 was already replayed while the mirror was idle, the change stream sees it with c3
+	syncedSchemas := map[string]*protos.TableSchema{
+		"public.dst": {Columns: map[string]string{
+			"id": string(qvalue.QValueKindInt64),
+			"c2": string(qvalue.QValueKindInt64),
+		}},
+	}
+	tableSchemaDeltas := []*protos.TableSchemaDelta{{
+		SrcTableName: "public.src",
+		DstTableName: "public.dst",
+		AddedColumns: []*protos.DeltaAddedColumn{
+			{ColumnName: "c2", ColumnType: string(qvalue.QValueKindInt64)},
+			{ColumnName: "c3", ColumnType: string(qvalue.QValueKindInt64)},
+		},
+	}}
+	require.Equal(t, []*protos.TableSchemaDelta{{
+		SrcTableName: "public.src",
+		DstTableName: "public.dst",
+		AddedColumns: []*protos.DeltaAddedColumn{{ColumnName: "c3", ColumnType: string(qvalue.QValueKindInt64)}},
+	}}, UnsyncedSchemaDeltas(tableSchemaDeltas, syncedSchemas))
+
+	syncedSchemas["public.dst"].Columns["c3"] = string(qvalue.QValueKindInt64)
+	require.Nil(t, UnsyncedSchemaDeltas(tableSchemaDeltas, syncedSchemas))
+	require.Nil(t, UnsyncedSchemaDeltas(nil, syncedSchemas))
+}
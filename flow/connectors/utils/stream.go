@@ -9,9 +9,9 @@ import (
 	"github.com/google/uuid"
 )
 
-func RecordsToRawTableStream(req model.RecordsToStreamRequest) (*model.RecordsToStreamResponse, error) {
-	recordStream := model.NewQRecordStream(len(req.Records))
-	err := recordStream.SetSchema(&model.QRecordSchema{
+// RawTableRecordSchema returns the schema of the records in a raw table stream.
+func RawTableRecordSchema() *model.QRecordSchema {
+	return &model.QRecordSchema{
 		Fields: []*model.QField{
 			{
 				Name:     "_peerdb_uid",
@@ -54,19 +54,30 @@ func RecordsToRawTableStream(req model.RecordsToStreamRequest) (*model.RecordsTo
 				Nullable: true,
 			},
 		},
-	})
+	}
+}
+
+func RecordsToRawTableStream(req model.RecordsToStreamRequest) (*model.RecordsToStreamResponse, error) {
+	recordStream := model.NewQRecordStream(len(req.Records))
+	err := recordStream.SetSchema(RawTableRecordSchema())
 	if err != nil {
 		return nil, err
 	}
 
+	toJSONOpts := req.ToJSONOptions
+	if toJSONOpts == nil {
+		toJSONOpts = model.NewToJSONOptions(nil)
+	}
+
 	first := true
 	firstCP := req.CP
 	for _, record := range req.Records {
 		var entries [8]qvalue.QValue
+		recordJSONOpts := toJSONOpts.ForRecord(record)
 		switch typedRecord := record.(type) {
 		case *model.InsertRecord:
 			// json.Marshal converts bytes in Hex automatically to BASE64 string.
-			itemsJSON, err := typedRecord.Items.ToJSON()
+			itemsJSON, err := typedRecord.Items.ToJSONWithOpts(recordJSONOpts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to serialize insert record items to JSON: %w", err)
 			}
@@ -94,11 +105,11 @@ func RecordsToRawTableStream(req model.RecordsToStreamRequest) (*model.RecordsTo
 			}
 			req.TableMapping[typedRecord.DestinationTableName] += 1
 		case *model.UpdateRecord:
-			newItemsJSON, err := typedRecord.NewItems.ToJSON()
+			newItemsJSON, err := typedRecord.NewItems.ToJSONWithOpts(recordJSONOpts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to serialize update record new items to JSON: %w", err)
 			}
-			oldItemsJSON, err := typedRecord.OldItems.ToJSON()
+			oldItemsJSON, err := typedRecord.OldItems.ToJSONWithOpts(recordJSONOpts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to serialize update record old items to JSON: %w", err)
 			}
@@ -125,7 +136,7 @@ func RecordsToRawTableStream(req model.RecordsToStreamRequest) (*model.RecordsTo
 			}
 			req.TableMapping[typedRecord.DestinationTableName] += 1
 		case *model.DeleteRecord:
-			itemsJSON, err := typedRecord.Items.ToJSON()
+			itemsJSON, err := typedRecord.Items.ToJSONWithOpts(recordJSONOpts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to serialize delete record items to JSON: %w", err)
 			}
@@ -156,7 +167,7 @@ func RecordsToRawTableStream(req model.RecordsToStreamRequest) (*model.RecordsTo
 			return nil, fmt.Errorf("record type %T not supported", typedRecord)
 		}
 
-		if first {
+		if first || record.GetCheckPointID() < firstCP {
 			firstCP = record.GetCheckPointID()
 			first = false
 		}
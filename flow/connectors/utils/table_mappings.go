@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+// RawOnlyTables returns the destination tables of the mappings that are only captured into the raw
+// table, which are not created or normalized.
+func RawOnlyTables(tableMappings []*protos.TableMapping) map[string]bool {
+	rawOnlyTables := make(map[string]bool)
+	for _, mapping := range tableMappings {
+		if mapping.RawOnly {
+			rawOnlyTables[mapping.DestinationTableIdentifier] = true
+		}
+	}
+	return rawOnlyTables
+}
+
+// DisabledSourceTables returns the source tables of the mappings whose replication is disabled.
+func DisabledSourceTables(tableMappings []*protos.TableMapping) map[string]bool {
+	disabledTables := make(map[string]bool)
+	for _, mapping := range tableMappings {
+		if mapping.Disabled {
+			disabledTables[mapping.SourceTableIdentifier] = true
+		}
+	}
+	return disabledTables
+}
+
+// ValidateTableMappings rejects table mappings that the mirror cannot replicate correctly.
+// Two sources mapped to the same destination would have their merges interleave and overwrite
+// each other's rows. A source mapped to several destinations is rejected as well, as records are
+// routed by source table to a single destination and all but one mapping would be silently ignored.
+// Identifiers are compared case-insensitively, since destinations fold unquoted identifiers.
+func ValidateTableMappings(tableMappings []*protos.TableMapping) error {
+	sourceForDestination := make(map[string]string, len(tableMappings))
+	destinationForSource := make(map[string]string, len(tableMappings))
+	for _, mapping := range tableMappings {
+		source := mapping.SourceTableIdentifier
+		destination := mapping.DestinationTableIdentifier
+
+		if otherSource, ok := sourceForDestination[strings.ToLower(destination)]; ok {
+			return fmt.Errorf("source tables %s and %s are both mapped to destination table %s",
+				otherSource, source, destination)
+		}
+		sourceForDestination[strings.ToLower(destination)] = source
+
+		if otherDestination, ok := destinationForSource[strings.ToLower(source)]; ok {
+			return fmt.Errorf("source table %s is mapped to both %s and %s, "+
+				"replicating a table to multiple destinations in one mirror is not supported",
+				source, otherDestination, destination)
+		}
+		destinationForSource[strings.ToLower(source)] = destination
+	}
+	return nil
+}
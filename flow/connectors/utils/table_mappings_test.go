@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTableMappings(t *testing.T) {
+	require.NoError(t, ValidateTableMappings([]*protos.TableMapping{
+		{SourceTableIdentifier: "public.a", DestinationTableIdentifier: "public.a"},
+		{SourceTableIdentifier: "public.b", DestinationTableIdentifier: "public.b"},
+	}))
+
+	err := ValidateTableMappings([]*protos.TableMapping{
+		{SourceTableIdentifier: "public.a", DestinationTableIdentifier: "public.dst"},
+		{SourceTableIdentifier: "public.b", DestinationTableIdentifier: "PUBLIC.DST"},
+	})
+	require.ErrorContains(t, err, "source tables public.a and public.b are both mapped to destination table PUBLIC.DST")
+
+	err = ValidateTableMappings([]*protos.TableMapping{
+		{SourceTableIdentifier: "public.a", DestinationTableIdentifier: "public.a_1"},
+		{SourceTableIdentifier: "public.a", DestinationTableIdentifier: "public.a_2"},
+	})
+	require.ErrorContains(t, err, "source table public.a is mapped to both public.a_1 and public.a_2")
+}
+
+func TestRawOnlyTables(t *testing.T) {
+	rawOnlyTables := RawOnlyTables([]*protos.TableMapping{
+		{SourceTableIdentifier: "public.a", DestinationTableIdentifier: "public.a"},
+		{SourceTableIdentifier: "public.b", DestinationTableIdentifier: "public.b_raw", RawOnly: true},
+	})
+	require.Equal(t, map[string]bool{"public.b_raw": true}, rawOnlyTables)
+}
+
+func TestDisabledTables(t *testing.T) {
+	tableMappings := []*protos.TableMapping{
+		{SourceTableIdentifier: "public.a", DestinationTableIdentifier: "public.a"},
+		{SourceTableIdentifier: "public.b", DestinationTableIdentifier: "public.b_raw", RawOnly: true},
+		{SourceTableIdentifier: "public.c", DestinationTableIdentifier: "public.c_dst", Disabled: true},
+	}
+	require.Equal(t, map[string]bool{"public.c": true}, DisabledSourceTables(tableMappings))
+	// the disabled table is still normalized, its raw records synced before it was disabled are merged.
+	require.Equal(t, map[string]bool{"public.b_raw": true}, RawOnlyTables(tableMappings))
+
+	// re-enabling the table replicates it again
+	tableMappings[2].Disabled = false
+	require.Empty(t, DisabledSourceTables(tableMappings))
+}
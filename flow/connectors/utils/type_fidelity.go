@@ -0,0 +1,218 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+)
+
+const (
+	TypeFidelityTimestampFormat = "2006-01-02 15:04:05.000000"
+	TypeFidelityDateFormat      = "2006-01-02"
+	TypeFidelityTimeFormat      = "15:04:05.000000"
+)
+
+// TypeFidelityValue returns the value written for a column of the kind when testing whether the
+// destination keeps values of the kind intact, picked to expose lossy mappings: integers at the ends
+// of their range, numerics with many digits, timestamps with microseconds, text beyond ASCII.
+// Kinds without such a value aren't tested.
+func TypeFidelityValue(kind qvalue.QValueKind) (*qvalue.QValue, bool) {
+	timestamp := time.Date(2023, 12, 31, 23, 59, 59, 123456000, time.UTC)
+
+	var value interface{}
+	switch kind {
+	case qvalue.QValueKindBoolean:
+		value = true
+	case qvalue.QValueKindInt16:
+		value = int16(math.MinInt16)
+	case qvalue.QValueKindInt32:
+		value = int32(math.MinInt32)
+	case qvalue.QValueKindInt64:
+		value = int64(math.MaxInt64)
+	case qvalue.QValueKindFloat32:
+		value = float32(3.14159)
+	case qvalue.QValueKindFloat64:
+		value = 1234.5678
+	case qvalue.QValueKindNumeric:
+		numeric, _ := new(big.Rat).SetString("12345678901234567890.123456789")
+		value = numeric
+	case qvalue.QValueKindString:
+		value = `héllo, 世界 'single' "double"`
+	case qvalue.QValueKindUUID:
+		value = "6f1d2b3c-4a5e-4f60-8a7b-9c0d1e2f3a4b"
+	case qvalue.QValueKindJSON:
+		value = `{"key": "value", "nested": {"array": [1, 2.5, "three", null]}}`
+	case qvalue.QValueKindTimestamp:
+		value = timestamp
+	case qvalue.QValueKindTimestampTZ:
+		value = timestamp.In(time.FixedZone("", 5*60*60+30*60))
+	case qvalue.QValueKindDate:
+		value = time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+	case qvalue.QValueKindTime, qvalue.QValueKindTimeTZ:
+		value = time.Date(1970, 1, 1, 23, 59, 59, 123456000, time.UTC)
+	case qvalue.QValueKindBytes:
+		value = []byte{0x00, 0x7f, 0x80, 0xff}
+	case qvalue.QValueKindArrayInt32:
+		value = []int32{math.MinInt32, 0, math.MaxInt32}
+	case qvalue.QValueKindArrayInt64:
+		value = []int64{math.MinInt64, 0, math.MaxInt64}
+	case qvalue.QValueKindArrayFloat32:
+		value = []float32{-1.5, 0, 3.25}
+	case qvalue.QValueKindArrayFloat64:
+		value = []float64{-1.5, 0, 1234.5678}
+	case qvalue.QValueKindArrayString:
+		value = []string{"a", "", "世界"}
+	case qvalue.QValueKindArrayUUID:
+		value = []string{"00000000-0000-0000-0000-000000000000", "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"}
+	default:
+		return nil, false
+	}
+	return &qvalue.QValue{Kind: kind, Value: value}, true
+}
+
+// formatTypeFidelityValue renders a value written by a type fidelity test as text.
+func formatTypeFidelityValue(written *qvalue.QValue) string {
+	switch v := written.Value.(type) {
+	case *big.Rat:
+		return strings.TrimRight(strings.TrimRight(v.FloatString(20), "0"), ".")
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case []byte:
+		return strings.ToUpper(hex.EncodeToString(v))
+	case time.Time:
+		switch written.Kind {
+		case qvalue.QValueKindDate:
+			return v.Format(TypeFidelityDateFormat)
+		case qvalue.QValueKindTime, qvalue.QValueKindTimeTZ:
+			return v.UTC().Format(TypeFidelityTimeFormat)
+		default:
+			return v.UTC().Format(TypeFidelityTimestampFormat)
+		}
+	case []int32, []int64, []float32, []float64, []string:
+		arrayJSON, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(arrayJSON)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// sameJSON returns whether two json documents are equal, regardless of key order, whitespace and
+// how numbers are written, comparing numbers exactly.
+func sameJSON(a string, b string) bool {
+	aValue, err := decodeJSONWithNumbers(a)
+	if err != nil {
+		return false
+	}
+	bValue, err := decodeJSONWithNumbers(b)
+	if err != nil {
+		return false
+	}
+	return sameJSONValue(aValue, bValue)
+}
+
+func decodeJSONWithNumbers(document string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+	var value interface{}
+	err := decoder.Decode(&value)
+	return value, err
+}
+
+func sameJSONValue(a interface{}, b interface{}) bool {
+	switch aValue := a.(type) {
+	case json.Number:
+		bValue, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		aRat, aOK := new(big.Rat).SetString(aValue.String())
+		bRat, bOK := new(big.Rat).SetString(bValue.String())
+		return aOK && bOK && aRat.Cmp(bRat) == 0
+	case []interface{}:
+		bValue, ok := b.([]interface{})
+		if !ok || len(aValue) != len(bValue) {
+			return false
+		}
+		for i := range aValue {
+			if !sameJSONValue(aValue[i], bValue[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		bValue, ok := b.(map[string]interface{})
+		if !ok || len(aValue) != len(bValue) {
+			return false
+		}
+		for key, aElem := range aValue {
+			bElem, ok := bValue[key]
+			if !ok || !sameJSONValue(aElem, bElem) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// CheckTypeFidelity compares a value written by a type fidelity test with the value read back, which the
+// destination renders as text: integers, floats and numerics in decimal, bytes in hex, timestamps in UTC
+// and times in the TypeFidelity formats, json and arrays as json. A nil read value stands for NULL.
+func CheckTypeFidelity(columnName string, written *qvalue.QValue, read *string) *protos.TypeFidelityResult {
+	writtenValue := formatTypeFidelityValue(written)
+	result := &protos.TypeFidelityResult{
+		ColumnName:   columnName,
+		ColumnType:   string(written.Kind),
+		Tested:       true,
+		WrittenValue: writtenValue,
+		ReadValue:    "null",
+	}
+	if read == nil {
+		return result
+	}
+	result.ReadValue = *read
+
+	switch v := written.Value.(type) {
+	case int16, int32, int64:
+		readInt, err := strconv.ParseInt(*read, 10, 64)
+		result.Lossless = err == nil && readInt == reflect.ValueOf(v).Int()
+	case float32:
+		readFloat, err := strconv.ParseFloat(*read, 32)
+		result.Lossless = err == nil && float32(readFloat) == v
+	case float64:
+		readFloat, err := strconv.ParseFloat(*read, 64)
+		result.Lossless = err == nil && readFloat == v
+	case *big.Rat:
+		readRat, ok := new(big.Rat).SetString(*read)
+		result.Lossless = ok && readRat.Cmp(v) == 0
+	case bool:
+		readBool, err := strconv.ParseBool(*read)
+		result.Lossless = err == nil && readBool == v
+	case []byte:
+		readBytes, err := hex.DecodeString(*read)
+		result.Lossless = err == nil && bytes.Equal(readBytes, v)
+	default:
+		if written.Kind == qvalue.QValueKindJSON || strings.HasPrefix(string(written.Kind), "array_") {
+			result.Lossless = sameJSON(writtenValue, *read)
+		} else {
+			result.Lossless = writtenValue == *read
+		}
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func checkTypeFidelity(t *testing.T, kind qvalue.QValueKind, read string) bool {
+	written, ok := TypeFidelityValue(kind)
+	require.True(t, ok, kind)
+	result := CheckTypeFidelity("col", written, &read)
+	require.True(t, result.Tested)
+	require.Equal(t, string(kind), result.ColumnType)
+	require.Equal(t, read, result.ReadValue)
+	return result.Lossless
+}
+
+func TestCheckTypeFidelityNumeric(t *testing.T) {
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindNumeric, "12345678901234567890.123456789"))
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindNumeric, "12345678901234567890.123456789000"))
+	// scale cut down to 6 digits
+	require.False(t, checkTypeFidelity(t, qvalue.QValueKindNumeric, "12345678901234567890.123457"))
+	// precision of a double
+	require.False(t, checkTypeFidelity(t, qvalue.QValueKindNumeric, "12345678901234567000"))
+
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindInt64, "9223372036854775807"))
+	require.False(t, checkTypeFidelity(t, qvalue.QValueKindInt64, "9223372036854775000"))
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindFloat64, "1234.5678"))
+	require.False(t, checkTypeFidelity(t, qvalue.QValueKindFloat64, "1234.57"))
+}
+
+func TestCheckTypeFidelityTimestamp(t *testing.T) {
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindTimestamp, "2023-12-31 23:59:59.123456"))
+	// microseconds truncated to milliseconds
+	require.False(t, checkTypeFidelity(t, qvalue.QValueKindTimestamp, "2023-12-31 23:59:59.123000"))
+	// the written value is at +05:30, compared in UTC
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindTimestampTZ, "2023-12-31 23:59:59.123456"))
+	// the offset was dropped instead of converted
+	require.False(t, checkTypeFidelity(t, qvalue.QValueKindTimestampTZ, "2024-01-01 05:29:59.123456"))
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindDate, "2023-12-31"))
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindTime, "23:59:59.123456"))
+}
+
+func TestCheckTypeFidelityArray(t *testing.T) {
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindArrayInt64,
+		"[-9223372036854775808,0,9223372036854775807]"))
+	// rounded through a double
+	require.False(t, checkTypeFidelity(t, qvalue.QValueKindArrayInt64,
+		"[-9223372036854775808,0,9223372036854775806]"))
+	// doubles in a variant are written in exponent notation
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindArrayFloat64,
+		"[-1.500000000000000e+00,0.000000000000000e+00,1.234567800000000e+03]"))
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindArrayString, `["a","","世界"]`))
+	// arrays written as text
+	require.False(t, checkTypeFidelity(t, qvalue.QValueKindArrayString, `"{a,\"\",世界}"`))
+	require.False(t, checkTypeFidelity(t, qvalue.QValueKindArrayInt32, "[0]"))
+}
+
+func TestCheckTypeFidelityJSON(t *testing.T) {
+	// key order and whitespace don't matter
+	require.True(t, checkTypeFidelity(t, qvalue.QValueKindJSON,
+		`{"nested":{"array":[1,2.5,"three",null]},"key":"value"}`))
+	// nulls dropped
+	require.False(t, checkTypeFidelity(t, qvalue.QValueKindJSON,
+		`{"nested":{"array":[1,2.5,"three"]},"key":"value"}`))
+	// stored as a string instead of a document
+	require.False(t, checkTypeFidelity(t, qvalue.QValueKindJSON,
+		`"{\"key\": \"value\", \"nested\": {\"array\": [1, 2.5, \"three\", null]}}"`))
+}
+
+func TestCheckTypeFidelityNull(t *testing.T) {
+	written, ok := TypeFidelityValue(qvalue.QValueKindString)
+	require.True(t, ok)
+	result := CheckTypeFidelity("col", written, nil)
+	require.False(t, result.Lossless)
+	require.Equal(t, "null", result.ReadValue)
+
+	_, ok = TypeFidelityValue(qvalue.QValueKindGeometry)
+	require.False(t, ok)
+}
@@ -3,6 +3,9 @@ package e2e_postgres
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/PeerDB-io/peer-flow/e2e"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
@@ -242,6 +245,74 @@ func (s *PeerFlowE2ETestSuitePG) Test_Simple_Schema_Changes_PG() {
 	env.AssertExpectations(s.T())
 }
 
+func (s *PeerFlowE2ETestSuitePG) Test_Idle_Schema_Changes_PG() {
+	env := s.NewTestWorkflowEnvironment()
+	e2e.RegisterWorkflowsAndActivities(env)
+
+	srcTableName := s.attachSchemaSuffix("test_idle_schema_changes")
+	dstTableName := s.attachSchemaSuffix("test_idle_schema_changes_dst")
+
+	_, err := s.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+			c1 BIGINT
+		);
+	`, srcTableName))
+	s.NoError(err)
+
+	connectionGen := e2e.FlowConnectionGenerationConfig{
+		FlowJobName:      s.attachSuffix("test_idle_schema_changes"),
+		TableNameMapping: map[string]string{srcTableName: dstTableName},
+		PostgresPort:     e2e.PostgresPort,
+		Destination:      s.peer,
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+	flowConnConfig.DetectIdleSchemaChanges = true
+
+	limits := peerflow.CDCFlowLimits{
+		TotalSyncFlows: 4,
+		MaxBatchSize:   100,
+	}
+
+	// add a column without changing any rows, which the change stream never sees.
+	var alterErr error
+	go func() {
+		e2e.SetupCDCFlowStatusQuery(env, connectionGen)
+		_, alterErr = s.pool.Exec(context.Background(), fmt.Sprintf(`
+		ALTER TABLE %s ADD COLUMN c2 BIGINT`, srcTableName))
+	}()
+
+	env.ExecuteWorkflow(peerflow.CDCFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+
+	// Verify workflow completes without error
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+
+	// allow only continue as new error
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+	s.NoError(alterErr)
+
+	// the column was added to the destination by the syncs without records
+	output, err := s.connector.GetTableSchema(&protos.GetTableSchemaBatchInput{
+		TableIdentifiers: []string{dstTableName},
+	})
+	s.NoError(err)
+	s.Equal(&protos.TableSchema{
+		TableIdentifier: dstTableName,
+		Columns: map[string]string{
+			"id": string(qvalue.QValueKindInt64),
+			"c1": string(qvalue.QValueKindInt64),
+			"c2": string(qvalue.QValueKindInt64),
+		},
+		PrimaryKeyColumns: []string{"id"},
+	}, output.TableNameSchemaMapping[dstTableName])
+
+	env.AssertExpectations(s.T())
+}
+
 func (s *PeerFlowE2ETestSuitePG) Test_Composite_PKey_PG() {
 	env := s.NewTestWorkflowEnvironment()
 	e2e.RegisterWorkflowsAndActivities(env)
@@ -474,3 +545,215 @@ func (s *PeerFlowE2ETestSuitePG) Test_Composite_PKey_Toast_2_PG() {
 
 	env.AssertExpectations(s.T())
 }
+
+func (s *PeerFlowE2ETestSuitePG) Test_XML_Column_PG() {
+	env := s.NewTestWorkflowEnvironment()
+	e2e.RegisterWorkflowsAndActivities(env)
+
+	srcTableName := s.attachSchemaSuffix("test_xml_column")
+	dstTableName := s.attachSchemaSuffix("test_xml_column_dst")
+
+	_, err := s.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			id SERIAL PRIMARY KEY,
+			doc XML
+		);
+	`, srcTableName))
+	s.NoError(err)
+
+	connectionGen := e2e.FlowConnectionGenerationConfig{
+		FlowJobName:      s.attachSuffix("test_xml_column"),
+		TableNameMapping: map[string]string{srcTableName: dstTableName},
+		PostgresPort:     e2e.PostgresPort,
+		Destination:      s.peer,
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+
+	limits := peerflow.CDCFlowLimits{
+		TotalSyncFlows: 2,
+		MaxBatchSize:   100,
+	}
+
+	go func() {
+		e2e.SetupCDCFlowStatusQuery(env, connectionGen)
+		// a document with a declaration and significant whitespace, an empty document and a NULL
+		_, err = s.pool.Exec(context.Background(), fmt.Sprintf(`
+			INSERT INTO %s(doc) VALUES
+			('<?xml version="1.0"?><root a="1">  <child>text &amp; more</child></root>'),
+			(''), (NULL)
+		`, srcTableName))
+		s.NoError(err)
+		fmt.Println("Inserted 3 rows into the source table")
+	}()
+
+	env.ExecuteWorkflow(peerflow.CDCFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+
+	// Verify workflow completes without error
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+
+	// allow only continue as new error
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+
+	// xml is replicated as text, so compare the serialized form
+	err = s.comparePGTables(srcTableName, dstTableName, "id,doc::text")
+	s.NoError(err)
+
+	env.AssertExpectations(s.T())
+}
+
+func (s *PeerFlowE2ETestSuitePG) Test_Composite_Array_Column_PG() {
+	env := s.NewTestWorkflowEnvironment()
+	e2e.RegisterWorkflowsAndActivities(env)
+
+	itemTypeName := s.attachSchemaSuffix("line_item")
+	srcTableName := s.attachSchemaSuffix("test_composite_array")
+	dstTableName := s.attachSchemaSuffix("test_composite_array_dst")
+
+	_, err := s.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TYPE %s AS (sku TEXT, quantity INT);
+		CREATE TABLE IF NOT EXISTS %s (
+			id SERIAL PRIMARY KEY,
+			items %s[]
+		);
+	`, itemTypeName, srcTableName, itemTypeName))
+	s.NoError(err)
+
+	connectionGen := e2e.FlowConnectionGenerationConfig{
+		FlowJobName:      s.attachSuffix("test_composite_array"),
+		TableNameMapping: map[string]string{srcTableName: dstTableName},
+		PostgresPort:     e2e.PostgresPort,
+		Destination:      s.peer,
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+
+	limits := peerflow.CDCFlowLimits{
+		TotalSyncFlows: 2,
+		MaxBatchSize:   100,
+	}
+
+	go func() {
+		e2e.SetupCDCFlowStatusQuery(env, connectionGen)
+		// nulls inside a composite, a null element of the array and a null array
+		_, err = s.pool.Exec(context.Background(), fmt.Sprintf(`
+			INSERT INTO %s(items) VALUES
+			(ARRAY[ROW('apple', 2), ROW('pear', NULL), NULL]::%s[]), ('{}'), (NULL)
+		`, srcTableName, itemTypeName))
+		s.NoError(err)
+		fmt.Println("Inserted 3 rows into the source table")
+	}()
+
+	env.ExecuteWorkflow(peerflow.CDCFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+
+	// Verify workflow completes without error
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+
+	// allow only continue as new error
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+
+	// arrays of composites are replicated as json arrays of objects
+	var items string
+	err = s.pool.QueryRow(context.Background(),
+		fmt.Sprintf("SELECT items::text FROM %s WHERE id = 1", dstTableName)).Scan(&items)
+	s.NoError(err)
+	s.JSONEq(`[{"sku":"apple","quantity":2},{"sku":"pear","quantity":null},null]`, items)
+
+	err = s.comparePGTables(srcTableName, dstTableName, "id,to_jsonb(items)")
+	s.NoError(err)
+
+	env.AssertExpectations(s.T())
+}
+
+func (s *PeerFlowE2ETestSuitePG) Test_Snapshot_CDC_Boundary_PG() {
+	env := s.NewTestWorkflowEnvironment()
+	e2e.RegisterWorkflowsAndActivities(env)
+
+	srcTableName := s.attachSchemaSuffix("test_snapshot_boundary")
+	dstTableName := s.attachSchemaSuffix("test_snapshot_boundary_dst")
+
+	_, err := s.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			id SERIAL PRIMARY KEY,
+			value TEXT NOT NULL
+		);
+		INSERT INTO %s(value) SELECT md5(i::text) FROM generate_series(1,1000) i;
+	`, srcTableName, srcTableName))
+	s.NoError(err)
+
+	connectionGen := e2e.FlowConnectionGenerationConfig{
+		FlowJobName:      s.attachSuffix("test_snapshot_boundary"),
+		TableNameMapping: map[string]string{srcTableName: dstTableName},
+		PostgresPort:     e2e.PostgresPort,
+		Destination:      s.peer,
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+	flowConnConfig.DoInitialCopy = true
+
+	limits := peerflow.CDCFlowLimits{
+		TotalSyncFlows: 2,
+		MaxBatchSize:   10000,
+	}
+
+	// rows keep being written while the slot is created and the tables are copied,
+	// each must reach the destination through exactly one of the copy and replication.
+	writesDone := make(chan struct{})
+	var stopWrites atomic.Bool
+	var writeErr error
+	go func() {
+		defer close(writesDone)
+		for !stopWrites.Load() {
+			_, writeErr = s.pool.Exec(context.Background(),
+				fmt.Sprintf("INSERT INTO %s(value) VALUES (md5(random()::text))", srcTableName))
+			if writeErr != nil {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	go func() {
+		e2e.SetupCDCFlowStatusQuery(env, connectionGen)
+		stopWrites.Store(true)
+		<-writesDone
+	}()
+
+	env.ExecuteWorkflow(peerflow.CDCFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+	stopWrites.Store(true)
+	<-writesDone
+	s.NoError(writeErr)
+
+	// Verify workflow completes without error
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+
+	// allow only continue as new error
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+
+	err = s.comparePGTables(srcTableName, dstTableName, "id,value")
+	s.NoError(err)
+
+	// rows already in the snapshot are not replicated again, and no row is replicated twice.
+	rawTableName := fmt.Sprintf("_peerdb_internal._peerdb_raw_%s", strings.ToLower(connectionGen.FlowJobName))
+	var replicatedTwice, replicatedSnapshotRows int64
+	err = s.pool.QueryRow(context.Background(), fmt.Sprintf(`
+		SELECT COUNT(*) FROM (SELECT _peerdb_data::json->>'id' FROM %s
+		WHERE _peerdb_record_type=0 GROUP BY 1 HAVING COUNT(*)>1) dup`, rawTableName)).Scan(&replicatedTwice)
+	s.NoError(err)
+	s.Zero(replicatedTwice)
+	err = s.pool.QueryRow(context.Background(), fmt.Sprintf(`
+		SELECT COUNT(*) FROM %s WHERE _peerdb_record_type=0 AND (_peerdb_data::json->>'id')::int <= 1000`,
+		rawTableName)).Scan(&replicatedSnapshotRows)
+	s.NoError(err)
+	s.Zero(replicatedSnapshotRows)
+
+	env.AssertExpectations(s.T())
+}
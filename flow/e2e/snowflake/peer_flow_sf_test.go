@@ -3,12 +3,16 @@ package e2e_snowflake
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	connsnowflake "github.com/PeerDB-io/peer-flow/connectors/snowflake"
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/e2e"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
 	"github.com/PeerDB-io/peer-flow/model/qvalue"
 	peerflow "github.com/PeerDB-io/peer-flow/workflows"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -1291,3 +1295,982 @@ func (s *PeerFlowE2ETestSuiteSF) Test_Composite_PKey_Toast_2_SF() {
 
 	env.AssertExpectations(s.T())
 }
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Rebuild_Normalized_Table_SF() {
+	env := s.NewTestWorkflowEnvironment()
+	e2e.RegisterWorkflowsAndActivities(env)
+
+	srcTableName := s.attachSchemaSuffix("test_rebuild_normalized")
+	dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, "test_rebuild_normalized")
+
+	_, err := s.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE %s (
+			id SERIAL PRIMARY KEY,
+			c1 INT,
+			t TEXT
+		);
+	`, srcTableName))
+	s.NoError(err)
+
+	connectionGen := e2e.FlowConnectionGenerationConfig{
+		FlowJobName:      s.attachSuffix("test_rebuild_normalized"),
+		TableNameMapping: map[string]string{srcTableName: dstTableName},
+		PostgresPort:     e2e.PostgresPort,
+		Destination:      s.sfHelper.Peer,
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+
+	limits := peerflow.CDCFlowLimits{
+		TotalSyncFlows: 4,
+		MaxBatchSize:   100,
+	}
+
+	// insert, update and delete rows across multiple batches
+	go func() {
+		e2e.SetupCDCFlowStatusQuery(env, connectionGen)
+		for i := 0; i < 10; i++ {
+			_, err = s.pool.Exec(context.Background(), fmt.Sprintf(`
+			INSERT INTO %s(c1,t) VALUES ($1,$2)
+		`, srcTableName), i, fmt.Sprintf("test_value_%d", i))
+			s.NoError(err)
+		}
+		fmt.Println("Inserted 10 rows into the source table")
+
+		e2e.NormalizeFlowCountQuery(env, connectionGen, 2)
+		_, err = s.pool.Exec(context.Background(),
+			fmt.Sprintf(`UPDATE %s SET c1=c1+1 WHERE MOD(c1,2)=$1`, srcTableName), 1)
+		s.NoError(err)
+		_, err = s.pool.Exec(context.Background(), fmt.Sprintf(`DELETE FROM %s WHERE MOD(c1,3)=$1`, srcTableName), 0)
+		s.NoError(err)
+	}()
+
+	env.ExecuteWorkflow(peerflow.CDCFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+
+	// Verify workflow completes without error
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+
+	// allow only continue as new error
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+	s.compareTableContentsSF("test_rebuild_normalized", "id,c1,t", false)
+
+	// simulate an accidental truncate and rebuild the table one batch at a time
+	err = s.sfHelper.RunCommand(fmt.Sprintf("TRUNCATE TABLE %s", dstTableName))
+	s.NoError(err)
+	count, err := s.sfHelper.CountRows("test_rebuild_normalized")
+	s.NoError(err)
+	s.Equal(0, count)
+
+	err = s.connector.InitializeTableSchema(map[string]*protos.TableSchema{
+		dstTableName: {
+			TableIdentifier: srcTableName,
+			Columns: map[string]string{
+				"id": string(qvalue.QValueKindInt32),
+				"c1": string(qvalue.QValueKindInt32),
+				"t":  string(qvalue.QValueKindString),
+			},
+			PrimaryKeyColumns: []string{"id"},
+		},
+	})
+	s.NoError(err)
+	res, err := s.connector.RebuildNormalizedTable(&model.RebuildNormalizedTableRequest{
+		Normalize:                  &model.NormalizeRecordsRequest{FlowJobName: connectionGen.FlowJobName},
+		DestinationTableIdentifier: dstTableName,
+		BatchesPerMerge:            1,
+	})
+	s.NoError(err)
+	s.Positive(res.LastBatchId)
+
+	s.compareTableContentsSF("test_rebuild_normalized", "id,c1,t", false)
+
+	// a mirror that snapshotted its tables can't be rebuilt, the table keeps its rows
+	_, err = s.connector.RebuildNormalizedTable(&model.RebuildNormalizedTableRequest{
+		Normalize:                  &model.NormalizeRecordsRequest{FlowJobName: connectionGen.FlowJobName},
+		DestinationTableIdentifier: dstTableName,
+		InitialCopy:                true,
+	})
+	s.ErrorContains(err, "initial copy")
+	s.compareTableContentsSF("test_rebuild_normalized", "id,c1,t", false)
+
+	env.AssertExpectations(s.T())
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Cluster_Raw_Table_SF() {
+	flowJobName := s.attachSuffix("test_cluster_raw_table")
+	res, err := s.connector.CreateRawTable(&protos.CreateRawTableInput{
+		FlowJobName:     flowJobName,
+		ClusterRawTable: true,
+	})
+	s.NoError(err)
+	defer func() {
+		err := s.connector.SyncFlowCleanup(flowJobName)
+		s.NoError(err)
+	}()
+
+	// merges filter the raw table on these columns, so they should be the clustering key
+	clusteringKey, err := s.sfHelper.ExecuteAndProcessQuery(fmt.Sprintf(`SELECT CLUSTERING_KEY
+		FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA='_PEERDB_INTERNAL' AND TABLE_NAME='%s'`,
+		res.TableIdentifier))
+	s.NoError(err)
+	s.Len(clusteringKey.Records, 1)
+	s.Equal("LINEAR(_PEERDB_DESTINATION_TABLE_NAME,_PEERDB_BATCH_ID)", clusteringKey.Records[0].Entries[0].Value)
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Get_Raw_Table_Schema_SF() {
+	flowJobName := s.attachSuffix("test_raw_table_schema")
+	_, err := s.connector.CreateRawTable(&protos.CreateRawTableInput{
+		FlowJobName: flowJobName,
+	})
+	s.NoError(err)
+	defer func() {
+		err := s.connector.SyncFlowCleanup(flowJobName)
+		s.NoError(err)
+	}()
+
+	rawTableSchema, err := s.connector.GetRawTableSchema(flowJobName)
+	s.NoError(err)
+
+	columnNames := make([]string, 0, len(rawTableSchema.Columns))
+	for _, column := range rawTableSchema.Columns {
+		columnNames = append(columnNames, column.Name)
+	}
+	s.Equal([]string{"_PEERDB_UID", "_PEERDB_TIMESTAMP", "_PEERDB_DESTINATION_TABLE_NAME", "_PEERDB_DATA",
+		"_PEERDB_RECORD_TYPE", "_PEERDB_MATCH_DATA", "_PEERDB_BATCH_ID", "_PEERDB_UNCHANGED_TOAST_COLUMNS"}, columnNames)
+	s.Equal("TEXT", rawTableSchema.Columns[0].Type)
+	s.Equal("NUMBER", rawTableSchema.Columns[1].Type)
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Drop_Stale_Raw_Tables_SF() {
+	flowJobName := s.attachSuffix("test_stale_raw_table")
+	// a raw table of a mirror that is not in the catalog, as left behind by a drop whose cleanup failed.
+	_, err := s.connector.CreateRawTable(&protos.CreateRawTableInput{
+		FlowJobName: flowJobName,
+	})
+	s.NoError(err)
+	defer func() {
+		err := s.connector.SyncFlowCleanup(flowJobName)
+		s.NoError(err)
+	}()
+
+	rawTables, err := s.connector.ListRawTables([]string{flowJobName})
+	s.NoError(err)
+	for _, rawTable := range rawTables {
+		if strings.EqualFold(rawTable.TableIdentifier, "_PEERDB_RAW_"+flowJobName) {
+			s.Equal(flowJobName, rawTable.MirrorJobName)
+		}
+	}
+
+	rawTables, err = s.connector.ListRawTables([]string{})
+	s.NoError(err)
+	var orphanRawTable *model.RawTable
+	for _, rawTable := range rawTables {
+		if strings.EqualFold(rawTable.TableIdentifier, "_PEERDB_RAW_"+flowJobName) {
+			orphanRawTable = rawTable
+		}
+	}
+	s.NotNil(orphanRawTable)
+	s.Empty(orphanRawTable.MirrorJobName)
+
+	// the raw table was just created, so it is within any grace period.
+	s.NotContains(utils.StaleRawTables(rawTables, time.Hour, time.Now()), orphanRawTable)
+	staleRawTables := utils.StaleRawTables(rawTables, time.Hour, time.Now().Add(2*time.Hour))
+	s.Contains(staleRawTables, orphanRawTable)
+
+	err = s.connector.DropRawTable(orphanRawTable.TableIdentifier)
+	s.NoError(err)
+	rawTables, err = s.connector.ListRawTables(nil)
+	s.NoError(err)
+	for _, rawTable := range rawTables {
+		s.NotEqual(orphanRawTable.TableIdentifier, rawTable.TableIdentifier)
+	}
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Destination_Database_SF() {
+	env := s.NewTestWorkflowEnvironment()
+	e2e.RegisterWorkflowsAndActivities(env)
+
+	overrideDatabaseName := s.sfHelper.testDatabaseName + "_override"
+	err := s.sfHelper.adminClient.ExecuteQuery(fmt.Sprintf("CREATE DATABASE %s", overrideDatabaseName))
+	s.NoError(err)
+	defer func() {
+		err := s.sfHelper.adminClient.ExecuteQuery(fmt.Sprintf("DROP DATABASE %s", overrideDatabaseName))
+		s.NoError(err)
+	}()
+
+	srcTableName := s.attachSchemaSuffix("test_destination_database")
+	dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, "test_destination_database")
+
+	_, err = s.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE %s (
+			id SERIAL PRIMARY KEY,
+			key TEXT NOT NULL,
+			value TEXT NOT NULL
+		);
+	`, srcTableName))
+	s.NoError(err)
+	connectionGen := e2e.FlowConnectionGenerationConfig{
+		FlowJobName:      s.attachSuffix("test_destination_database"),
+		TableNameMapping: map[string]string{srcTableName: dstTableName},
+		PostgresPort:     e2e.PostgresPort,
+		Destination:      s.sfHelper.Peer,
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+	flowConnConfig.DestinationDatabase = overrideDatabaseName
+
+	limits := peerflow.CDCFlowLimits{
+		TotalSyncFlows: 2,
+		MaxBatchSize:   100,
+	}
+
+	go func() {
+		e2e.SetupCDCFlowStatusQuery(env, connectionGen)
+		for i := 0; i < 10; i++ {
+			_, err = s.pool.Exec(context.Background(), fmt.Sprintf(`
+			INSERT INTO %s (key, value) VALUES ($1, $2)
+		`, srcTableName), fmt.Sprintf("test_key_%d", i), fmt.Sprintf("test_value_%d", i))
+			s.NoError(err)
+		}
+	}()
+
+	env.ExecuteWorkflow(peerflow.CDCFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+
+	// the normalized table is created in the mirror's database, not the peer's default one.
+	count, err := s.sfHelper.testClient.CountRows(overrideDatabaseName+"."+s.sfHelper.testSchemaName,
+		"test_destination_database")
+	s.NoError(err)
+	s.Equal(int64(10), count)
+	_, err = s.sfHelper.CountRows("test_destination_database")
+	s.Error(err)
+	// the shared peer still points to the default database.
+	s.Equal(s.sfHelper.testDatabaseName, s.sfHelper.Peer.GetSnowflakeConfig().Database)
+
+	env.AssertExpectations(s.T())
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Raw_Only_Table_SF() {
+	env := s.NewTestWorkflowEnvironment()
+	e2e.RegisterWorkflowsAndActivities(env)
+
+	srcTableName := s.attachSchemaSuffix("test_normalized")
+	dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, "test_normalized")
+	srcRawOnlyTableName := s.attachSchemaSuffix("test_raw_only")
+	dstRawOnlyTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, "test_raw_only")
+
+	for _, tableName := range []string{srcTableName, srcRawOnlyTableName} {
+		_, err := s.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE %s (
+			id SERIAL PRIMARY KEY,
+			key TEXT NOT NULL
+		);
+	`, tableName))
+		s.NoError(err)
+	}
+	connectionGen := e2e.FlowConnectionGenerationConfig{
+		FlowJobName: s.attachSuffix("test_raw_only_table"),
+		TableNameMapping: map[string]string{
+			srcTableName:        dstTableName,
+			srcRawOnlyTableName: dstRawOnlyTableName,
+		},
+		PostgresPort: e2e.PostgresPort,
+		Destination:  s.sfHelper.Peer,
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+	for _, tableMapping := range flowConnConfig.TableMappings {
+		tableMapping.RawOnly = tableMapping.SourceTableIdentifier == srcRawOnlyTableName
+	}
+
+	limits := peerflow.CDCFlowLimits{
+		TotalSyncFlows: 2,
+		MaxBatchSize:   100,
+	}
+
+	go func() {
+		e2e.SetupCDCFlowStatusQuery(env, connectionGen)
+		for i := 0; i < 10; i++ {
+			for _, tableName := range []string{srcTableName, srcRawOnlyTableName} {
+				_, err = s.pool.Exec(context.Background(), fmt.Sprintf(`
+			INSERT INTO %s (key) VALUES ($1)
+		`, tableName), fmt.Sprintf("test_key_%d", i))
+				s.NoError(err)
+			}
+		}
+	}()
+
+	env.ExecuteWorkflow(peerflow.CDCFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+
+	count, err := s.sfHelper.CountRows("test_normalized")
+	s.NoError(err)
+	s.Equal(10, count)
+
+	// the raw-only table is captured into the raw table, but never created or normalized.
+	_, err = s.sfHelper.CountRows("test_raw_only")
+	s.Error(err)
+	rawRecords, err := s.sfHelper.ExecuteAndProcessQuery(fmt.Sprintf(
+		"SELECT _PEERDB_UID FROM _PEERDB_INTERNAL._PEERDB_RAW_%s WHERE _PEERDB_DESTINATION_TABLE_NAME = '%s'",
+		connectionGen.FlowJobName, dstRawOnlyTableName))
+	s.NoError(err)
+	s.Len(rawRecords.Records, 10)
+
+	env.AssertExpectations(s.T())
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Time_Types_SF() {
+	env := s.NewTestWorkflowEnvironment()
+	e2e.RegisterWorkflowsAndActivities(env)
+
+	srcTableName := s.attachSchemaSuffix("test_time_types")
+	dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, "test_time_types")
+
+	_, err := s.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE %s (
+			id SERIAL PRIMARY KEY,
+			t TIME NOT NULL,
+			ttz TIMETZ NOT NULL
+		);
+	`, srcTableName))
+	s.NoError(err)
+
+	connectionGen := e2e.FlowConnectionGenerationConfig{
+		FlowJobName:      s.attachSuffix("test_time_types"),
+		TableNameMapping: map[string]string{srcTableName: dstTableName},
+		PostgresPort:     e2e.PostgresPort,
+		Destination:      s.sfHelper.Peer,
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+
+	limits := peerflow.CDCFlowLimits{
+		TotalSyncFlows: 2,
+		MaxBatchSize:   100,
+	}
+
+	go func() {
+		e2e.SetupCDCFlowStatusQuery(env, connectionGen)
+		_, err = s.pool.Exec(context.Background(), fmt.Sprintf(`
+			INSERT INTO %s (t, ttz) VALUES ('12:34:56.789', '12:34:56.789+05:30'), ('23:59:59', '01:00:00-08')
+		`, srcTableName))
+		s.NoError(err)
+	}()
+
+	env.ExecuteWorkflow(peerflow.CDCFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+
+	// time with time zone is replicated as the time of day in UTC.
+	records, err := s.sfHelper.ExecuteAndProcessQuery(fmt.Sprintf("SELECT T, TTZ FROM %s ORDER BY ID", dstTableName))
+	s.NoError(err)
+	s.Len(records.Records, 2)
+	expectedTimes := [][]string{{"12:34:56.789", "07:04:56.789"}, {"23:59:59", "09:00:00"}}
+	for i, record := range records.Records {
+		for j, value := range record.Entries {
+			s.Equal(qvalue.QValueKindTime, value.Kind)
+			timeStr, err := value.GoTimeConvert()
+			s.NoError(err)
+			s.Equal(expectedTimes[i][j], timeStr)
+		}
+	}
+
+	env.AssertExpectations(s.T())
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Source_LSN_Column_SF() {
+	env := s.NewTestWorkflowEnvironment()
+	e2e.RegisterWorkflowsAndActivities(env)
+
+	srcTableName := s.attachSchemaSuffix("test_source_lsn")
+	dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, "test_source_lsn")
+
+	_, err := s.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE %s (
+			id SERIAL PRIMARY KEY,
+			val TEXT NOT NULL
+		);
+	`, srcTableName))
+	s.NoError(err)
+
+	connectionGen := e2e.FlowConnectionGenerationConfig{
+		FlowJobName:      s.attachSuffix("test_source_lsn"),
+		TableNameMapping: map[string]string{srcTableName: dstTableName},
+		PostgresPort:     e2e.PostgresPort,
+		Destination:      s.sfHelper.Peer,
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+	flowConnConfig.SourceLsnColumn = true
+
+	limits := peerflow.CDCFlowLimits{
+		TotalSyncFlows: 4,
+		MaxBatchSize:   100,
+	}
+
+	getSourceLSN := func() string {
+		records, err := s.sfHelper.ExecuteAndProcessQuery(
+			fmt.Sprintf("SELECT TO_VARCHAR(_PEERDB_SOURCE_LSN) FROM %s WHERE ID = 1", dstTableName))
+		s.NoError(err)
+		s.Len(records.Records, 1)
+		return records.Records[0].Entries[0].Value.(string)
+	}
+
+	var insertLSN string
+	go func() {
+		e2e.SetupCDCFlowStatusQuery(env, connectionGen)
+		_, err = s.pool.Exec(context.Background(),
+			fmt.Sprintf(`INSERT INTO %s (val) VALUES ('inserted')`, srcTableName))
+		s.NoError(err)
+		e2e.NormalizeFlowCountQuery(env, connectionGen, 2)
+		insertLSN = getSourceLSN()
+
+		_, err = s.pool.Exec(context.Background(),
+			fmt.Sprintf(`UPDATE %s SET val = 'updated' WHERE id = 1`, srcTableName))
+		s.NoError(err)
+	}()
+
+	env.ExecuteWorkflow(peerflow.CDCFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+
+	// every merged change sets the column to the LSN of that change
+	insertLSNValue, err := strconv.ParseInt(insertLSN, 10, 64)
+	s.NoError(err)
+	s.Greater(insertLSNValue, int64(0))
+	updateLSNValue, err := strconv.ParseInt(getSourceLSN(), 10, 64)
+	s.NoError(err)
+	s.Greater(updateLSNValue, insertLSNValue)
+
+	env.AssertExpectations(s.T())
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Dedup_By_Source_LSN_SF() {
+	flowJobName := s.attachSuffix("test_dedup_by_source_lsn")
+	dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, "test_dedup_by_source_lsn")
+	tableSchema := &protos.TableSchema{
+		TableIdentifier: dstTableName,
+		Columns: map[string]string{
+			"ID":  string(qvalue.QValueKindInt64),
+			"VAL": string(qvalue.QValueKindString),
+		},
+		PrimaryKeyColumns: []string{"ID"},
+	}
+	tableNameSchemaMapping := map[string]*protos.TableSchema{dstTableName: tableSchema}
+
+	s.NoError(s.connector.SetupMetadataTables())
+	_, err := s.connector.CreateRawTable(&protos.CreateRawTableInput{
+		FlowJobName:      flowJobName,
+		TableNameMapping: map[string]string{dstTableName: dstTableName},
+	})
+	s.NoError(err)
+	defer func() {
+		err := s.connector.SyncFlowCleanup(flowJobName)
+		s.NoError(err)
+	}()
+	_, err = s.connector.SetupNormalizedTables(&protos.SetupNormalizedTableBatchInput{
+		TableNameSchemaMapping: tableNameSchemaMapping,
+		SourceLsnColumn:        true,
+	})
+	s.NoError(err)
+	s.NoError(s.connector.InitializeTableSchema(tableNameSchemaMapping))
+
+	items := func(val string) *model.RecordItems {
+		items := model.NewRecordItems()
+		items.AddColumn("ID", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)})
+		items.AddColumn("VAL", &qvalue.QValue{Kind: qvalue.QValueKindString, Value: val})
+		return items
+	}
+	insert := &model.InsertRecord{
+		SourceTableName:      dstTableName,
+		DestinationTableName: dstTableName,
+		CheckPointID:         100,
+		Items:                items("inserted"),
+	}
+	update := &model.UpdateRecord{
+		SourceTableName:       dstTableName,
+		DestinationTableName:  dstTableName,
+		CheckPointID:          200,
+		OldItems:              items("inserted"),
+		NewItems:              items("updated"),
+		UnchangedToastColumns: map[string]struct{}{},
+	}
+	syncAndNormalize := func(records ...model.Record) {
+		_, err := s.connector.SyncRecords(&model.SyncRecordsRequest{
+			Records:         &model.RecordBatch{Records: records},
+			FlowJobName:     flowJobName,
+			SourceLSNColumn: true,
+		})
+		s.NoError(err)
+		_, err = s.connector.NormalizeRecords(&model.NormalizeRecordsRequest{
+			FlowJobName:      flowJobName,
+			SourceLSNColumn:  true,
+			DedupBySourceLSN: true,
+		})
+		s.NoError(err)
+	}
+
+	syncAndNormalize(insert, update)
+	// the insert arrives again in a later batch, after the update that followed it was applied
+	syncAndNormalize(insert)
+
+	records, err := s.sfHelper.ExecuteAndProcessQuery(
+		fmt.Sprintf("SELECT VAL, TO_VARCHAR(_PEERDB_SOURCE_LSN) FROM %s WHERE ID = 1", dstTableName))
+	s.NoError(err)
+	s.Len(records.Records, 1)
+	s.Equal("updated", records.Records[0].Entries[0].Value)
+	s.Equal("200", records.Records[0].Entries[1].Value)
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Primary_Key_Version_Policy_SF() {
+	// the key of the table went from (ID) to (ID, REGION) after the insert of 4 and before the delete of 2
+	items := func(id int64, region string, value string) *model.RecordItems {
+		items := model.NewRecordItems()
+		items.AddColumn("ID", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: id})
+		if region != "" {
+			items.AddColumn("REGION", &qvalue.QValue{Kind: qvalue.QValueKindString, Value: region})
+		}
+		if value != "" {
+			items.AddColumn("VALUE", &qvalue.QValue{Kind: qvalue.QValueKindString, Value: value})
+		}
+		return items
+	}
+
+	for policy, expected := range map[protos.PrimaryKeyVersionPolicy][]string{
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_MERGE_ALL_RECORDS: {
+			"1,eu,a2", "2,us,b", "3,eu,c", "4,<nil>,d",
+		},
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS: {
+			"1,eu,a2", "2,us,b", "3,eu,c",
+		},
+		protos.PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_REKEY_OLDER_RECORDS: {
+			"1,eu,a2", "3,eu,c",
+		},
+	} {
+		tableName := strings.ToLower(policy.String())
+		flowJobName := s.attachSuffix(tableName)
+		dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, tableName)
+		s.setupNormalizedTable(flowJobName, &protos.TableSchema{
+			TableIdentifier: dstTableName,
+			Columns: map[string]string{
+				"ID":     string(qvalue.QValueKindInt64),
+				"REGION": string(qvalue.QValueKindString),
+				"VALUE":  string(qvalue.QValueKindString),
+			},
+			PrimaryKeyColumns: []string{"ID", "REGION"},
+		})
+
+		_, err := s.connector.SyncRecords(&model.SyncRecordsRequest{
+			Records: &model.RecordBatch{Records: []model.Record{
+				&model.InsertRecord{DestinationTableName: dstTableName, Items: items(4, "", "d")},
+				&model.InsertRecord{DestinationTableName: dstTableName, Items: items(1, "eu", "a")},
+				&model.InsertRecord{DestinationTableName: dstTableName, Items: items(2, "us", "b")},
+				&model.DeleteRecord{DestinationTableName: dstTableName, Items: items(2, "", "")},
+				&model.InsertRecord{DestinationTableName: dstTableName, Items: items(3, "eu", "c")},
+				&model.UpdateRecord{
+					DestinationTableName:  dstTableName,
+					OldItems:              items(1, "eu", "a"),
+					NewItems:              items(1, "eu", "a2"),
+					UnchangedToastColumns: map[string]struct{}{},
+				},
+			}},
+			FlowJobName: flowJobName,
+		})
+		s.NoError(err)
+		_, err = s.connector.NormalizeRecords(&model.NormalizeRecordsRequest{
+			FlowJobName:      flowJobName,
+			KeyVersionPolicy: policy,
+		})
+		s.NoError(err)
+
+		s.Equal(expected, s.queryRowStrings(
+			fmt.Sprintf("SELECT TO_VARCHAR(ID), REGION, VALUE FROM %s ORDER BY ID", dstTableName)), policy.String())
+	}
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Variant_Null_Policy_SF() {
+	items := func(id int64) *model.RecordItems {
+		items := model.NewRecordItems()
+		items.AddColumn("ID", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: id})
+		return items
+	}
+	// 1 has json nulls, 2 is missing the columns and 3 has values
+	jsonNulls := items(1)
+	jsonNulls.AddColumn("SCORE", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: nil})
+	jsonNulls.AddColumn("TAGS", &qvalue.QValue{Kind: qvalue.QValueKindArrayString, Value: nil})
+	values := items(3)
+	values.AddColumn("SCORE", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(7)})
+	values.AddColumn("TAGS", &qvalue.QValue{Kind: qvalue.QValueKindArrayString, Value: []string{"a"}})
+
+	for policy, expected := range map[protos.VariantNullPolicy][]string{
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL: {
+			"1,true,true,false", "2,true,true,false", "3,false,false,false",
+		},
+		protos.VariantNullPolicy_VARIANT_NULL_POLICY_KEEP_JSON_NULL: {
+			"1,true,false,true", "2,true,true,false", "3,false,false,false",
+		},
+	} {
+		tableName := strings.ToLower(policy.String())
+		flowJobName := s.attachSuffix(tableName)
+		dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, tableName)
+		s.setupNormalizedTable(flowJobName, &protos.TableSchema{
+			TableIdentifier: dstTableName,
+			Columns: map[string]string{
+				"ID":    string(qvalue.QValueKindInt64),
+				"SCORE": string(qvalue.QValueKindInt64),
+				"TAGS":  string(qvalue.QValueKindArrayString),
+			},
+			PrimaryKeyColumns: []string{"ID"},
+		})
+
+		_, err := s.connector.SyncRecords(&model.SyncRecordsRequest{
+			Records: &model.RecordBatch{Records: []model.Record{
+				&model.InsertRecord{DestinationTableName: dstTableName, Items: jsonNulls},
+				&model.InsertRecord{DestinationTableName: dstTableName, Items: items(2)},
+				&model.InsertRecord{DestinationTableName: dstTableName, Items: values},
+			}},
+			FlowJobName: flowJobName,
+		})
+		s.NoError(err)
+		_, err = s.connector.NormalizeRecords(&model.NormalizeRecordsRequest{
+			FlowJobName:       flowJobName,
+			VariantNullPolicy: policy,
+		})
+		s.NoError(err)
+
+		s.Equal(expected, s.queryRowStrings(fmt.Sprintf(`SELECT TO_VARCHAR(ID), SCORE IS NULL, TAGS IS NULL,
+			COALESCE(IS_NULL_VALUE(TAGS), FALSE) FROM %s ORDER BY ID`, dstTableName)), policy.String())
+	}
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Max_Records_Per_Merge_SF() {
+	flowJobName := s.attachSuffix("test_max_records_per_merge")
+	dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, "test_max_records_per_merge")
+	s.setupNormalizedTable(flowJobName, &protos.TableSchema{
+		TableIdentifier: dstTableName,
+		Columns: map[string]string{
+			"ID":  string(qvalue.QValueKindInt64),
+			"VAL": string(qvalue.QValueKindString),
+		},
+		PrimaryKeyColumns: []string{"ID"},
+	})
+
+	items := func(id int64, val string) *model.RecordItems {
+		items := model.NewRecordItems()
+		items.AddColumn("ID", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: id})
+		items.AddColumn("VAL", &qvalue.QValue{Kind: qvalue.QValueKindString, Value: val})
+		return items
+	}
+	sync := func(records ...model.Record) {
+		_, err := s.connector.SyncRecords(&model.SyncRecordsRequest{
+			Records:     &model.RecordBatch{Records: records},
+			FlowJobName: flowJobName,
+		})
+		s.NoError(err)
+	}
+
+	// three batches of 5 records, none of which fits in a single merge
+	var inserts, updates []model.Record
+	for id := int64(1); id <= 5; id++ {
+		inserts = append(inserts, &model.InsertRecord{DestinationTableName: dstTableName, Items: items(id, "v1")})
+		updates = append(updates, &model.UpdateRecord{
+			DestinationTableName:  dstTableName,
+			OldItems:              items(id, "v1"),
+			NewItems:              items(id, "v2"),
+			UnchangedToastColumns: map[string]struct{}{},
+		})
+	}
+	sync(inserts...)
+	sync(updates...)
+	sync(
+		&model.DeleteRecord{DestinationTableName: dstTableName, Items: items(1, "v2")},
+		&model.DeleteRecord{DestinationTableName: dstTableName, Items: items(2, "v2")},
+		&model.InsertRecord{DestinationTableName: dstTableName, Items: items(6, "v1")},
+		&model.UpdateRecord{
+			DestinationTableName:  dstTableName,
+			OldItems:              items(3, "v2"),
+			NewItems:              items(3, "v3"),
+			UnchangedToastColumns: map[string]struct{}{},
+		},
+		&model.InsertRecord{DestinationTableName: dstTableName, Items: items(2, "v3")},
+	)
+
+	res, err := s.connector.NormalizeRecords(&model.NormalizeRecordsRequest{
+		FlowJobName:        flowJobName,
+		MaxRecordsPerMerge: 2,
+	})
+	s.NoError(err)
+	s.True(res.Done)
+	s.Equal(int64(3), res.EndBatchID)
+
+	s.Equal([]string{"2,v3", "3,v3", "4,v2", "5,v2", "6,v1"}, s.queryRowStrings(
+		fmt.Sprintf("SELECT TO_VARCHAR(ID), VAL FROM %s ORDER BY ID", dstTableName)))
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_NotNull_Column_Defaults_SF() {
+	flowJobName := s.attachSuffix("test_not_null_column_defaults")
+	dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, "test_not_null_column_defaults")
+	tableNameSchemaMapping := map[string]*protos.TableSchema{
+		dstTableName: {
+			TableIdentifier: dstTableName,
+			Columns: map[string]string{
+				"ID":  string(qvalue.QValueKindInt64),
+				"VAL": string(qvalue.QValueKindString),
+			},
+			PrimaryKeyColumns: []string{"ID"},
+		},
+	}
+	// the table already exists with a NOT NULL column that is not replicated
+	s.NoError(s.sfHelper.RunCommand(fmt.Sprintf(
+		"CREATE TABLE %s (ID INTEGER NOT NULL, VAL STRING, TENANT STRING NOT NULL)", dstTableName)))
+
+	s.NoError(s.connector.SetupMetadataTables())
+	_, err := s.connector.CreateRawTable(&protos.CreateRawTableInput{
+		FlowJobName:      flowJobName,
+		TableNameMapping: map[string]string{dstTableName: dstTableName},
+	})
+	s.NoError(err)
+	defer func() {
+		err := s.connector.SyncFlowCleanup(flowJobName)
+		s.NoError(err)
+	}()
+
+	_, err = s.connector.SetupNormalizedTables(&protos.SetupNormalizedTableBatchInput{
+		TableNameSchemaMapping: tableNameSchemaMapping,
+	})
+	s.ErrorContains(err, "TENANT")
+
+	columnDefaults := map[string]*protos.ColumnDefaults{
+		dstTableName: {Defaults: map[string]string{"TENANT": "'acme'"}},
+	}
+	_, err = s.connector.SetupNormalizedTables(&protos.SetupNormalizedTableBatchInput{
+		TableNameSchemaMapping: tableNameSchemaMapping,
+		ColumnDefaults:         columnDefaults,
+	})
+	s.NoError(err)
+	s.NoError(s.connector.InitializeTableSchema(tableNameSchemaMapping))
+
+	items := model.NewRecordItems()
+	items.AddColumn("ID", &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)})
+	items.AddColumn("VAL", &qvalue.QValue{Kind: qvalue.QValueKindString, Value: "inserted"})
+	_, err = s.connector.SyncRecords(&model.SyncRecordsRequest{
+		Records: &model.RecordBatch{Records: []model.Record{&model.InsertRecord{
+			SourceTableName:      dstTableName,
+			DestinationTableName: dstTableName,
+			CheckPointID:         100,
+			Items:                items,
+		}}},
+		FlowJobName: flowJobName,
+	})
+	s.NoError(err)
+	_, err = s.connector.NormalizeRecords(&model.NormalizeRecordsRequest{
+		FlowJobName:    flowJobName,
+		ColumnDefaults: columnDefaults,
+	})
+	s.NoError(err)
+
+	records, err := s.sfHelper.ExecuteAndProcessQuery(
+		fmt.Sprintf("SELECT VAL, TENANT FROM %s WHERE ID = 1", dstTableName))
+	s.NoError(err)
+	s.Len(records.Records, 1)
+	s.Equal("inserted", records.Records[0].Entries[0].Value)
+	s.Equal("acme", records.Records[0].Entries[1].Value)
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Type_Fidelity_SF() {
+	// values are stored differently with raw data as VARIANT and when synced through staged files
+	for _, cfg := range []*protos.FlowConnectionConfigs{
+		{},
+		{RawDataAsVariant: true},
+		{CdcSyncMode: protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO},
+	} {
+		results, err := s.connector.TestTypeFidelity(cfg, &protos.TableSchema{
+			TableIdentifier: "public.test_type_fidelity",
+			Columns: map[string]string{
+				"id":  string(qvalue.QValueKindInt64),
+				"num": string(qvalue.QValueKindNumeric),
+				"ts":  string(qvalue.QValueKindTimestamp),
+				"tz":  string(qvalue.QValueKindTimestampTZ),
+				"arr": string(qvalue.QValueKindArrayInt64),
+				"j":   string(qvalue.QValueKindJSON),
+				"geo": string(qvalue.QValueKindGeometry),
+			},
+			PrimaryKeyColumns: []string{"id"},
+		})
+		s.NoError(err)
+		s.Len(results, 7)
+
+		for _, result := range results {
+			s.Equal("public.test_type_fidelity", result.TableIdentifier)
+			if result.ColumnName == "geo" {
+				// there is no value to test geometries with
+				s.False(result.Tested)
+				continue
+			}
+			s.True(result.Tested, result.ColumnName)
+			s.True(result.Lossless, "column %s of type %s with %v: wrote %s, read back %s",
+				result.ColumnName, result.ColumnType, cfg, result.WrittenValue, result.ReadValue)
+		}
+	}
+
+	// the tables used by the test are dropped after
+	rawTables, err := s.connector.ListRawTables(nil)
+	s.NoError(err)
+	for _, rawTable := range rawTables {
+		s.NotContains(strings.ToLower(rawTable.TableIdentifier), "type_fidelity")
+	}
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_UUID_Array_SF() {
+	env := s.NewTestWorkflowEnvironment()
+	e2e.RegisterWorkflowsAndActivities(env)
+
+	srcTableName := s.attachSchemaSuffix("test_uuid_array")
+	dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, "test_uuid_array")
+
+	_, err := s.pool.Exec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE %s (
+			id SERIAL PRIMARY KEY,
+			ids UUID[]
+		);
+	`, srcTableName))
+	s.NoError(err)
+
+	connectionGen := e2e.FlowConnectionGenerationConfig{
+		FlowJobName:      s.attachSuffix("test_uuid_array"),
+		TableNameMapping: map[string]string{srcTableName: dstTableName},
+		PostgresPort:     e2e.PostgresPort,
+		Destination:      s.sfHelper.Peer,
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+
+	limits := peerflow.CDCFlowLimits{
+		TotalSyncFlows: 2,
+		MaxBatchSize:   100,
+	}
+
+	go func() {
+		e2e.SetupCDCFlowStatusQuery(env, connectionGen)
+		_, err = s.pool.Exec(context.Background(), fmt.Sprintf(`
+			INSERT INTO %s (ids) VALUES
+			('{66073c38-b8df-4bdb-bbca-1c97596b8940,00000000-0000-0000-0000-000000000000}'::uuid[]),
+			('{}'::uuid[])
+		`, srcTableName))
+		s.NoError(err)
+	}()
+
+	env.ExecuteWorkflow(peerflow.CDCFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+
+	// uuid arrays are replicated as an ARRAY of the uuids in their string form
+	records, err := s.sfHelper.ExecuteAndProcessQuery(
+		fmt.Sprintf("SELECT TYPEOF(IDS::VARIANT), TO_JSON(IDS) FROM %s ORDER BY ID", dstTableName))
+	s.NoError(err)
+	s.Len(records.Records, 2)
+	expectedIDs := []string{`["66073c38-b8df-4bdb-bbca-1c97596b8940","00000000-0000-0000-0000-000000000000"]`, `[]`}
+	for i, record := range records.Records {
+		s.Equal("ARRAY", record.Entries[0].Value)
+		s.JSONEq(expectedIDs[i], record.Entries[1].Value.(string))
+	}
+
+	env.AssertExpectations(s.T())
+}
+
+func (s *PeerFlowE2ETestSuiteSF) Test_Destination_Tags_SF() {
+	flowJobName := s.attachSuffix("test_destination_tags")
+	dstTableName := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, "test_destination_tags")
+	tagName := fmt.Sprintf("%s.%s.PEERDB_TEST_TEAM", s.sfHelper.testDatabaseName, s.sfHelper.testSchemaName)
+	s.NoError(s.sfHelper.RunCommand(fmt.Sprintf("CREATE TAG IF NOT EXISTS %s", tagName)))
+	tags := map[string]string{tagName: "data platform"}
+
+	s.NoError(s.connector.SetupMetadataTables())
+	res, err := s.connector.CreateRawTable(&protos.CreateRawTableInput{
+		FlowJobName:      flowJobName,
+		TableNameMapping: map[string]string{dstTableName: dstTableName},
+		Tags:             tags,
+	})
+	s.NoError(err)
+	defer func() {
+		err := s.connector.SyncFlowCleanup(flowJobName)
+		s.NoError(err)
+	}()
+	_, err = s.connector.SetupNormalizedTables(&protos.SetupNormalizedTableBatchInput{
+		TableNameSchemaMapping: map[string]*protos.TableSchema{dstTableName: {
+			TableIdentifier:   dstTableName,
+			Columns:           map[string]string{"ID": string(qvalue.QValueKindInt64)},
+			PrimaryKeyColumns: []string{"ID"},
+		}},
+		Tags: tags,
+	})
+	s.NoError(err)
+
+	for _, tableIdentifier := range []string{dstTableName, "_PEERDB_INTERNAL." + res.TableIdentifier} {
+		records, err := s.sfHelper.ExecuteAndProcessQuery(
+			fmt.Sprintf("SELECT SYSTEM$GET_TAG('%s', '%s', 'table')", tagName, tableIdentifier))
+		s.NoError(err)
+		s.Len(records.Records, 1)
+		s.Equal("data platform", records.Records[0].Entries[0].Value)
+	}
+}
+
+// setupNormalizedTable creates the raw table of a mirror and its normalized table, which the connector
+// normalizes with the given schema from then on. The mirror is cleaned up at the end of the test.
+func (s *PeerFlowE2ETestSuiteSF) setupNormalizedTable(flowJobName string, tableSchema *protos.TableSchema) {
+	tableNameSchemaMapping := map[string]*protos.TableSchema{tableSchema.TableIdentifier: tableSchema}
+
+	s.NoError(s.connector.SetupMetadataTables())
+	_, err := s.connector.CreateRawTable(&protos.CreateRawTableInput{
+		FlowJobName:      flowJobName,
+		TableNameMapping: map[string]string{tableSchema.TableIdentifier: tableSchema.TableIdentifier},
+	})
+	s.NoError(err)
+	s.T().Cleanup(func() {
+		s.NoError(s.connector.SyncFlowCleanup(flowJobName))
+	})
+	_, err = s.connector.SetupNormalizedTables(&protos.SetupNormalizedTableBatchInput{
+		TableNameSchemaMapping: tableNameSchemaMapping,
+	})
+	s.NoError(err)
+	s.NoError(s.connector.InitializeTableSchema(tableNameSchemaMapping))
+}
+
+// queryRowStrings runs a query on Snowflake and returns each row as its values joined by commas.
+func (s *PeerFlowE2ETestSuiteSF) queryRowStrings(query string) []string {
+	records, err := s.sfHelper.ExecuteAndProcessQuery(query)
+	s.Require().NoError(err)
+	rows := make([]string, 0, len(records.Records))
+	for _, record := range records.Records {
+		values := make([]string, 0, len(record.Entries))
+		for _, entry := range record.Entries {
+			values = append(values, fmt.Sprint(entry.Value))
+		}
+		rows = append(rows, strings.Join(values, ","))
+	}
+	return rows
+}
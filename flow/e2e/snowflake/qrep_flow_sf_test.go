@@ -3,6 +3,7 @@ package e2e_snowflake
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	connpostgres "github.com/PeerDB-io/peer-flow/connectors/postgres"
 	"github.com/PeerDB-io/peer-flow/e2e"
@@ -272,3 +273,66 @@ func (s *PeerFlowE2ETestSuiteSF) Test_Complete_QRep_Flow_Avro_SF_S3_Integration(
 
 	env.AssertExpectations(s.T())
 }
+
+func (s *PeerFlowE2ETestSuiteSF) Test_QRep_Flow_Staging_Formats_SF() {
+	// quotes, a comma and a line break, all of which CSV has to escape
+	doc := `{"note": "a, \"quoted\"` + "\n" + `text"}`
+
+	for _, format := range []protos.StagingFileFormat{
+		protos.StagingFileFormat_STAGING_FILE_FORMAT_CSV,
+		protos.StagingFileFormat_STAGING_FILE_FORMAT_NDJSON,
+		protos.StagingFileFormat_STAGING_FILE_FORMAT_PARQUET,
+	} {
+		env := s.NewTestWorkflowEnvironment()
+		e2e.RegisterWorkflowsAndActivities(env)
+
+		tblName := "test_qrep_" + strings.ToLower(format.String())
+		srcTableName := s.attachSchemaSuffix(tblName)
+		dstSchemaQualified := fmt.Sprintf("%s.%s", s.sfHelper.testSchemaName, tblName)
+
+		_, err := s.pool.Exec(context.Background(), fmt.Sprintf(`
+			CREATE TABLE %s (
+				id INT PRIMARY KEY,
+				amount NUMERIC,
+				created_at TIMESTAMP,
+				doc JSON,
+				updated_at TIMESTAMP NOT NULL DEFAULT now()
+			);
+		`, srcTableName))
+		s.NoError(err)
+		// before 1971, where Snowflake would read microseconds since the epoch as seconds
+		_, err = s.pool.Exec(context.Background(), fmt.Sprintf(`
+			INSERT INTO %s(id, amount, created_at, doc) VALUES
+			(1, 123.456, '1969-07-20 20:17:40.123456', $1), (2, NULL, NULL, NULL)
+		`, srcTableName), doc)
+		s.NoError(err)
+		err = s.sfHelper.RunCommand(fmt.Sprintf(`CREATE TABLE %s (ID INT, AMOUNT NUMBER(38, 9),
+			CREATED_AT TIMESTAMP_NTZ, DOC VARIANT, UPDATED_AT TIMESTAMP_NTZ)`, dstSchemaQualified))
+		s.NoError(err)
+
+		qrepConfig, err := e2e.CreateQRepWorkflowConfig(
+			tblName,
+			srcTableName,
+			dstSchemaQualified,
+			fmt.Sprintf("SELECT * FROM %s WHERE updated_at BETWEEN {{.start}} AND {{.end}}", srcTableName),
+			protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO,
+			s.sfHelper.Peer,
+			"",
+		)
+		s.NoError(err)
+		qrepConfig.StagingFileFormat = format
+
+		e2e.RunQrepFlowWorkflow(env, qrepConfig)
+		s.True(env.IsWorkflowCompleted())
+		s.NoError(env.GetWorkflowError())
+
+		s.Equal([]string{
+			"1,123.456000000,1969-07-20 20:17:40.123456," + doc,
+			"2,<nil>,<nil>,<nil>",
+		}, s.queryRowStrings(fmt.Sprintf(`SELECT TO_VARCHAR(ID), TO_VARCHAR(AMOUNT),
+			TO_VARCHAR(CREATED_AT, 'YYYY-MM-DD HH24:MI:SS.FF6'), TO_VARCHAR(DOC) FROM %s ORDER BY ID`,
+			dstSchemaQualified)), format.String())
+
+		env.AssertExpectations(s.T())
+	}
+}
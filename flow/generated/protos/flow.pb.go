@@ -21,129 +21,2003 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// protos for qrep
-type QRepSyncMode int32
+// a column of a raw record is missing when it was added after the record was synced or is an unchanged
+// toast column, and json null when its source value is NULL or, for json columns, the json value null.
+// a missing column always flattens to SQL NULL.
+type VariantNullPolicy int32
 
 const (
-	QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT QRepSyncMode = 0
-	QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO QRepSyncMode = 1
+	// flatten json null to SQL NULL like a missing column, so both land as NULL in every column type.
+	VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL VariantNullPolicy = 0
+	// cast json null as it is, which keeps it as a json null in VARIANT columns, the behavior of
+	// mirrors created before this policy.
+	VariantNullPolicy_VARIANT_NULL_POLICY_KEEP_JSON_NULL VariantNullPolicy = 1
 )
 
-// Enum value maps for QRepSyncMode.
+// Enum value maps for VariantNullPolicy.
 var (
-	QRepSyncMode_name = map[int32]string{
-		0: "QREP_SYNC_MODE_MULTI_INSERT",
-		1: "QREP_SYNC_MODE_STORAGE_AVRO",
+	VariantNullPolicy_name = map[int32]string{
+		0: "VARIANT_NULL_POLICY_SQL_NULL",
+		1: "VARIANT_NULL_POLICY_KEEP_JSON_NULL",
 	}
-	QRepSyncMode_value = map[string]int32{
-		"QREP_SYNC_MODE_MULTI_INSERT": 0,
-		"QREP_SYNC_MODE_STORAGE_AVRO": 1,
+	VariantNullPolicy_value = map[string]int32{
+		"VARIANT_NULL_POLICY_SQL_NULL":       0,
+		"VARIANT_NULL_POLICY_KEEP_JSON_NULL": 1,
 	}
 )
 
-func (x QRepSyncMode) Enum() *QRepSyncMode {
-	p := new(QRepSyncMode)
+func (x VariantNullPolicy) Enum() *VariantNullPolicy {
+	p := new(VariantNullPolicy)
 	*p = x
 	return p
 }
 
-func (x QRepSyncMode) String() string {
+func (x VariantNullPolicy) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (QRepSyncMode) Descriptor() protoreflect.EnumDescriptor {
+func (VariantNullPolicy) Descriptor() protoreflect.EnumDescriptor {
 	return file_flow_proto_enumTypes[0].Descriptor()
 }
 
-func (QRepSyncMode) Type() protoreflect.EnumType {
+func (VariantNullPolicy) Type() protoreflect.EnumType {
 	return &file_flow_proto_enumTypes[0]
 }
 
-func (x QRepSyncMode) Number() protoreflect.EnumNumber {
+func (x VariantNullPolicy) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use QRepSyncMode.Descriptor instead.
-func (QRepSyncMode) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use VariantNullPolicy.Descriptor instead.
+func (VariantNullPolicy) EnumDescriptor() ([]byte, []int) {
 	return file_flow_proto_rawDescGZIP(), []int{0}
 }
 
-type QRepWriteType int32
+// raw records are deduplicated and merged on the current primary key of their table. Records synced
+// before the key changed have all columns of the row as it was then, except deletes, which only have
+// the columns of the key at the time. Their columns of the current key can be missing, which never match
+// a normalized row.
+type PrimaryKeyVersionPolicy int32
 
 const (
-	QRepWriteType_QREP_WRITE_MODE_APPEND QRepWriteType = 0
-	QRepWriteType_QREP_WRITE_MODE_UPSERT QRepWriteType = 1
-	// only valid when initial_copy_true is set to true. TRUNCATES tables before reverting to APPEND.
-	QRepWriteType_QREP_WRITE_MODE_OVERWRITE QRepWriteType = 2
+	// merge every record as it is. Records missing a column of the current key match no normalized row,
+	// so their deletes change nothing and their inserts and updates insert a row without the column.
+	PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_MERGE_ALL_RECORDS PrimaryKeyVersionPolicy = 0
+	// skip records missing a column of the current key, leaving the rows they refer to as they are.
+	PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS PrimaryKeyVersionPolicy = 1
+	// fill the missing key columns of a record from the latest earlier record of the same merge that agrees
+	// with it on the rest of the key, which is the row it refers to. Records that cannot be re-keyed that
+	// way are skipped.
+	PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_REKEY_OLDER_RECORDS PrimaryKeyVersionPolicy = 2
 )
 
-// Enum value maps for QRepWriteType.
+// Enum value maps for PrimaryKeyVersionPolicy.
 var (
-	QRepWriteType_name = map[int32]string{
-		0: "QREP_WRITE_MODE_APPEND",
-		1: "QREP_WRITE_MODE_UPSERT",
-		2: "QREP_WRITE_MODE_OVERWRITE",
+	PrimaryKeyVersionPolicy_name = map[int32]string{
+		0: "PRIMARY_KEY_VERSION_POLICY_MERGE_ALL_RECORDS",
+		1: "PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS",
+		2: "PRIMARY_KEY_VERSION_POLICY_REKEY_OLDER_RECORDS",
+	}
+	PrimaryKeyVersionPolicy_value = map[string]int32{
+		"PRIMARY_KEY_VERSION_POLICY_MERGE_ALL_RECORDS":   0,
+		"PRIMARY_KEY_VERSION_POLICY_SKIP_OLDER_RECORDS":  1,
+		"PRIMARY_KEY_VERSION_POLICY_REKEY_OLDER_RECORDS": 2,
+	}
+)
+
+func (x PrimaryKeyVersionPolicy) Enum() *PrimaryKeyVersionPolicy {
+	p := new(PrimaryKeyVersionPolicy)
+	*p = x
+	return p
+}
+
+func (x PrimaryKeyVersionPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrimaryKeyVersionPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[1].Descriptor()
+}
+
+func (PrimaryKeyVersionPolicy) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[1]
+}
+
+func (x PrimaryKeyVersionPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrimaryKeyVersionPolicy.Descriptor instead.
+func (PrimaryKeyVersionPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{1}
+}
+
+// raw records are inserts (0), updates (1) or deletes (2). Any other record type, from a newer version
+// or a corrupted raw table, is never merged.
+type UnknownRecordTypePolicy int32
+
+const (
+	// fail the normalize, leaving the batches with such records to be fixed up by hand.
+	UnknownRecordTypePolicy_UNKNOWN_RECORD_TYPE_POLICY_FAIL UnknownRecordTypePolicy = 0
+	// log the records and normalize the batches without them.
+	UnknownRecordTypePolicy_UNKNOWN_RECORD_TYPE_POLICY_SKIP UnknownRecordTypePolicy = 1
+)
+
+// Enum value maps for UnknownRecordTypePolicy.
+var (
+	UnknownRecordTypePolicy_name = map[int32]string{
+		0: "UNKNOWN_RECORD_TYPE_POLICY_FAIL",
+		1: "UNKNOWN_RECORD_TYPE_POLICY_SKIP",
+	}
+	UnknownRecordTypePolicy_value = map[string]int32{
+		"UNKNOWN_RECORD_TYPE_POLICY_FAIL": 0,
+		"UNKNOWN_RECORD_TYPE_POLICY_SKIP": 1,
+	}
+)
+
+func (x UnknownRecordTypePolicy) Enum() *UnknownRecordTypePolicy {
+	p := new(UnknownRecordTypePolicy)
+	*p = x
+	return p
+}
+
+func (x UnknownRecordTypePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnknownRecordTypePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[2].Descriptor()
+}
+
+func (UnknownRecordTypePolicy) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[2]
+}
+
+func (x UnknownRecordTypePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnknownRecordTypePolicy.Descriptor instead.
+func (UnknownRecordTypePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{2}
+}
+
+// a batch is never split, so a merge of a single batch that times out fails the normalize regardless.
+type MergeTimeoutSplitPolicy int32
+
+const (
+	// merge the older and the newer half of the batches separately, halving again on further timeouts.
+	MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_HALVE MergeTimeoutSplitPolicy = 0
+	// merge every batch separately.
+	MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_PER_BATCH MergeTimeoutSplitPolicy = 1
+	// fail the normalize without splitting the batches.
+	MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_FAIL MergeTimeoutSplitPolicy = 2
+)
+
+// Enum value maps for MergeTimeoutSplitPolicy.
+var (
+	MergeTimeoutSplitPolicy_name = map[int32]string{
+		0: "MERGE_TIMEOUT_SPLIT_POLICY_HALVE",
+		1: "MERGE_TIMEOUT_SPLIT_POLICY_PER_BATCH",
+		2: "MERGE_TIMEOUT_SPLIT_POLICY_FAIL",
+	}
+	MergeTimeoutSplitPolicy_value = map[string]int32{
+		"MERGE_TIMEOUT_SPLIT_POLICY_HALVE":     0,
+		"MERGE_TIMEOUT_SPLIT_POLICY_PER_BATCH": 1,
+		"MERGE_TIMEOUT_SPLIT_POLICY_FAIL":      2,
+	}
+)
+
+func (x MergeTimeoutSplitPolicy) Enum() *MergeTimeoutSplitPolicy {
+	p := new(MergeTimeoutSplitPolicy)
+	*p = x
+	return p
+}
+
+func (x MergeTimeoutSplitPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeTimeoutSplitPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[3].Descriptor()
+}
+
+func (MergeTimeoutSplitPolicy) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[3]
+}
+
+func (x MergeTimeoutSplitPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeTimeoutSplitPolicy.Descriptor instead.
+func (MergeTimeoutSplitPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{3}
+}
+
+type SyncBatchIDConflictPolicy int32
+
+const (
+	// record the batch regardless, the last sync to commit sets the batch id.
+	SyncBatchIDConflictPolicy_SYNC_BATCH_ID_CONFLICT_POLICY_OVERWRITE SyncBatchIDConflictPolicy = 0
+	// fail the sync without recording its batch, the batch id only advances if it is the one the sync
+	// started from. only guards syncs whose raw rows are inserted in the sync transaction, so it cannot
+	// be used with avro syncs, idempotent staged raw inserts or parallel raw inserts.
+	SyncBatchIDConflictPolicy_SYNC_BATCH_ID_CONFLICT_POLICY_FAIL SyncBatchIDConflictPolicy = 1
+)
+
+// Enum value maps for SyncBatchIDConflictPolicy.
+var (
+	SyncBatchIDConflictPolicy_name = map[int32]string{
+		0: "SYNC_BATCH_ID_CONFLICT_POLICY_OVERWRITE",
+		1: "SYNC_BATCH_ID_CONFLICT_POLICY_FAIL",
+	}
+	SyncBatchIDConflictPolicy_value = map[string]int32{
+		"SYNC_BATCH_ID_CONFLICT_POLICY_OVERWRITE": 0,
+		"SYNC_BATCH_ID_CONFLICT_POLICY_FAIL":      1,
+	}
+)
+
+func (x SyncBatchIDConflictPolicy) Enum() *SyncBatchIDConflictPolicy {
+	p := new(SyncBatchIDConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x SyncBatchIDConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncBatchIDConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[4].Descriptor()
+}
+
+func (SyncBatchIDConflictPolicy) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[4]
+}
+
+func (x SyncBatchIDConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncBatchIDConflictPolicy.Descriptor instead.
+func (SyncBatchIDConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{4}
+}
+
+type IdentifierLengthPolicy int32
+
+const (
+	// truncate the names for postgres destinations, which would otherwise cut them off without a hash,
+	// and fail the setup for the others.
+	IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_DEFAULT IdentifierLengthPolicy = 0
+	// shorten the names, replacing their end with a hash of the full name so they stay unique.
+	IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_TRUNCATE IdentifierLengthPolicy = 1
+	// fail the setup, naming the destination tables that are too long.
+	IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_ERROR IdentifierLengthPolicy = 2
+)
+
+// Enum value maps for IdentifierLengthPolicy.
+var (
+	IdentifierLengthPolicy_name = map[int32]string{
+		0: "IDENTIFIER_LENGTH_POLICY_DEFAULT",
+		1: "IDENTIFIER_LENGTH_POLICY_TRUNCATE",
+		2: "IDENTIFIER_LENGTH_POLICY_ERROR",
+	}
+	IdentifierLengthPolicy_value = map[string]int32{
+		"IDENTIFIER_LENGTH_POLICY_DEFAULT":  0,
+		"IDENTIFIER_LENGTH_POLICY_TRUNCATE": 1,
+		"IDENTIFIER_LENGTH_POLICY_ERROR":    2,
+	}
+)
+
+func (x IdentifierLengthPolicy) Enum() *IdentifierLengthPolicy {
+	p := new(IdentifierLengthPolicy)
+	*p = x
+	return p
+}
+
+func (x IdentifierLengthPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdentifierLengthPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[5].Descriptor()
+}
+
+func (IdentifierLengthPolicy) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[5]
+}
+
+func (x IdentifierLengthPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdentifierLengthPolicy.Descriptor instead.
+func (IdentifierLengthPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{5}
+}
+
+type IncompleteSetupPolicy int32
+
+const (
+	// fail with an error saying the setup is not complete, retried until it is.
+	IncompleteSetupPolicy_INCOMPLETE_SETUP_POLICY_ERROR IncompleteSetupPolicy = 0
+	// set up the metadata tables and the raw table before syncing.
+	IncompleteSetupPolicy_INCOMPLETE_SETUP_POLICY_SETUP IncompleteSetupPolicy = 1
+)
+
+// Enum value maps for IncompleteSetupPolicy.
+var (
+	IncompleteSetupPolicy_name = map[int32]string{
+		0: "INCOMPLETE_SETUP_POLICY_ERROR",
+		1: "INCOMPLETE_SETUP_POLICY_SETUP",
+	}
+	IncompleteSetupPolicy_value = map[string]int32{
+		"INCOMPLETE_SETUP_POLICY_ERROR": 0,
+		"INCOMPLETE_SETUP_POLICY_SETUP": 1,
+	}
+)
+
+func (x IncompleteSetupPolicy) Enum() *IncompleteSetupPolicy {
+	p := new(IncompleteSetupPolicy)
+	*p = x
+	return p
+}
+
+func (x IncompleteSetupPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncompleteSetupPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[6].Descriptor()
+}
+
+func (IncompleteSetupPolicy) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[6]
+}
+
+func (x IncompleteSetupPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncompleteSetupPolicy.Descriptor instead.
+func (IncompleteSetupPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{6}
+}
+
+// what to do with a primary key column of a type that does not compare exactly, such as a float.
+// the merge dedups records by primary key, so such keys may update the wrong row or duplicate rows.
+type RiskyPrimaryKeyPolicy int32
+
+const (
+	// log a warning recommending a safer key, and replicate the table as is.
+	RiskyPrimaryKeyPolicy_RISKY_PRIMARY_KEY_POLICY_WARN RiskyPrimaryKeyPolicy = 0
+	// fail the setup.
+	RiskyPrimaryKeyPolicy_RISKY_PRIMARY_KEY_POLICY_ERROR RiskyPrimaryKeyPolicy = 1
+)
+
+// Enum value maps for RiskyPrimaryKeyPolicy.
+var (
+	RiskyPrimaryKeyPolicy_name = map[int32]string{
+		0: "RISKY_PRIMARY_KEY_POLICY_WARN",
+		1: "RISKY_PRIMARY_KEY_POLICY_ERROR",
+	}
+	RiskyPrimaryKeyPolicy_value = map[string]int32{
+		"RISKY_PRIMARY_KEY_POLICY_WARN":  0,
+		"RISKY_PRIMARY_KEY_POLICY_ERROR": 1,
+	}
+)
+
+func (x RiskyPrimaryKeyPolicy) Enum() *RiskyPrimaryKeyPolicy {
+	p := new(RiskyPrimaryKeyPolicy)
+	*p = x
+	return p
+}
+
+func (x RiskyPrimaryKeyPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RiskyPrimaryKeyPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[7].Descriptor()
+}
+
+func (RiskyPrimaryKeyPolicy) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[7]
+}
+
+func (x RiskyPrimaryKeyPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RiskyPrimaryKeyPolicy.Descriptor instead.
+func (RiskyPrimaryKeyPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{7}
+}
+
+// what to do with a source table whose replica identity is NOTHING. such a table sends no key
+// with updates and deletes, so they cannot be merged into the destination.
+type ReplicaIdentityNothingPolicy int32
+
+const (
+	// fail, asking for the table's replica identity to be changed.
+	ReplicaIdentityNothingPolicy_REPLICA_IDENTITY_NOTHING_POLICY_ERROR ReplicaIdentityNothingPolicy = 0
+	// set the table's replica identity to DEFAULT if it has a primary key, otherwise to FULL.
+	ReplicaIdentityNothingPolicy_REPLICA_IDENTITY_NOTHING_POLICY_SET_IDENTITY ReplicaIdentityNothingPolicy = 1
+	// replicate only the inserts of the table, which is only suited for tables that are only inserted into.
+	// the table is published by a separate publication of its inserts, as publishing its updates and
+	// deletes would fail them on the source.
+	ReplicaIdentityNothingPolicy_REPLICA_IDENTITY_NOTHING_POLICY_ALLOW ReplicaIdentityNothingPolicy = 2
+)
+
+// Enum value maps for ReplicaIdentityNothingPolicy.
+var (
+	ReplicaIdentityNothingPolicy_name = map[int32]string{
+		0: "REPLICA_IDENTITY_NOTHING_POLICY_ERROR",
+		1: "REPLICA_IDENTITY_NOTHING_POLICY_SET_IDENTITY",
+		2: "REPLICA_IDENTITY_NOTHING_POLICY_ALLOW",
+	}
+	ReplicaIdentityNothingPolicy_value = map[string]int32{
+		"REPLICA_IDENTITY_NOTHING_POLICY_ERROR":        0,
+		"REPLICA_IDENTITY_NOTHING_POLICY_SET_IDENTITY": 1,
+		"REPLICA_IDENTITY_NOTHING_POLICY_ALLOW":        2,
+	}
+)
+
+func (x ReplicaIdentityNothingPolicy) Enum() *ReplicaIdentityNothingPolicy {
+	p := new(ReplicaIdentityNothingPolicy)
+	*p = x
+	return p
+}
+
+func (x ReplicaIdentityNothingPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReplicaIdentityNothingPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[8].Descriptor()
+}
+
+func (ReplicaIdentityNothingPolicy) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[8]
+}
+
+func (x ReplicaIdentityNothingPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplicaIdentityNothingPolicy.Descriptor instead.
+func (ReplicaIdentityNothingPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{8}
+}
+
+// where NULL timestamps sort when ranking the records of a primary key, latest first.
+type DedupNullsOrdering int32
+
+const (
+	// records without a timestamp are only kept if there is no other record.
+	DedupNullsOrdering_DEDUP_NULLS_LAST  DedupNullsOrdering = 0
+	DedupNullsOrdering_DEDUP_NULLS_FIRST DedupNullsOrdering = 1
+)
+
+// Enum value maps for DedupNullsOrdering.
+var (
+	DedupNullsOrdering_name = map[int32]string{
+		0: "DEDUP_NULLS_LAST",
+		1: "DEDUP_NULLS_FIRST",
+	}
+	DedupNullsOrdering_value = map[string]int32{
+		"DEDUP_NULLS_LAST":  0,
+		"DEDUP_NULLS_FIRST": 1,
+	}
+)
+
+func (x DedupNullsOrdering) Enum() *DedupNullsOrdering {
+	p := new(DedupNullsOrdering)
+	*p = x
+	return p
+}
+
+func (x DedupNullsOrdering) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DedupNullsOrdering) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[9].Descriptor()
+}
+
+func (DedupNullsOrdering) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[9]
+}
+
+func (x DedupNullsOrdering) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DedupNullsOrdering.Descriptor instead.
+func (DedupNullsOrdering) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{9}
+}
+
+// isolation level of the transactions reading from a Postgres source.
+type SourceIsolationLevel int32
+
+const (
+	SourceIsolationLevel_SOURCE_ISOLATION_LEVEL_REPEATABLE_READ SourceIsolationLevel = 0
+	// read only deferrable, waits for a snapshot that can't see serialization anomalies.
+	// Cannot be used with an exported snapshot, such as the one of the initial copy of a CDC mirror.
+	SourceIsolationLevel_SOURCE_ISOLATION_LEVEL_SERIALIZABLE SourceIsolationLevel = 1
+)
+
+// Enum value maps for SourceIsolationLevel.
+var (
+	SourceIsolationLevel_name = map[int32]string{
+		0: "SOURCE_ISOLATION_LEVEL_REPEATABLE_READ",
+		1: "SOURCE_ISOLATION_LEVEL_SERIALIZABLE",
+	}
+	SourceIsolationLevel_value = map[string]int32{
+		"SOURCE_ISOLATION_LEVEL_REPEATABLE_READ": 0,
+		"SOURCE_ISOLATION_LEVEL_SERIALIZABLE":    1,
+	}
+)
+
+func (x SourceIsolationLevel) Enum() *SourceIsolationLevel {
+	p := new(SourceIsolationLevel)
+	*p = x
+	return p
+}
+
+func (x SourceIsolationLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SourceIsolationLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[10].Descriptor()
+}
+
+func (SourceIsolationLevel) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[10]
+}
+
+func (x SourceIsolationLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SourceIsolationLevel.Descriptor instead.
+func (SourceIsolationLevel) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{10}
+}
+
+// protos for qrep
+type QRepSyncMode int32
+
+const (
+	QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT QRepSyncMode = 0
+	QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO QRepSyncMode = 1
+	// qrep only, picks storage avro or multi insert based on the size of rows sampled from the first partition.
+	QRepSyncMode_QREP_SYNC_MODE_AUTO QRepSyncMode = 2
+)
+
+// Enum value maps for QRepSyncMode.
+var (
+	QRepSyncMode_name = map[int32]string{
+		0: "QREP_SYNC_MODE_MULTI_INSERT",
+		1: "QREP_SYNC_MODE_STORAGE_AVRO",
+		2: "QREP_SYNC_MODE_AUTO",
+	}
+	QRepSyncMode_value = map[string]int32{
+		"QREP_SYNC_MODE_MULTI_INSERT": 0,
+		"QREP_SYNC_MODE_STORAGE_AVRO": 1,
+		"QREP_SYNC_MODE_AUTO":         2,
+	}
+)
+
+func (x QRepSyncMode) Enum() *QRepSyncMode {
+	p := new(QRepSyncMode)
+	*p = x
+	return p
+}
+
+func (x QRepSyncMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QRepSyncMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[11].Descriptor()
+}
+
+func (QRepSyncMode) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[11]
+}
+
+func (x QRepSyncMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QRepSyncMode.Descriptor instead.
+func (QRepSyncMode) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{11}
+}
+
+type StagingFileFormat int32
+
+const (
+	StagingFileFormat_STAGING_FILE_FORMAT_AVRO StagingFileFormat = 0
+	// columns are staged in the order of their lower-cased names, nulls are unquoted empty fields.
+	StagingFileFormat_STAGING_FILE_FORMAT_CSV StagingFileFormat = 1
+	// one JSON object per line.
+	StagingFileFormat_STAGING_FILE_FORMAT_NDJSON  StagingFileFormat = 2
+	StagingFileFormat_STAGING_FILE_FORMAT_PARQUET StagingFileFormat = 3
+)
+
+// Enum value maps for StagingFileFormat.
+var (
+	StagingFileFormat_name = map[int32]string{
+		0: "STAGING_FILE_FORMAT_AVRO",
+		1: "STAGING_FILE_FORMAT_CSV",
+		2: "STAGING_FILE_FORMAT_NDJSON",
+		3: "STAGING_FILE_FORMAT_PARQUET",
+	}
+	StagingFileFormat_value = map[string]int32{
+		"STAGING_FILE_FORMAT_AVRO":    0,
+		"STAGING_FILE_FORMAT_CSV":     1,
+		"STAGING_FILE_FORMAT_NDJSON":  2,
+		"STAGING_FILE_FORMAT_PARQUET": 3,
+	}
+)
+
+func (x StagingFileFormat) Enum() *StagingFileFormat {
+	p := new(StagingFileFormat)
+	*p = x
+	return p
+}
+
+func (x StagingFileFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StagingFileFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[12].Descriptor()
+}
+
+func (StagingFileFormat) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[12]
+}
+
+func (x StagingFileFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StagingFileFormat.Descriptor instead.
+func (StagingFileFormat) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{12}
+}
+
+// what a QRep flow does with a source table that has no rows to replicate on its first run.
+type EmptySourceTablePolicy int32
+
+const (
+	// sync a partition without rows, which creates a missing destination table from the schema of the query.
+	// only snowflake and postgres destinations create tables this way, others skip empty source tables.
+	EmptySourceTablePolicy_EMPTY_SOURCE_TABLE_POLICY_CREATE_DESTINATION_TABLE EmptySourceTablePolicy = 0
+	// sync nothing, leaving a missing destination table missing.
+	EmptySourceTablePolicy_EMPTY_SOURCE_TABLE_POLICY_SKIP EmptySourceTablePolicy = 1
+)
+
+// Enum value maps for EmptySourceTablePolicy.
+var (
+	EmptySourceTablePolicy_name = map[int32]string{
+		0: "EMPTY_SOURCE_TABLE_POLICY_CREATE_DESTINATION_TABLE",
+		1: "EMPTY_SOURCE_TABLE_POLICY_SKIP",
+	}
+	EmptySourceTablePolicy_value = map[string]int32{
+		"EMPTY_SOURCE_TABLE_POLICY_CREATE_DESTINATION_TABLE": 0,
+		"EMPTY_SOURCE_TABLE_POLICY_SKIP":                     1,
+	}
+)
+
+func (x EmptySourceTablePolicy) Enum() *EmptySourceTablePolicy {
+	p := new(EmptySourceTablePolicy)
+	*p = x
+	return p
+}
+
+func (x EmptySourceTablePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmptySourceTablePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[13].Descriptor()
+}
+
+func (EmptySourceTablePolicy) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[13]
+}
+
+func (x EmptySourceTablePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmptySourceTablePolicy.Descriptor instead.
+func (EmptySourceTablePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{13}
+}
+
+type QRepWriteType int32
+
+const (
+	QRepWriteType_QREP_WRITE_MODE_APPEND QRepWriteType = 0
+	QRepWriteType_QREP_WRITE_MODE_UPSERT QRepWriteType = 1
+	// only valid when initial_copy_true is set to true. TRUNCATES tables before reverting to APPEND.
+	QRepWriteType_QREP_WRITE_MODE_OVERWRITE QRepWriteType = 2
+)
+
+// Enum value maps for QRepWriteType.
+var (
+	QRepWriteType_name = map[int32]string{
+		0: "QREP_WRITE_MODE_APPEND",
+		1: "QREP_WRITE_MODE_UPSERT",
+		2: "QREP_WRITE_MODE_OVERWRITE",
+	}
+	QRepWriteType_value = map[string]int32{
+		"QREP_WRITE_MODE_APPEND":    0,
+		"QREP_WRITE_MODE_UPSERT":    1,
+		"QREP_WRITE_MODE_OVERWRITE": 2,
+	}
+)
+
+func (x QRepWriteType) Enum() *QRepWriteType {
+	p := new(QRepWriteType)
+	*p = x
+	return p
+}
+
+func (x QRepWriteType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QRepWriteType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[14].Descriptor()
+}
+
+func (QRepWriteType) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[14]
+}
+
+func (x QRepWriteType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QRepWriteType.Descriptor instead.
+func (QRepWriteType) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{14}
+}
+
+// what to do with an integer value that doesn't fit the type of its column.
+type IntRangePolicy int32
+
+const (
+	// fail the sync with an error naming the column and the value. CDC syncs leave the check to the destination.
+	IntRangePolicy_INT_RANGE_POLICY_ERROR IntRangePolicy = 0
+	// replace the value with the nearest value that fits.
+	IntRangePolicy_INT_RANGE_POLICY_CLAMP IntRangePolicy = 1
+	// replace the value with NULL.
+	IntRangePolicy_INT_RANGE_POLICY_NULL IntRangePolicy = 2
+)
+
+// Enum value maps for IntRangePolicy.
+var (
+	IntRangePolicy_name = map[int32]string{
+		0: "INT_RANGE_POLICY_ERROR",
+		1: "INT_RANGE_POLICY_CLAMP",
+		2: "INT_RANGE_POLICY_NULL",
+	}
+	IntRangePolicy_value = map[string]int32{
+		"INT_RANGE_POLICY_ERROR": 0,
+		"INT_RANGE_POLICY_CLAMP": 1,
+		"INT_RANGE_POLICY_NULL":  2,
+	}
+)
+
+func (x IntRangePolicy) Enum() *IntRangePolicy {
+	p := new(IntRangePolicy)
+	*p = x
+	return p
+}
+
+func (x IntRangePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IntRangePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_flow_proto_enumTypes[15].Descriptor()
+}
+
+func (IntRangePolicy) Type() protoreflect.EnumType {
+	return &file_flow_proto_enumTypes[15]
+}
+
+func (x IntRangePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IntRangePolicy.Descriptor instead.
+func (IntRangePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{15}
+}
+
+type TableNameMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceTableName      string `protobuf:"bytes,1,opt,name=source_table_name,json=sourceTableName,proto3" json:"source_table_name,omitempty"`
+	DestinationTableName string `protobuf:"bytes,2,opt,name=destination_table_name,json=destinationTableName,proto3" json:"destination_table_name,omitempty"`
+}
+
+func (x *TableNameMapping) Reset() {
+	*x = TableNameMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flow_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableNameMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableNameMapping) ProtoMessage() {}
+
+func (x *TableNameMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableNameMapping.ProtoReflect.Descriptor instead.
+func (*TableNameMapping) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TableNameMapping) GetSourceTableName() string {
+	if x != nil {
+		return x.SourceTableName
+	}
+	return ""
+}
+
+func (x *TableNameMapping) GetDestinationTableName() string {
+	if x != nil {
+		return x.DestinationTableName
+	}
+	return ""
+}
+
+type RelationMessageColumn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags    uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DataType uint32 `protobuf:"varint,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+}
+
+func (x *RelationMessageColumn) Reset() {
+	*x = RelationMessageColumn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flow_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationMessageColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationMessageColumn) ProtoMessage() {}
+
+func (x *RelationMessageColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationMessageColumn.ProtoReflect.Descriptor instead.
+func (*RelationMessageColumn) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RelationMessageColumn) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *RelationMessageColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RelationMessageColumn) GetDataType() uint32 {
+	if x != nil {
+		return x.DataType
+	}
+	return 0
+}
+
+type RelationMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelationId   uint32                   `protobuf:"varint,1,opt,name=relation_id,json=relationId,proto3" json:"relation_id,omitempty"`
+	RelationName string                   `protobuf:"bytes,2,opt,name=relation_name,json=relationName,proto3" json:"relation_name,omitempty"`
+	Columns      []*RelationMessageColumn `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	// LSN of the last schema change of the relation that a pull ended at, so that the change is replayed
+	// before the rows after it. pulling the change again from there doesn't end the pull again.
+	FlushedSchemaChangeLsn uint64 `protobuf:"varint,4,opt,name=flushed_schema_change_lsn,json=flushedSchemaChangeLsn,proto3" json:"flushed_schema_change_lsn,omitempty"`
+}
+
+func (x *RelationMessage) Reset() {
+	*x = RelationMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flow_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationMessage) ProtoMessage() {}
+
+func (x *RelationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationMessage.ProtoReflect.Descriptor instead.
+func (*RelationMessage) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RelationMessage) GetRelationId() uint32 {
+	if x != nil {
+		return x.RelationId
+	}
+	return 0
+}
+
+func (x *RelationMessage) GetRelationName() string {
+	if x != nil {
+		return x.RelationName
+	}
+	return ""
+}
+
+func (x *RelationMessage) GetColumns() []*RelationMessageColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *RelationMessage) GetFlushedSchemaChangeLsn() uint64 {
+	if x != nil {
+		return x.FlushedSchemaChangeLsn
+	}
+	return 0
+}
+
+type TableMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceTableIdentifier      string `protobuf:"bytes,1,opt,name=source_table_identifier,json=sourceTableIdentifier,proto3" json:"source_table_identifier,omitempty"`
+	DestinationTableIdentifier string `protobuf:"bytes,2,opt,name=destination_table_identifier,json=destinationTableIdentifier,proto3" json:"destination_table_identifier,omitempty"`
+	PartitionKey               string `protobuf:"bytes,3,opt,name=partition_key,json=partitionKey,proto3" json:"partition_key,omitempty"`
+	// only capture the table's changes into the raw table, without creating or normalizing
+	// into the destination table. the table is not part of the initial copy either.
+	RawOnly bool `protobuf:"varint,4,opt,name=raw_only,json=rawOnly,proto3" json:"raw_only,omitempty"`
+	// stop replicating the table's changes while keeping it in the mirror and its publication.
+	// changes made while the table is disabled are not replicated once it is enabled again.
+	Disabled bool `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *TableMapping) Reset() {
+	*x = TableMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flow_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableMapping) ProtoMessage() {}
+
+func (x *TableMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableMapping.ProtoReflect.Descriptor instead.
+func (*TableMapping) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TableMapping) GetSourceTableIdentifier() string {
+	if x != nil {
+		return x.SourceTableIdentifier
+	}
+	return ""
+}
+
+func (x *TableMapping) GetDestinationTableIdentifier() string {
+	if x != nil {
+		return x.DestinationTableIdentifier
+	}
+	return ""
+}
+
+func (x *TableMapping) GetPartitionKey() string {
+	if x != nil {
+		return x.PartitionKey
+	}
+	return ""
+}
+
+func (x *TableMapping) GetRawOnly() bool {
+	if x != nil {
+		return x.RawOnly
+	}
+	return false
+}
+
+func (x *TableMapping) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type FlowConnectionConfigs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source                 *Peer                   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination            *Peer                   `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	FlowJobName            string                  `protobuf:"bytes,3,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
+	TableSchema            *TableSchema            `protobuf:"bytes,4,opt,name=table_schema,json=tableSchema,proto3" json:"table_schema,omitempty"`
+	TableMappings          []*TableMapping         `protobuf:"bytes,5,rep,name=table_mappings,json=tableMappings,proto3" json:"table_mappings,omitempty"`
+	SrcTableIdNameMapping  map[uint32]string       `protobuf:"bytes,6,rep,name=src_table_id_name_mapping,json=srcTableIdNameMapping,proto3" json:"src_table_id_name_mapping,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TableNameSchemaMapping map[string]*TableSchema `protobuf:"bytes,7,rep,name=table_name_schema_mapping,json=tableNameSchemaMapping,proto3" json:"table_name_schema_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// This is an optional peer that will be used to hold metadata in cases where
+	// the destination isn't ideal for holding metadata.
+	MetadataPeer                *Peer  `protobuf:"bytes,8,opt,name=metadata_peer,json=metadataPeer,proto3" json:"metadata_peer,omitempty"`
+	MaxBatchSize                uint32 `protobuf:"varint,9,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	DoInitialCopy               bool   `protobuf:"varint,10,opt,name=do_initial_copy,json=doInitialCopy,proto3" json:"do_initial_copy,omitempty"`
+	PublicationName             string `protobuf:"bytes,11,opt,name=publication_name,json=publicationName,proto3" json:"publication_name,omitempty"`
+	SnapshotNumRowsPerPartition uint32 `protobuf:"varint,12,opt,name=snapshot_num_rows_per_partition,json=snapshotNumRowsPerPartition,proto3" json:"snapshot_num_rows_per_partition,omitempty"`
+	// max parallel workers is per table
+	SnapshotMaxParallelWorkers  uint32       `protobuf:"varint,13,opt,name=snapshot_max_parallel_workers,json=snapshotMaxParallelWorkers,proto3" json:"snapshot_max_parallel_workers,omitempty"`
+	SnapshotNumTablesInParallel uint32       `protobuf:"varint,14,opt,name=snapshot_num_tables_in_parallel,json=snapshotNumTablesInParallel,proto3" json:"snapshot_num_tables_in_parallel,omitempty"`
+	SnapshotSyncMode            QRepSyncMode `protobuf:"varint,15,opt,name=snapshot_sync_mode,json=snapshotSyncMode,proto3,enum=peerdb_flow.QRepSyncMode" json:"snapshot_sync_mode,omitempty"`
+	CdcSyncMode                 QRepSyncMode `protobuf:"varint,16,opt,name=cdc_sync_mode,json=cdcSyncMode,proto3,enum=peerdb_flow.QRepSyncMode" json:"cdc_sync_mode,omitempty"`
+	SnapshotStagingPath         string       `protobuf:"bytes,17,opt,name=snapshot_staging_path,json=snapshotStagingPath,proto3" json:"snapshot_staging_path,omitempty"`
+	CdcStagingPath              string       `protobuf:"bytes,18,opt,name=cdc_staging_path,json=cdcStagingPath,proto3" json:"cdc_staging_path,omitempty"`
+	// currently only works for snowflake
+	SoftDelete          bool   `protobuf:"varint,19,opt,name=soft_delete,json=softDelete,proto3" json:"soft_delete,omitempty"`
+	ReplicationSlotName string `protobuf:"bytes,20,opt,name=replication_slot_name,json=replicationSlotName,proto3" json:"replication_slot_name,omitempty"`
+	// the below two are for eventhub only
+	PushBatchSize   int64 `protobuf:"varint,21,opt,name=push_batch_size,json=pushBatchSize,proto3" json:"push_batch_size,omitempty"`
+	PushParallelism int64 `protobuf:"varint,22,opt,name=push_parallelism,json=pushParallelism,proto3" json:"push_parallelism,omitempty"`
+	// create normalized tables as TRANSIENT, currently only works for snowflake
+	TransientNormalizedTables bool `protobuf:"varint,23,opt,name=transient_normalized_tables,json=transientNormalizedTables,proto3" json:"transient_normalized_tables,omitempty"`
+	// sync NaN and +/-Infinity floats as NULL instead of 'NaN', 'inf' and '-inf'
+	NullFloatSpecialValues bool `protobuf:"varint,24,opt,name=null_float_special_values,json=nullFloatSpecialValues,proto3" json:"null_float_special_values,omitempty"`
+	// only sync the last change to each primary key within a batch
+	CompactBatches bool `protobuf:"varint,25,opt,name=compact_batches,json=compactBatches,proto3" json:"compact_batches,omitempty"`
+	// cluster the raw table by destination table and batch id, currently only works for snowflake
+	ClusterRawTable bool `protobuf:"varint,26,opt,name=cluster_raw_table,json=clusterRawTable,proto3" json:"cluster_raw_table,omitempty"`
+	// sync empty strings as NULL, for sources that use them interchangeably.
+	// currently only works for snowflake
+	EmptyStringsAsNull bool `protobuf:"varint,27,opt,name=empty_strings_as_null,json=emptyStringsAsNull,proto3" json:"empty_strings_as_null,omitempty"`
+	// store _PEERDB_DATA in the raw table as a VARIANT instead of a JSON string,
+	// so normalize doesn't have to parse it again. currently only works for snowflake
+	RawDataAsVariant bool `protobuf:"varint,28,opt,name=raw_data_as_variant,json=rawDataAsVariant,proto3" json:"raw_data_as_variant,omitempty"`
+	// LSN to start replication from, in X/X form, instead of the slot's
+	// confirmed flush LSN. needs an existing replication slot.
+	StartLsn string `protobuf:"bytes,29,opt,name=start_lsn,json=startLsn,proto3" json:"start_lsn,omitempty"`
+	// persist the checkpoint on the destination only every N sync batches, and at least every
+	// max_checkpoint_interval_seconds (defaults to 300). a crash re-syncs the batches in between.
+	// currently only works for snowflake and postgres
+	CheckpointEveryNBatches      uint32 `protobuf:"varint,30,opt,name=checkpoint_every_n_batches,json=checkpointEveryNBatches,proto3" json:"checkpoint_every_n_batches,omitempty"`
+	MaxCheckpointIntervalSeconds uint32 `protobuf:"varint,31,opt,name=max_checkpoint_interval_seconds,json=maxCheckpointIntervalSeconds,proto3" json:"max_checkpoint_interval_seconds,omitempty"`
+	// create the normalized tables as dynamic tables that refresh from the raw table on their own,
+	// instead of merging into them. not compatible with the initial copy, and the dynamic tables
+	// keep their columns on schema changes. currently only works for snowflake
+	DynamicNormalizedTables bool `protobuf:"varint,32,opt,name=dynamic_normalized_tables,json=dynamicNormalizedTables,proto3" json:"dynamic_normalized_tables,omitempty"`
+	// how far the dynamic tables may lag behind the raw table, defaults to '5 minutes'.
+	DynamicTableTargetLag string `protobuf:"bytes,33,opt,name=dynamic_table_target_lag,json=dynamicTableTargetLag,proto3" json:"dynamic_table_target_lag,omitempty"`
+	// database on the destination to create the mirror's tables in, instead of the peer's default
+	// database. currently only works for snowflake
+	DestinationDatabase string `protobuf:"bytes,34,opt,name=destination_database,json=destinationDatabase,proto3" json:"destination_database,omitempty"`
+	// replicate postgres json columns as text, exactly as written. json columns are otherwise
+	// stored as native json like jsonb columns, which does not keep key order or whitespace.
+	JsonAsText bool `protobuf:"varint,35,opt,name=json_as_text,json=jsonAsText,proto3" json:"json_as_text,omitempty"`
+	// what to do with source tables that have REPLICA IDENTITY NOTHING, fails the setup by default.
+	ReplicaIdentityNothingPolicy ReplicaIdentityNothingPolicy `protobuf:"varint,36,opt,name=replica_identity_nothing_policy,json=replicaIdentityNothingPolicy,proto3,enum=peerdb_flow.ReplicaIdentityNothingPolicy" json:"replica_identity_nothing_policy,omitempty"`
+	// records larger than this many bytes are staged as files instead of inserted inline,
+	// even when cdc_sync_mode is multi insert. 0 means no limit. staged records commit ahead of the
+	// transaction recording the batch, and a failed sync leaves them behind to be deleted before the
+	// batch is synced again, so this cannot be used with the fail sync_batch_id_conflict_policy.
+	// currently only works for snowflake
+	MaxInlineRecordSizeBytes int64 `protobuf:"varint,37,opt,name=max_inline_record_size_bytes,json=maxInlineRecordSizeBytes,proto3" json:"max_inline_record_size_bytes,omitempty"`
+	// add a _PEERDB_SOURCE_LSN column to normalized tables holding the LSN of the latest
+	// change applied to each row, rows from the initial copy have none until they change.
+	// currently only works for snowflake
+	SourceLsnColumn bool `protobuf:"varint,38,opt,name=source_lsn_column,json=sourceLsnColumn,proto3" json:"source_lsn_column,omitempty"`
+	// what to do with primary key columns whose type does not compare exactly, warns by default.
+	RiskyPrimaryKeyPolicy RiskyPrimaryKeyPolicy `protobuf:"varint,39,opt,name=risky_primary_key_policy,json=riskyPrimaryKeyPolicy,proto3,enum=peerdb_flow.RiskyPrimaryKeyPolicy" json:"risky_primary_key_policy,omitempty"`
+	// longest a pull may take before the records pulled so far are synced, even if changes keep
+	// arriving. keeps the sync activity within its timeout, defaults to 600.
+	MaxPullDurationSeconds uint32 `protobuf:"varint,40,opt,name=max_pull_duration_seconds,json=maxPullDurationSeconds,proto3" json:"max_pull_duration_seconds,omitempty"`
+	// what to do when the metadata tables or the raw table are missing on the destination, which happens
+	// if syncs start before the setup completed. checked once per run of the mirror, fails by default.
+	IncompleteSetupPolicy IncompleteSetupPolicy `protobuf:"varint,41,opt,name=incomplete_setup_policy,json=incompleteSetupPolicy,proto3,enum=peerdb_flow.IncompleteSetupPolicy" json:"incomplete_setup_policy,omitempty"`
+	// only merge a change into a row that was last changed at an earlier LSN, so a change synced again
+	// in a later batch is not applied twice. requires source_lsn_column. with hard deletes, a late
+	// duplicate of a change to a deleted row can still bring the row back.
+	DedupBySourceLsn bool `protobuf:"varint,42,opt,name=dedup_by_source_lsn,json=dedupBySourceLsn,proto3" json:"dedup_by_source_lsn,omitempty"`
+	// start syncing in batches of this many records, doubling the batch size after each successful
+	// batch up to max_batch_size and halving it after each failed one. 0 syncs every batch at
+	// max_batch_size.
+	InitialBatchSize uint32 `protobuf:"varint,43,opt,name=initial_batch_size,json=initialBatchSize,proto3" json:"initial_batch_size,omitempty"`
+	// rewrite text primary key values in unicode NFC before syncing them, including in the initial copy,
+	// so that keys only differing in their normalization are merged into the same row.
+	NfcPrimaryKeys bool `protobuf:"varint,44,opt,name=nfc_primary_keys,json=nfcPrimaryKeys,proto3" json:"nfc_primary_keys,omitempty"`
+	// run the initial copy even if it already completed for this mirror, which copies the rows again.
+	// Without it a snapshot of an already snapshotted mirror is skipped.
+	ForceSnapshot bool `protobuf:"varint,45,opt,name=force_snapshot,json=forceSnapshot,proto3" json:"force_snapshot,omitempty"`
+	// tags set on the destination tables of the mirror, including tables that already existed, keyed by
+	// tag name. Setting a tag needs the APPLY privilege on it, so tables are only tagged if any are configured.
+	DestinationTags map[string]string `protobuf:"bytes,46,rep,name=destination_tags,json=destinationTags,proto3" json:"destination_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// re-run the merge of the last normalized batch on the next normalize, even if normalize has caught up
+	// with sync, e.g. to re-apply it after manual changes to the destination tables. Only applies to a
+	// single normalize, the flag is cleared once it ran.
+	ForceNormalize bool `protobuf:"varint,47,opt,name=force_normalize,json=forceNormalize,proto3" json:"force_normalize,omitempty"`
+	// values for NOT NULL columns of existing destination tables that are not replicated from the
+	// source, keyed by destination table identifier. Setup fails if such a column has no default.
+	// Only applies to Snowflake destinations.
+	DestinationColumnDefaults map[string]*ColumnDefaults `protobuf:"bytes,48,rep,name=destination_column_defaults,json=destinationColumnDefaults,proto3" json:"destination_column_defaults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// count transactions without changes to replicated tables in the number of transactions of a
+	// sync batch, they are excluded by default. Their records are never counted, there are none.
+	CountEmptyTransactions bool `protobuf:"varint,49,opt,name=count_empty_transactions,json=countEmptyTransactions,proto3" json:"count_empty_transactions,omitempty"`
+	// Number of times a normalize is attempted again after the connection to the destination dropped
+	// while merging, before the activity fails. Only applies to Snowflake, defaults to 3.
+	MaxMergeDisconnectRetries uint32 `protobuf:"varint,50,opt,name=max_merge_disconnect_retries,json=maxMergeDisconnectRetries,proto3" json:"max_merge_disconnect_retries,omitempty"`
+	// end a pull when a column is added to a source table, so the records before the change are
+	// synced and the change is replayed to the destination before any records written after it.
+	FlushOnSchemaChange bool `protobuf:"varint,51,opt,name=flush_on_schema_change,json=flushOnSchemaChange,proto3" json:"flush_on_schema_change,omitempty"`
+	// where records with a NULL commit timestamp rank when keeping the latest record of each primary key.
+	DedupNullsOrdering DedupNullsOrdering `protobuf:"varint,52,opt,name=dedup_nulls_ordering,json=dedupNullsOrdering,proto3,enum=peerdb_flow.DedupNullsOrdering" json:"dedup_nulls_ordering,omitempty"`
+	// number of source tables whose schema is fetched at the same time during setup, 8 if unset.
+	MaxParallelSchemaFetches uint32 `protobuf:"varint,53,opt,name=max_parallel_schema_fetches,json=maxParallelSchemaFetches,proto3" json:"max_parallel_schema_fetches,omitempty"`
+	// what setup does with destination table names longer than the destination allows.
+	IdentifierLengthPolicy IdentifierLengthPolicy `protobuf:"varint,54,opt,name=identifier_length_policy,json=identifierLengthPolicy,proto3,enum=peerdb_flow.IdentifierLengthPolicy" json:"identifier_length_policy,omitempty"`
+	// delete the raw table rows a failed sync left behind for its batch before the batch is synced again.
+	// syncs loading raw rows outside of the sync transaction, avro syncs, parallel raw inserts and records
+	// staged for their size, always do, this does it for every sync. only used by snowflake.
+	IdempotentStagedRawInserts bool `protobuf:"varint,55,opt,name=idempotent_staged_raw_inserts,json=idempotentStagedRawInserts,proto3" json:"idempotent_staged_raw_inserts,omitempty"`
+	// replicate postgres range columns in their postgres text form, like [1,10). range columns are
+	// otherwise stored as json objects with their bounds and whether each bound is inclusive.
+	RangesAsText bool `protobuf:"varint,56,opt,name=ranges_as_text,json=rangesAsText,proto3" json:"ranges_as_text,omitempty"`
+	// what a sync does when another sync of the mirror advanced the sync batch id while it was syncing,
+	// as when two workers run the mirror at once. only used by snowflake and postgres.
+	SyncBatchIdConflictPolicy SyncBatchIDConflictPolicy `protobuf:"varint,57,opt,name=sync_batch_id_conflict_policy,json=syncBatchIdConflictPolicy,proto3,enum=peerdb_flow.SyncBatchIDConflictPolicy" json:"sync_batch_id_conflict_policy,omitempty"`
+	// names of the record transformers applied to each batch before it is synced, in order. besides
+	// transformers compiled into the worker, lowercase-keys and add-ingest-ts are built in. only the
+	// records of cdc syncs are transformed, not those of the initial snapshot. lowercase-keys is only
+	// supported for event hubs destinations, as its renamed columns are not normalized.
+	RecordTransformers []string `protobuf:"bytes,58,rep,name=record_transformers,json=recordTransformers,proto3" json:"record_transformers,omitempty"`
+	// how snowflake normalizes a column whose value in the raw record is json null, as opposed to a
+	// column missing from the record. only used by snowflake.
+	VariantNullPolicy VariantNullPolicy `protobuf:"varint,59,opt,name=variant_null_policy,json=variantNullPolicy,proto3,enum=peerdb_flow.VariantNullPolicy" json:"variant_null_policy,omitempty"`
+	// most raw table rows read by each normalize merge, oldest first. the batches of a normalize are
+	// merged and committed in chunks until it caught up, a batch with more rows is merged by several
+	// merges in a transaction of its own. only used by snowflake, 0 merges all batches at once.
+	MaxRecordsPerMerge uint32 `protobuf:"varint,60,opt,name=max_records_per_merge,json=maxRecordsPerMerge,proto3" json:"max_records_per_merge,omitempty"`
+	// how snowflake splits the batches of a merge that ran into the statement timeout of the warehouse
+	// before merging them again. only used by snowflake.
+	MergeTimeoutSplitPolicy MergeTimeoutSplitPolicy `protobuf:"varint,61,opt,name=merge_timeout_split_policy,json=mergeTimeoutSplitPolicy,proto3,enum=peerdb_flow.MergeTimeoutSplitPolicy" json:"merge_timeout_split_policy,omitempty"`
+	// how snowflake normalizes raw records synced before the primary key of their source table changed,
+	// which can be missing columns of the current key. only used by snowflake.
+	PrimaryKeyVersionPolicy PrimaryKeyVersionPolicy `protobuf:"varint,62,opt,name=primary_key_version_policy,json=primaryKeyVersionPolicy,proto3,enum=peerdb_flow.PrimaryKeyVersionPolicy" json:"primary_key_version_policy,omitempty"`
+	// add a _PEERDB_LAST_OP column to normalized tables holding the operation of the latest change merged
+	// into each row, INSERT, UPDATE or DELETE. only soft deleted rows are left to show DELETE, rows from the
+	// initial copy have none until they change. currently only works for snowflake, without dynamic tables.
+	LastOpColumn bool `protobuf:"varint,63,opt,name=last_op_column,json=lastOpColumn,proto3" json:"last_op_column,omitempty"`
+	// caps the connectors held open at once by the initial copy partitions of the tables on each worker,
+	// see QRepConfig.max_source_connections. 0 means no cap.
+	SnapshotMaxSourceConnections      uint32 `protobuf:"varint,64,opt,name=snapshot_max_source_connections,json=snapshotMaxSourceConnections,proto3" json:"snapshot_max_source_connections,omitempty"`
+	SnapshotMaxDestinationConnections uint32 `protobuf:"varint,65,opt,name=snapshot_max_destination_connections,json=snapshotMaxDestinationConnections,proto3" json:"snapshot_max_destination_connections,omitempty"`
+	// what to do with raw records of an unknown record type found while normalizing, currently only
+	// checked for snowflake.
+	UnknownRecordTypePolicy UnknownRecordTypePolicy `protobuf:"varint,66,opt,name=unknown_record_type_policy,json=unknownRecordTypePolicy,proto3,enum=peerdb_flow.UnknownRecordTypePolicy" json:"unknown_record_type_policy,omitempty"`
+	// insert the chunks of a multi insert sync into the raw table over up to this many connections at once,
+	// 0 or 1 inserts them one after the other in the sync transaction. chunks inserted in parallel commit
+	// ahead of the transaction recording the batch, and a failed sync leaves them behind to be deleted
+	// before the batch is synced again. cannot be used with the fail sync_batch_id_conflict_policy, whose
+	// guard these chunks would get around. only used by snowflake.
+	MaxParallelRawInserts uint32 `protobuf:"varint,67,opt,name=max_parallel_raw_inserts,json=maxParallelRawInserts,proto3" json:"max_parallel_raw_inserts,omitempty"`
+	// when a sync or normalize fails because the destination ran out of quota, such as bigquery slots or a
+	// snowflake resource monitor, the mirror waits quota_backoff_seconds before syncing again, doubling the
+	// wait for every failure in a row up to max_quota_backoff_seconds. default to 5 minutes and an hour.
+	// other errors are retried as before.
+	QuotaBackoffSeconds    uint32 `protobuf:"varint,68,opt,name=quota_backoff_seconds,json=quotaBackoffSeconds,proto3" json:"quota_backoff_seconds,omitempty"`
+	MaxQuotaBackoffSeconds uint32 `protobuf:"varint,69,opt,name=max_quota_backoff_seconds,json=maxQuotaBackoffSeconds,proto3" json:"max_quota_backoff_seconds,omitempty"`
+	// a sql statement run on the destination before the merges of each normalize, such as suspending the
+	// refresh of a materialized view, and one run after them, even if they failed. each runs on its own,
+	// outside the transactions of the merges. a failing pre-normalize statement fails the normalize before
+	// anything is merged. a failing post-normalize statement only fails the normalize if the merges failed
+	// too, otherwise it is logged and runs again with the next normalize. currently only works for snowflake.
+	PreNormalizeSql  string `protobuf:"bytes,70,opt,name=pre_normalize_sql,json=preNormalizeSql,proto3" json:"pre_normalize_sql,omitempty"`
+	PostNormalizeSql string `protobuf:"bytes,71,opt,name=post_normalize_sql,json=postNormalizeSql,proto3" json:"post_normalize_sql,omitempty"`
+	// What to do with integer values that are out of range for their destination column, fails the sync
+	// by default. Only checked for destinations with integer types narrower than the source's, which is
+	// postgres.
+	IntRangePolicy IntRangePolicy `protobuf:"varint,72,opt,name=int_range_policy,json=intRangePolicy,proto3,enum=peerdb_flow.IntRangePolicy" json:"int_range_policy,omitempty"`
+	// columns added to a source table are only seen in the change stream once rows of the table change.
+	// when a pull has no records, compare the schema of the source tables with the schema the mirror
+	// last synced, and replay added columns to the destination right away. costs a schema fetch of the
+	// source tables on every pull without records.
+	DetectIdleSchemaChanges bool `protobuf:"varint,73,opt,name=detect_idle_schema_changes,json=detectIdleSchemaChanges,proto3" json:"detect_idle_schema_changes,omitempty"`
+	// isolation level the initial copy reads the source tables with, repeatable read by default.
+	// repeatable read reads the snapshot exported with the replication slot, so the copy ends exactly where
+	// replication starts. Postgres can't import that snapshot into a serializable transaction, so a serializable
+	// copy reads a later snapshot and changes made in between are replicated on top of the copied rows again,
+	// which is only allowed for tables with a primary key.
+	SnapshotIsolationLevel SourceIsolationLevel `protobuf:"varint,74,opt,name=snapshot_isolation_level,json=snapshotIsolationLevel,proto3,enum=peerdb_flow.SourceIsolationLevel" json:"snapshot_isolation_level,omitempty"`
+}
+
+func (x *FlowConnectionConfigs) Reset() {
+	*x = FlowConnectionConfigs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flow_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowConnectionConfigs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowConnectionConfigs) ProtoMessage() {}
+
+func (x *FlowConnectionConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowConnectionConfigs.ProtoReflect.Descriptor instead.
+func (*FlowConnectionConfigs) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FlowConnectionConfigs) GetSource() *Peer {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *FlowConnectionConfigs) GetDestination() *Peer {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *FlowConnectionConfigs) GetFlowJobName() string {
+	if x != nil {
+		return x.FlowJobName
+	}
+	return ""
+}
+
+func (x *FlowConnectionConfigs) GetTableSchema() *TableSchema {
+	if x != nil {
+		return x.TableSchema
+	}
+	return nil
+}
+
+func (x *FlowConnectionConfigs) GetTableMappings() []*TableMapping {
+	if x != nil {
+		return x.TableMappings
+	}
+	return nil
+}
+
+func (x *FlowConnectionConfigs) GetSrcTableIdNameMapping() map[uint32]string {
+	if x != nil {
+		return x.SrcTableIdNameMapping
+	}
+	return nil
+}
+
+func (x *FlowConnectionConfigs) GetTableNameSchemaMapping() map[string]*TableSchema {
+	if x != nil {
+		return x.TableNameSchemaMapping
+	}
+	return nil
+}
+
+func (x *FlowConnectionConfigs) GetMetadataPeer() *Peer {
+	if x != nil {
+		return x.MetadataPeer
+	}
+	return nil
+}
+
+func (x *FlowConnectionConfigs) GetMaxBatchSize() uint32 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetDoInitialCopy() bool {
+	if x != nil {
+		return x.DoInitialCopy
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetPublicationName() string {
+	if x != nil {
+		return x.PublicationName
+	}
+	return ""
+}
+
+func (x *FlowConnectionConfigs) GetSnapshotNumRowsPerPartition() uint32 {
+	if x != nil {
+		return x.SnapshotNumRowsPerPartition
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetSnapshotMaxParallelWorkers() uint32 {
+	if x != nil {
+		return x.SnapshotMaxParallelWorkers
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetSnapshotNumTablesInParallel() uint32 {
+	if x != nil {
+		return x.SnapshotNumTablesInParallel
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetSnapshotSyncMode() QRepSyncMode {
+	if x != nil {
+		return x.SnapshotSyncMode
+	}
+	return QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT
+}
+
+func (x *FlowConnectionConfigs) GetCdcSyncMode() QRepSyncMode {
+	if x != nil {
+		return x.CdcSyncMode
+	}
+	return QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT
+}
+
+func (x *FlowConnectionConfigs) GetSnapshotStagingPath() string {
+	if x != nil {
+		return x.SnapshotStagingPath
+	}
+	return ""
+}
+
+func (x *FlowConnectionConfigs) GetCdcStagingPath() string {
+	if x != nil {
+		return x.CdcStagingPath
+	}
+	return ""
+}
+
+func (x *FlowConnectionConfigs) GetSoftDelete() bool {
+	if x != nil {
+		return x.SoftDelete
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetReplicationSlotName() string {
+	if x != nil {
+		return x.ReplicationSlotName
+	}
+	return ""
+}
+
+func (x *FlowConnectionConfigs) GetPushBatchSize() int64 {
+	if x != nil {
+		return x.PushBatchSize
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetPushParallelism() int64 {
+	if x != nil {
+		return x.PushParallelism
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetTransientNormalizedTables() bool {
+	if x != nil {
+		return x.TransientNormalizedTables
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetNullFloatSpecialValues() bool {
+	if x != nil {
+		return x.NullFloatSpecialValues
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetCompactBatches() bool {
+	if x != nil {
+		return x.CompactBatches
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetClusterRawTable() bool {
+	if x != nil {
+		return x.ClusterRawTable
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetEmptyStringsAsNull() bool {
+	if x != nil {
+		return x.EmptyStringsAsNull
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetRawDataAsVariant() bool {
+	if x != nil {
+		return x.RawDataAsVariant
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetStartLsn() string {
+	if x != nil {
+		return x.StartLsn
+	}
+	return ""
+}
+
+func (x *FlowConnectionConfigs) GetCheckpointEveryNBatches() uint32 {
+	if x != nil {
+		return x.CheckpointEveryNBatches
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetMaxCheckpointIntervalSeconds() uint32 {
+	if x != nil {
+		return x.MaxCheckpointIntervalSeconds
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetDynamicNormalizedTables() bool {
+	if x != nil {
+		return x.DynamicNormalizedTables
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetDynamicTableTargetLag() string {
+	if x != nil {
+		return x.DynamicTableTargetLag
+	}
+	return ""
+}
+
+func (x *FlowConnectionConfigs) GetDestinationDatabase() string {
+	if x != nil {
+		return x.DestinationDatabase
+	}
+	return ""
+}
+
+func (x *FlowConnectionConfigs) GetJsonAsText() bool {
+	if x != nil {
+		return x.JsonAsText
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetReplicaIdentityNothingPolicy() ReplicaIdentityNothingPolicy {
+	if x != nil {
+		return x.ReplicaIdentityNothingPolicy
+	}
+	return ReplicaIdentityNothingPolicy_REPLICA_IDENTITY_NOTHING_POLICY_ERROR
+}
+
+func (x *FlowConnectionConfigs) GetMaxInlineRecordSizeBytes() int64 {
+	if x != nil {
+		return x.MaxInlineRecordSizeBytes
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetSourceLsnColumn() bool {
+	if x != nil {
+		return x.SourceLsnColumn
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetRiskyPrimaryKeyPolicy() RiskyPrimaryKeyPolicy {
+	if x != nil {
+		return x.RiskyPrimaryKeyPolicy
+	}
+	return RiskyPrimaryKeyPolicy_RISKY_PRIMARY_KEY_POLICY_WARN
+}
+
+func (x *FlowConnectionConfigs) GetMaxPullDurationSeconds() uint32 {
+	if x != nil {
+		return x.MaxPullDurationSeconds
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetIncompleteSetupPolicy() IncompleteSetupPolicy {
+	if x != nil {
+		return x.IncompleteSetupPolicy
+	}
+	return IncompleteSetupPolicy_INCOMPLETE_SETUP_POLICY_ERROR
+}
+
+func (x *FlowConnectionConfigs) GetDedupBySourceLsn() bool {
+	if x != nil {
+		return x.DedupBySourceLsn
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetInitialBatchSize() uint32 {
+	if x != nil {
+		return x.InitialBatchSize
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetNfcPrimaryKeys() bool {
+	if x != nil {
+		return x.NfcPrimaryKeys
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetForceSnapshot() bool {
+	if x != nil {
+		return x.ForceSnapshot
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetDestinationTags() map[string]string {
+	if x != nil {
+		return x.DestinationTags
+	}
+	return nil
+}
+
+func (x *FlowConnectionConfigs) GetForceNormalize() bool {
+	if x != nil {
+		return x.ForceNormalize
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetDestinationColumnDefaults() map[string]*ColumnDefaults {
+	if x != nil {
+		return x.DestinationColumnDefaults
+	}
+	return nil
+}
+
+func (x *FlowConnectionConfigs) GetCountEmptyTransactions() bool {
+	if x != nil {
+		return x.CountEmptyTransactions
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetMaxMergeDisconnectRetries() uint32 {
+	if x != nil {
+		return x.MaxMergeDisconnectRetries
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetFlushOnSchemaChange() bool {
+	if x != nil {
+		return x.FlushOnSchemaChange
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetDedupNullsOrdering() DedupNullsOrdering {
+	if x != nil {
+		return x.DedupNullsOrdering
+	}
+	return DedupNullsOrdering_DEDUP_NULLS_LAST
+}
+
+func (x *FlowConnectionConfigs) GetMaxParallelSchemaFetches() uint32 {
+	if x != nil {
+		return x.MaxParallelSchemaFetches
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetIdentifierLengthPolicy() IdentifierLengthPolicy {
+	if x != nil {
+		return x.IdentifierLengthPolicy
+	}
+	return IdentifierLengthPolicy_IDENTIFIER_LENGTH_POLICY_DEFAULT
+}
+
+func (x *FlowConnectionConfigs) GetIdempotentStagedRawInserts() bool {
+	if x != nil {
+		return x.IdempotentStagedRawInserts
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetRangesAsText() bool {
+	if x != nil {
+		return x.RangesAsText
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetSyncBatchIdConflictPolicy() SyncBatchIDConflictPolicy {
+	if x != nil {
+		return x.SyncBatchIdConflictPolicy
+	}
+	return SyncBatchIDConflictPolicy_SYNC_BATCH_ID_CONFLICT_POLICY_OVERWRITE
+}
+
+func (x *FlowConnectionConfigs) GetRecordTransformers() []string {
+	if x != nil {
+		return x.RecordTransformers
+	}
+	return nil
+}
+
+func (x *FlowConnectionConfigs) GetVariantNullPolicy() VariantNullPolicy {
+	if x != nil {
+		return x.VariantNullPolicy
+	}
+	return VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL
+}
+
+func (x *FlowConnectionConfigs) GetMaxRecordsPerMerge() uint32 {
+	if x != nil {
+		return x.MaxRecordsPerMerge
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetMergeTimeoutSplitPolicy() MergeTimeoutSplitPolicy {
+	if x != nil {
+		return x.MergeTimeoutSplitPolicy
+	}
+	return MergeTimeoutSplitPolicy_MERGE_TIMEOUT_SPLIT_POLICY_HALVE
+}
+
+func (x *FlowConnectionConfigs) GetPrimaryKeyVersionPolicy() PrimaryKeyVersionPolicy {
+	if x != nil {
+		return x.PrimaryKeyVersionPolicy
+	}
+	return PrimaryKeyVersionPolicy_PRIMARY_KEY_VERSION_POLICY_MERGE_ALL_RECORDS
+}
+
+func (x *FlowConnectionConfigs) GetLastOpColumn() bool {
+	if x != nil {
+		return x.LastOpColumn
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetSnapshotMaxSourceConnections() uint32 {
+	if x != nil {
+		return x.SnapshotMaxSourceConnections
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetSnapshotMaxDestinationConnections() uint32 {
+	if x != nil {
+		return x.SnapshotMaxDestinationConnections
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetUnknownRecordTypePolicy() UnknownRecordTypePolicy {
+	if x != nil {
+		return x.UnknownRecordTypePolicy
+	}
+	return UnknownRecordTypePolicy_UNKNOWN_RECORD_TYPE_POLICY_FAIL
+}
+
+func (x *FlowConnectionConfigs) GetMaxParallelRawInserts() uint32 {
+	if x != nil {
+		return x.MaxParallelRawInserts
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetQuotaBackoffSeconds() uint32 {
+	if x != nil {
+		return x.QuotaBackoffSeconds
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetMaxQuotaBackoffSeconds() uint32 {
+	if x != nil {
+		return x.MaxQuotaBackoffSeconds
+	}
+	return 0
+}
+
+func (x *FlowConnectionConfigs) GetPreNormalizeSql() string {
+	if x != nil {
+		return x.PreNormalizeSql
+	}
+	return ""
+}
+
+func (x *FlowConnectionConfigs) GetPostNormalizeSql() string {
+	if x != nil {
+		return x.PostNormalizeSql
+	}
+	return ""
+}
+
+func (x *FlowConnectionConfigs) GetIntRangePolicy() IntRangePolicy {
+	if x != nil {
+		return x.IntRangePolicy
+	}
+	return IntRangePolicy_INT_RANGE_POLICY_ERROR
+}
+
+func (x *FlowConnectionConfigs) GetDetectIdleSchemaChanges() bool {
+	if x != nil {
+		return x.DetectIdleSchemaChanges
+	}
+	return false
+}
+
+func (x *FlowConnectionConfigs) GetSnapshotIsolationLevel() SourceIsolationLevel {
+	if x != nil {
+		return x.SnapshotIsolationLevel
+	}
+	return SourceIsolationLevel_SOURCE_ISOLATION_LEVEL_REPEATABLE_READ
+}
+
+type SyncFlowOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchSize              int32                       `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	RelationMessageMapping map[uint32]*RelationMessage `protobuf:"bytes,2,rep,name=relation_message_mapping,json=relationMessageMapping,proto3" json:"relation_message_mapping,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// last checkpoint synced, which can be ahead of the one persisted on the destination.
+	LastSyncState *LastSyncState `protobuf:"bytes,3,opt,name=last_sync_state,json=lastSyncState,proto3" json:"last_sync_state,omitempty"`
+	// sync without persisting the checkpoint on the destination.
+	DeferCheckpoint bool `protobuf:"varint,4,opt,name=defer_checkpoint,json=deferCheckpoint,proto3" json:"defer_checkpoint,omitempty"`
+}
+
+func (x *SyncFlowOptions) Reset() {
+	*x = SyncFlowOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flow_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncFlowOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFlowOptions) ProtoMessage() {}
+
+func (x *SyncFlowOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFlowOptions.ProtoReflect.Descriptor instead.
+func (*SyncFlowOptions) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SyncFlowOptions) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *SyncFlowOptions) GetRelationMessageMapping() map[uint32]*RelationMessage {
+	if x != nil {
+		return x.RelationMessageMapping
+	}
+	return nil
+}
+
+func (x *SyncFlowOptions) GetLastSyncState() *LastSyncState {
+	if x != nil {
+		return x.LastSyncState
 	}
-	QRepWriteType_value = map[string]int32{
-		"QREP_WRITE_MODE_APPEND":    0,
-		"QREP_WRITE_MODE_UPSERT":    1,
-		"QREP_WRITE_MODE_OVERWRITE": 2,
+	return nil
+}
+
+func (x *SyncFlowOptions) GetDeferCheckpoint() bool {
+	if x != nil {
+		return x.DeferCheckpoint
 	}
-)
+	return false
+}
 
-func (x QRepWriteType) Enum() *QRepWriteType {
-	p := new(QRepWriteType)
-	*p = x
-	return p
+type NormalizeFlowOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchSize int32 `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 }
 
-func (x QRepWriteType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *NormalizeFlowOptions) Reset() {
+	*x = NormalizeFlowOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flow_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (QRepWriteType) Descriptor() protoreflect.EnumDescriptor {
-	return file_flow_proto_enumTypes[1].Descriptor()
+func (x *NormalizeFlowOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (QRepWriteType) Type() protoreflect.EnumType {
-	return &file_flow_proto_enumTypes[1]
+func (*NormalizeFlowOptions) ProtoMessage() {}
+
+func (x *NormalizeFlowOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x QRepWriteType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use NormalizeFlowOptions.ProtoReflect.Descriptor instead.
+func (*NormalizeFlowOptions) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{6}
 }
 
-// Deprecated: Use QRepWriteType.Descriptor instead.
-func (QRepWriteType) EnumDescriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{1}
+func (x *NormalizeFlowOptions) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
 }
 
-type TableNameMapping struct {
+type LastSyncState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SourceTableName      string `protobuf:"bytes,1,opt,name=source_table_name,json=sourceTableName,proto3" json:"source_table_name,omitempty"`
-	DestinationTableName string `protobuf:"bytes,2,opt,name=destination_table_name,json=destinationTableName,proto3" json:"destination_table_name,omitempty"`
+	Checkpoint   int64                  `protobuf:"varint,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	LastSyncedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_synced_at,json=lastSyncedAt,proto3" json:"last_synced_at,omitempty"`
 }
 
-func (x *TableNameMapping) Reset() {
-	*x = TableNameMapping{}
+func (x *LastSyncState) Reset() {
+	*x = LastSyncState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[0]
+		mi := &file_flow_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TableNameMapping) String() string {
+func (x *LastSyncState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableNameMapping) ProtoMessage() {}
+func (*LastSyncState) ProtoMessage() {}
 
-func (x *TableNameMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[0]
+func (x *LastSyncState) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,52 +2028,53 @@ func (x *TableNameMapping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableNameMapping.ProtoReflect.Descriptor instead.
-func (*TableNameMapping) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use LastSyncState.ProtoReflect.Descriptor instead.
+func (*LastSyncState) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TableNameMapping) GetSourceTableName() string {
+func (x *LastSyncState) GetCheckpoint() int64 {
 	if x != nil {
-		return x.SourceTableName
+		return x.Checkpoint
 	}
-	return ""
+	return 0
 }
 
-func (x *TableNameMapping) GetDestinationTableName() string {
+func (x *LastSyncState) GetLastSyncedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DestinationTableName
+		return x.LastSyncedAt
 	}
-	return ""
+	return nil
 }
 
-type RelationMessageColumn struct {
+type StartFlowInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flags    uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	DataType uint32 `protobuf:"varint,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	LastSyncState          *LastSyncState              `protobuf:"bytes,1,opt,name=last_sync_state,json=lastSyncState,proto3" json:"last_sync_state,omitempty"`
+	FlowConnectionConfigs  *FlowConnectionConfigs      `protobuf:"bytes,2,opt,name=flow_connection_configs,json=flowConnectionConfigs,proto3" json:"flow_connection_configs,omitempty"`
+	SyncFlowOptions        *SyncFlowOptions            `protobuf:"bytes,3,opt,name=sync_flow_options,json=syncFlowOptions,proto3" json:"sync_flow_options,omitempty"`
+	RelationMessageMapping map[uint32]*RelationMessage `protobuf:"bytes,4,rep,name=relation_message_mapping,json=relationMessageMapping,proto3" json:"relation_message_mapping,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *RelationMessageColumn) Reset() {
-	*x = RelationMessageColumn{}
+func (x *StartFlowInput) Reset() {
+	*x = StartFlowInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[1]
+		mi := &file_flow_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RelationMessageColumn) String() string {
+func (x *StartFlowInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationMessageColumn) ProtoMessage() {}
+func (*StartFlowInput) ProtoMessage() {}
 
-func (x *RelationMessageColumn) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[1]
+func (x *StartFlowInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,59 +2085,64 @@ func (x *RelationMessageColumn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationMessageColumn.ProtoReflect.Descriptor instead.
-func (*RelationMessageColumn) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use StartFlowInput.ProtoReflect.Descriptor instead.
+func (*StartFlowInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RelationMessageColumn) GetFlags() uint32 {
+func (x *StartFlowInput) GetLastSyncState() *LastSyncState {
 	if x != nil {
-		return x.Flags
+		return x.LastSyncState
 	}
-	return 0
+	return nil
 }
 
-func (x *RelationMessageColumn) GetName() string {
+func (x *StartFlowInput) GetFlowConnectionConfigs() *FlowConnectionConfigs {
 	if x != nil {
-		return x.Name
+		return x.FlowConnectionConfigs
 	}
-	return ""
+	return nil
 }
 
-func (x *RelationMessageColumn) GetDataType() uint32 {
+func (x *StartFlowInput) GetSyncFlowOptions() *SyncFlowOptions {
 	if x != nil {
-		return x.DataType
+		return x.SyncFlowOptions
 	}
-	return 0
+	return nil
 }
 
-type RelationMessage struct {
+func (x *StartFlowInput) GetRelationMessageMapping() map[uint32]*RelationMessage {
+	if x != nil {
+		return x.RelationMessageMapping
+	}
+	return nil
+}
+
+type StartNormalizeInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RelationId   uint32                   `protobuf:"varint,1,opt,name=relation_id,json=relationId,proto3" json:"relation_id,omitempty"`
-	RelationName string                   `protobuf:"bytes,2,opt,name=relation_name,json=relationName,proto3" json:"relation_name,omitempty"`
-	Columns      []*RelationMessageColumn `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	FlowConnectionConfigs *FlowConnectionConfigs `protobuf:"bytes,1,opt,name=flow_connection_configs,json=flowConnectionConfigs,proto3" json:"flow_connection_configs,omitempty"`
 }
 
-func (x *RelationMessage) Reset() {
-	*x = RelationMessage{}
+func (x *StartNormalizeInput) Reset() {
+	*x = StartNormalizeInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[2]
+		mi := &file_flow_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RelationMessage) String() string {
+func (x *StartNormalizeInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationMessage) ProtoMessage() {}
+func (*StartNormalizeInput) ProtoMessage() {}
 
-func (x *RelationMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[2]
+func (x *StartNormalizeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,59 +2153,100 @@ func (x *RelationMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationMessage.ProtoReflect.Descriptor instead.
-func (*RelationMessage) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use StartNormalizeInput.ProtoReflect.Descriptor instead.
+func (*StartNormalizeInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RelationMessage) GetRelationId() uint32 {
+func (x *StartNormalizeInput) GetFlowConnectionConfigs() *FlowConnectionConfigs {
 	if x != nil {
-		return x.RelationId
+		return x.FlowConnectionConfigs
 	}
-	return 0
+	return nil
 }
 
-func (x *RelationMessage) GetRelationName() string {
-	if x != nil {
-		return x.RelationName
+type GetLastSyncedIDInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerConnectionConfig *Peer  `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
+	FlowJobName          string `protobuf:"bytes,2,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
+}
+
+func (x *GetLastSyncedIDInput) Reset() {
+	*x = GetLastSyncedIDInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flow_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *RelationMessage) GetColumns() []*RelationMessageColumn {
+func (x *GetLastSyncedIDInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastSyncedIDInput) ProtoMessage() {}
+
+func (x *GetLastSyncedIDInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastSyncedIDInput.ProtoReflect.Descriptor instead.
+func (*GetLastSyncedIDInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetLastSyncedIDInput) GetPeerConnectionConfig() *Peer {
 	if x != nil {
-		return x.Columns
+		return x.PeerConnectionConfig
 	}
 	return nil
 }
 
-type TableMapping struct {
+func (x *GetLastSyncedIDInput) GetFlowJobName() string {
+	if x != nil {
+		return x.FlowJobName
+	}
+	return ""
+}
+
+type EnsurePullabilityInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SourceTableIdentifier      string `protobuf:"bytes,1,opt,name=source_table_identifier,json=sourceTableIdentifier,proto3" json:"source_table_identifier,omitempty"`
-	DestinationTableIdentifier string `protobuf:"bytes,2,opt,name=destination_table_identifier,json=destinationTableIdentifier,proto3" json:"destination_table_identifier,omitempty"`
-	PartitionKey               string `protobuf:"bytes,3,opt,name=partition_key,json=partitionKey,proto3" json:"partition_key,omitempty"`
+	PeerConnectionConfig  *Peer  `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
+	FlowJobName           string `protobuf:"bytes,2,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
+	SourceTableIdentifier string `protobuf:"bytes,3,opt,name=source_table_identifier,json=sourceTableIdentifier,proto3" json:"source_table_identifier,omitempty"`
 }
 
-func (x *TableMapping) Reset() {
-	*x = TableMapping{}
+func (x *EnsurePullabilityInput) Reset() {
+	*x = EnsurePullabilityInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[3]
+		mi := &file_flow_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TableMapping) String() string {
+func (x *EnsurePullabilityInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableMapping) ProtoMessage() {}
+func (*EnsurePullabilityInput) ProtoMessage() {}
 
-func (x *TableMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[3]
+func (x *EnsurePullabilityInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,83 +2257,60 @@ func (x *TableMapping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableMapping.ProtoReflect.Descriptor instead.
-func (*TableMapping) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EnsurePullabilityInput.ProtoReflect.Descriptor instead.
+func (*EnsurePullabilityInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *TableMapping) GetSourceTableIdentifier() string {
+func (x *EnsurePullabilityInput) GetPeerConnectionConfig() *Peer {
 	if x != nil {
-		return x.SourceTableIdentifier
+		return x.PeerConnectionConfig
 	}
-	return ""
+	return nil
 }
 
-func (x *TableMapping) GetDestinationTableIdentifier() string {
+func (x *EnsurePullabilityInput) GetFlowJobName() string {
 	if x != nil {
-		return x.DestinationTableIdentifier
+		return x.FlowJobName
 	}
 	return ""
 }
 
-func (x *TableMapping) GetPartitionKey() string {
+func (x *EnsurePullabilityInput) GetSourceTableIdentifier() string {
 	if x != nil {
-		return x.PartitionKey
+		return x.SourceTableIdentifier
 	}
 	return ""
 }
 
-type FlowConnectionConfigs struct {
+type EnsurePullabilityBatchInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Source                 *Peer                   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Destination            *Peer                   `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
-	FlowJobName            string                  `protobuf:"bytes,3,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
-	TableSchema            *TableSchema            `protobuf:"bytes,4,opt,name=table_schema,json=tableSchema,proto3" json:"table_schema,omitempty"`
-	TableMappings          []*TableMapping         `protobuf:"bytes,5,rep,name=table_mappings,json=tableMappings,proto3" json:"table_mappings,omitempty"`
-	SrcTableIdNameMapping  map[uint32]string       `protobuf:"bytes,6,rep,name=src_table_id_name_mapping,json=srcTableIdNameMapping,proto3" json:"src_table_id_name_mapping,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	TableNameSchemaMapping map[string]*TableSchema `protobuf:"bytes,7,rep,name=table_name_schema_mapping,json=tableNameSchemaMapping,proto3" json:"table_name_schema_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// This is an optional peer that will be used to hold metadata in cases where
-	// the destination isn't ideal for holding metadata.
-	MetadataPeer                *Peer  `protobuf:"bytes,8,opt,name=metadata_peer,json=metadataPeer,proto3" json:"metadata_peer,omitempty"`
-	MaxBatchSize                uint32 `protobuf:"varint,9,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
-	DoInitialCopy               bool   `protobuf:"varint,10,opt,name=do_initial_copy,json=doInitialCopy,proto3" json:"do_initial_copy,omitempty"`
-	PublicationName             string `protobuf:"bytes,11,opt,name=publication_name,json=publicationName,proto3" json:"publication_name,omitempty"`
-	SnapshotNumRowsPerPartition uint32 `protobuf:"varint,12,opt,name=snapshot_num_rows_per_partition,json=snapshotNumRowsPerPartition,proto3" json:"snapshot_num_rows_per_partition,omitempty"`
-	// max parallel workers is per table
-	SnapshotMaxParallelWorkers  uint32       `protobuf:"varint,13,opt,name=snapshot_max_parallel_workers,json=snapshotMaxParallelWorkers,proto3" json:"snapshot_max_parallel_workers,omitempty"`
-	SnapshotNumTablesInParallel uint32       `protobuf:"varint,14,opt,name=snapshot_num_tables_in_parallel,json=snapshotNumTablesInParallel,proto3" json:"snapshot_num_tables_in_parallel,omitempty"`
-	SnapshotSyncMode            QRepSyncMode `protobuf:"varint,15,opt,name=snapshot_sync_mode,json=snapshotSyncMode,proto3,enum=peerdb_flow.QRepSyncMode" json:"snapshot_sync_mode,omitempty"`
-	CdcSyncMode                 QRepSyncMode `protobuf:"varint,16,opt,name=cdc_sync_mode,json=cdcSyncMode,proto3,enum=peerdb_flow.QRepSyncMode" json:"cdc_sync_mode,omitempty"`
-	SnapshotStagingPath         string       `protobuf:"bytes,17,opt,name=snapshot_staging_path,json=snapshotStagingPath,proto3" json:"snapshot_staging_path,omitempty"`
-	CdcStagingPath              string       `protobuf:"bytes,18,opt,name=cdc_staging_path,json=cdcStagingPath,proto3" json:"cdc_staging_path,omitempty"`
-	// currently only works for snowflake
-	SoftDelete          bool   `protobuf:"varint,19,opt,name=soft_delete,json=softDelete,proto3" json:"soft_delete,omitempty"`
-	ReplicationSlotName string `protobuf:"bytes,20,opt,name=replication_slot_name,json=replicationSlotName,proto3" json:"replication_slot_name,omitempty"`
-	// the below two are for eventhub only
-	PushBatchSize   int64 `protobuf:"varint,21,opt,name=push_batch_size,json=pushBatchSize,proto3" json:"push_batch_size,omitempty"`
-	PushParallelism int64 `protobuf:"varint,22,opt,name=push_parallelism,json=pushParallelism,proto3" json:"push_parallelism,omitempty"`
+	PeerConnectionConfig         *Peer                        `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
+	FlowJobName                  string                       `protobuf:"bytes,2,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
+	SourceTableIdentifiers       []string                     `protobuf:"bytes,3,rep,name=source_table_identifiers,json=sourceTableIdentifiers,proto3" json:"source_table_identifiers,omitempty"`
+	ReplicaIdentityNothingPolicy ReplicaIdentityNothingPolicy `protobuf:"varint,4,opt,name=replica_identity_nothing_policy,json=replicaIdentityNothingPolicy,proto3,enum=peerdb_flow.ReplicaIdentityNothingPolicy" json:"replica_identity_nothing_policy,omitempty"`
 }
 
-func (x *FlowConnectionConfigs) Reset() {
-	*x = FlowConnectionConfigs{}
+func (x *EnsurePullabilityBatchInput) Reset() {
+	*x = EnsurePullabilityBatchInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[4]
+		mi := &file_flow_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FlowConnectionConfigs) String() string {
+func (x *EnsurePullabilityBatchInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FlowConnectionConfigs) ProtoMessage() {}
+func (*EnsurePullabilityBatchInput) ProtoMessage() {}
 
-func (x *FlowConnectionConfigs) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[4]
+func (x *EnsurePullabilityBatchInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,191 +2321,225 @@ func (x *FlowConnectionConfigs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FlowConnectionConfigs.ProtoReflect.Descriptor instead.
-func (*FlowConnectionConfigs) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *FlowConnectionConfigs) GetSource() *Peer {
-	if x != nil {
-		return x.Source
-	}
-	return nil
+// Deprecated: Use EnsurePullabilityBatchInput.ProtoReflect.Descriptor instead.
+func (*EnsurePullabilityBatchInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *FlowConnectionConfigs) GetDestination() *Peer {
+func (x *EnsurePullabilityBatchInput) GetPeerConnectionConfig() *Peer {
 	if x != nil {
-		return x.Destination
+		return x.PeerConnectionConfig
 	}
 	return nil
 }
 
-func (x *FlowConnectionConfigs) GetFlowJobName() string {
+func (x *EnsurePullabilityBatchInput) GetFlowJobName() string {
 	if x != nil {
 		return x.FlowJobName
 	}
 	return ""
 }
 
-func (x *FlowConnectionConfigs) GetTableSchema() *TableSchema {
+func (x *EnsurePullabilityBatchInput) GetSourceTableIdentifiers() []string {
 	if x != nil {
-		return x.TableSchema
+		return x.SourceTableIdentifiers
 	}
 	return nil
 }
 
-func (x *FlowConnectionConfigs) GetTableMappings() []*TableMapping {
+func (x *EnsurePullabilityBatchInput) GetReplicaIdentityNothingPolicy() ReplicaIdentityNothingPolicy {
 	if x != nil {
-		return x.TableMappings
+		return x.ReplicaIdentityNothingPolicy
 	}
-	return nil
+	return ReplicaIdentityNothingPolicy_REPLICA_IDENTITY_NOTHING_POLICY_ERROR
 }
 
-func (x *FlowConnectionConfigs) GetSrcTableIdNameMapping() map[uint32]string {
-	if x != nil {
-		return x.SrcTableIdNameMapping
-	}
-	return nil
+type PostgresTableIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelId uint32 `protobuf:"varint,1,opt,name=rel_id,json=relId,proto3" json:"rel_id,omitempty"`
 }
 
-func (x *FlowConnectionConfigs) GetTableNameSchemaMapping() map[string]*TableSchema {
-	if x != nil {
-		return x.TableNameSchemaMapping
+func (x *PostgresTableIdentifier) Reset() {
+	*x = PostgresTableIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flow_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *FlowConnectionConfigs) GetMetadataPeer() *Peer {
-	if x != nil {
-		return x.MetadataPeer
+func (x *PostgresTableIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostgresTableIdentifier) ProtoMessage() {}
+
+func (x *PostgresTableIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FlowConnectionConfigs) GetMaxBatchSize() uint32 {
+// Deprecated: Use PostgresTableIdentifier.ProtoReflect.Descriptor instead.
+func (*PostgresTableIdentifier) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PostgresTableIdentifier) GetRelId() uint32 {
 	if x != nil {
-		return x.MaxBatchSize
+		return x.RelId
 	}
 	return 0
 }
 
-func (x *FlowConnectionConfigs) GetDoInitialCopy() bool {
-	if x != nil {
-		return x.DoInitialCopy
-	}
-	return false
+type TableIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to TableIdentifier:
+	//
+	//	*TableIdentifier_PostgresTableIdentifier
+	TableIdentifier isTableIdentifier_TableIdentifier `protobuf_oneof:"table_identifier"`
 }
 
-func (x *FlowConnectionConfigs) GetPublicationName() string {
-	if x != nil {
-		return x.PublicationName
+func (x *TableIdentifier) Reset() {
+	*x = TableIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flow_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *FlowConnectionConfigs) GetSnapshotNumRowsPerPartition() uint32 {
-	if x != nil {
-		return x.SnapshotNumRowsPerPartition
-	}
-	return 0
+func (x *TableIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FlowConnectionConfigs) GetSnapshotMaxParallelWorkers() uint32 {
-	if x != nil {
-		return x.SnapshotMaxParallelWorkers
+func (*TableIdentifier) ProtoMessage() {}
+
+func (x *TableIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *FlowConnectionConfigs) GetSnapshotNumTablesInParallel() uint32 {
-	if x != nil {
-		return x.SnapshotNumTablesInParallel
-	}
-	return 0
+// Deprecated: Use TableIdentifier.ProtoReflect.Descriptor instead.
+func (*TableIdentifier) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *FlowConnectionConfigs) GetSnapshotSyncMode() QRepSyncMode {
-	if x != nil {
-		return x.SnapshotSyncMode
+func (m *TableIdentifier) GetTableIdentifier() isTableIdentifier_TableIdentifier {
+	if m != nil {
+		return m.TableIdentifier
 	}
-	return QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT
+	return nil
 }
 
-func (x *FlowConnectionConfigs) GetCdcSyncMode() QRepSyncMode {
-	if x != nil {
-		return x.CdcSyncMode
+func (x *TableIdentifier) GetPostgresTableIdentifier() *PostgresTableIdentifier {
+	if x, ok := x.GetTableIdentifier().(*TableIdentifier_PostgresTableIdentifier); ok {
+		return x.PostgresTableIdentifier
 	}
-	return QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT
+	return nil
 }
 
-func (x *FlowConnectionConfigs) GetSnapshotStagingPath() string {
-	if x != nil {
-		return x.SnapshotStagingPath
-	}
-	return ""
+type isTableIdentifier_TableIdentifier interface {
+	isTableIdentifier_TableIdentifier()
 }
 
-func (x *FlowConnectionConfigs) GetCdcStagingPath() string {
-	if x != nil {
-		return x.CdcStagingPath
-	}
-	return ""
+type TableIdentifier_PostgresTableIdentifier struct {
+	PostgresTableIdentifier *PostgresTableIdentifier `protobuf:"bytes,1,opt,name=postgres_table_identifier,json=postgresTableIdentifier,proto3,oneof"`
 }
 
-func (x *FlowConnectionConfigs) GetSoftDelete() bool {
-	if x != nil {
-		return x.SoftDelete
+func (*TableIdentifier_PostgresTableIdentifier) isTableIdentifier_TableIdentifier() {}
+
+type EnsurePullabilityOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableIdentifier *TableIdentifier `protobuf:"bytes,1,opt,name=table_identifier,json=tableIdentifier,proto3" json:"table_identifier,omitempty"`
+}
+
+func (x *EnsurePullabilityOutput) Reset() {
+	*x = EnsurePullabilityOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flow_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *FlowConnectionConfigs) GetReplicationSlotName() string {
-	if x != nil {
-		return x.ReplicationSlotName
+func (x *EnsurePullabilityOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsurePullabilityOutput) ProtoMessage() {}
+
+func (x *EnsurePullabilityOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FlowConnectionConfigs) GetPushBatchSize() int64 {
-	if x != nil {
-		return x.PushBatchSize
-	}
-	return 0
+// Deprecated: Use EnsurePullabilityOutput.ProtoReflect.Descriptor instead.
+func (*EnsurePullabilityOutput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *FlowConnectionConfigs) GetPushParallelism() int64 {
+func (x *EnsurePullabilityOutput) GetTableIdentifier() *TableIdentifier {
 	if x != nil {
-		return x.PushParallelism
+		return x.TableIdentifier
 	}
-	return 0
+	return nil
 }
 
-type SyncFlowOptions struct {
+type EnsurePullabilityBatchOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BatchSize              int32                       `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	RelationMessageMapping map[uint32]*RelationMessage `protobuf:"bytes,2,rep,name=relation_message_mapping,json=relationMessageMapping,proto3" json:"relation_message_mapping,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TableIdentifierMapping map[string]*TableIdentifier `protobuf:"bytes,1,rep,name=table_identifier_mapping,json=tableIdentifierMapping,proto3" json:"table_identifier_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SyncFlowOptions) Reset() {
-	*x = SyncFlowOptions{}
+func (x *EnsurePullabilityBatchOutput) Reset() {
+	*x = EnsurePullabilityBatchOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[5]
+		mi := &file_flow_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncFlowOptions) String() string {
+func (x *EnsurePullabilityBatchOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncFlowOptions) ProtoMessage() {}
+func (*EnsurePullabilityBatchOutput) ProtoMessage() {}
 
-func (x *SyncFlowOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[5]
+func (x *EnsurePullabilityBatchOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,50 +2550,51 @@ func (x *SyncFlowOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncFlowOptions.ProtoReflect.Descriptor instead.
-func (*SyncFlowOptions) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *SyncFlowOptions) GetBatchSize() int32 {
-	if x != nil {
-		return x.BatchSize
-	}
-	return 0
+// Deprecated: Use EnsurePullabilityBatchOutput.ProtoReflect.Descriptor instead.
+func (*EnsurePullabilityBatchOutput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SyncFlowOptions) GetRelationMessageMapping() map[uint32]*RelationMessage {
+func (x *EnsurePullabilityBatchOutput) GetTableIdentifierMapping() map[string]*TableIdentifier {
 	if x != nil {
-		return x.RelationMessageMapping
+		return x.TableIdentifierMapping
 	}
 	return nil
 }
 
-type NormalizeFlowOptions struct {
+type SetupReplicationInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BatchSize int32 `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	PeerConnectionConfig *Peer             `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
+	FlowJobName          string            `protobuf:"bytes,2,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
+	TableNameMapping     map[string]string `protobuf:"bytes,3,rep,name=table_name_mapping,json=tableNameMapping,proto3" json:"table_name_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// replicate to destination using ctid
+	DestinationPeer             *Peer  `protobuf:"bytes,4,opt,name=destination_peer,json=destinationPeer,proto3" json:"destination_peer,omitempty"`
+	DoInitialCopy               bool   `protobuf:"varint,5,opt,name=do_initial_copy,json=doInitialCopy,proto3" json:"do_initial_copy,omitempty"`
+	ExistingPublicationName     string `protobuf:"bytes,6,opt,name=existing_publication_name,json=existingPublicationName,proto3" json:"existing_publication_name,omitempty"`
+	ExistingReplicationSlotName string `protobuf:"bytes,7,opt,name=existing_replication_slot_name,json=existingReplicationSlotName,proto3" json:"existing_replication_slot_name,omitempty"`
+	StartLsn                    string `protobuf:"bytes,8,opt,name=start_lsn,json=startLsn,proto3" json:"start_lsn,omitempty"`
 }
 
-func (x *NormalizeFlowOptions) Reset() {
-	*x = NormalizeFlowOptions{}
+func (x *SetupReplicationInput) Reset() {
+	*x = SetupReplicationInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[6]
+		mi := &file_flow_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NormalizeFlowOptions) String() string {
+func (x *SetupReplicationInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NormalizeFlowOptions) ProtoMessage() {}
+func (*SetupReplicationInput) ProtoMessage() {}
 
-func (x *NormalizeFlowOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[6]
+func (x *SetupReplicationInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,44 +2605,93 @@ func (x *NormalizeFlowOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NormalizeFlowOptions.ProtoReflect.Descriptor instead.
-func (*NormalizeFlowOptions) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SetupReplicationInput.ProtoReflect.Descriptor instead.
+func (*SetupReplicationInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *NormalizeFlowOptions) GetBatchSize() int32 {
+func (x *SetupReplicationInput) GetPeerConnectionConfig() *Peer {
 	if x != nil {
-		return x.BatchSize
+		return x.PeerConnectionConfig
 	}
-	return 0
+	return nil
 }
 
-type LastSyncState struct {
+func (x *SetupReplicationInput) GetFlowJobName() string {
+	if x != nil {
+		return x.FlowJobName
+	}
+	return ""
+}
+
+func (x *SetupReplicationInput) GetTableNameMapping() map[string]string {
+	if x != nil {
+		return x.TableNameMapping
+	}
+	return nil
+}
+
+func (x *SetupReplicationInput) GetDestinationPeer() *Peer {
+	if x != nil {
+		return x.DestinationPeer
+	}
+	return nil
+}
+
+func (x *SetupReplicationInput) GetDoInitialCopy() bool {
+	if x != nil {
+		return x.DoInitialCopy
+	}
+	return false
+}
+
+func (x *SetupReplicationInput) GetExistingPublicationName() string {
+	if x != nil {
+		return x.ExistingPublicationName
+	}
+	return ""
+}
+
+func (x *SetupReplicationInput) GetExistingReplicationSlotName() string {
+	if x != nil {
+		return x.ExistingReplicationSlotName
+	}
+	return ""
+}
+
+func (x *SetupReplicationInput) GetStartLsn() string {
+	if x != nil {
+		return x.StartLsn
+	}
+	return ""
+}
+
+type SetupReplicationOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Checkpoint   int64                  `protobuf:"varint,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
-	LastSyncedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_synced_at,json=lastSyncedAt,proto3" json:"last_synced_at,omitempty"`
+	SlotName     string `protobuf:"bytes,1,opt,name=slot_name,json=slotName,proto3" json:"slot_name,omitempty"`
+	SnapshotName string `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
 }
 
-func (x *LastSyncState) Reset() {
-	*x = LastSyncState{}
+func (x *SetupReplicationOutput) Reset() {
+	*x = SetupReplicationOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[7]
+		mi := &file_flow_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LastSyncState) String() string {
+func (x *SetupReplicationOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LastSyncState) ProtoMessage() {}
+func (*SetupReplicationOutput) ProtoMessage() {}
 
-func (x *LastSyncState) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[7]
+func (x *SetupReplicationOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,53 +2702,56 @@ func (x *LastSyncState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LastSyncState.ProtoReflect.Descriptor instead.
-func (*LastSyncState) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SetupReplicationOutput.ProtoReflect.Descriptor instead.
+func (*SetupReplicationOutput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *LastSyncState) GetCheckpoint() int64 {
+func (x *SetupReplicationOutput) GetSlotName() string {
 	if x != nil {
-		return x.Checkpoint
+		return x.SlotName
 	}
-	return 0
+	return ""
 }
 
-func (x *LastSyncState) GetLastSyncedAt() *timestamppb.Timestamp {
+func (x *SetupReplicationOutput) GetSnapshotName() string {
 	if x != nil {
-		return x.LastSyncedAt
+		return x.SnapshotName
 	}
-	return nil
+	return ""
 }
 
-type StartFlowInput struct {
+type CreateRawTableInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LastSyncState          *LastSyncState              `protobuf:"bytes,1,opt,name=last_sync_state,json=lastSyncState,proto3" json:"last_sync_state,omitempty"`
-	FlowConnectionConfigs  *FlowConnectionConfigs      `protobuf:"bytes,2,opt,name=flow_connection_configs,json=flowConnectionConfigs,proto3" json:"flow_connection_configs,omitempty"`
-	SyncFlowOptions        *SyncFlowOptions            `protobuf:"bytes,3,opt,name=sync_flow_options,json=syncFlowOptions,proto3" json:"sync_flow_options,omitempty"`
-	RelationMessageMapping map[uint32]*RelationMessage `protobuf:"bytes,4,rep,name=relation_message_mapping,json=relationMessageMapping,proto3" json:"relation_message_mapping,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PeerConnectionConfig *Peer             `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
+	FlowJobName          string            `protobuf:"bytes,2,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
+	TableNameMapping     map[string]string `protobuf:"bytes,3,rep,name=table_name_mapping,json=tableNameMapping,proto3" json:"table_name_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CdcSyncMode          QRepSyncMode      `protobuf:"varint,4,opt,name=cdc_sync_mode,json=cdcSyncMode,proto3,enum=peerdb_flow.QRepSyncMode" json:"cdc_sync_mode,omitempty"`
+	ClusterRawTable      bool              `protobuf:"varint,5,opt,name=cluster_raw_table,json=clusterRawTable,proto3" json:"cluster_raw_table,omitempty"`
+	RawDataAsVariant     bool              `protobuf:"varint,6,opt,name=raw_data_as_variant,json=rawDataAsVariant,proto3" json:"raw_data_as_variant,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *StartFlowInput) Reset() {
-	*x = StartFlowInput{}
+func (x *CreateRawTableInput) Reset() {
+	*x = CreateRawTableInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[8]
+		mi := &file_flow_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartFlowInput) String() string {
+func (x *CreateRawTableInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartFlowInput) ProtoMessage() {}
+func (*CreateRawTableInput) ProtoMessage() {}
 
-func (x *StartFlowInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[8]
+func (x *CreateRawTableInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,112 +2762,85 @@ func (x *StartFlowInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartFlowInput.ProtoReflect.Descriptor instead.
-func (*StartFlowInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *StartFlowInput) GetLastSyncState() *LastSyncState {
-	if x != nil {
-		return x.LastSyncState
-	}
-	return nil
+// Deprecated: Use CreateRawTableInput.ProtoReflect.Descriptor instead.
+func (*CreateRawTableInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *StartFlowInput) GetFlowConnectionConfigs() *FlowConnectionConfigs {
+func (x *CreateRawTableInput) GetPeerConnectionConfig() *Peer {
 	if x != nil {
-		return x.FlowConnectionConfigs
+		return x.PeerConnectionConfig
 	}
 	return nil
 }
 
-func (x *StartFlowInput) GetSyncFlowOptions() *SyncFlowOptions {
+func (x *CreateRawTableInput) GetFlowJobName() string {
 	if x != nil {
-		return x.SyncFlowOptions
+		return x.FlowJobName
 	}
-	return nil
+	return ""
 }
 
-func (x *StartFlowInput) GetRelationMessageMapping() map[uint32]*RelationMessage {
+func (x *CreateRawTableInput) GetTableNameMapping() map[string]string {
 	if x != nil {
-		return x.RelationMessageMapping
+		return x.TableNameMapping
 	}
 	return nil
 }
 
-type StartNormalizeInput struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	FlowConnectionConfigs *FlowConnectionConfigs `protobuf:"bytes,1,opt,name=flow_connection_configs,json=flowConnectionConfigs,proto3" json:"flow_connection_configs,omitempty"`
-}
-
-func (x *StartNormalizeInput) Reset() {
-	*x = StartNormalizeInput{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *StartNormalizeInput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateRawTableInput) GetCdcSyncMode() QRepSyncMode {
+	if x != nil {
+		return x.CdcSyncMode
+	}
+	return QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT
 }
 
-func (*StartNormalizeInput) ProtoMessage() {}
-
-func (x *StartNormalizeInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateRawTableInput) GetClusterRawTable() bool {
+	if x != nil {
+		return x.ClusterRawTable
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use StartNormalizeInput.ProtoReflect.Descriptor instead.
-func (*StartNormalizeInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{9}
+func (x *CreateRawTableInput) GetRawDataAsVariant() bool {
+	if x != nil {
+		return x.RawDataAsVariant
+	}
+	return false
 }
 
-func (x *StartNormalizeInput) GetFlowConnectionConfigs() *FlowConnectionConfigs {
+func (x *CreateRawTableInput) GetTags() map[string]string {
 	if x != nil {
-		return x.FlowConnectionConfigs
+		return x.Tags
 	}
 	return nil
 }
 
-type GetLastSyncedIDInput struct {
+type CreateRawTableOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerConnectionConfig *Peer  `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
-	FlowJobName          string `protobuf:"bytes,2,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
+	TableIdentifier string `protobuf:"bytes,1,opt,name=table_identifier,json=tableIdentifier,proto3" json:"table_identifier,omitempty"`
 }
 
-func (x *GetLastSyncedIDInput) Reset() {
-	*x = GetLastSyncedIDInput{}
+func (x *CreateRawTableOutput) Reset() {
+	*x = CreateRawTableOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[10]
+		mi := &file_flow_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLastSyncedIDInput) String() string {
+func (x *CreateRawTableOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastSyncedIDInput) ProtoMessage() {}
+func (*CreateRawTableOutput) ProtoMessage() {}
 
-func (x *GetLastSyncedIDInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[10]
+func (x *CreateRawTableOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -893,52 +2851,47 @@ func (x *GetLastSyncedIDInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastSyncedIDInput.ProtoReflect.Descriptor instead.
-func (*GetLastSyncedIDInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *GetLastSyncedIDInput) GetPeerConnectionConfig() *Peer {
-	if x != nil {
-		return x.PeerConnectionConfig
-	}
-	return nil
+// Deprecated: Use CreateRawTableOutput.ProtoReflect.Descriptor instead.
+func (*CreateRawTableOutput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetLastSyncedIDInput) GetFlowJobName() string {
+func (x *CreateRawTableOutput) GetTableIdentifier() string {
 	if x != nil {
-		return x.FlowJobName
+		return x.TableIdentifier
 	}
 	return ""
 }
 
-type EnsurePullabilityInput struct {
+type DropStaleRawTablesInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerConnectionConfig  *Peer  `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
-	FlowJobName           string `protobuf:"bytes,2,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
-	SourceTableIdentifier string `protobuf:"bytes,3,opt,name=source_table_identifier,json=sourceTableIdentifier,proto3" json:"source_table_identifier,omitempty"`
+	PeerConnectionConfig *Peer `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
+	// raw tables changed within this many hours are never considered stale, defaults to 168 (a week).
+	GracePeriodHours uint32 `protobuf:"varint,2,opt,name=grace_period_hours,json=gracePeriodHours,proto3" json:"grace_period_hours,omitempty"`
+	// only report stale raw tables, without dropping them.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *EnsurePullabilityInput) Reset() {
-	*x = EnsurePullabilityInput{}
+func (x *DropStaleRawTablesInput) Reset() {
+	*x = DropStaleRawTablesInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[11]
+		mi := &file_flow_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnsurePullabilityInput) String() string {
+func (x *DropStaleRawTablesInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnsurePullabilityInput) ProtoMessage() {}
+func (*DropStaleRawTablesInput) ProtoMessage() {}
 
-func (x *EnsurePullabilityInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[11]
+func (x *DropStaleRawTablesInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,59 +2902,57 @@ func (x *EnsurePullabilityInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnsurePullabilityInput.ProtoReflect.Descriptor instead.
-func (*EnsurePullabilityInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DropStaleRawTablesInput.ProtoReflect.Descriptor instead.
+func (*DropStaleRawTablesInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *EnsurePullabilityInput) GetPeerConnectionConfig() *Peer {
+func (x *DropStaleRawTablesInput) GetPeerConnectionConfig() *Peer {
 	if x != nil {
 		return x.PeerConnectionConfig
 	}
 	return nil
 }
 
-func (x *EnsurePullabilityInput) GetFlowJobName() string {
+func (x *DropStaleRawTablesInput) GetGracePeriodHours() uint32 {
 	if x != nil {
-		return x.FlowJobName
+		return x.GracePeriodHours
 	}
-	return ""
+	return 0
 }
 
-func (x *EnsurePullabilityInput) GetSourceTableIdentifier() string {
+func (x *DropStaleRawTablesInput) GetDryRun() bool {
 	if x != nil {
-		return x.SourceTableIdentifier
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-type EnsurePullabilityBatchInput struct {
+type DropStaleRawTablesOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerConnectionConfig   *Peer    `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
-	FlowJobName            string   `protobuf:"bytes,2,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
-	SourceTableIdentifiers []string `protobuf:"bytes,3,rep,name=source_table_identifiers,json=sourceTableIdentifiers,proto3" json:"source_table_identifiers,omitempty"`
+	StaleRawTables []string `protobuf:"bytes,1,rep,name=stale_raw_tables,json=staleRawTables,proto3" json:"stale_raw_tables,omitempty"`
 }
 
-func (x *EnsurePullabilityBatchInput) Reset() {
-	*x = EnsurePullabilityBatchInput{}
+func (x *DropStaleRawTablesOutput) Reset() {
+	*x = DropStaleRawTablesOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[12]
+		mi := &file_flow_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnsurePullabilityBatchInput) String() string {
+func (x *DropStaleRawTablesOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnsurePullabilityBatchInput) ProtoMessage() {}
+func (*DropStaleRawTablesOutput) ProtoMessage() {}
 
-func (x *EnsurePullabilityBatchInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[12]
+func (x *DropStaleRawTablesOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,57 +2963,44 @@ func (x *EnsurePullabilityBatchInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnsurePullabilityBatchInput.ProtoReflect.Descriptor instead.
-func (*EnsurePullabilityBatchInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *EnsurePullabilityBatchInput) GetPeerConnectionConfig() *Peer {
-	if x != nil {
-		return x.PeerConnectionConfig
-	}
-	return nil
-}
-
-func (x *EnsurePullabilityBatchInput) GetFlowJobName() string {
-	if x != nil {
-		return x.FlowJobName
-	}
-	return ""
+// Deprecated: Use DropStaleRawTablesOutput.ProtoReflect.Descriptor instead.
+func (*DropStaleRawTablesOutput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *EnsurePullabilityBatchInput) GetSourceTableIdentifiers() []string {
+func (x *DropStaleRawTablesOutput) GetStaleRawTables() []string {
 	if x != nil {
-		return x.SourceTableIdentifiers
+		return x.StaleRawTables
 	}
 	return nil
 }
 
-type PostgresTableIdentifier struct {
+type RawTableColumn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RelId uint32 `protobuf:"varint,1,opt,name=rel_id,json=relId,proto3" json:"rel_id,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *PostgresTableIdentifier) Reset() {
-	*x = PostgresTableIdentifier{}
+func (x *RawTableColumn) Reset() {
+	*x = RawTableColumn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[13]
+		mi := &file_flow_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PostgresTableIdentifier) String() string {
+func (x *RawTableColumn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostgresTableIdentifier) ProtoMessage() {}
+func (*RawTableColumn) ProtoMessage() {}
 
-func (x *PostgresTableIdentifier) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[13]
+func (x *RawTableColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1073,46 +3011,52 @@ func (x *PostgresTableIdentifier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostgresTableIdentifier.ProtoReflect.Descriptor instead.
-func (*PostgresTableIdentifier) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RawTableColumn.ProtoReflect.Descriptor instead.
+func (*RawTableColumn) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *PostgresTableIdentifier) GetRelId() uint32 {
+func (x *RawTableColumn) GetName() string {
 	if x != nil {
-		return x.RelId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type TableIdentifier struct {
+func (x *RawTableColumn) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type GetRawTableSchemaOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to TableIdentifier:
-	//
-	//	*TableIdentifier_PostgresTableIdentifier
-	TableIdentifier isTableIdentifier_TableIdentifier `protobuf_oneof:"table_identifier"`
+	TableIdentifier string `protobuf:"bytes,1,opt,name=table_identifier,json=tableIdentifier,proto3" json:"table_identifier,omitempty"`
+	// columns of the raw table as they exist on the destination, in table order
+	Columns []*RawTableColumn `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
 }
 
-func (x *TableIdentifier) Reset() {
-	*x = TableIdentifier{}
+func (x *GetRawTableSchemaOutput) Reset() {
+	*x = GetRawTableSchemaOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[14]
+		mi := &file_flow_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TableIdentifier) String() string {
+func (x *GetRawTableSchemaOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableIdentifier) ProtoMessage() {}
+func (*GetRawTableSchemaOutput) ProtoMessage() {}
 
-func (x *TableIdentifier) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[14]
+func (x *GetRawTableSchemaOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1123,60 +3067,51 @@ func (x *TableIdentifier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableIdentifier.ProtoReflect.Descriptor instead.
-func (*TableIdentifier) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetRawTableSchemaOutput.ProtoReflect.Descriptor instead.
+func (*GetRawTableSchemaOutput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{24}
 }
 
-func (m *TableIdentifier) GetTableIdentifier() isTableIdentifier_TableIdentifier {
-	if m != nil {
-		return m.TableIdentifier
+func (x *GetRawTableSchemaOutput) GetTableIdentifier() string {
+	if x != nil {
+		return x.TableIdentifier
 	}
-	return nil
+	return ""
 }
 
-func (x *TableIdentifier) GetPostgresTableIdentifier() *PostgresTableIdentifier {
-	if x, ok := x.GetTableIdentifier().(*TableIdentifier_PostgresTableIdentifier); ok {
-		return x.PostgresTableIdentifier
+func (x *GetRawTableSchemaOutput) GetColumns() []*RawTableColumn {
+	if x != nil {
+		return x.Columns
 	}
 	return nil
 }
 
-type isTableIdentifier_TableIdentifier interface {
-	isTableIdentifier_TableIdentifier()
-}
-
-type TableIdentifier_PostgresTableIdentifier struct {
-	PostgresTableIdentifier *PostgresTableIdentifier `protobuf:"bytes,1,opt,name=postgres_table_identifier,json=postgresTableIdentifier,proto3,oneof"`
-}
-
-func (*TableIdentifier_PostgresTableIdentifier) isTableIdentifier_TableIdentifier() {}
-
-type EnsurePullabilityOutput struct {
+// SQL expressions inserted into destination columns that are not replicated, keyed by column name.
+type ColumnDefaults struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableIdentifier *TableIdentifier `protobuf:"bytes,1,opt,name=table_identifier,json=tableIdentifier,proto3" json:"table_identifier,omitempty"`
+	Defaults map[string]string `protobuf:"bytes,1,rep,name=defaults,proto3" json:"defaults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *EnsurePullabilityOutput) Reset() {
-	*x = EnsurePullabilityOutput{}
+func (x *ColumnDefaults) Reset() {
+	*x = ColumnDefaults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[15]
+		mi := &file_flow_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnsurePullabilityOutput) String() string {
+func (x *ColumnDefaults) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnsurePullabilityOutput) ProtoMessage() {}
+func (*ColumnDefaults) ProtoMessage() {}
 
-func (x *EnsurePullabilityOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[15]
+func (x *ColumnDefaults) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,43 +3122,48 @@ func (x *EnsurePullabilityOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnsurePullabilityOutput.ProtoReflect.Descriptor instead.
-func (*EnsurePullabilityOutput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ColumnDefaults.ProtoReflect.Descriptor instead.
+func (*ColumnDefaults) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *EnsurePullabilityOutput) GetTableIdentifier() *TableIdentifier {
+func (x *ColumnDefaults) GetDefaults() map[string]string {
 	if x != nil {
-		return x.TableIdentifier
+		return x.Defaults
 	}
 	return nil
 }
 
-type EnsurePullabilityBatchOutput struct {
+type TableSchema struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableIdentifierMapping map[string]*TableIdentifier `protobuf:"bytes,1,rep,name=table_identifier_mapping,json=tableIdentifierMapping,proto3" json:"table_identifier_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TableIdentifier string `protobuf:"bytes,1,opt,name=table_identifier,json=tableIdentifier,proto3" json:"table_identifier,omitempty"`
+	// list of column names and types, types can be one of the following:
+	// "string", "int", "float", "bool", "timestamp".
+	Columns               map[string]string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PrimaryKeyColumns     []string          `protobuf:"bytes,3,rep,name=primary_key_columns,json=primaryKeyColumns,proto3" json:"primary_key_columns,omitempty"`
+	IsReplicaIdentityFull bool              `protobuf:"varint,4,opt,name=is_replica_identity_full,json=isReplicaIdentityFull,proto3" json:"is_replica_identity_full,omitempty"`
 }
 
-func (x *EnsurePullabilityBatchOutput) Reset() {
-	*x = EnsurePullabilityBatchOutput{}
+func (x *TableSchema) Reset() {
+	*x = TableSchema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[16]
+		mi := &file_flow_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnsurePullabilityBatchOutput) String() string {
+func (x *TableSchema) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnsurePullabilityBatchOutput) ProtoMessage() {}
+func (*TableSchema) ProtoMessage() {}
 
-func (x *EnsurePullabilityBatchOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[16]
+func (x *TableSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,50 +3174,71 @@ func (x *EnsurePullabilityBatchOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnsurePullabilityBatchOutput.ProtoReflect.Descriptor instead.
-func (*EnsurePullabilityBatchOutput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use TableSchema.ProtoReflect.Descriptor instead.
+func (*TableSchema) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *EnsurePullabilityBatchOutput) GetTableIdentifierMapping() map[string]*TableIdentifier {
+func (x *TableSchema) GetTableIdentifier() string {
 	if x != nil {
-		return x.TableIdentifierMapping
+		return x.TableIdentifier
+	}
+	return ""
+}
+
+func (x *TableSchema) GetColumns() map[string]string {
+	if x != nil {
+		return x.Columns
 	}
 	return nil
 }
 
-type SetupReplicationInput struct {
+func (x *TableSchema) GetPrimaryKeyColumns() []string {
+	if x != nil {
+		return x.PrimaryKeyColumns
+	}
+	return nil
+}
+
+func (x *TableSchema) GetIsReplicaIdentityFull() bool {
+	if x != nil {
+		return x.IsReplicaIdentityFull
+	}
+	return false
+}
+
+type GetTableSchemaBatchInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerConnectionConfig *Peer             `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
-	FlowJobName          string            `protobuf:"bytes,2,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
-	TableNameMapping     map[string]string `protobuf:"bytes,3,rep,name=table_name_mapping,json=tableNameMapping,proto3" json:"table_name_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// replicate to destination using ctid
-	DestinationPeer             *Peer  `protobuf:"bytes,4,opt,name=destination_peer,json=destinationPeer,proto3" json:"destination_peer,omitempty"`
-	DoInitialCopy               bool   `protobuf:"varint,5,opt,name=do_initial_copy,json=doInitialCopy,proto3" json:"do_initial_copy,omitempty"`
-	ExistingPublicationName     string `protobuf:"bytes,6,opt,name=existing_publication_name,json=existingPublicationName,proto3" json:"existing_publication_name,omitempty"`
-	ExistingReplicationSlotName string `protobuf:"bytes,7,opt,name=existing_replication_slot_name,json=existingReplicationSlotName,proto3" json:"existing_replication_slot_name,omitempty"`
+	PeerConnectionConfig *Peer    `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
+	TableIdentifiers     []string `protobuf:"bytes,2,rep,name=table_identifiers,json=tableIdentifiers,proto3" json:"table_identifiers,omitempty"`
+	// map postgres json columns to text instead of json.
+	JsonAsText bool `protobuf:"varint,3,opt,name=json_as_text,json=jsonAsText,proto3" json:"json_as_text,omitempty"`
+	// number of tables whose schema is fetched at the same time, 8 if unset.
+	MaxParallelFetches uint32 `protobuf:"varint,4,opt,name=max_parallel_fetches,json=maxParallelFetches,proto3" json:"max_parallel_fetches,omitempty"`
+	// map postgres range columns to text instead of json.
+	RangesAsText bool `protobuf:"varint,5,opt,name=ranges_as_text,json=rangesAsText,proto3" json:"ranges_as_text,omitempty"`
 }
 
-func (x *SetupReplicationInput) Reset() {
-	*x = SetupReplicationInput{}
+func (x *GetTableSchemaBatchInput) Reset() {
+	*x = GetTableSchemaBatchInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[17]
+		mi := &file_flow_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetupReplicationInput) String() string {
+func (x *GetTableSchemaBatchInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetupReplicationInput) ProtoMessage() {}
+func (*GetTableSchemaBatchInput) ProtoMessage() {}
 
-func (x *SetupReplicationInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[17]
+func (x *GetTableSchemaBatchInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,86 +3249,71 @@ func (x *SetupReplicationInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetupReplicationInput.ProtoReflect.Descriptor instead.
-func (*SetupReplicationInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetTableSchemaBatchInput.ProtoReflect.Descriptor instead.
+func (*GetTableSchemaBatchInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SetupReplicationInput) GetPeerConnectionConfig() *Peer {
+func (x *GetTableSchemaBatchInput) GetPeerConnectionConfig() *Peer {
 	if x != nil {
 		return x.PeerConnectionConfig
 	}
 	return nil
 }
 
-func (x *SetupReplicationInput) GetFlowJobName() string {
-	if x != nil {
-		return x.FlowJobName
-	}
-	return ""
-}
-
-func (x *SetupReplicationInput) GetTableNameMapping() map[string]string {
-	if x != nil {
-		return x.TableNameMapping
-	}
-	return nil
-}
-
-func (x *SetupReplicationInput) GetDestinationPeer() *Peer {
+func (x *GetTableSchemaBatchInput) GetTableIdentifiers() []string {
 	if x != nil {
-		return x.DestinationPeer
+		return x.TableIdentifiers
 	}
 	return nil
 }
 
-func (x *SetupReplicationInput) GetDoInitialCopy() bool {
+func (x *GetTableSchemaBatchInput) GetJsonAsText() bool {
 	if x != nil {
-		return x.DoInitialCopy
+		return x.JsonAsText
 	}
 	return false
 }
 
-func (x *SetupReplicationInput) GetExistingPublicationName() string {
+func (x *GetTableSchemaBatchInput) GetMaxParallelFetches() uint32 {
 	if x != nil {
-		return x.ExistingPublicationName
+		return x.MaxParallelFetches
 	}
-	return ""
+	return 0
 }
 
-func (x *SetupReplicationInput) GetExistingReplicationSlotName() string {
+func (x *GetTableSchemaBatchInput) GetRangesAsText() bool {
 	if x != nil {
-		return x.ExistingReplicationSlotName
+		return x.RangesAsText
 	}
-	return ""
+	return false
 }
 
-type SetupReplicationOutput struct {
+type GetTableSchemaBatchOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SlotName     string `protobuf:"bytes,1,opt,name=slot_name,json=slotName,proto3" json:"slot_name,omitempty"`
-	SnapshotName string `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	TableNameSchemaMapping map[string]*TableSchema `protobuf:"bytes,1,rep,name=table_name_schema_mapping,json=tableNameSchemaMapping,proto3" json:"table_name_schema_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SetupReplicationOutput) Reset() {
-	*x = SetupReplicationOutput{}
+func (x *GetTableSchemaBatchOutput) Reset() {
+	*x = GetTableSchemaBatchOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[18]
+		mi := &file_flow_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetupReplicationOutput) String() string {
+func (x *GetTableSchemaBatchOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetupReplicationOutput) ProtoMessage() {}
+func (*GetTableSchemaBatchOutput) ProtoMessage() {}
 
-func (x *SetupReplicationOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[18]
+func (x *GetTableSchemaBatchOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,53 +3324,45 @@ func (x *SetupReplicationOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetupReplicationOutput.ProtoReflect.Descriptor instead.
-func (*SetupReplicationOutput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *SetupReplicationOutput) GetSlotName() string {
-	if x != nil {
-		return x.SlotName
-	}
-	return ""
+// Deprecated: Use GetTableSchemaBatchOutput.ProtoReflect.Descriptor instead.
+func (*GetTableSchemaBatchOutput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SetupReplicationOutput) GetSnapshotName() string {
+func (x *GetTableSchemaBatchOutput) GetTableNameSchemaMapping() map[string]*TableSchema {
 	if x != nil {
-		return x.SnapshotName
+		return x.TableNameSchemaMapping
 	}
-	return ""
+	return nil
 }
 
-type CreateRawTableInput struct {
+type SetupNormalizedTableInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerConnectionConfig *Peer             `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
-	FlowJobName          string            `protobuf:"bytes,2,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
-	TableNameMapping     map[string]string `protobuf:"bytes,3,rep,name=table_name_mapping,json=tableNameMapping,proto3" json:"table_name_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	CdcSyncMode          QRepSyncMode      `protobuf:"varint,4,opt,name=cdc_sync_mode,json=cdcSyncMode,proto3,enum=peerdb_flow.QRepSyncMode" json:"cdc_sync_mode,omitempty"`
+	PeerConnectionConfig *Peer        `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
+	TableIdentifier      string       `protobuf:"bytes,2,opt,name=table_identifier,json=tableIdentifier,proto3" json:"table_identifier,omitempty"`
+	SourceTableSchema    *TableSchema `protobuf:"bytes,3,opt,name=source_table_schema,json=sourceTableSchema,proto3" json:"source_table_schema,omitempty"`
 }
 
-func (x *CreateRawTableInput) Reset() {
-	*x = CreateRawTableInput{}
+func (x *SetupNormalizedTableInput) Reset() {
+	*x = SetupNormalizedTableInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[19]
+		mi := &file_flow_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRawTableInput) String() string {
+func (x *SetupNormalizedTableInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRawTableInput) ProtoMessage() {}
+func (*SetupNormalizedTableInput) ProtoMessage() {}
 
-func (x *CreateRawTableInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[19]
+func (x *SetupNormalizedTableInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,64 +3373,71 @@ func (x *CreateRawTableInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRawTableInput.ProtoReflect.Descriptor instead.
-func (*CreateRawTableInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SetupNormalizedTableInput.ProtoReflect.Descriptor instead.
+func (*SetupNormalizedTableInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CreateRawTableInput) GetPeerConnectionConfig() *Peer {
+func (x *SetupNormalizedTableInput) GetPeerConnectionConfig() *Peer {
 	if x != nil {
 		return x.PeerConnectionConfig
 	}
 	return nil
 }
 
-func (x *CreateRawTableInput) GetFlowJobName() string {
+func (x *SetupNormalizedTableInput) GetTableIdentifier() string {
 	if x != nil {
-		return x.FlowJobName
+		return x.TableIdentifier
 	}
 	return ""
 }
 
-func (x *CreateRawTableInput) GetTableNameMapping() map[string]string {
+func (x *SetupNormalizedTableInput) GetSourceTableSchema() *TableSchema {
 	if x != nil {
-		return x.TableNameMapping
+		return x.SourceTableSchema
 	}
 	return nil
 }
 
-func (x *CreateRawTableInput) GetCdcSyncMode() QRepSyncMode {
-	if x != nil {
-		return x.CdcSyncMode
-	}
-	return QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT
-}
-
-type CreateRawTableOutput struct {
+type SetupNormalizedTableBatchInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableIdentifier string `protobuf:"bytes,1,opt,name=table_identifier,json=tableIdentifier,proto3" json:"table_identifier,omitempty"`
+	PeerConnectionConfig   *Peer                   `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
+	TableNameSchemaMapping map[string]*TableSchema `protobuf:"bytes,2,rep,name=table_name_schema_mapping,json=tableNameSchemaMapping,proto3" json:"table_name_schema_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TransientTables        bool                    `protobuf:"varint,3,opt,name=transient_tables,json=transientTables,proto3" json:"transient_tables,omitempty"`
+	// the below are only needed for dynamic tables.
+	DynamicTables         bool                       `protobuf:"varint,4,opt,name=dynamic_tables,json=dynamicTables,proto3" json:"dynamic_tables,omitempty"`
+	DynamicTableTargetLag string                     `protobuf:"bytes,5,opt,name=dynamic_table_target_lag,json=dynamicTableTargetLag,proto3" json:"dynamic_table_target_lag,omitempty"`
+	FlowJobName           string                     `protobuf:"bytes,6,opt,name=flow_job_name,json=flowJobName,proto3" json:"flow_job_name,omitempty"`
+	SoftDelete            bool                       `protobuf:"varint,7,opt,name=soft_delete,json=softDelete,proto3" json:"soft_delete,omitempty"`
+	RawDataAsVariant      bool                       `protobuf:"varint,8,opt,name=raw_data_as_variant,json=rawDataAsVariant,proto3" json:"raw_data_as_variant,omitempty"`
+	SourceLsnColumn       bool                       `protobuf:"varint,9,opt,name=source_lsn_column,json=sourceLsnColumn,proto3" json:"source_lsn_column,omitempty"`
+	Tags                  map[string]string          `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ColumnDefaults        map[string]*ColumnDefaults `protobuf:"bytes,11,rep,name=column_defaults,json=columnDefaults,proto3" json:"column_defaults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DedupNullsOrdering    DedupNullsOrdering         `protobuf:"varint,12,opt,name=dedup_nulls_ordering,json=dedupNullsOrdering,proto3,enum=peerdb_flow.DedupNullsOrdering" json:"dedup_nulls_ordering,omitempty"`
+	VariantNullPolicy     VariantNullPolicy          `protobuf:"varint,13,opt,name=variant_null_policy,json=variantNullPolicy,proto3,enum=peerdb_flow.VariantNullPolicy" json:"variant_null_policy,omitempty"`
+	LastOpColumn          bool                       `protobuf:"varint,14,opt,name=last_op_column,json=lastOpColumn,proto3" json:"last_op_column,omitempty"`
 }
 
-func (x *CreateRawTableOutput) Reset() {
-	*x = CreateRawTableOutput{}
+func (x *SetupNormalizedTableBatchInput) Reset() {
+	*x = SetupNormalizedTableBatchInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[20]
+		mi := &file_flow_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRawTableOutput) String() string {
+func (x *SetupNormalizedTableBatchInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRawTableOutput) ProtoMessage() {}
+func (*SetupNormalizedTableBatchInput) ProtoMessage() {}
 
-func (x *CreateRawTableOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[20]
+func (x *SetupNormalizedTableBatchInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1503,117 +3448,138 @@ func (x *CreateRawTableOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRawTableOutput.ProtoReflect.Descriptor instead.
-func (*CreateRawTableOutput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SetupNormalizedTableBatchInput.ProtoReflect.Descriptor instead.
+func (*SetupNormalizedTableBatchInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CreateRawTableOutput) GetTableIdentifier() string {
+func (x *SetupNormalizedTableBatchInput) GetPeerConnectionConfig() *Peer {
 	if x != nil {
-		return x.TableIdentifier
+		return x.PeerConnectionConfig
 	}
-	return ""
+	return nil
 }
 
-type TableSchema struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SetupNormalizedTableBatchInput) GetTableNameSchemaMapping() map[string]*TableSchema {
+	if x != nil {
+		return x.TableNameSchemaMapping
+	}
+	return nil
+}
 
-	TableIdentifier string `protobuf:"bytes,1,opt,name=table_identifier,json=tableIdentifier,proto3" json:"table_identifier,omitempty"`
-	// list of column names and types, types can be one of the following:
-	// "string", "int", "float", "bool", "timestamp".
-	Columns               map[string]string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	PrimaryKeyColumns     []string          `protobuf:"bytes,3,rep,name=primary_key_columns,json=primaryKeyColumns,proto3" json:"primary_key_columns,omitempty"`
-	IsReplicaIdentityFull bool              `protobuf:"varint,4,opt,name=is_replica_identity_full,json=isReplicaIdentityFull,proto3" json:"is_replica_identity_full,omitempty"`
+func (x *SetupNormalizedTableBatchInput) GetTransientTables() bool {
+	if x != nil {
+		return x.TransientTables
+	}
+	return false
 }
 
-func (x *TableSchema) Reset() {
-	*x = TableSchema{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SetupNormalizedTableBatchInput) GetDynamicTables() bool {
+	if x != nil {
+		return x.DynamicTables
 	}
+	return false
 }
 
-func (x *TableSchema) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SetupNormalizedTableBatchInput) GetDynamicTableTargetLag() string {
+	if x != nil {
+		return x.DynamicTableTargetLag
+	}
+	return ""
 }
 
-func (*TableSchema) ProtoMessage() {}
+func (x *SetupNormalizedTableBatchInput) GetFlowJobName() string {
+	if x != nil {
+		return x.FlowJobName
+	}
+	return ""
+}
 
-func (x *TableSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SetupNormalizedTableBatchInput) GetSoftDelete() bool {
+	if x != nil {
+		return x.SoftDelete
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use TableSchema.ProtoReflect.Descriptor instead.
-func (*TableSchema) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{21}
+func (x *SetupNormalizedTableBatchInput) GetRawDataAsVariant() bool {
+	if x != nil {
+		return x.RawDataAsVariant
+	}
+	return false
 }
 
-func (x *TableSchema) GetTableIdentifier() string {
+func (x *SetupNormalizedTableBatchInput) GetSourceLsnColumn() bool {
 	if x != nil {
-		return x.TableIdentifier
+		return x.SourceLsnColumn
 	}
-	return ""
+	return false
 }
 
-func (x *TableSchema) GetColumns() map[string]string {
+func (x *SetupNormalizedTableBatchInput) GetTags() map[string]string {
 	if x != nil {
-		return x.Columns
+		return x.Tags
 	}
 	return nil
 }
 
-func (x *TableSchema) GetPrimaryKeyColumns() []string {
+func (x *SetupNormalizedTableBatchInput) GetColumnDefaults() map[string]*ColumnDefaults {
 	if x != nil {
-		return x.PrimaryKeyColumns
+		return x.ColumnDefaults
 	}
 	return nil
 }
 
-func (x *TableSchema) GetIsReplicaIdentityFull() bool {
+func (x *SetupNormalizedTableBatchInput) GetDedupNullsOrdering() DedupNullsOrdering {
 	if x != nil {
-		return x.IsReplicaIdentityFull
+		return x.DedupNullsOrdering
+	}
+	return DedupNullsOrdering_DEDUP_NULLS_LAST
+}
+
+func (x *SetupNormalizedTableBatchInput) GetVariantNullPolicy() VariantNullPolicy {
+	if x != nil {
+		return x.VariantNullPolicy
+	}
+	return VariantNullPolicy_VARIANT_NULL_POLICY_SQL_NULL
+}
+
+func (x *SetupNormalizedTableBatchInput) GetLastOpColumn() bool {
+	if x != nil {
+		return x.LastOpColumn
 	}
 	return false
 }
 
-type GetTableSchemaBatchInput struct {
+type RebuildNormalizedTableInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerConnectionConfig *Peer    `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
-	TableIdentifiers     []string `protobuf:"bytes,2,rep,name=table_identifiers,json=tableIdentifiers,proto3" json:"table_identifiers,omitempty"`
+	// the mirror the table belongs to, whose normalize options the table is merged with.
+	FlowConnectionConfigs      *FlowConnectionConfigs `protobuf:"bytes,1,opt,name=flow_connection_configs,json=flowConnectionConfigs,proto3" json:"flow_connection_configs,omitempty"`
+	DestinationTableIdentifier string                 `protobuf:"bytes,2,opt,name=destination_table_identifier,json=destinationTableIdentifier,proto3" json:"destination_table_identifier,omitempty"`
+	// number of raw table batches merged per statement, defaults to 100.
+	BatchesPerMerge uint32 `protobuf:"varint,3,opt,name=batches_per_merge,json=batchesPerMerge,proto3" json:"batches_per_merge,omitempty"`
 }
 
-func (x *GetTableSchemaBatchInput) Reset() {
-	*x = GetTableSchemaBatchInput{}
+func (x *RebuildNormalizedTableInput) Reset() {
+	*x = RebuildNormalizedTableInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[22]
+		mi := &file_flow_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTableSchemaBatchInput) String() string {
+func (x *RebuildNormalizedTableInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableSchemaBatchInput) ProtoMessage() {}
+func (*RebuildNormalizedTableInput) ProtoMessage() {}
 
-func (x *GetTableSchemaBatchInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[22]
+func (x *RebuildNormalizedTableInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,50 +3590,58 @@ func (x *GetTableSchemaBatchInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableSchemaBatchInput.ProtoReflect.Descriptor instead.
-func (*GetTableSchemaBatchInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use RebuildNormalizedTableInput.ProtoReflect.Descriptor instead.
+func (*RebuildNormalizedTableInput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetTableSchemaBatchInput) GetPeerConnectionConfig() *Peer {
+func (x *RebuildNormalizedTableInput) GetFlowConnectionConfigs() *FlowConnectionConfigs {
 	if x != nil {
-		return x.PeerConnectionConfig
+		return x.FlowConnectionConfigs
 	}
 	return nil
 }
 
-func (x *GetTableSchemaBatchInput) GetTableIdentifiers() []string {
+func (x *RebuildNormalizedTableInput) GetDestinationTableIdentifier() string {
 	if x != nil {
-		return x.TableIdentifiers
+		return x.DestinationTableIdentifier
 	}
-	return nil
+	return ""
 }
 
-type GetTableSchemaBatchOutput struct {
+func (x *RebuildNormalizedTableInput) GetBatchesPerMerge() uint32 {
+	if x != nil {
+		return x.BatchesPerMerge
+	}
+	return 0
+}
+
+type RebuildNormalizedTableOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableNameSchemaMapping map[string]*TableSchema `protobuf:"bytes,1,rep,name=table_name_schema_mapping,json=tableNameSchemaMapping,proto3" json:"table_name_schema_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RowsAffected int64 `protobuf:"varint,1,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+	LastBatchId  int64 `protobuf:"varint,2,opt,name=last_batch_id,json=lastBatchId,proto3" json:"last_batch_id,omitempty"`
 }
 
-func (x *GetTableSchemaBatchOutput) Reset() {
-	*x = GetTableSchemaBatchOutput{}
+func (x *RebuildNormalizedTableOutput) Reset() {
+	*x = RebuildNormalizedTableOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[23]
+		mi := &file_flow_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTableSchemaBatchOutput) String() string {
+func (x *RebuildNormalizedTableOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableSchemaBatchOutput) ProtoMessage() {}
+func (*RebuildNormalizedTableOutput) ProtoMessage() {}
 
-func (x *GetTableSchemaBatchOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[23]
+func (x *RebuildNormalizedTableOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1678,45 +3652,59 @@ func (x *GetTableSchemaBatchOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableSchemaBatchOutput.ProtoReflect.Descriptor instead.
-func (*GetTableSchemaBatchOutput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use RebuildNormalizedTableOutput.ProtoReflect.Descriptor instead.
+func (*RebuildNormalizedTableOutput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetTableSchemaBatchOutput) GetTableNameSchemaMapping() map[string]*TableSchema {
+func (x *RebuildNormalizedTableOutput) GetRowsAffected() int64 {
 	if x != nil {
-		return x.TableNameSchemaMapping
+		return x.RowsAffected
 	}
-	return nil
+	return 0
 }
 
-type SetupNormalizedTableInput struct {
+func (x *RebuildNormalizedTableOutput) GetLastBatchId() int64 {
+	if x != nil {
+		return x.LastBatchId
+	}
+	return 0
+}
+
+// outcome of writing a value of a column's type to the destination and reading it back.
+type TypeFidelityResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerConnectionConfig *Peer        `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
-	TableIdentifier      string       `protobuf:"bytes,2,opt,name=table_identifier,json=tableIdentifier,proto3" json:"table_identifier,omitempty"`
-	SourceTableSchema    *TableSchema `protobuf:"bytes,3,opt,name=source_table_schema,json=sourceTableSchema,proto3" json:"source_table_schema,omitempty"`
-}
-
-func (x *SetupNormalizedTableInput) Reset() {
-	*x = SetupNormalizedTableInput{}
+	TableIdentifier string `protobuf:"bytes,1,opt,name=table_identifier,json=tableIdentifier,proto3" json:"table_identifier,omitempty"`
+	ColumnName      string `protobuf:"bytes,2,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	ColumnType      string `protobuf:"bytes,3,opt,name=column_type,json=columnType,proto3" json:"column_type,omitempty"`
+	// false for types there is no value to test with for.
+	Tested       bool   `protobuf:"varint,4,opt,name=tested,proto3" json:"tested,omitempty"`
+	Lossless     bool   `protobuf:"varint,5,opt,name=lossless,proto3" json:"lossless,omitempty"`
+	WrittenValue string `protobuf:"bytes,6,opt,name=written_value,json=writtenValue,proto3" json:"written_value,omitempty"`
+	// "null" if the value was read back as NULL.
+	ReadValue string `protobuf:"bytes,7,opt,name=read_value,json=readValue,proto3" json:"read_value,omitempty"`
+}
+
+func (x *TypeFidelityResult) Reset() {
+	*x = TypeFidelityResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[24]
+		mi := &file_flow_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetupNormalizedTableInput) String() string {
+func (x *TypeFidelityResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetupNormalizedTableInput) ProtoMessage() {}
+func (*TypeFidelityResult) ProtoMessage() {}
 
-func (x *SetupNormalizedTableInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[24]
+func (x *TypeFidelityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1727,58 +3715,85 @@ func (x *SetupNormalizedTableInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetupNormalizedTableInput.ProtoReflect.Descriptor instead.
-func (*SetupNormalizedTableInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use TypeFidelityResult.ProtoReflect.Descriptor instead.
+func (*TypeFidelityResult) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SetupNormalizedTableInput) GetPeerConnectionConfig() *Peer {
+func (x *TypeFidelityResult) GetTableIdentifier() string {
 	if x != nil {
-		return x.PeerConnectionConfig
+		return x.TableIdentifier
 	}
-	return nil
+	return ""
 }
 
-func (x *SetupNormalizedTableInput) GetTableIdentifier() string {
+func (x *TypeFidelityResult) GetColumnName() string {
 	if x != nil {
-		return x.TableIdentifier
+		return x.ColumnName
 	}
 	return ""
 }
 
-func (x *SetupNormalizedTableInput) GetSourceTableSchema() *TableSchema {
+func (x *TypeFidelityResult) GetColumnType() string {
 	if x != nil {
-		return x.SourceTableSchema
+		return x.ColumnType
 	}
-	return nil
+	return ""
 }
 
-type SetupNormalizedTableBatchInput struct {
+func (x *TypeFidelityResult) GetTested() bool {
+	if x != nil {
+		return x.Tested
+	}
+	return false
+}
+
+func (x *TypeFidelityResult) GetLossless() bool {
+	if x != nil {
+		return x.Lossless
+	}
+	return false
+}
+
+func (x *TypeFidelityResult) GetWrittenValue() string {
+	if x != nil {
+		return x.WrittenValue
+	}
+	return ""
+}
+
+func (x *TypeFidelityResult) GetReadValue() string {
+	if x != nil {
+		return x.ReadValue
+	}
+	return ""
+}
+
+type GenerateDDLOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerConnectionConfig   *Peer                   `protobuf:"bytes,1,opt,name=peer_connection_config,json=peerConnectionConfig,proto3" json:"peer_connection_config,omitempty"`
-	TableNameSchemaMapping map[string]*TableSchema `protobuf:"bytes,2,rep,name=table_name_schema_mapping,json=tableNameSchemaMapping,proto3" json:"table_name_schema_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TableDdlMapping map[string]string `protobuf:"bytes,1,rep,name=table_ddl_mapping,json=tableDdlMapping,proto3" json:"table_ddl_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SetupNormalizedTableBatchInput) Reset() {
-	*x = SetupNormalizedTableBatchInput{}
+func (x *GenerateDDLOutput) Reset() {
+	*x = GenerateDDLOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[25]
+		mi := &file_flow_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetupNormalizedTableBatchInput) String() string {
+func (x *GenerateDDLOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetupNormalizedTableBatchInput) ProtoMessage() {}
+func (*GenerateDDLOutput) ProtoMessage() {}
 
-func (x *SetupNormalizedTableBatchInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[25]
+func (x *GenerateDDLOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_flow_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1789,21 +3804,14 @@ func (x *SetupNormalizedTableBatchInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetupNormalizedTableBatchInput.ProtoReflect.Descriptor instead.
-func (*SetupNormalizedTableBatchInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *SetupNormalizedTableBatchInput) GetPeerConnectionConfig() *Peer {
-	if x != nil {
-		return x.PeerConnectionConfig
-	}
-	return nil
+// Deprecated: Use GenerateDDLOutput.ProtoReflect.Descriptor instead.
+func (*GenerateDDLOutput) Descriptor() ([]byte, []int) {
+	return file_flow_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SetupNormalizedTableBatchInput) GetTableNameSchemaMapping() map[string]*TableSchema {
+func (x *GenerateDDLOutput) GetTableDdlMapping() map[string]string {
 	if x != nil {
-		return x.TableNameSchemaMapping
+		return x.TableDdlMapping
 	}
 	return nil
 }
@@ -1820,7 +3828,7 @@ type SetupNormalizedTableOutput struct {
 func (x *SetupNormalizedTableOutput) Reset() {
 	*x = SetupNormalizedTableOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[26]
+		mi := &file_flow_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1833,7 +3841,7 @@ func (x *SetupNormalizedTableOutput) String() string {
 func (*SetupNormalizedTableOutput) ProtoMessage() {}
 
 func (x *SetupNormalizedTableOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[26]
+	mi := &file_flow_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1846,7 +3854,7 @@ func (x *SetupNormalizedTableOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetupNormalizedTableOutput.ProtoReflect.Descriptor instead.
 func (*SetupNormalizedTableOutput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{26}
+	return file_flow_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *SetupNormalizedTableOutput) GetTableIdentifier() string {
@@ -1874,7 +3882,7 @@ type SetupNormalizedTableBatchOutput struct {
 func (x *SetupNormalizedTableBatchOutput) Reset() {
 	*x = SetupNormalizedTableBatchOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[27]
+		mi := &file_flow_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1887,7 +3895,7 @@ func (x *SetupNormalizedTableBatchOutput) String() string {
 func (*SetupNormalizedTableBatchOutput) ProtoMessage() {}
 
 func (x *SetupNormalizedTableBatchOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[27]
+	mi := &file_flow_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1900,7 +3908,7 @@ func (x *SetupNormalizedTableBatchOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetupNormalizedTableBatchOutput.ProtoReflect.Descriptor instead.
 func (*SetupNormalizedTableBatchOutput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{27}
+	return file_flow_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SetupNormalizedTableBatchOutput) GetTableExistsMapping() map[string]bool {
@@ -1923,7 +3931,7 @@ type IntPartitionRange struct {
 func (x *IntPartitionRange) Reset() {
 	*x = IntPartitionRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[28]
+		mi := &file_flow_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1936,7 +3944,7 @@ func (x *IntPartitionRange) String() string {
 func (*IntPartitionRange) ProtoMessage() {}
 
 func (x *IntPartitionRange) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[28]
+	mi := &file_flow_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1949,7 +3957,7 @@ func (x *IntPartitionRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntPartitionRange.ProtoReflect.Descriptor instead.
 func (*IntPartitionRange) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{28}
+	return file_flow_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *IntPartitionRange) GetStart() int64 {
@@ -1978,7 +3986,7 @@ type TimestampPartitionRange struct {
 func (x *TimestampPartitionRange) Reset() {
 	*x = TimestampPartitionRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[29]
+		mi := &file_flow_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1991,7 +3999,7 @@ func (x *TimestampPartitionRange) String() string {
 func (*TimestampPartitionRange) ProtoMessage() {}
 
 func (x *TimestampPartitionRange) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[29]
+	mi := &file_flow_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2004,7 +4012,7 @@ func (x *TimestampPartitionRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimestampPartitionRange.ProtoReflect.Descriptor instead.
 func (*TimestampPartitionRange) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{29}
+	return file_flow_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *TimestampPartitionRange) GetStart() *timestamppb.Timestamp {
@@ -2033,7 +4041,7 @@ type TID struct {
 func (x *TID) Reset() {
 	*x = TID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[30]
+		mi := &file_flow_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2046,7 +4054,7 @@ func (x *TID) String() string {
 func (*TID) ProtoMessage() {}
 
 func (x *TID) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[30]
+	mi := &file_flow_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2059,7 +4067,7 @@ func (x *TID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TID.ProtoReflect.Descriptor instead.
 func (*TID) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{30}
+	return file_flow_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *TID) GetBlockNumber() uint32 {
@@ -2088,7 +4096,7 @@ type TIDPartitionRange struct {
 func (x *TIDPartitionRange) Reset() {
 	*x = TIDPartitionRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[31]
+		mi := &file_flow_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2101,7 +4109,7 @@ func (x *TIDPartitionRange) String() string {
 func (*TIDPartitionRange) ProtoMessage() {}
 
 func (x *TIDPartitionRange) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[31]
+	mi := &file_flow_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2114,7 +4122,7 @@ func (x *TIDPartitionRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TIDPartitionRange.ProtoReflect.Descriptor instead.
 func (*TIDPartitionRange) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{31}
+	return file_flow_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *TIDPartitionRange) GetStart() *TID {
@@ -2149,7 +4157,7 @@ type PartitionRange struct {
 func (x *PartitionRange) Reset() {
 	*x = PartitionRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[32]
+		mi := &file_flow_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2162,7 +4170,7 @@ func (x *PartitionRange) String() string {
 func (*PartitionRange) ProtoMessage() {}
 
 func (x *PartitionRange) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[32]
+	mi := &file_flow_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2175,7 +4183,7 @@ func (x *PartitionRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartitionRange.ProtoReflect.Descriptor instead.
 func (*PartitionRange) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{32}
+	return file_flow_proto_rawDescGZIP(), []int{41}
 }
 
 func (m *PartitionRange) GetRange() isPartitionRange_Range {
@@ -2240,7 +4248,7 @@ type QRepWriteMode struct {
 func (x *QRepWriteMode) Reset() {
 	*x = QRepWriteMode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[33]
+		mi := &file_flow_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2253,7 +4261,7 @@ func (x *QRepWriteMode) String() string {
 func (*QRepWriteMode) ProtoMessage() {}
 
 func (x *QRepWriteMode) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[33]
+	mi := &file_flow_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2266,7 +4274,7 @@ func (x *QRepWriteMode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QRepWriteMode.ProtoReflect.Descriptor instead.
 func (*QRepWriteMode) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{33}
+	return file_flow_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *QRepWriteMode) GetWriteType() QRepWriteType {
@@ -2316,12 +4324,46 @@ type QRepConfig struct {
 	NumRowsPerPartition uint32 `protobuf:"varint,16,opt,name=num_rows_per_partition,json=numRowsPerPartition,proto3" json:"num_rows_per_partition,omitempty"`
 	// Creates the watermark table on the destination as-is, can be used for some queries.
 	SetupWatermarkTableOnDestination bool `protobuf:"varint,17,opt,name=setup_watermark_table_on_destination,json=setupWatermarkTableOnDestination,proto3" json:"setup_watermark_table_on_destination,omitempty"`
+	// Number of times a partition is pulled again after a transient source error
+	// (dropped connection, timeout) before the activity fails, defaults to 3.
+	MaxTransientPullRetries uint32 `protobuf:"varint,18,opt,name=max_transient_pull_retries,json=maxTransientPullRetries,proto3" json:"max_transient_pull_retries,omitempty"`
+	// What to do with integer values that are out of range for their column, fails the sync by default.
+	IntRangePolicy IntRangePolicy `protobuf:"varint,19,opt,name=int_range_policy,json=intRangePolicy,proto3,enum=peerdb_flow.IntRangePolicy" json:"int_range_policy,omitempty"`
+	// Replicate postgres json columns as text, exactly as written.
+	JsonAsText bool `protobuf:"varint,20,opt,name=json_as_text,json=jsonAsText,proto3" json:"json_as_text,omitempty"`
+	// With sync_mode auto, rows of the first partition averaging more than this many bytes are synced
+	// through staged files and narrower rows are inserted directly, defaults to 1024.
+	AutoSyncModeRowSizeThresholdBytes uint32 `protobuf:"varint,21,opt,name=auto_sync_mode_row_size_threshold_bytes,json=autoSyncModeRowSizeThresholdBytes,proto3" json:"auto_sync_mode_row_size_threshold_bytes,omitempty"`
+	// Number of rows of the first partition sampled with sync_mode auto, defaults to 1000.
+	AutoSyncModeSampleRows uint32 `protobuf:"varint,22,opt,name=auto_sync_mode_sample_rows,json=autoSyncModeSampleRows,proto3" json:"auto_sync_mode_sample_rows,omitempty"`
+	// text values of these columns are rewritten in unicode NFC before syncing them.
+	NfcColumns []string `protobuf:"bytes,23,rep,name=nfc_columns,json=nfcColumns,proto3" json:"nfc_columns,omitempty"`
+	// Number of times consolidating the partitions is attempted again after a transient error
+	// (dropped connection, timeout) before the activity fails, defaults to 3.
+	MaxTransientConsolidateRetries uint32 `protobuf:"varint,24,opt,name=max_transient_consolidate_retries,json=maxTransientConsolidateRetries,proto3" json:"max_transient_consolidate_retries,omitempty"`
+	// Isolation level the source is read with, defaults to repeatable read.
+	SourceIsolationLevel SourceIsolationLevel `protobuf:"varint,25,opt,name=source_isolation_level,json=sourceIsolationLevel,proto3,enum=peerdb_flow.SourceIsolationLevel" json:"source_isolation_level,omitempty"`
+	// Format of the files staged by the storage sync mode, only used by Snowflake. Defaults to Avro.
+	StagingFileFormat StagingFileFormat `protobuf:"varint,26,opt,name=staging_file_format,json=stagingFileFormat,proto3,enum=peerdb_flow.StagingFileFormat" json:"staging_file_format,omitempty"`
+	// Replicate postgres range columns in their postgres text form instead of as json objects.
+	RangesAsText bool `protobuf:"varint,27,opt,name=ranges_as_text,json=rangesAsText,proto3" json:"ranges_as_text,omitempty"`
+	// What to do with a source table that has no rows to replicate on the first run, defaults to
+	// syncing an empty partition that creates a missing snowflake or postgres destination table.
+	EmptySourceTablePolicy EmptySourceTablePolicy `protobuf:"varint,28,opt,name=empty_source_table_policy,json=emptySourceTablePolicy,proto3,enum=peerdb_flow.EmptySourceTablePolicy" json:"empty_source_table_policy,omitempty"`
+	// Caps the source and destination connectors held open at once by the partitions replicating from
+	// or to the same peers on a worker, across mirrors, 0 means no cap. This limits connectors, not the
+	// connections each opens. Mirrors with different caps share one limit per peer and side, the cap of
+	// the last partition to start applies. Partitions wait for a free connector before opening theirs.
+	// Each still opens its own source connector, so the partitions of a snapshot keep reading in the
+	// exported snapshot.
+	MaxSourceConnections      uint32 `protobuf:"varint,29,opt,name=max_source_connections,json=maxSourceConnections,proto3" json:"max_source_connections,omitempty"`
+	MaxDestinationConnections uint32 `protobuf:"varint,30,opt,name=max_destination_connections,json=maxDestinationConnections,proto3" json:"max_destination_connections,omitempty"`
 }
 
 func (x *QRepConfig) Reset() {
 	*x = QRepConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[34]
+		mi := &file_flow_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2334,7 +4376,7 @@ func (x *QRepConfig) String() string {
 func (*QRepConfig) ProtoMessage() {}
 
 func (x *QRepConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[34]
+	mi := &file_flow_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2347,7 +4389,7 @@ func (x *QRepConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QRepConfig.ProtoReflect.Descriptor instead.
 func (*QRepConfig) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{34}
+	return file_flow_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *QRepConfig) GetFlowJobName() string {
@@ -2366,107 +4408,198 @@ func (x *QRepConfig) GetSourcePeer() *Peer {
 
 func (x *QRepConfig) GetDestinationPeer() *Peer {
 	if x != nil {
-		return x.DestinationPeer
+		return x.DestinationPeer
+	}
+	return nil
+}
+
+func (x *QRepConfig) GetDestinationTableIdentifier() string {
+	if x != nil {
+		return x.DestinationTableIdentifier
+	}
+	return ""
+}
+
+func (x *QRepConfig) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *QRepConfig) GetWatermarkTable() string {
+	if x != nil {
+		return x.WatermarkTable
+	}
+	return ""
+}
+
+func (x *QRepConfig) GetWatermarkColumn() string {
+	if x != nil {
+		return x.WatermarkColumn
+	}
+	return ""
+}
+
+func (x *QRepConfig) GetInitialCopyOnly() bool {
+	if x != nil {
+		return x.InitialCopyOnly
+	}
+	return false
+}
+
+func (x *QRepConfig) GetSyncMode() QRepSyncMode {
+	if x != nil {
+		return x.SyncMode
+	}
+	return QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT
+}
+
+func (x *QRepConfig) GetBatchSizeInt() uint32 {
+	if x != nil {
+		return x.BatchSizeInt
+	}
+	return 0
+}
+
+func (x *QRepConfig) GetBatchDurationSeconds() uint32 {
+	if x != nil {
+		return x.BatchDurationSeconds
+	}
+	return 0
+}
+
+func (x *QRepConfig) GetMaxParallelWorkers() uint32 {
+	if x != nil {
+		return x.MaxParallelWorkers
+	}
+	return 0
+}
+
+func (x *QRepConfig) GetWaitBetweenBatchesSeconds() uint32 {
+	if x != nil {
+		return x.WaitBetweenBatchesSeconds
+	}
+	return 0
+}
+
+func (x *QRepConfig) GetWriteMode() *QRepWriteMode {
+	if x != nil {
+		return x.WriteMode
+	}
+	return nil
+}
+
+func (x *QRepConfig) GetStagingPath() string {
+	if x != nil {
+		return x.StagingPath
 	}
-	return nil
+	return ""
 }
 
-func (x *QRepConfig) GetDestinationTableIdentifier() string {
+func (x *QRepConfig) GetNumRowsPerPartition() uint32 {
 	if x != nil {
-		return x.DestinationTableIdentifier
+		return x.NumRowsPerPartition
 	}
-	return ""
+	return 0
 }
 
-func (x *QRepConfig) GetQuery() string {
+func (x *QRepConfig) GetSetupWatermarkTableOnDestination() bool {
 	if x != nil {
-		return x.Query
+		return x.SetupWatermarkTableOnDestination
 	}
-	return ""
+	return false
 }
 
-func (x *QRepConfig) GetWatermarkTable() string {
+func (x *QRepConfig) GetMaxTransientPullRetries() uint32 {
 	if x != nil {
-		return x.WatermarkTable
+		return x.MaxTransientPullRetries
 	}
-	return ""
+	return 0
 }
 
-func (x *QRepConfig) GetWatermarkColumn() string {
+func (x *QRepConfig) GetIntRangePolicy() IntRangePolicy {
 	if x != nil {
-		return x.WatermarkColumn
+		return x.IntRangePolicy
 	}
-	return ""
+	return IntRangePolicy_INT_RANGE_POLICY_ERROR
 }
 
-func (x *QRepConfig) GetInitialCopyOnly() bool {
+func (x *QRepConfig) GetJsonAsText() bool {
 	if x != nil {
-		return x.InitialCopyOnly
+		return x.JsonAsText
 	}
 	return false
 }
 
-func (x *QRepConfig) GetSyncMode() QRepSyncMode {
+func (x *QRepConfig) GetAutoSyncModeRowSizeThresholdBytes() uint32 {
 	if x != nil {
-		return x.SyncMode
+		return x.AutoSyncModeRowSizeThresholdBytes
 	}
-	return QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT
+	return 0
 }
 
-func (x *QRepConfig) GetBatchSizeInt() uint32 {
+func (x *QRepConfig) GetAutoSyncModeSampleRows() uint32 {
 	if x != nil {
-		return x.BatchSizeInt
+		return x.AutoSyncModeSampleRows
 	}
 	return 0
 }
 
-func (x *QRepConfig) GetBatchDurationSeconds() uint32 {
+func (x *QRepConfig) GetNfcColumns() []string {
 	if x != nil {
-		return x.BatchDurationSeconds
+		return x.NfcColumns
 	}
-	return 0
+	return nil
 }
 
-func (x *QRepConfig) GetMaxParallelWorkers() uint32 {
+func (x *QRepConfig) GetMaxTransientConsolidateRetries() uint32 {
 	if x != nil {
-		return x.MaxParallelWorkers
+		return x.MaxTransientConsolidateRetries
 	}
 	return 0
 }
 
-func (x *QRepConfig) GetWaitBetweenBatchesSeconds() uint32 {
+func (x *QRepConfig) GetSourceIsolationLevel() SourceIsolationLevel {
 	if x != nil {
-		return x.WaitBetweenBatchesSeconds
+		return x.SourceIsolationLevel
 	}
-	return 0
+	return SourceIsolationLevel_SOURCE_ISOLATION_LEVEL_REPEATABLE_READ
 }
 
-func (x *QRepConfig) GetWriteMode() *QRepWriteMode {
+func (x *QRepConfig) GetStagingFileFormat() StagingFileFormat {
 	if x != nil {
-		return x.WriteMode
+		return x.StagingFileFormat
 	}
-	return nil
+	return StagingFileFormat_STAGING_FILE_FORMAT_AVRO
 }
 
-func (x *QRepConfig) GetStagingPath() string {
+func (x *QRepConfig) GetRangesAsText() bool {
 	if x != nil {
-		return x.StagingPath
+		return x.RangesAsText
 	}
-	return ""
+	return false
 }
 
-func (x *QRepConfig) GetNumRowsPerPartition() uint32 {
+func (x *QRepConfig) GetEmptySourceTablePolicy() EmptySourceTablePolicy {
 	if x != nil {
-		return x.NumRowsPerPartition
+		return x.EmptySourceTablePolicy
+	}
+	return EmptySourceTablePolicy_EMPTY_SOURCE_TABLE_POLICY_CREATE_DESTINATION_TABLE
+}
+
+func (x *QRepConfig) GetMaxSourceConnections() uint32 {
+	if x != nil {
+		return x.MaxSourceConnections
 	}
 	return 0
 }
 
-func (x *QRepConfig) GetSetupWatermarkTableOnDestination() bool {
+func (x *QRepConfig) GetMaxDestinationConnections() uint32 {
 	if x != nil {
-		return x.SetupWatermarkTableOnDestination
+		return x.MaxDestinationConnections
 	}
-	return false
+	return 0
 }
 
 type QRepPartition struct {
@@ -2477,12 +4610,14 @@ type QRepPartition struct {
 	PartitionId        string          `protobuf:"bytes,2,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
 	Range              *PartitionRange `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
 	FullTablePartition bool            `protobuf:"varint,4,opt,name=full_table_partition,json=fullTablePartition,proto3" json:"full_table_partition,omitempty"`
+	// partition of a source table without rows, only the schema of the query is read for it.
+	SchemaOnly bool `protobuf:"varint,5,opt,name=schema_only,json=schemaOnly,proto3" json:"schema_only,omitempty"`
 }
 
 func (x *QRepPartition) Reset() {
 	*x = QRepPartition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[35]
+		mi := &file_flow_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2495,7 +4630,7 @@ func (x *QRepPartition) String() string {
 func (*QRepPartition) ProtoMessage() {}
 
 func (x *QRepPartition) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[35]
+	mi := &file_flow_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2508,7 +4643,7 @@ func (x *QRepPartition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QRepPartition.ProtoReflect.Descriptor instead.
 func (*QRepPartition) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{35}
+	return file_flow_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *QRepPartition) GetPartitionId() string {
@@ -2532,6 +4667,13 @@ func (x *QRepPartition) GetFullTablePartition() bool {
 	return false
 }
 
+func (x *QRepPartition) GetSchemaOnly() bool {
+	if x != nil {
+		return x.SchemaOnly
+	}
+	return false
+}
+
 type QRepPartitionBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2544,7 +4686,7 @@ type QRepPartitionBatch struct {
 func (x *QRepPartitionBatch) Reset() {
 	*x = QRepPartitionBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[36]
+		mi := &file_flow_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2557,7 +4699,7 @@ func (x *QRepPartitionBatch) String() string {
 func (*QRepPartitionBatch) ProtoMessage() {}
 
 func (x *QRepPartitionBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[36]
+	mi := &file_flow_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2570,7 +4712,7 @@ func (x *QRepPartitionBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QRepPartitionBatch.ProtoReflect.Descriptor instead.
 func (*QRepPartitionBatch) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{36}
+	return file_flow_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *QRepPartitionBatch) GetBatchId() int32 {
@@ -2598,7 +4740,7 @@ type QRepParitionResult struct {
 func (x *QRepParitionResult) Reset() {
 	*x = QRepParitionResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[37]
+		mi := &file_flow_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2611,7 +4753,7 @@ func (x *QRepParitionResult) String() string {
 func (*QRepParitionResult) ProtoMessage() {}
 
 func (x *QRepParitionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[37]
+	mi := &file_flow_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2624,7 +4766,7 @@ func (x *QRepParitionResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QRepParitionResult.ProtoReflect.Descriptor instead.
 func (*QRepParitionResult) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{37}
+	return file_flow_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *QRepParitionResult) GetPartitions() []*QRepPartition {
@@ -2645,7 +4787,7 @@ type DropFlowInput struct {
 func (x *DropFlowInput) Reset() {
 	*x = DropFlowInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[38]
+		mi := &file_flow_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2658,7 +4800,7 @@ func (x *DropFlowInput) String() string {
 func (*DropFlowInput) ProtoMessage() {}
 
 func (x *DropFlowInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[38]
+	mi := &file_flow_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2671,7 +4813,7 @@ func (x *DropFlowInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropFlowInput.ProtoReflect.Descriptor instead.
 func (*DropFlowInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{38}
+	return file_flow_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *DropFlowInput) GetFlowName() string {
@@ -2693,7 +4835,7 @@ type DeltaAddedColumn struct {
 func (x *DeltaAddedColumn) Reset() {
 	*x = DeltaAddedColumn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[39]
+		mi := &file_flow_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2706,7 +4848,7 @@ func (x *DeltaAddedColumn) String() string {
 func (*DeltaAddedColumn) ProtoMessage() {}
 
 func (x *DeltaAddedColumn) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[39]
+	mi := &file_flow_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2719,7 +4861,7 @@ func (x *DeltaAddedColumn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeltaAddedColumn.ProtoReflect.Descriptor instead.
 func (*DeltaAddedColumn) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{39}
+	return file_flow_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *DeltaAddedColumn) GetColumnName() string {
@@ -2749,7 +4891,7 @@ type TableSchemaDelta struct {
 func (x *TableSchemaDelta) Reset() {
 	*x = TableSchemaDelta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[40]
+		mi := &file_flow_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2762,7 +4904,7 @@ func (x *TableSchemaDelta) String() string {
 func (*TableSchemaDelta) ProtoMessage() {}
 
 func (x *TableSchemaDelta) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[40]
+	mi := &file_flow_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2775,7 +4917,7 @@ func (x *TableSchemaDelta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableSchemaDelta.ProtoReflect.Descriptor instead.
 func (*TableSchemaDelta) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{40}
+	return file_flow_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *TableSchemaDelta) GetSrcTableName() string {
@@ -2811,7 +4953,7 @@ type ReplayTableSchemaDeltaInput struct {
 func (x *ReplayTableSchemaDeltaInput) Reset() {
 	*x = ReplayTableSchemaDeltaInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flow_proto_msgTypes[41]
+		mi := &file_flow_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2824,7 +4966,7 @@ func (x *ReplayTableSchemaDeltaInput) String() string {
 func (*ReplayTableSchemaDeltaInput) ProtoMessage() {}
 
 func (x *ReplayTableSchemaDeltaInput) ProtoReflect() protoreflect.Message {
-	mi := &file_flow_proto_msgTypes[41]
+	mi := &file_flow_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2837,7 +4979,7 @@ func (x *ReplayTableSchemaDeltaInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplayTableSchemaDeltaInput.ProtoReflect.Descriptor instead.
 func (*ReplayTableSchemaDeltaInput) Descriptor() ([]byte, []int) {
-	return file_flow_proto_rawDescGZIP(), []int{41}
+	return file_flow_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ReplayTableSchemaDeltaInput) GetFlowConnectionConfigs() *FlowConnectionConfigs {
@@ -2874,7 +5016,7 @@ var file_flow_proto_rawDesc = []byte{
 	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x22, 0x95, 0x01,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x22, 0xd0, 0x01,
 	0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
@@ -2884,121 +5026,356 @@ var file_flow_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64,
 	0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x07, 0x63, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x0c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x40,
-	0x0a, 0x1c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0xce, 0x0b, 0x0a, 0x15, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12,
-	0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x50,
-	0x65, 0x65, 0x72, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e,
-	0x50, 0x65, 0x65, 0x72, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x77, 0x4a, 0x6f,
-	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x65,
-	0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65,
-	0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x73, 0x12, 0x77, 0x0a, 0x19, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62,
-	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x72, 0x63, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x73, 0x72, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x79, 0x0a,
-	0x19, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x3e, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x65, 0x64,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6c,
+	0x73, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x65,
+	0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x73, 0x6e,
+	0x22, 0xe4, 0x01, 0x0a, 0x0c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x1c, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x77, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x72, 0x61, 0x77, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x9d, 0x27, 0x0a, 0x15, 0x46, 0x6c, 0x6f, 0x77,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x34, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6a, 0x6f, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x77,
+	0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x77, 0x0a, 0x19, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x72,
+	0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x73, 0x72, 0x63, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12,
+	0x79, 0x0a, 0x19, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x16, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x0d, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x50,
+	0x65, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x6f, 0x5f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x64, 0x6f, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x70,
+	0x79, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x1f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x6f, 0x77,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e,
+	0x75, 0x6d, 0x52, 0x6f, 0x77, 0x73, 0x50, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x1d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x1f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f,
+	0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x75, 0x6d, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x49, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x12, 0x47, 0x0a, 0x12, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62,
+	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x10, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x79, 0x6e, 0x63,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x64, 0x63, 0x5f, 0x73, 0x79, 0x6e, 0x63,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x53, 0x79,
+	0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x63, 0x64, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x64, 0x63, 0x5f, 0x73,
+	0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x64, 0x63, 0x53, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c,
+	0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x70, 0x75, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69,
+	0x73, 0x6d, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x50, 0x61,
+	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x3e, 0x0a, 0x1b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x6e, 0x75, 0x6c,
+	0x6c, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x6e, 0x75,
+	0x6c, 0x6c, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x5f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x61, 0x77, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x6e, 0x75,
+	0x6c, 0x6c, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x41, 0x73, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x2d, 0x0a, 0x13,
+	0x72, 0x61, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x73, 0x5f, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x61, 0x77, 0x44, 0x61,
+	0x74, 0x61, 0x41, 0x73, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x73, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x73, 0x6e, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6e, 0x5f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x72, 0x79, 0x4e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x1f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c,
+	0x6d, 0x61, 0x78, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x19,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x17, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x6c, 0x61, 0x67, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x61,
+	0x67, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x73, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e,
+	0x41, 0x73, 0x54, 0x65, 0x78, 0x74, 0x12, 0x70, 0x0a, 0x1f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x6f, 0x74, 0x68, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x29, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x6f, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x1c, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x6f, 0x74, 0x68, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3e, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f,
+	0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18,
+	0x6d, 0x61, 0x78, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6c, 0x73, 0x6e, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x26, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x73, 0x6e, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x5b, 0x0a, 0x18, 0x72, 0x69, 0x73, 0x6b, 0x79, 0x5f, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x27, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x79, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x15, 0x72, 0x69, 0x73, 0x6b,
+	0x79, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x28,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x50, 0x75, 0x6c, 0x6c, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x5a, 0x0a, 0x17,
+	0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x70,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x74, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x15, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x74,
+	0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x13, 0x64, 0x65, 0x64, 0x75,
+	0x70, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x73, 0x6e, 0x18,
+	0x2a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65, 0x64, 0x75, 0x70, 0x42, 0x79, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x73, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x2b, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x66, 0x63, 0x5f, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x6e, 0x66, 0x63, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x62, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x2e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46,
 	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x73, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x16, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x50,
-	0x65, 0x65, 0x72, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x50, 0x65, 0x65,
-	0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x6f, 0x5f, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x64, 0x6f, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x70, 0x79, 0x12,
-	0x29, 0x0a, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x5f,
-	0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x1b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x75, 0x6d,
-	0x52, 0x6f, 0x77, 0x73, 0x50, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x41, 0x0a, 0x1d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6d, 0x61, 0x78,
-	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x4d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x57, 0x6f, 0x72, 0x6b,
-	0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x1f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
-	0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x61,
-	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x75, 0x6d, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x49,
-	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x12, 0x47, 0x0a, 0x12, 0x73, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66,
-	0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65,
-	0x52, 0x10, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f,
-	0x64, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x64, 0x63, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d,
-	0x6f, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65, 0x72,
-	0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x53, 0x79, 0x6e, 0x63,
-	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x63, 0x64, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64,
-	0x65, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x73, 0x74,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x13, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x67, 0x69, 0x6e,
-	0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x64, 0x63, 0x5f, 0x73, 0x74, 0x61,
-	0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x63, 0x64, 0x63, 0x53, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x13, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70,
-	0x75, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x10,
-	0x70, 0x75, 0x73, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d,
-	0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x50, 0x61, 0x72, 0x61,
-	0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x1a, 0x48, 0x0a, 0x1a, 0x53, 0x72, 0x63, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x63, 0x0a, 0x1b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x66, 0x69, 0x67, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x2f, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x1b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x30, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x19, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x31, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x6f, 0x6e, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x33, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x4f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x51, 0x0a, 0x14, 0x64, 0x65, 0x64, 0x75, 0x70,
+	0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x73, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x34, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x44, 0x65, 0x64, 0x75, 0x70, 0x4e, 0x75, 0x6c, 0x6c, 0x73, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x64, 0x65, 0x64, 0x75, 0x70, 0x4e, 0x75, 0x6c,
+	0x6c, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x3d, 0x0a, 0x1b, 0x6d, 0x61,
+	0x78, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x18, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x18, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x16, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x41, 0x0a, 0x1d, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x5f, 0x72, 0x61,
+	0x77, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x73, 0x18, 0x37, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x1a, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x64, 0x52, 0x61, 0x77, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x38, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x41, 0x73, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x68, 0x0a, 0x1d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64,
+	0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x44, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x19, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65,
+	0x72, 0x73, 0x18, 0x3a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x13,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x4e,
+	0x75, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x11, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x31, 0x0a, 0x15,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12,
+	0x61, 0x0a, 0x1a, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x3d, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x17, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x61, 0x0a, 0x1a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x3e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x17, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70,
+	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x4f, 0x70, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x45, 0x0a, 0x1f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x40,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x61,
+	0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x4f, 0x0a, 0x24, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x41, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x21, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x61, 0x0a, 0x1a, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x42, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62,
+	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x17, 0x75,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61,
+	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x73, 0x18, 0x43, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72,
+	0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x52, 0x61, 0x77, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x73, 0x12,
+	0x32, 0x0a, 0x15, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x44, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x45, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x70, 0x72, 0x65, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f,
+	0x73, 0x71, 0x6c, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x4e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x71, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x71, 0x6c,
+	0x18, 0x47, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x4e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x71, 0x6c, 0x12, 0x45, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x48, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x0e, 0x69, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x3b, 0x0a, 0x1a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x49, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x17, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x49, 0x64, 0x6c, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x18,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x16, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x73, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x1a, 0x48, 0x0a, 0x1a, 0x53, 0x72, 0x63,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x63, 0x0a, 0x1b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
 	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8d, 0x02, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x46,
-	0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61,
-	0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x72, 0x0a, 0x18, 0x72, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x65,
-	0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x6c,
-	0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x67, 0x0a,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x69, 0x0a, 0x1e,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xfc, 0x02, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63,
+	0x46, 0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x72, 0x0a, 0x18, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70,
+	0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x46,
+	0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x42,
+	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62,
+	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x66, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64, 0x65,
+	0x66, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x1a, 0x67, 0x0a,
 	0x1b, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
 	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32,
@@ -3073,7 +5450,7 @@ var file_flow_proto_rawDesc = []byte{
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65,
 	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x1b, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x50,
+	0x66, 0x69, 0x65, 0x72, 0x22, 0xb7, 0x02, 0x0a, 0x1b, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x50,
 	0x75, 0x6c, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49,
 	0x6e, 0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
 	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
@@ -3085,380 +5462,708 @@ var file_flow_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x61, 0x62,
 	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x03,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x30, 0x0a, 0x17,
-	0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x89,
-	0x01, 0x0a, 0x0f, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x12, 0x62, 0x0a, 0x19, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x5f, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66,
-	0x6c, 0x6f, 0x77, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00, 0x52, 0x17, 0x70,
-	0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x12, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x62, 0x0a, 0x17, 0x45, 0x6e,
-	0x73, 0x75, 0x72, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x47, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0f, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x88,
-	0x02, 0x0a, 0x1c, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
-	0x7f, 0x0a, 0x18, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x45, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x70, 0x0a, 0x1f,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x5f, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x1c, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x30,
+	0x0a, 0x17, 0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x65, 0x6c, 0x49, 0x64,
+	0x22, 0x89, 0x01, 0x0a, 0x0f, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x19, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73,
+	0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62,
+	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x17, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x12, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x62, 0x0a, 0x17,
 	0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x1a, 0x67, 0x0a, 0x1b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9a, 0x04, 0x0a, 0x15, 0x53, 0x65,
+	0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x47, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x22, 0x88, 0x02, 0x0a, 0x1c, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x7f, 0x0a, 0x18, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x1a, 0x67, 0x0a, 0x1b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb7, 0x04, 0x0a, 0x15,
+	0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x14, 0x70, 0x65, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x77, 0x4a, 0x6f, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x66, 0x0a, 0x12, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x65,
 	0x74, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65,
-	0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x14, 0x70, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x0a,
-	0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x77, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x66, 0x0a, 0x12, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
-	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
-	0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x65, 0x74, 0x75,
-	0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x3d, 0x0a, 0x10, 0x64, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65,
-	0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x6f, 0x5f, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0d, 0x64, 0x6f, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x70, 0x79,
-	0x12, 0x3a, 0x0a, 0x19, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x1e,
-	0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x1a, 0x43, 0x0a, 0x15, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52,
-	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6c, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0xed, 0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16, 0x70, 0x65,
+	0x70, 0x75, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x3d, 0x0a, 0x10, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x6f,
+	0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x6f, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f,
+	0x70, 0x79, 0x12, 0x3a, 0x0a, 0x19, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43,
+	0x0a, 0x1e, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x73, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x73, 0x6e,
+	0x1a, 0x43, 0x0a, 0x15, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x6c, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0xc1, 0x04, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x14, 0x70,
+	0x65, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6a, 0x6f, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x77,
+	0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x64, 0x0a, 0x12, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x3d, 0x0a,
+	0x0d, 0x63, 0x64, 0x63, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c,
+	0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x0b, 0x63, 0x64, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x61, 0x77, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x72, 0x61, 0x77, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x73, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x41, 0x73,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x43, 0x0a, 0x15, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09,
+	0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x41, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x61, 0x77, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xaa, 0x01, 0x0a, 0x17, 0x44, 0x72, 0x6f,
+	0x70, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x52, 0x61, 0x77, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x14, 0x70, 0x65, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c,
+	0x0a, 0x12, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x68,
+	0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x67, 0x72, 0x61, 0x63,
+	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x44, 0x0a, 0x18, 0x44, 0x72, 0x6f, 0x70, 0x53, 0x74, 0x61,
+	0x6c, 0x65, 0x52, 0x61, 0x77, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x61,
+	0x6c, 0x65, 0x52, 0x61, 0x77, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x0e, 0x52,
+	0x61, 0x77, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x7b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62,
+	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9e, 0x02, 0x0a, 0x0b, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x75, 0x6c,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x75, 0x6c, 0x6c, 0x1a, 0x3a,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x02, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62,
+	0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x14, 0x70, 0x65, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x20,
+	0x0a, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x73, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x41, 0x73, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c,
+	0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
+	0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x61, 0x73, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x41, 0x73, 0x54, 0x65, 0x78, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x7d, 0x0a, 0x19, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x63, 0x0a, 0x1b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xda, 0x01, 0x0a, 0x19, 0x53,
+	0x65, 0x74, 0x75, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64,
+	0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x14, 0x70, 0x65,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x48, 0x0a,
+	0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x65, 0x65,
+	0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x96, 0x09, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x75,
+	0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16, 0x70, 0x65,
 	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65,
 	0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x14,
 	0x70, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6a, 0x6f, 0x62,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c, 0x6f,
-	0x77, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x64, 0x0a, 0x12, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c,
-	0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x3d,
-	0x0a, 0x0d, 0x63, 0x64, 0x63, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66,
-	0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65,
-	0x52, 0x0b, 0x63, 0x64, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x1a, 0x43, 0x0a,
-	0x15, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x41, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x82, 0x01, 0x0a, 0x19, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64,
+	0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x16, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x4c, 0x61, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6a, 0x6f, 0x62,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c, 0x6f,
+	0x77, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x66, 0x74,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73,
+	0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x72, 0x61, 0x77,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x73, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x41,
+	0x73, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6c, 0x73, 0x6e, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x73, 0x6e, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x49, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e,
+	0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x68, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64,
+	0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x14, 0x64, 0x65, 0x64,
+	0x75, 0x70, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x73, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62,
+	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x44, 0x65, 0x64, 0x75, 0x70, 0x4e, 0x75, 0x6c, 0x6c, 0x73,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x64, 0x65, 0x64, 0x75, 0x70, 0x4e,
+	0x75, 0x6c, 0x6c, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x13,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x65, 0x72,
+	0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x4e,
+	0x75, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x11, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x24, 0x0a, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x1a, 0x63, 0x0a, 0x1b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x5e, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64,
+	0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xe7, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x5a, 0x0a, 0x17, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x15, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x40, 0x0a, 0x1c,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2a,
+	0x0a, 0x11, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x22, 0x67, 0x0a, 0x1c, 0x52, 0x65,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f,
+	0x77, 0x73, 0x5f, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12,
+	0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x49, 0x64, 0x22, 0xf9, 0x01, 0x0a, 0x12, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x64, 0x65,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61,
 	0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x9e, 0x02, 0x0a, 0x0b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x12, 0x3f, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79,
-	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
-	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x12, 0x37, 0x0a, 0x18, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x75, 0x6c, 0x6c, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65,
-	0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x14, 0x70, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a,
-	0x11, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x7d, 0x0a, 0x19, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x70, 0x65,
-	0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x16, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x63, 0x0a, 0x1b, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62,
-	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xda, 0x01, 0x0a,
-	0x19, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16, 0x70, 0x65,
-	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65,
-	0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x14,
-	0x70, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
-	0x48, 0x0a, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
-	0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xd4, 0x02, 0x0a, 0x1e, 0x53, 0x65,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x6c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x77,
+	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xb8, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x44, 0x4c, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x5f, 0x0a, 0x11, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64,
+	0x64, 0x6c, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x44, 0x4c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x64, 0x6c, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x64, 0x6c, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x42, 0x0a, 0x14, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x64, 0x6c, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6e, 0x0a, 0x1a, 0x53, 0x65,
 	0x74, 0x75, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x16,
-	0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
-	0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x52, 0x14, 0x70, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x82, 0x01, 0x0a, 0x19, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x70, 0x65, 0x65,
-	0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4e, 0x6f,
-	0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x16, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x63, 0x0a, 0x1b, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x65,
-	0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x6e, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x29,
-	0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x72,
-	0x65, 0x61, 0x64, 0x79, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0d, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
-	0x22, 0xe0, 0x01, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x12, 0x76, 0x0a, 0x14, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x78,
-	0x69, 0x73, 0x74, 0x73, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x44, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
-	0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x45, 0x0a, 0x17,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x11, 0x49, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64,
-	0x22, 0x79, 0x0a, 0x17, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a,
-	0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x4d, 0x0a, 0x03, 0x54,
-	0x49, 0x44, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x5f, 0x0a, 0x11, 0x54, 0x49,
-	0x44, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x65,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x1f, 0x53,
+	0x65, 0x74, 0x75, 0x70, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x76,
+	0x0a, 0x14, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x5f, 0x6d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x70,
+	0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70,
+	0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x12, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x45, 0x0a, 0x17, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a,
+	0x11, 0x49, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x79, 0x0a, 0x17, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x4d, 0x0a, 0x03, 0x54, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x22, 0x5f, 0x0a, 0x11, 0x54, 0x49, 0x44, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64,
+	0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x49, 0x44, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x22, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
 	0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x49, 0x44,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c,
-	0x6f, 0x77, 0x2e, 0x54, 0x49, 0x44, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x0e,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3d,
-	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
-	0x49, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4f, 0x0a,
-	0x0f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f,
-	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3d,
-	0x0a, 0x09, 0x74, 0x69, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
-	0x54, 0x49, 0x44, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x08, 0x74, 0x69, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x07, 0x0a,
-	0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x78, 0x0a, 0x0d, 0x51, 0x52, 0x65, 0x70, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x65,
-	0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79,
-	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
-	0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x22, 0xe6, 0x06, 0x0a, 0x0a, 0x51, 0x52, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x77, 0x4a, 0x6f, 0x62, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x65,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64,
-	0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x50, 0x65, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x69, 0x64, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x49, 0x44, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x08, 0x74,
+	0x69, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x22, 0x78, 0x0a, 0x0d, 0x51, 0x52, 0x65, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74,
+	0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0xad, 0x0d, 0x0a, 0x0a, 0x51,
+	0x52, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6c, 0x6f,
+	0x77, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x77, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a,
+	0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72,
-	0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x1c, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x27, 0x0a, 0x0f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d,
-	0x61, 0x72, 0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x74, 0x65,
-	0x72, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63,
-	0x6f, 0x70, 0x79, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x70, 0x79, 0x4f, 0x6e, 0x6c, 0x79, 0x12,
-	0x36, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
-	0x2e, 0x51, 0x52, 0x65, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x73,
-	0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x74, 0x12, 0x34, 0x0a,
-	0x16, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c,
-	0x6c, 0x65, 0x6c, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x62, 0x65,
-	0x74, 0x77, 0x65, 0x65, 0x6e, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x73, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x77, 0x61, 0x69,
-	0x74, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x53,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x65, 0x65,
-	0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x6f, 0x77, 0x73,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6e, 0x75, 0x6d, 0x52, 0x6f, 0x77, 0x73, 0x50, 0x65, 0x72,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x24, 0x73, 0x65, 0x74,
-	0x75, 0x70, 0x5f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x20, 0x73, 0x65, 0x74, 0x75, 0x70, 0x57, 0x61,
-	0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x44, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x51, 0x52,
-	0x65, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31,
-	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x12, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x12, 0x51, 0x52, 0x65, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64,
-	0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x50, 0x0a, 0x12, 0x51, 0x52, 0x65, 0x70, 0x50, 0x61, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x65, 0x65,
+	0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x65,
+	0x65, 0x72, 0x12, 0x3d, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
+	0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x65,
+	0x72, 0x12, 0x40, 0x0a, 0x1c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x61, 0x74,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x5f,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x61,
+	0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x2a, 0x0a,
+	0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x43, 0x6f, 0x70, 0x79, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70,
+	0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x53,
+	0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x69, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x5f, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78,
+	0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12,
+	0x3f, 0x0a, 0x1c, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x5f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x77, 0x61, 0x69, 0x74, 0x42, 0x65, 0x74, 0x77, 0x65,
+	0x65, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c,
+	0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x33,
+	0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x6e, 0x75, 0x6d, 0x52, 0x6f, 0x77, 0x73, 0x50, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x24, 0x73, 0x65, 0x74, 0x75, 0x70, 0x5f, 0x77, 0x61, 0x74,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x20, 0x73, 0x65, 0x74, 0x75, 0x70, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x45, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x65, 0x65,
+	0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
+	0x61, 0x73, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6a,
+	0x73, 0x6f, 0x6e, 0x41, 0x73, 0x54, 0x65, 0x78, 0x74, 0x12, 0x52, 0x0a, 0x27, 0x61, 0x75, 0x74,
+	0x6f, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x6f, 0x77, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x21, 0x61, 0x75, 0x74, 0x6f,
+	0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a,
+	0x1a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x66, 0x63,
+	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x66, 0x63, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x49, 0x0a, 0x21, 0x6d, 0x61,
+	0x78, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1e, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x16, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x73, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x14, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x4e,
+	0x0a, 0x13, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x65,
+	0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x11, 0x73, 0x74, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x41, 0x73,
+	0x54, 0x65, 0x78, 0x74, 0x12, 0x5e, 0x0a, 0x19, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62,
+	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x16, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x19, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x0d, 0x51,
+	0x52, 0x65, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x6b, 0x0a, 0x12, 0x51, 0x52, 0x65, 0x70, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x65, 0x65,
 	0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x50, 0x61, 0x72,
 	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x2c, 0x0a, 0x0d, 0x44, 0x72, 0x6f, 0x70, 0x46, 0x6c, 0x6f, 0x77, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x77, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x54, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x41, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x73,
-	0x72, 0x63, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x72, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x73, 0x74, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x65, 0x64,
-	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x44, 0x65, 0x6c,
-	0x74, 0x61, 0x41, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x0c, 0x61,
-	0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x1b,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x5a, 0x0a, 0x17, 0x66,
-	0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70,
-	0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-	0x52, 0x15, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x4d, 0x0a, 0x13, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c,
-	0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65,
-	0x6c, 0x74, 0x61, 0x52, 0x11, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x44, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x2a, 0x50, 0x0a, 0x0c, 0x51, 0x52, 0x65, 0x70, 0x53, 0x79,
-	0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x51, 0x52, 0x45, 0x50, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x49,
-	0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x51, 0x52, 0x45, 0x50, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47,
-	0x45, 0x5f, 0x41, 0x56, 0x52, 0x4f, 0x10, 0x01, 0x2a, 0x66, 0x0a, 0x0d, 0x51, 0x52, 0x65, 0x70,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x51, 0x52, 0x45,
-	0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x50, 0x50,
-	0x45, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x51, 0x52, 0x45, 0x50, 0x5f, 0x57, 0x52,
-	0x49, 0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54, 0x10,
-	0x01, 0x12, 0x1d, 0x0a, 0x19, 0x51, 0x52, 0x45, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f,
-	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02,
-	0x42, 0x76, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66,
-	0x6c, 0x6f, 0x77, 0x42, 0x09, 0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x64,
-	0x62, 0x46, 0x6c, 0x6f, 0x77, 0xca, 0x02, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x64, 0x62, 0x46, 0x6c,
-	0x6f, 0x77, 0xe2, 0x02, 0x16, 0x50, 0x65, 0x65, 0x72, 0x64, 0x62, 0x46, 0x6c, 0x6f, 0x77, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x50, 0x65,
-	0x65, 0x72, 0x64, 0x62, 0x46, 0x6c, 0x6f, 0x77, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x73, 0x22, 0x50, 0x0a, 0x12, 0x51, 0x52, 0x65, 0x70, 0x50, 0x61, 0x72, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
+	0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x51, 0x52, 0x65, 0x70, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2c, 0x0a, 0x0d, 0x44, 0x72, 0x6f, 0x70, 0x46, 0x6c, 0x6f, 0x77,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x77, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x41, 0x64, 0x64, 0x65, 0x64,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x24, 0x0a,
+	0x0e, 0x73, 0x72, 0x63, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x72, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x73, 0x74,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x41, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52,
+	0x0c, 0x61, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0xc8, 0x01,
+	0x0a, 0x1b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x5a, 0x0a,
+	0x17, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f,
+	0x77, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x52, 0x15, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x4d, 0x0a, 0x13, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x11, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x2a, 0x5d, 0x0a, 0x11, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a,
+	0x1c, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x51, 0x4c, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12,
+	0x26, 0x0a, 0x22, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x5f, 0x4a, 0x53, 0x4f, 0x4e,
+	0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x01, 0x2a, 0xb2, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x2c, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x4b,
+	0x45, 0x59, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x4f,
+	0x52, 0x44, 0x53, 0x10, 0x00, 0x12, 0x31, 0x0a, 0x2d, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59,
+	0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x59, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x52,
+	0x45, 0x43, 0x4f, 0x52, 0x44, 0x53, 0x10, 0x01, 0x12, 0x32, 0x0a, 0x2e, 0x50, 0x52, 0x49, 0x4d,
+	0x41, 0x52, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45, 0x4b, 0x45, 0x59, 0x5f, 0x4f, 0x4c, 0x44,
+	0x45, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x53, 0x10, 0x02, 0x2a, 0x63, 0x0a, 0x17,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x1f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10,
+	0x01, 0x2a, 0x8e, 0x01, 0x0a, 0x17, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x24, 0x0a,
+	0x20, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x5f, 0x53,
+	0x50, 0x4c, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x48, 0x41, 0x4c, 0x56,
+	0x45, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x4f, 0x55, 0x54, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x23, 0x0a,
+	0x1f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x5f, 0x53,
+	0x50, 0x4c, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x10, 0x02, 0x2a, 0x70, 0x0a, 0x19, 0x53, 0x79, 0x6e, 0x63, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x44, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x2b, 0x0a, 0x27, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x49, 0x44,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x4f, 0x56, 0x45, 0x52, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22,
+	0x53, 0x59, 0x4e, 0x43, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x49, 0x44, 0x5f, 0x43, 0x4f,
+	0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x10, 0x01, 0x2a, 0x89, 0x01, 0x0a, 0x16, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x24, 0x0a, 0x20, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x4c, 0x45,
+	0x4e, 0x47, 0x54, 0x48, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x44, 0x45, 0x46, 0x41,
+	0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46,
+	0x49, 0x45, 0x52, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e,
+	0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54,
+	0x48, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02,
+	0x2a, 0x5d, 0x0a, 0x15, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x74, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x43,
+	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x55, 0x50, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d,
+	0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x55, 0x50,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x45, 0x54, 0x55, 0x50, 0x10, 0x01, 0x2a,
+	0x5e, 0x0a, 0x15, 0x52, 0x69, 0x73, 0x6b, 0x79, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b,
+	0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x49, 0x53, 0x4b,
+	0x59, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x52,
+	0x49, 0x53, 0x4b, 0x59, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x4b, 0x45, 0x59,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x2a,
+	0xa6, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x29, 0x0a, 0x25, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x5f, 0x49, 0x44, 0x45, 0x4e,
+	0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x30, 0x0a, 0x2c, 0x52,
+	0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f,
+	0x4e, 0x4f, 0x54, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53,
+	0x45, 0x54, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x01, 0x12, 0x29, 0x0a,
+	0x25, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54,
+	0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0x41, 0x0a, 0x12, 0x44, 0x65, 0x64, 0x75,
+	0x70, 0x4e, 0x75, 0x6c, 0x6c, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x0a, 0x10, 0x44, 0x45, 0x44, 0x55, 0x50, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x4c, 0x41,
+	0x53, 0x54, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x44, 0x55, 0x50, 0x5f, 0x4e, 0x55,
+	0x4c, 0x4c, 0x53, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x01, 0x2a, 0x6b, 0x0a, 0x14, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x26, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49, 0x53,
+	0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x52, 0x45,
+	0x50, 0x45, 0x41, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x00, 0x12,
+	0x27, 0x0a, 0x23, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49, 0x53, 0x4f, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x41, 0x4c,
+	0x49, 0x5a, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x2a, 0x69, 0x0a, 0x0c, 0x51, 0x52, 0x65, 0x70,
+	0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x51, 0x52, 0x45, 0x50,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49,
+	0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x51, 0x52, 0x45,
+	0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52,
+	0x41, 0x47, 0x45, 0x5f, 0x41, 0x56, 0x52, 0x4f, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x51, 0x52,
+	0x45, 0x50, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x55, 0x54,
+	0x4f, 0x10, 0x02, 0x2a, 0x8f, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x46,
+	0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x54, 0x41,
+	0x47, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x41, 0x56, 0x52, 0x4f, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x47, 0x49,
+	0x4e, 0x47, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43,
+	0x53, 0x56, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x41, 0x47, 0x49, 0x4e, 0x47, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e, 0x44, 0x4a, 0x53,
+	0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x41, 0x47, 0x49, 0x4e, 0x47, 0x5f,
+	0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x51,
+	0x55, 0x45, 0x54, 0x10, 0x03, 0x2a, 0x74, 0x0a, 0x16, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x36, 0x0a, 0x32, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x4d, 0x50, 0x54, 0x59,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x01, 0x2a, 0x66, 0x0a, 0x0d, 0x51,
+	0x52, 0x65, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16,
+	0x51, 0x52, 0x45, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x51, 0x52, 0x45, 0x50,
+	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x50, 0x53, 0x45,
+	0x52, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x51, 0x52, 0x45, 0x50, 0x5f, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x10, 0x02, 0x2a, 0x63, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x4c, 0x41, 0x4d, 0x50, 0x10, 0x01, 0x12, 0x19, 0x0a,
+	0x15, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x02, 0x42, 0x76, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e,
+	0x70, 0x65, 0x65, 0x72, 0x64, 0x62, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x09, 0x46, 0x6c, 0x6f,
+	0x77, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58,
+	0xaa, 0x02, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x64, 0x62, 0x46, 0x6c, 0x6f, 0x77, 0xca, 0x02, 0x0a,
+	0x50, 0x65, 0x65, 0x72, 0x64, 0x62, 0x46, 0x6c, 0x6f, 0x77, 0xe2, 0x02, 0x16, 0x50, 0x65, 0x65,
+	0x72, 0x64, 0x62, 0x46, 0x6c, 0x6f, 0x77, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x64, 0x62, 0x46, 0x6c, 0x6f, 0x77,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3473,134 +6178,196 @@ func file_flow_proto_rawDescGZIP() []byte {
 	return file_flow_proto_rawDescData
 }
 
-var file_flow_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_flow_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_flow_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_flow_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_flow_proto_goTypes = []interface{}{
-	(QRepSyncMode)(0),                       // 0: peerdb_flow.QRepSyncMode
-	(QRepWriteType)(0),                      // 1: peerdb_flow.QRepWriteType
-	(*TableNameMapping)(nil),                // 2: peerdb_flow.TableNameMapping
-	(*RelationMessageColumn)(nil),           // 3: peerdb_flow.RelationMessageColumn
-	(*RelationMessage)(nil),                 // 4: peerdb_flow.RelationMessage
-	(*TableMapping)(nil),                    // 5: peerdb_flow.TableMapping
-	(*FlowConnectionConfigs)(nil),           // 6: peerdb_flow.FlowConnectionConfigs
-	(*SyncFlowOptions)(nil),                 // 7: peerdb_flow.SyncFlowOptions
-	(*NormalizeFlowOptions)(nil),            // 8: peerdb_flow.NormalizeFlowOptions
-	(*LastSyncState)(nil),                   // 9: peerdb_flow.LastSyncState
-	(*StartFlowInput)(nil),                  // 10: peerdb_flow.StartFlowInput
-	(*StartNormalizeInput)(nil),             // 11: peerdb_flow.StartNormalizeInput
-	(*GetLastSyncedIDInput)(nil),            // 12: peerdb_flow.GetLastSyncedIDInput
-	(*EnsurePullabilityInput)(nil),          // 13: peerdb_flow.EnsurePullabilityInput
-	(*EnsurePullabilityBatchInput)(nil),     // 14: peerdb_flow.EnsurePullabilityBatchInput
-	(*PostgresTableIdentifier)(nil),         // 15: peerdb_flow.PostgresTableIdentifier
-	(*TableIdentifier)(nil),                 // 16: peerdb_flow.TableIdentifier
-	(*EnsurePullabilityOutput)(nil),         // 17: peerdb_flow.EnsurePullabilityOutput
-	(*EnsurePullabilityBatchOutput)(nil),    // 18: peerdb_flow.EnsurePullabilityBatchOutput
-	(*SetupReplicationInput)(nil),           // 19: peerdb_flow.SetupReplicationInput
-	(*SetupReplicationOutput)(nil),          // 20: peerdb_flow.SetupReplicationOutput
-	(*CreateRawTableInput)(nil),             // 21: peerdb_flow.CreateRawTableInput
-	(*CreateRawTableOutput)(nil),            // 22: peerdb_flow.CreateRawTableOutput
-	(*TableSchema)(nil),                     // 23: peerdb_flow.TableSchema
-	(*GetTableSchemaBatchInput)(nil),        // 24: peerdb_flow.GetTableSchemaBatchInput
-	(*GetTableSchemaBatchOutput)(nil),       // 25: peerdb_flow.GetTableSchemaBatchOutput
-	(*SetupNormalizedTableInput)(nil),       // 26: peerdb_flow.SetupNormalizedTableInput
-	(*SetupNormalizedTableBatchInput)(nil),  // 27: peerdb_flow.SetupNormalizedTableBatchInput
-	(*SetupNormalizedTableOutput)(nil),      // 28: peerdb_flow.SetupNormalizedTableOutput
-	(*SetupNormalizedTableBatchOutput)(nil), // 29: peerdb_flow.SetupNormalizedTableBatchOutput
-	(*IntPartitionRange)(nil),               // 30: peerdb_flow.IntPartitionRange
-	(*TimestampPartitionRange)(nil),         // 31: peerdb_flow.TimestampPartitionRange
-	(*TID)(nil),                             // 32: peerdb_flow.TID
-	(*TIDPartitionRange)(nil),               // 33: peerdb_flow.TIDPartitionRange
-	(*PartitionRange)(nil),                  // 34: peerdb_flow.PartitionRange
-	(*QRepWriteMode)(nil),                   // 35: peerdb_flow.QRepWriteMode
-	(*QRepConfig)(nil),                      // 36: peerdb_flow.QRepConfig
-	(*QRepPartition)(nil),                   // 37: peerdb_flow.QRepPartition
-	(*QRepPartitionBatch)(nil),              // 38: peerdb_flow.QRepPartitionBatch
-	(*QRepParitionResult)(nil),              // 39: peerdb_flow.QRepParitionResult
-	(*DropFlowInput)(nil),                   // 40: peerdb_flow.DropFlowInput
-	(*DeltaAddedColumn)(nil),                // 41: peerdb_flow.DeltaAddedColumn
-	(*TableSchemaDelta)(nil),                // 42: peerdb_flow.TableSchemaDelta
-	(*ReplayTableSchemaDeltaInput)(nil),     // 43: peerdb_flow.ReplayTableSchemaDeltaInput
-	nil,                                     // 44: peerdb_flow.FlowConnectionConfigs.SrcTableIdNameMappingEntry
-	nil,                                     // 45: peerdb_flow.FlowConnectionConfigs.TableNameSchemaMappingEntry
-	nil,                                     // 46: peerdb_flow.SyncFlowOptions.RelationMessageMappingEntry
-	nil,                                     // 47: peerdb_flow.StartFlowInput.RelationMessageMappingEntry
-	nil,                                     // 48: peerdb_flow.EnsurePullabilityBatchOutput.TableIdentifierMappingEntry
-	nil,                                     // 49: peerdb_flow.SetupReplicationInput.TableNameMappingEntry
-	nil,                                     // 50: peerdb_flow.CreateRawTableInput.TableNameMappingEntry
-	nil,                                     // 51: peerdb_flow.TableSchema.ColumnsEntry
-	nil,                                     // 52: peerdb_flow.GetTableSchemaBatchOutput.TableNameSchemaMappingEntry
-	nil,                                     // 53: peerdb_flow.SetupNormalizedTableBatchInput.TableNameSchemaMappingEntry
-	nil,                                     // 54: peerdb_flow.SetupNormalizedTableBatchOutput.TableExistsMappingEntry
-	(*Peer)(nil),                            // 55: peerdb_peers.Peer
-	(*timestamppb.Timestamp)(nil),           // 56: google.protobuf.Timestamp
+	(VariantNullPolicy)(0),                  // 0: peerdb_flow.VariantNullPolicy
+	(PrimaryKeyVersionPolicy)(0),            // 1: peerdb_flow.PrimaryKeyVersionPolicy
+	(UnknownRecordTypePolicy)(0),            // 2: peerdb_flow.UnknownRecordTypePolicy
+	(MergeTimeoutSplitPolicy)(0),            // 3: peerdb_flow.MergeTimeoutSplitPolicy
+	(SyncBatchIDConflictPolicy)(0),          // 4: peerdb_flow.SyncBatchIDConflictPolicy
+	(IdentifierLengthPolicy)(0),             // 5: peerdb_flow.IdentifierLengthPolicy
+	(IncompleteSetupPolicy)(0),              // 6: peerdb_flow.IncompleteSetupPolicy
+	(RiskyPrimaryKeyPolicy)(0),              // 7: peerdb_flow.RiskyPrimaryKeyPolicy
+	(ReplicaIdentityNothingPolicy)(0),       // 8: peerdb_flow.ReplicaIdentityNothingPolicy
+	(DedupNullsOrdering)(0),                 // 9: peerdb_flow.DedupNullsOrdering
+	(SourceIsolationLevel)(0),               // 10: peerdb_flow.SourceIsolationLevel
+	(QRepSyncMode)(0),                       // 11: peerdb_flow.QRepSyncMode
+	(StagingFileFormat)(0),                  // 12: peerdb_flow.StagingFileFormat
+	(EmptySourceTablePolicy)(0),             // 13: peerdb_flow.EmptySourceTablePolicy
+	(QRepWriteType)(0),                      // 14: peerdb_flow.QRepWriteType
+	(IntRangePolicy)(0),                     // 15: peerdb_flow.IntRangePolicy
+	(*TableNameMapping)(nil),                // 16: peerdb_flow.TableNameMapping
+	(*RelationMessageColumn)(nil),           // 17: peerdb_flow.RelationMessageColumn
+	(*RelationMessage)(nil),                 // 18: peerdb_flow.RelationMessage
+	(*TableMapping)(nil),                    // 19: peerdb_flow.TableMapping
+	(*FlowConnectionConfigs)(nil),           // 20: peerdb_flow.FlowConnectionConfigs
+	(*SyncFlowOptions)(nil),                 // 21: peerdb_flow.SyncFlowOptions
+	(*NormalizeFlowOptions)(nil),            // 22: peerdb_flow.NormalizeFlowOptions
+	(*LastSyncState)(nil),                   // 23: peerdb_flow.LastSyncState
+	(*StartFlowInput)(nil),                  // 24: peerdb_flow.StartFlowInput
+	(*StartNormalizeInput)(nil),             // 25: peerdb_flow.StartNormalizeInput
+	(*GetLastSyncedIDInput)(nil),            // 26: peerdb_flow.GetLastSyncedIDInput
+	(*EnsurePullabilityInput)(nil),          // 27: peerdb_flow.EnsurePullabilityInput
+	(*EnsurePullabilityBatchInput)(nil),     // 28: peerdb_flow.EnsurePullabilityBatchInput
+	(*PostgresTableIdentifier)(nil),         // 29: peerdb_flow.PostgresTableIdentifier
+	(*TableIdentifier)(nil),                 // 30: peerdb_flow.TableIdentifier
+	(*EnsurePullabilityOutput)(nil),         // 31: peerdb_flow.EnsurePullabilityOutput
+	(*EnsurePullabilityBatchOutput)(nil),    // 32: peerdb_flow.EnsurePullabilityBatchOutput
+	(*SetupReplicationInput)(nil),           // 33: peerdb_flow.SetupReplicationInput
+	(*SetupReplicationOutput)(nil),          // 34: peerdb_flow.SetupReplicationOutput
+	(*CreateRawTableInput)(nil),             // 35: peerdb_flow.CreateRawTableInput
+	(*CreateRawTableOutput)(nil),            // 36: peerdb_flow.CreateRawTableOutput
+	(*DropStaleRawTablesInput)(nil),         // 37: peerdb_flow.DropStaleRawTablesInput
+	(*DropStaleRawTablesOutput)(nil),        // 38: peerdb_flow.DropStaleRawTablesOutput
+	(*RawTableColumn)(nil),                  // 39: peerdb_flow.RawTableColumn
+	(*GetRawTableSchemaOutput)(nil),         // 40: peerdb_flow.GetRawTableSchemaOutput
+	(*ColumnDefaults)(nil),                  // 41: peerdb_flow.ColumnDefaults
+	(*TableSchema)(nil),                     // 42: peerdb_flow.TableSchema
+	(*GetTableSchemaBatchInput)(nil),        // 43: peerdb_flow.GetTableSchemaBatchInput
+	(*GetTableSchemaBatchOutput)(nil),       // 44: peerdb_flow.GetTableSchemaBatchOutput
+	(*SetupNormalizedTableInput)(nil),       // 45: peerdb_flow.SetupNormalizedTableInput
+	(*SetupNormalizedTableBatchInput)(nil),  // 46: peerdb_flow.SetupNormalizedTableBatchInput
+	(*RebuildNormalizedTableInput)(nil),     // 47: peerdb_flow.RebuildNormalizedTableInput
+	(*RebuildNormalizedTableOutput)(nil),    // 48: peerdb_flow.RebuildNormalizedTableOutput
+	(*TypeFidelityResult)(nil),              // 49: peerdb_flow.TypeFidelityResult
+	(*GenerateDDLOutput)(nil),               // 50: peerdb_flow.GenerateDDLOutput
+	(*SetupNormalizedTableOutput)(nil),      // 51: peerdb_flow.SetupNormalizedTableOutput
+	(*SetupNormalizedTableBatchOutput)(nil), // 52: peerdb_flow.SetupNormalizedTableBatchOutput
+	(*IntPartitionRange)(nil),               // 53: peerdb_flow.IntPartitionRange
+	(*TimestampPartitionRange)(nil),         // 54: peerdb_flow.TimestampPartitionRange
+	(*TID)(nil),                             // 55: peerdb_flow.TID
+	(*TIDPartitionRange)(nil),               // 56: peerdb_flow.TIDPartitionRange
+	(*PartitionRange)(nil),                  // 57: peerdb_flow.PartitionRange
+	(*QRepWriteMode)(nil),                   // 58: peerdb_flow.QRepWriteMode
+	(*QRepConfig)(nil),                      // 59: peerdb_flow.QRepConfig
+	(*QRepPartition)(nil),                   // 60: peerdb_flow.QRepPartition
+	(*QRepPartitionBatch)(nil),              // 61: peerdb_flow.QRepPartitionBatch
+	(*QRepParitionResult)(nil),              // 62: peerdb_flow.QRepParitionResult
+	(*DropFlowInput)(nil),                   // 63: peerdb_flow.DropFlowInput
+	(*DeltaAddedColumn)(nil),                // 64: peerdb_flow.DeltaAddedColumn
+	(*TableSchemaDelta)(nil),                // 65: peerdb_flow.TableSchemaDelta
+	(*ReplayTableSchemaDeltaInput)(nil),     // 66: peerdb_flow.ReplayTableSchemaDeltaInput
+	nil,                                     // 67: peerdb_flow.FlowConnectionConfigs.SrcTableIdNameMappingEntry
+	nil,                                     // 68: peerdb_flow.FlowConnectionConfigs.TableNameSchemaMappingEntry
+	nil,                                     // 69: peerdb_flow.FlowConnectionConfigs.DestinationTagsEntry
+	nil,                                     // 70: peerdb_flow.FlowConnectionConfigs.DestinationColumnDefaultsEntry
+	nil,                                     // 71: peerdb_flow.SyncFlowOptions.RelationMessageMappingEntry
+	nil,                                     // 72: peerdb_flow.StartFlowInput.RelationMessageMappingEntry
+	nil,                                     // 73: peerdb_flow.EnsurePullabilityBatchOutput.TableIdentifierMappingEntry
+	nil,                                     // 74: peerdb_flow.SetupReplicationInput.TableNameMappingEntry
+	nil,                                     // 75: peerdb_flow.CreateRawTableInput.TableNameMappingEntry
+	nil,                                     // 76: peerdb_flow.CreateRawTableInput.TagsEntry
+	nil,                                     // 77: peerdb_flow.ColumnDefaults.DefaultsEntry
+	nil,                                     // 78: peerdb_flow.TableSchema.ColumnsEntry
+	nil,                                     // 79: peerdb_flow.GetTableSchemaBatchOutput.TableNameSchemaMappingEntry
+	nil,                                     // 80: peerdb_flow.SetupNormalizedTableBatchInput.TableNameSchemaMappingEntry
+	nil,                                     // 81: peerdb_flow.SetupNormalizedTableBatchInput.TagsEntry
+	nil,                                     // 82: peerdb_flow.SetupNormalizedTableBatchInput.ColumnDefaultsEntry
+	nil,                                     // 83: peerdb_flow.GenerateDDLOutput.TableDdlMappingEntry
+	nil,                                     // 84: peerdb_flow.SetupNormalizedTableBatchOutput.TableExistsMappingEntry
+	(*Peer)(nil),                            // 85: peerdb_peers.Peer
+	(*timestamppb.Timestamp)(nil),           // 86: google.protobuf.Timestamp
 }
 var file_flow_proto_depIdxs = []int32{
-	3,  // 0: peerdb_flow.RelationMessage.columns:type_name -> peerdb_flow.RelationMessageColumn
-	55, // 1: peerdb_flow.FlowConnectionConfigs.source:type_name -> peerdb_peers.Peer
-	55, // 2: peerdb_flow.FlowConnectionConfigs.destination:type_name -> peerdb_peers.Peer
-	23, // 3: peerdb_flow.FlowConnectionConfigs.table_schema:type_name -> peerdb_flow.TableSchema
-	5,  // 4: peerdb_flow.FlowConnectionConfigs.table_mappings:type_name -> peerdb_flow.TableMapping
-	44, // 5: peerdb_flow.FlowConnectionConfigs.src_table_id_name_mapping:type_name -> peerdb_flow.FlowConnectionConfigs.SrcTableIdNameMappingEntry
-	45, // 6: peerdb_flow.FlowConnectionConfigs.table_name_schema_mapping:type_name -> peerdb_flow.FlowConnectionConfigs.TableNameSchemaMappingEntry
-	55, // 7: peerdb_flow.FlowConnectionConfigs.metadata_peer:type_name -> peerdb_peers.Peer
-	0,  // 8: peerdb_flow.FlowConnectionConfigs.snapshot_sync_mode:type_name -> peerdb_flow.QRepSyncMode
-	0,  // 9: peerdb_flow.FlowConnectionConfigs.cdc_sync_mode:type_name -> peerdb_flow.QRepSyncMode
-	46, // 10: peerdb_flow.SyncFlowOptions.relation_message_mapping:type_name -> peerdb_flow.SyncFlowOptions.RelationMessageMappingEntry
-	56, // 11: peerdb_flow.LastSyncState.last_synced_at:type_name -> google.protobuf.Timestamp
-	9,  // 12: peerdb_flow.StartFlowInput.last_sync_state:type_name -> peerdb_flow.LastSyncState
-	6,  // 13: peerdb_flow.StartFlowInput.flow_connection_configs:type_name -> peerdb_flow.FlowConnectionConfigs
-	7,  // 14: peerdb_flow.StartFlowInput.sync_flow_options:type_name -> peerdb_flow.SyncFlowOptions
-	47, // 15: peerdb_flow.StartFlowInput.relation_message_mapping:type_name -> peerdb_flow.StartFlowInput.RelationMessageMappingEntry
-	6,  // 16: peerdb_flow.StartNormalizeInput.flow_connection_configs:type_name -> peerdb_flow.FlowConnectionConfigs
-	55, // 17: peerdb_flow.GetLastSyncedIDInput.peer_connection_config:type_name -> peerdb_peers.Peer
-	55, // 18: peerdb_flow.EnsurePullabilityInput.peer_connection_config:type_name -> peerdb_peers.Peer
-	55, // 19: peerdb_flow.EnsurePullabilityBatchInput.peer_connection_config:type_name -> peerdb_peers.Peer
-	15, // 20: peerdb_flow.TableIdentifier.postgres_table_identifier:type_name -> peerdb_flow.PostgresTableIdentifier
-	16, // 21: peerdb_flow.EnsurePullabilityOutput.table_identifier:type_name -> peerdb_flow.TableIdentifier
-	48, // 22: peerdb_flow.EnsurePullabilityBatchOutput.table_identifier_mapping:type_name -> peerdb_flow.EnsurePullabilityBatchOutput.TableIdentifierMappingEntry
-	55, // 23: peerdb_flow.SetupReplicationInput.peer_connection_config:type_name -> peerdb_peers.Peer
-	49, // 24: peerdb_flow.SetupReplicationInput.table_name_mapping:type_name -> peerdb_flow.SetupReplicationInput.TableNameMappingEntry
-	55, // 25: peerdb_flow.SetupReplicationInput.destination_peer:type_name -> peerdb_peers.Peer
-	55, // 26: peerdb_flow.CreateRawTableInput.peer_connection_config:type_name -> peerdb_peers.Peer
-	50, // 27: peerdb_flow.CreateRawTableInput.table_name_mapping:type_name -> peerdb_flow.CreateRawTableInput.TableNameMappingEntry
-	0,  // 28: peerdb_flow.CreateRawTableInput.cdc_sync_mode:type_name -> peerdb_flow.QRepSyncMode
-	51, // 29: peerdb_flow.TableSchema.columns:type_name -> peerdb_flow.TableSchema.ColumnsEntry
-	55, // 30: peerdb_flow.GetTableSchemaBatchInput.peer_connection_config:type_name -> peerdb_peers.Peer
-	52, // 31: peerdb_flow.GetTableSchemaBatchOutput.table_name_schema_mapping:type_name -> peerdb_flow.GetTableSchemaBatchOutput.TableNameSchemaMappingEntry
-	55, // 32: peerdb_flow.SetupNormalizedTableInput.peer_connection_config:type_name -> peerdb_peers.Peer
-	23, // 33: peerdb_flow.SetupNormalizedTableInput.source_table_schema:type_name -> peerdb_flow.TableSchema
-	55, // 34: peerdb_flow.SetupNormalizedTableBatchInput.peer_connection_config:type_name -> peerdb_peers.Peer
-	53, // 35: peerdb_flow.SetupNormalizedTableBatchInput.table_name_schema_mapping:type_name -> peerdb_flow.SetupNormalizedTableBatchInput.TableNameSchemaMappingEntry
-	54, // 36: peerdb_flow.SetupNormalizedTableBatchOutput.table_exists_mapping:type_name -> peerdb_flow.SetupNormalizedTableBatchOutput.TableExistsMappingEntry
-	56, // 37: peerdb_flow.TimestampPartitionRange.start:type_name -> google.protobuf.Timestamp
-	56, // 38: peerdb_flow.TimestampPartitionRange.end:type_name -> google.protobuf.Timestamp
-	32, // 39: peerdb_flow.TIDPartitionRange.start:type_name -> peerdb_flow.TID
-	32, // 40: peerdb_flow.TIDPartitionRange.end:type_name -> peerdb_flow.TID
-	30, // 41: peerdb_flow.PartitionRange.int_range:type_name -> peerdb_flow.IntPartitionRange
-	31, // 42: peerdb_flow.PartitionRange.timestamp_range:type_name -> peerdb_flow.TimestampPartitionRange
-	33, // 43: peerdb_flow.PartitionRange.tid_range:type_name -> peerdb_flow.TIDPartitionRange
-	1,  // 44: peerdb_flow.QRepWriteMode.write_type:type_name -> peerdb_flow.QRepWriteType
-	55, // 45: peerdb_flow.QRepConfig.source_peer:type_name -> peerdb_peers.Peer
-	55, // 46: peerdb_flow.QRepConfig.destination_peer:type_name -> peerdb_peers.Peer
-	0,  // 47: peerdb_flow.QRepConfig.sync_mode:type_name -> peerdb_flow.QRepSyncMode
-	35, // 48: peerdb_flow.QRepConfig.write_mode:type_name -> peerdb_flow.QRepWriteMode
-	34, // 49: peerdb_flow.QRepPartition.range:type_name -> peerdb_flow.PartitionRange
-	37, // 50: peerdb_flow.QRepPartitionBatch.partitions:type_name -> peerdb_flow.QRepPartition
-	37, // 51: peerdb_flow.QRepParitionResult.partitions:type_name -> peerdb_flow.QRepPartition
-	41, // 52: peerdb_flow.TableSchemaDelta.added_columns:type_name -> peerdb_flow.DeltaAddedColumn
-	6,  // 53: peerdb_flow.ReplayTableSchemaDeltaInput.flow_connection_configs:type_name -> peerdb_flow.FlowConnectionConfigs
-	42, // 54: peerdb_flow.ReplayTableSchemaDeltaInput.table_schema_deltas:type_name -> peerdb_flow.TableSchemaDelta
-	23, // 55: peerdb_flow.FlowConnectionConfigs.TableNameSchemaMappingEntry.value:type_name -> peerdb_flow.TableSchema
-	4,  // 56: peerdb_flow.SyncFlowOptions.RelationMessageMappingEntry.value:type_name -> peerdb_flow.RelationMessage
-	4,  // 57: peerdb_flow.StartFlowInput.RelationMessageMappingEntry.value:type_name -> peerdb_flow.RelationMessage
-	16, // 58: peerdb_flow.EnsurePullabilityBatchOutput.TableIdentifierMappingEntry.value:type_name -> peerdb_flow.TableIdentifier
-	23, // 59: peerdb_flow.GetTableSchemaBatchOutput.TableNameSchemaMappingEntry.value:type_name -> peerdb_flow.TableSchema
-	23, // 60: peerdb_flow.SetupNormalizedTableBatchInput.TableNameSchemaMappingEntry.value:type_name -> peerdb_flow.TableSchema
-	61, // [61:61] is the sub-list for method output_type
-	61, // [61:61] is the sub-list for method input_type
-	61, // [61:61] is the sub-list for extension type_name
-	61, // [61:61] is the sub-list for extension extendee
-	0,  // [0:61] is the sub-list for field type_name
+	17, // 0: peerdb_flow.RelationMessage.columns:type_name -> peerdb_flow.RelationMessageColumn
+	85, // 1: peerdb_flow.FlowConnectionConfigs.source:type_name -> peerdb_peers.Peer
+	85, // 2: peerdb_flow.FlowConnectionConfigs.destination:type_name -> peerdb_peers.Peer
+	42, // 3: peerdb_flow.FlowConnectionConfigs.table_schema:type_name -> peerdb_flow.TableSchema
+	19, // 4: peerdb_flow.FlowConnectionConfigs.table_mappings:type_name -> peerdb_flow.TableMapping
+	67, // 5: peerdb_flow.FlowConnectionConfigs.src_table_id_name_mapping:type_name -> peerdb_flow.FlowConnectionConfigs.SrcTableIdNameMappingEntry
+	68, // 6: peerdb_flow.FlowConnectionConfigs.table_name_schema_mapping:type_name -> peerdb_flow.FlowConnectionConfigs.TableNameSchemaMappingEntry
+	85, // 7: peerdb_flow.FlowConnectionConfigs.metadata_peer:type_name -> peerdb_peers.Peer
+	11, // 8: peerdb_flow.FlowConnectionConfigs.snapshot_sync_mode:type_name -> peerdb_flow.QRepSyncMode
+	11, // 9: peerdb_flow.FlowConnectionConfigs.cdc_sync_mode:type_name -> peerdb_flow.QRepSyncMode
+	8,  // 10: peerdb_flow.FlowConnectionConfigs.replica_identity_nothing_policy:type_name -> peerdb_flow.ReplicaIdentityNothingPolicy
+	7,  // 11: peerdb_flow.FlowConnectionConfigs.risky_primary_key_policy:type_name -> peerdb_flow.RiskyPrimaryKeyPolicy
+	6,  // 12: peerdb_flow.FlowConnectionConfigs.incomplete_setup_policy:type_name -> peerdb_flow.IncompleteSetupPolicy
+	69, // 13: peerdb_flow.FlowConnectionConfigs.destination_tags:type_name -> peerdb_flow.FlowConnectionConfigs.DestinationTagsEntry
+	70, // 14: peerdb_flow.FlowConnectionConfigs.destination_column_defaults:type_name -> peerdb_flow.FlowConnectionConfigs.DestinationColumnDefaultsEntry
+	9,  // 15: peerdb_flow.FlowConnectionConfigs.dedup_nulls_ordering:type_name -> peerdb_flow.DedupNullsOrdering
+	5,  // 16: peerdb_flow.FlowConnectionConfigs.identifier_length_policy:type_name -> peerdb_flow.IdentifierLengthPolicy
+	4,  // 17: peerdb_flow.FlowConnectionConfigs.sync_batch_id_conflict_policy:type_name -> peerdb_flow.SyncBatchIDConflictPolicy
+	0,  // 18: peerdb_flow.FlowConnectionConfigs.variant_null_policy:type_name -> peerdb_flow.VariantNullPolicy
+	3,  // 19: peerdb_flow.FlowConnectionConfigs.merge_timeout_split_policy:type_name -> peerdb_flow.MergeTimeoutSplitPolicy
+	1,  // 20: peerdb_flow.FlowConnectionConfigs.primary_key_version_policy:type_name -> peerdb_flow.PrimaryKeyVersionPolicy
+	2,  // 21: peerdb_flow.FlowConnectionConfigs.unknown_record_type_policy:type_name -> peerdb_flow.UnknownRecordTypePolicy
+	15, // 22: peerdb_flow.FlowConnectionConfigs.int_range_policy:type_name -> peerdb_flow.IntRangePolicy
+	10, // 23: peerdb_flow.FlowConnectionConfigs.snapshot_isolation_level:type_name -> peerdb_flow.SourceIsolationLevel
+	71, // 24: peerdb_flow.SyncFlowOptions.relation_message_mapping:type_name -> peerdb_flow.SyncFlowOptions.RelationMessageMappingEntry
+	23, // 25: peerdb_flow.SyncFlowOptions.last_sync_state:type_name -> peerdb_flow.LastSyncState
+	86, // 26: peerdb_flow.LastSyncState.last_synced_at:type_name -> google.protobuf.Timestamp
+	23, // 27: peerdb_flow.StartFlowInput.last_sync_state:type_name -> peerdb_flow.LastSyncState
+	20, // 28: peerdb_flow.StartFlowInput.flow_connection_configs:type_name -> peerdb_flow.FlowConnectionConfigs
+	21, // 29: peerdb_flow.StartFlowInput.sync_flow_options:type_name -> peerdb_flow.SyncFlowOptions
+	72, // 30: peerdb_flow.StartFlowInput.relation_message_mapping:type_name -> peerdb_flow.StartFlowInput.RelationMessageMappingEntry
+	20, // 31: peerdb_flow.StartNormalizeInput.flow_connection_configs:type_name -> peerdb_flow.FlowConnectionConfigs
+	85, // 32: peerdb_flow.GetLastSyncedIDInput.peer_connection_config:type_name -> peerdb_peers.Peer
+	85, // 33: peerdb_flow.EnsurePullabilityInput.peer_connection_config:type_name -> peerdb_peers.Peer
+	85, // 34: peerdb_flow.EnsurePullabilityBatchInput.peer_connection_config:type_name -> peerdb_peers.Peer
+	8,  // 35: peerdb_flow.EnsurePullabilityBatchInput.replica_identity_nothing_policy:type_name -> peerdb_flow.ReplicaIdentityNothingPolicy
+	29, // 36: peerdb_flow.TableIdentifier.postgres_table_identifier:type_name -> peerdb_flow.PostgresTableIdentifier
+	30, // 37: peerdb_flow.EnsurePullabilityOutput.table_identifier:type_name -> peerdb_flow.TableIdentifier
+	73, // 38: peerdb_flow.EnsurePullabilityBatchOutput.table_identifier_mapping:type_name -> peerdb_flow.EnsurePullabilityBatchOutput.TableIdentifierMappingEntry
+	85, // 39: peerdb_flow.SetupReplicationInput.peer_connection_config:type_name -> peerdb_peers.Peer
+	74, // 40: peerdb_flow.SetupReplicationInput.table_name_mapping:type_name -> peerdb_flow.SetupReplicationInput.TableNameMappingEntry
+	85, // 41: peerdb_flow.SetupReplicationInput.destination_peer:type_name -> peerdb_peers.Peer
+	85, // 42: peerdb_flow.CreateRawTableInput.peer_connection_config:type_name -> peerdb_peers.Peer
+	75, // 43: peerdb_flow.CreateRawTableInput.table_name_mapping:type_name -> peerdb_flow.CreateRawTableInput.TableNameMappingEntry
+	11, // 44: peerdb_flow.CreateRawTableInput.cdc_sync_mode:type_name -> peerdb_flow.QRepSyncMode
+	76, // 45: peerdb_flow.CreateRawTableInput.tags:type_name -> peerdb_flow.CreateRawTableInput.TagsEntry
+	85, // 46: peerdb_flow.DropStaleRawTablesInput.peer_connection_config:type_name -> peerdb_peers.Peer
+	39, // 47: peerdb_flow.GetRawTableSchemaOutput.columns:type_name -> peerdb_flow.RawTableColumn
+	77, // 48: peerdb_flow.ColumnDefaults.defaults:type_name -> peerdb_flow.ColumnDefaults.DefaultsEntry
+	78, // 49: peerdb_flow.TableSchema.columns:type_name -> peerdb_flow.TableSchema.ColumnsEntry
+	85, // 50: peerdb_flow.GetTableSchemaBatchInput.peer_connection_config:type_name -> peerdb_peers.Peer
+	79, // 51: peerdb_flow.GetTableSchemaBatchOutput.table_name_schema_mapping:type_name -> peerdb_flow.GetTableSchemaBatchOutput.TableNameSchemaMappingEntry
+	85, // 52: peerdb_flow.SetupNormalizedTableInput.peer_connection_config:type_name -> peerdb_peers.Peer
+	42, // 53: peerdb_flow.SetupNormalizedTableInput.source_table_schema:type_name -> peerdb_flow.TableSchema
+	85, // 54: peerdb_flow.SetupNormalizedTableBatchInput.peer_connection_config:type_name -> peerdb_peers.Peer
+	80, // 55: peerdb_flow.SetupNormalizedTableBatchInput.table_name_schema_mapping:type_name -> peerdb_flow.SetupNormalizedTableBatchInput.TableNameSchemaMappingEntry
+	81, // 56: peerdb_flow.SetupNormalizedTableBatchInput.tags:type_name -> peerdb_flow.SetupNormalizedTableBatchInput.TagsEntry
+	82, // 57: peerdb_flow.SetupNormalizedTableBatchInput.column_defaults:type_name -> peerdb_flow.SetupNormalizedTableBatchInput.ColumnDefaultsEntry
+	9,  // 58: peerdb_flow.SetupNormalizedTableBatchInput.dedup_nulls_ordering:type_name -> peerdb_flow.DedupNullsOrdering
+	0,  // 59: peerdb_flow.SetupNormalizedTableBatchInput.variant_null_policy:type_name -> peerdb_flow.VariantNullPolicy
+	20, // 60: peerdb_flow.RebuildNormalizedTableInput.flow_connection_configs:type_name -> peerdb_flow.FlowConnectionConfigs
+	83, // 61: peerdb_flow.GenerateDDLOutput.table_ddl_mapping:type_name -> peerdb_flow.GenerateDDLOutput.TableDdlMappingEntry
+	84, // 62: peerdb_flow.SetupNormalizedTableBatchOutput.table_exists_mapping:type_name -> peerdb_flow.SetupNormalizedTableBatchOutput.TableExistsMappingEntry
+	86, // 63: peerdb_flow.TimestampPartitionRange.start:type_name -> google.protobuf.Timestamp
+	86, // 64: peerdb_flow.TimestampPartitionRange.end:type_name -> google.protobuf.Timestamp
+	55, // 65: peerdb_flow.TIDPartitionRange.start:type_name -> peerdb_flow.TID
+	55, // 66: peerdb_flow.TIDPartitionRange.end:type_name -> peerdb_flow.TID
+	53, // 67: peerdb_flow.PartitionRange.int_range:type_name -> peerdb_flow.IntPartitionRange
+	54, // 68: peerdb_flow.PartitionRange.timestamp_range:type_name -> peerdb_flow.TimestampPartitionRange
+	56, // 69: peerdb_flow.PartitionRange.tid_range:type_name -> peerdb_flow.TIDPartitionRange
+	14, // 70: peerdb_flow.QRepWriteMode.write_type:type_name -> peerdb_flow.QRepWriteType
+	85, // 71: peerdb_flow.QRepConfig.source_peer:type_name -> peerdb_peers.Peer
+	85, // 72: peerdb_flow.QRepConfig.destination_peer:type_name -> peerdb_peers.Peer
+	11, // 73: peerdb_flow.QRepConfig.sync_mode:type_name -> peerdb_flow.QRepSyncMode
+	58, // 74: peerdb_flow.QRepConfig.write_mode:type_name -> peerdb_flow.QRepWriteMode
+	15, // 75: peerdb_flow.QRepConfig.int_range_policy:type_name -> peerdb_flow.IntRangePolicy
+	10, // 76: peerdb_flow.QRepConfig.source_isolation_level:type_name -> peerdb_flow.SourceIsolationLevel
+	12, // 77: peerdb_flow.QRepConfig.staging_file_format:type_name -> peerdb_flow.StagingFileFormat
+	13, // 78: peerdb_flow.QRepConfig.empty_source_table_policy:type_name -> peerdb_flow.EmptySourceTablePolicy
+	57, // 79: peerdb_flow.QRepPartition.range:type_name -> peerdb_flow.PartitionRange
+	60, // 80: peerdb_flow.QRepPartitionBatch.partitions:type_name -> peerdb_flow.QRepPartition
+	60, // 81: peerdb_flow.QRepParitionResult.partitions:type_name -> peerdb_flow.QRepPartition
+	64, // 82: peerdb_flow.TableSchemaDelta.added_columns:type_name -> peerdb_flow.DeltaAddedColumn
+	20, // 83: peerdb_flow.ReplayTableSchemaDeltaInput.flow_connection_configs:type_name -> peerdb_flow.FlowConnectionConfigs
+	65, // 84: peerdb_flow.ReplayTableSchemaDeltaInput.table_schema_deltas:type_name -> peerdb_flow.TableSchemaDelta
+	42, // 85: peerdb_flow.FlowConnectionConfigs.TableNameSchemaMappingEntry.value:type_name -> peerdb_flow.TableSchema
+	41, // 86: peerdb_flow.FlowConnectionConfigs.DestinationColumnDefaultsEntry.value:type_name -> peerdb_flow.ColumnDefaults
+	18, // 87: peerdb_flow.SyncFlowOptions.RelationMessageMappingEntry.value:type_name -> peerdb_flow.RelationMessage
+	18, // 88: peerdb_flow.StartFlowInput.RelationMessageMappingEntry.value:type_name -> peerdb_flow.RelationMessage
+	30, // 89: peerdb_flow.EnsurePullabilityBatchOutput.TableIdentifierMappingEntry.value:type_name -> peerdb_flow.TableIdentifier
+	42, // 90: peerdb_flow.GetTableSchemaBatchOutput.TableNameSchemaMappingEntry.value:type_name -> peerdb_flow.TableSchema
+	42, // 91: peerdb_flow.SetupNormalizedTableBatchInput.TableNameSchemaMappingEntry.value:type_name -> peerdb_flow.TableSchema
+	41, // 92: peerdb_flow.SetupNormalizedTableBatchInput.ColumnDefaultsEntry.value:type_name -> peerdb_flow.ColumnDefaults
+	93, // [93:93] is the sub-list for method output_type
+	93, // [93:93] is the sub-list for method input_type
+	93, // [93:93] is the sub-list for extension type_name
+	93, // [93:93] is the sub-list for extension extendee
+	0,  // [0:93] is the sub-list for field type_name
 }
 
 func init() { file_flow_proto_init() }
@@ -3863,7 +6630,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TableSchema); i {
+			switch v := v.(*DropStaleRawTablesInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3875,7 +6642,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTableSchemaBatchInput); i {
+			switch v := v.(*DropStaleRawTablesOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3887,7 +6654,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTableSchemaBatchOutput); i {
+			switch v := v.(*RawTableColumn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3899,7 +6666,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetupNormalizedTableInput); i {
+			switch v := v.(*GetRawTableSchemaOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3911,7 +6678,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetupNormalizedTableBatchInput); i {
+			switch v := v.(*ColumnDefaults); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3923,7 +6690,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetupNormalizedTableOutput); i {
+			switch v := v.(*TableSchema); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3935,7 +6702,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetupNormalizedTableBatchOutput); i {
+			switch v := v.(*GetTableSchemaBatchInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3947,7 +6714,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntPartitionRange); i {
+			switch v := v.(*GetTableSchemaBatchOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3959,7 +6726,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimestampPartitionRange); i {
+			switch v := v.(*SetupNormalizedTableInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3971,7 +6738,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TID); i {
+			switch v := v.(*SetupNormalizedTableBatchInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3983,7 +6750,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TIDPartitionRange); i {
+			switch v := v.(*RebuildNormalizedTableInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3995,7 +6762,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartitionRange); i {
+			switch v := v.(*RebuildNormalizedTableOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4007,7 +6774,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QRepWriteMode); i {
+			switch v := v.(*TypeFidelityResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4019,7 +6786,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QRepConfig); i {
+			switch v := v.(*GenerateDDLOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4031,7 +6798,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QRepPartition); i {
+			switch v := v.(*SetupNormalizedTableOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4043,7 +6810,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QRepPartitionBatch); i {
+			switch v := v.(*SetupNormalizedTableBatchOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4055,7 +6822,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QRepParitionResult); i {
+			switch v := v.(*IntPartitionRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4067,7 +6834,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropFlowInput); i {
+			switch v := v.(*TimestampPartitionRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4079,7 +6846,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeltaAddedColumn); i {
+			switch v := v.(*TID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4091,7 +6858,7 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TableSchemaDelta); i {
+			switch v := v.(*TIDPartitionRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4103,6 +6870,114 @@ func file_flow_proto_init() {
 			}
 		}
 		file_flow_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flow_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QRepWriteMode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flow_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QRepConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flow_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QRepPartition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flow_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QRepPartitionBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flow_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QRepParitionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flow_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropFlowInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flow_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeltaAddedColumn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flow_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableSchemaDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flow_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplayTableSchemaDeltaInput); i {
 			case 0:
 				return &v.state
@@ -4118,7 +6993,7 @@ func file_flow_proto_init() {
 	file_flow_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*TableIdentifier_PostgresTableIdentifier)(nil),
 	}
-	file_flow_proto_msgTypes[32].OneofWrappers = []interface{}{
+	file_flow_proto_msgTypes[41].OneofWrappers = []interface{}{
 		(*PartitionRange_IntRange)(nil),
 		(*PartitionRange_TimestampRange)(nil),
 		(*PartitionRange_TidRange)(nil),
@@ -4128,8 +7003,8 @@ func file_flow_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_flow_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   53,
+			NumEnums:      16,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
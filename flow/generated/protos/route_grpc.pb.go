@@ -19,12 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	FlowService_ValidatePeer_FullMethodName   = "/peerdb_route.FlowService/ValidatePeer"
-	FlowService_CreatePeer_FullMethodName     = "/peerdb_route.FlowService/CreatePeer"
-	FlowService_CreateCDCFlow_FullMethodName  = "/peerdb_route.FlowService/CreateCDCFlow"
-	FlowService_CreateQRepFlow_FullMethodName = "/peerdb_route.FlowService/CreateQRepFlow"
-	FlowService_ShutdownFlow_FullMethodName   = "/peerdb_route.FlowService/ShutdownFlow"
-	FlowService_MirrorStatus_FullMethodName   = "/peerdb_route.FlowService/MirrorStatus"
+	FlowService_ValidatePeer_FullMethodName           = "/peerdb_route.FlowService/ValidatePeer"
+	FlowService_CreatePeer_FullMethodName             = "/peerdb_route.FlowService/CreatePeer"
+	FlowService_CreateCDCFlow_FullMethodName          = "/peerdb_route.FlowService/CreateCDCFlow"
+	FlowService_CreateQRepFlow_FullMethodName         = "/peerdb_route.FlowService/CreateQRepFlow"
+	FlowService_GenerateDDL_FullMethodName            = "/peerdb_route.FlowService/GenerateDDL"
+	FlowService_TestTypeFidelity_FullMethodName       = "/peerdb_route.FlowService/TestTypeFidelity"
+	FlowService_ShutdownFlow_FullMethodName           = "/peerdb_route.FlowService/ShutdownFlow"
+	FlowService_MirrorStatus_FullMethodName           = "/peerdb_route.FlowService/MirrorStatus"
+	FlowService_GetRawTableSchema_FullMethodName      = "/peerdb_route.FlowService/GetRawTableSchema"
+	FlowService_GetNormalizeBacklog_FullMethodName    = "/peerdb_route.FlowService/GetNormalizeBacklog"
+	FlowService_GetSchemaDeltaHistory_FullMethodName  = "/peerdb_route.FlowService/GetSchemaDeltaHistory"
+	FlowService_RebuildNormalizedTable_FullMethodName = "/peerdb_route.FlowService/RebuildNormalizedTable"
 )
 
 // FlowServiceClient is the client API for FlowService service.
@@ -35,8 +41,14 @@ type FlowServiceClient interface {
 	CreatePeer(ctx context.Context, in *CreatePeerRequest, opts ...grpc.CallOption) (*CreatePeerResponse, error)
 	CreateCDCFlow(ctx context.Context, in *CreateCDCFlowRequest, opts ...grpc.CallOption) (*CreateCDCFlowResponse, error)
 	CreateQRepFlow(ctx context.Context, in *CreateQRepFlowRequest, opts ...grpc.CallOption) (*CreateQRepFlowResponse, error)
+	GenerateDDL(ctx context.Context, in *GenerateDDLRequest, opts ...grpc.CallOption) (*GenerateDDLResponse, error)
+	TestTypeFidelity(ctx context.Context, in *TestTypeFidelityRequest, opts ...grpc.CallOption) (*TestTypeFidelityResponse, error)
 	ShutdownFlow(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error)
 	MirrorStatus(ctx context.Context, in *MirrorStatusRequest, opts ...grpc.CallOption) (*MirrorStatusResponse, error)
+	GetRawTableSchema(ctx context.Context, in *GetRawTableSchemaRequest, opts ...grpc.CallOption) (*GetRawTableSchemaResponse, error)
+	GetNormalizeBacklog(ctx context.Context, in *GetNormalizeBacklogRequest, opts ...grpc.CallOption) (*GetNormalizeBacklogResponse, error)
+	GetSchemaDeltaHistory(ctx context.Context, in *GetSchemaDeltaHistoryRequest, opts ...grpc.CallOption) (*GetSchemaDeltaHistoryResponse, error)
+	RebuildNormalizedTable(ctx context.Context, in *RebuildNormalizedTableRequest, opts ...grpc.CallOption) (*RebuildNormalizedTableResponse, error)
 }
 
 type flowServiceClient struct {
@@ -83,6 +95,24 @@ func (c *flowServiceClient) CreateQRepFlow(ctx context.Context, in *CreateQRepFl
 	return out, nil
 }
 
+func (c *flowServiceClient) GenerateDDL(ctx context.Context, in *GenerateDDLRequest, opts ...grpc.CallOption) (*GenerateDDLResponse, error) {
+	out := new(GenerateDDLResponse)
+	err := c.cc.Invoke(ctx, FlowService_GenerateDDL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flowServiceClient) TestTypeFidelity(ctx context.Context, in *TestTypeFidelityRequest, opts ...grpc.CallOption) (*TestTypeFidelityResponse, error) {
+	out := new(TestTypeFidelityResponse)
+	err := c.cc.Invoke(ctx, FlowService_TestTypeFidelity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *flowServiceClient) ShutdownFlow(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error) {
 	out := new(ShutdownResponse)
 	err := c.cc.Invoke(ctx, FlowService_ShutdownFlow_FullMethodName, in, out, opts...)
@@ -101,6 +131,42 @@ func (c *flowServiceClient) MirrorStatus(ctx context.Context, in *MirrorStatusRe
 	return out, nil
 }
 
+func (c *flowServiceClient) GetRawTableSchema(ctx context.Context, in *GetRawTableSchemaRequest, opts ...grpc.CallOption) (*GetRawTableSchemaResponse, error) {
+	out := new(GetRawTableSchemaResponse)
+	err := c.cc.Invoke(ctx, FlowService_GetRawTableSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flowServiceClient) GetNormalizeBacklog(ctx context.Context, in *GetNormalizeBacklogRequest, opts ...grpc.CallOption) (*GetNormalizeBacklogResponse, error) {
+	out := new(GetNormalizeBacklogResponse)
+	err := c.cc.Invoke(ctx, FlowService_GetNormalizeBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flowServiceClient) GetSchemaDeltaHistory(ctx context.Context, in *GetSchemaDeltaHistoryRequest, opts ...grpc.CallOption) (*GetSchemaDeltaHistoryResponse, error) {
+	out := new(GetSchemaDeltaHistoryResponse)
+	err := c.cc.Invoke(ctx, FlowService_GetSchemaDeltaHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flowServiceClient) RebuildNormalizedTable(ctx context.Context, in *RebuildNormalizedTableRequest, opts ...grpc.CallOption) (*RebuildNormalizedTableResponse, error) {
+	out := new(RebuildNormalizedTableResponse)
+	err := c.cc.Invoke(ctx, FlowService_RebuildNormalizedTable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FlowServiceServer is the server API for FlowService service.
 // All implementations must embed UnimplementedFlowServiceServer
 // for forward compatibility
@@ -109,8 +175,14 @@ type FlowServiceServer interface {
 	CreatePeer(context.Context, *CreatePeerRequest) (*CreatePeerResponse, error)
 	CreateCDCFlow(context.Context, *CreateCDCFlowRequest) (*CreateCDCFlowResponse, error)
 	CreateQRepFlow(context.Context, *CreateQRepFlowRequest) (*CreateQRepFlowResponse, error)
+	GenerateDDL(context.Context, *GenerateDDLRequest) (*GenerateDDLResponse, error)
+	TestTypeFidelity(context.Context, *TestTypeFidelityRequest) (*TestTypeFidelityResponse, error)
 	ShutdownFlow(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
 	MirrorStatus(context.Context, *MirrorStatusRequest) (*MirrorStatusResponse, error)
+	GetRawTableSchema(context.Context, *GetRawTableSchemaRequest) (*GetRawTableSchemaResponse, error)
+	GetNormalizeBacklog(context.Context, *GetNormalizeBacklogRequest) (*GetNormalizeBacklogResponse, error)
+	GetSchemaDeltaHistory(context.Context, *GetSchemaDeltaHistoryRequest) (*GetSchemaDeltaHistoryResponse, error)
+	RebuildNormalizedTable(context.Context, *RebuildNormalizedTableRequest) (*RebuildNormalizedTableResponse, error)
 	mustEmbedUnimplementedFlowServiceServer()
 }
 
@@ -130,12 +202,30 @@ func (UnimplementedFlowServiceServer) CreateCDCFlow(context.Context, *CreateCDCF
 func (UnimplementedFlowServiceServer) CreateQRepFlow(context.Context, *CreateQRepFlowRequest) (*CreateQRepFlowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateQRepFlow not implemented")
 }
+func (UnimplementedFlowServiceServer) GenerateDDL(context.Context, *GenerateDDLRequest) (*GenerateDDLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateDDL not implemented")
+}
+func (UnimplementedFlowServiceServer) TestTypeFidelity(context.Context, *TestTypeFidelityRequest) (*TestTypeFidelityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestTypeFidelity not implemented")
+}
 func (UnimplementedFlowServiceServer) ShutdownFlow(context.Context, *ShutdownRequest) (*ShutdownResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShutdownFlow not implemented")
 }
 func (UnimplementedFlowServiceServer) MirrorStatus(context.Context, *MirrorStatusRequest) (*MirrorStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MirrorStatus not implemented")
 }
+func (UnimplementedFlowServiceServer) GetRawTableSchema(context.Context, *GetRawTableSchemaRequest) (*GetRawTableSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawTableSchema not implemented")
+}
+func (UnimplementedFlowServiceServer) GetNormalizeBacklog(context.Context, *GetNormalizeBacklogRequest) (*GetNormalizeBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNormalizeBacklog not implemented")
+}
+func (UnimplementedFlowServiceServer) GetSchemaDeltaHistory(context.Context, *GetSchemaDeltaHistoryRequest) (*GetSchemaDeltaHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaDeltaHistory not implemented")
+}
+func (UnimplementedFlowServiceServer) RebuildNormalizedTable(context.Context, *RebuildNormalizedTableRequest) (*RebuildNormalizedTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildNormalizedTable not implemented")
+}
 func (UnimplementedFlowServiceServer) mustEmbedUnimplementedFlowServiceServer() {}
 
 // UnsafeFlowServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -221,6 +311,42 @@ func _FlowService_CreateQRepFlow_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FlowService_GenerateDDL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateDDLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowServiceServer).GenerateDDL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlowService_GenerateDDL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowServiceServer).GenerateDDL(ctx, req.(*GenerateDDLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlowService_TestTypeFidelity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestTypeFidelityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowServiceServer).TestTypeFidelity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlowService_TestTypeFidelity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowServiceServer).TestTypeFidelity(ctx, req.(*TestTypeFidelityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FlowService_ShutdownFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ShutdownRequest)
 	if err := dec(in); err != nil {
@@ -257,6 +383,78 @@ func _FlowService_MirrorStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FlowService_GetRawTableSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawTableSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowServiceServer).GetRawTableSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlowService_GetRawTableSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowServiceServer).GetRawTableSchema(ctx, req.(*GetRawTableSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlowService_GetNormalizeBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNormalizeBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowServiceServer).GetNormalizeBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlowService_GetNormalizeBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowServiceServer).GetNormalizeBacklog(ctx, req.(*GetNormalizeBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlowService_GetSchemaDeltaHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaDeltaHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowServiceServer).GetSchemaDeltaHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlowService_GetSchemaDeltaHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowServiceServer).GetSchemaDeltaHistory(ctx, req.(*GetSchemaDeltaHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlowService_RebuildNormalizedTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildNormalizedTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlowServiceServer).RebuildNormalizedTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlowService_RebuildNormalizedTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlowServiceServer).RebuildNormalizedTable(ctx, req.(*RebuildNormalizedTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FlowService_ServiceDesc is the grpc.ServiceDesc for FlowService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -280,6 +478,14 @@ var FlowService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateQRepFlow",
 			Handler:    _FlowService_CreateQRepFlow_Handler,
 		},
+		{
+			MethodName: "GenerateDDL",
+			Handler:    _FlowService_GenerateDDL_Handler,
+		},
+		{
+			MethodName: "TestTypeFidelity",
+			Handler:    _FlowService_TestTypeFidelity_Handler,
+		},
 		{
 			MethodName: "ShutdownFlow",
 			Handler:    _FlowService_ShutdownFlow_Handler,
@@ -288,6 +494,22 @@ var FlowService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MirrorStatus",
 			Handler:    _FlowService_MirrorStatus_Handler,
 		},
+		{
+			MethodName: "GetRawTableSchema",
+			Handler:    _FlowService_GetRawTableSchema_Handler,
+		},
+		{
+			MethodName: "GetNormalizeBacklog",
+			Handler:    _FlowService_GetNormalizeBacklog_Handler,
+		},
+		{
+			MethodName: "GetSchemaDeltaHistory",
+			Handler:    _FlowService_GetSchemaDeltaHistory_Handler,
+		},
+		{
+			MethodName: "RebuildNormalizedTable",
+			Handler:    _FlowService_RebuildNormalizedTable_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "route.proto",
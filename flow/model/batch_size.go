@@ -0,0 +1,26 @@
+package model
+
+// NextBatchSize decides the batch size of the next sync batch when ramping up from initialBatchSize,
+// so that a mirror doesn't start by sending a cold destination batches of maxBatchSize. The batch
+// size doubles after each successful batch up to maxBatchSize and halves after each failed one,
+// never going below initialBatchSize. A currentBatchSize of 0 means no batch was synced yet.
+// Without an initialBatchSize below maxBatchSize, every batch is of maxBatchSize.
+func NextBatchSize(currentBatchSize int32, initialBatchSize int32, maxBatchSize int32, succeeded bool) int32 {
+	if initialBatchSize <= 0 || initialBatchSize >= maxBatchSize {
+		return maxBatchSize
+	}
+	if currentBatchSize <= 0 {
+		return initialBatchSize
+	}
+
+	if !succeeded {
+		if currentBatchSize/2 < initialBatchSize {
+			return initialBatchSize
+		}
+		return currentBatchSize / 2
+	}
+	if currentBatchSize > maxBatchSize/2 {
+		return maxBatchSize
+	}
+	return currentBatchSize * 2
+}
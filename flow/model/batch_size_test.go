@@ -0,0 +1,37 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextBatchSize(t *testing.T) {
+	// without an initial batch size every batch is of the max batch size.
+	require.Equal(t, int32(1000), NextBatchSize(0, 0, 1000, true))
+	require.Equal(t, int32(1000), NextBatchSize(1000, 0, 1000, false))
+	require.Equal(t, int32(1000), NextBatchSize(0, 5000, 1000, true))
+
+	// the first batch is of the initial batch size.
+	batchSize := NextBatchSize(0, 100, 1000, true)
+	require.Equal(t, int32(100), batchSize)
+
+	// successful batches grow the batch size until it reaches the max.
+	batchSizes := make([]int32, 0, 5)
+	for i := 0; i < 5; i++ {
+		batchSize = NextBatchSize(batchSize, 100, 1000, true)
+		batchSizes = append(batchSizes, batchSize)
+	}
+	require.Equal(t, []int32{200, 400, 800, 1000, 1000}, batchSizes)
+
+	// failed batches shrink it, down to the initial batch size.
+	batchSizes = batchSizes[:0]
+	for i := 0; i < 5; i++ {
+		batchSize = NextBatchSize(batchSize, 100, 1000, false)
+		batchSizes = append(batchSizes, batchSize)
+	}
+	require.Equal(t, []int32{500, 250, 125, 100, 100}, batchSizes)
+
+	// and it grows again once batches succeed.
+	require.Equal(t, int32(200), NextBatchSize(batchSize, 100, 1000, true))
+}
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// DefaultMaxCheckpointInterval is how long a checkpoint may go unpersisted when checkpoints are
+// only persisted every few batches and no interval is configured.
+const DefaultMaxCheckpointInterval = 5 * time.Minute
+
+// ShouldPersistCheckpoint decides whether the next sync batch persists its checkpoint on the
+// destination. With everyNBatches above 1, only every Nth batch does, but never more than
+// maxInterval after the last persisted checkpoint. A crash resumes from the last persisted
+// checkpoint, so the batches in between are pulled and synced again.
+func ShouldPersistCheckpoint(
+	everyNBatches uint32,
+	maxInterval time.Duration,
+	batchesSincePersist uint32,
+	sincePersist time.Duration,
+) bool {
+	if everyNBatches <= 1 {
+		return true
+	}
+	if batchesSincePersist+1 >= everyNBatches {
+		return true
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultMaxCheckpointInterval
+	}
+	return sincePersist >= maxInterval
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldPersistCheckpoint(t *testing.T) {
+	// checkpointing every batch is the default.
+	require.True(t, ShouldPersistCheckpoint(0, 0, 0, 0))
+	require.True(t, ShouldPersistCheckpoint(1, 0, 0, 0))
+
+	// every 3rd batch persists its checkpoint.
+	persisted := make([]bool, 0, 6)
+	var batchesSincePersist uint32
+	for i := 0; i < 6; i++ {
+		persist := ShouldPersistCheckpoint(3, time.Hour, batchesSincePersist, time.Minute)
+		persisted = append(persisted, persist)
+		if persist {
+			batchesSincePersist = 0
+		} else {
+			batchesSincePersist++
+		}
+	}
+	require.Equal(t, []bool{false, false, true, false, false, true}, persisted)
+
+	// the time cap persists the checkpoint even if fewer batches were synced.
+	require.True(t, ShouldPersistCheckpoint(100, time.Minute, 1, 2*time.Minute))
+	require.False(t, ShouldPersistCheckpoint(100, time.Minute, 1, 30*time.Second))
+	require.True(t, ShouldPersistCheckpoint(100, 0, 1, DefaultMaxCheckpointInterval))
+}
@@ -0,0 +1,184 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+)
+
+// FitIntToKind fits an integer value of any width into the range of an int16, int32 or int64 destination
+// column. Values that fit are returned as is. Values that don't fit fail with an error naming the column,
+// are clamped to the nearest bound, or become nil, depending on the policy. Clamped values keep the type
+// of the value, so they still match the kind of the source column. Values of other kinds are returned
+// as is.
+func FitIntToKind(
+	columnName string,
+	kind qvalue.QValueKind,
+	value interface{},
+	policy protos.IntRangePolicy,
+) (interface{}, error) {
+	var minValue, maxValue int64
+	switch kind {
+	case qvalue.QValueKindInt16:
+		minValue, maxValue = math.MinInt16, math.MaxInt16
+	case qvalue.QValueKindInt32:
+		minValue, maxValue = math.MinInt32, math.MaxInt32
+	case qvalue.QValueKindInt64:
+		minValue, maxValue = math.MinInt64, math.MaxInt64
+	default:
+		return value, nil
+	}
+
+	var intValue int64
+	// set when the value is above the range of int64 as well
+	aboveInt64 := false
+	switch v := value.(type) {
+	case int:
+		intValue = int64(v)
+	case int8:
+		intValue = int64(v)
+	case int16:
+		intValue = int64(v)
+	case int32:
+		intValue = int64(v)
+	case int64:
+		intValue = v
+	case uint8:
+		intValue = int64(v)
+	case uint16:
+		intValue = int64(v)
+	case uint32:
+		intValue = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			aboveInt64 = true
+			intValue = math.MaxInt64
+		} else {
+			intValue = int64(v)
+		}
+	default:
+		return value, nil
+	}
+
+	if !aboveInt64 && intValue >= minValue && intValue <= maxValue {
+		return value, nil
+	}
+	switch policy {
+	case protos.IntRangePolicy_INT_RANGE_POLICY_CLAMP:
+		if intValue < minValue {
+			return intOfType(value, minValue), nil
+		}
+		return intOfType(value, maxValue), nil
+	case protos.IntRangePolicy_INT_RANGE_POLICY_NULL:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("value %v of column %s is out of range for %s", value, columnName, kind)
+	}
+}
+
+// intOfType converts a clamped value to the integer type of the value it replaces. The bound is within
+// the range of that type, as the value it replaces is beyond it.
+func intOfType(value interface{}, bound int64) interface{} {
+	switch value.(type) {
+	case int:
+		return int(bound)
+	case int8:
+		return int8(bound)
+	case int16:
+		return int16(bound)
+	case int32:
+		return int32(bound)
+	case uint8:
+		return uint8(bound)
+	case uint16:
+		return uint16(bound)
+	case uint32:
+		return uint32(bound)
+	case uint64:
+		return uint64(bound)
+	default:
+		return bound
+	}
+}
+
+// FitIntRanges returns a stream with the records of the given stream, where the values of columns with an
+// integer kind in destinationKinds are fitted into the range of their destination column by FitIntToKind.
+// destinationKinds is keyed by column name, other columns are passed on as is. A value failing to fit ends
+// the stream with an error. Once ctx is done, the remaining records are drained without being passed on.
+// Without destinationKinds, the stream is returned as is.
+func FitIntRanges(
+	ctx context.Context,
+	stream *QRecordStream,
+	destinationKinds map[string]qvalue.QValueKind,
+	policy protos.IntRangePolicy,
+) *QRecordStream {
+	if len(destinationKinds) == 0 {
+		return stream
+	}
+	return TransformStream(ctx, stream, func(schema *QRecordSchema) func(*QRecord) error {
+		return func(record *QRecord) error {
+			if schema == nil {
+				return nil
+			}
+			for i := range record.Entries {
+				entry := &record.Entries[i]
+				if entry.Value == nil || i >= len(schema.Fields) {
+					continue
+				}
+				columnName := schema.Fields[i].Name
+				destinationKind, ok := destinationKinds[columnName]
+				if !ok {
+					continue
+				}
+				value, err := FitIntToKind(columnName, destinationKind, entry.Value, policy)
+				if err != nil {
+					return err
+				}
+				entry.Value = value
+			}
+			return nil
+		}
+	})
+}
+
+// FitIntRanges fits the values of the records in the batch into the range of their destination column
+// like the stream version does for qrep. destinationKinds is keyed by destination table name and then by
+// column name, records of other tables and other columns are left as is. Old values of updates are left
+// as is as well, they are only used to find the row.
+func (r *RecordBatch) FitIntRanges(
+	destinationKinds map[string]map[string]qvalue.QValueKind,
+	policy protos.IntRangePolicy,
+) error {
+	if len(destinationKinds) == 0 {
+		return nil
+	}
+	for _, record := range r.Records {
+		tableKinds, ok := destinationKinds[recordDestinationTableName(record)]
+		if !ok {
+			continue
+		}
+		var items *RecordItems
+		switch typedRecord := record.(type) {
+		case *InsertRecord:
+			items = typedRecord.Items
+		case *UpdateRecord:
+			items = typedRecord.NewItems
+		default:
+			continue
+		}
+		for columnName, destinationKind := range tableKinds {
+			value, err := items.GetValueByColName(columnName)
+			if err != nil || value == nil || value.Value == nil {
+				continue
+			}
+			value.Value, err = FitIntToKind(columnName, destinationKind, value.Value, policy)
+			if err != nil {
+				return fmt.Errorf("table %s: %w", recordDestinationTableName(record), err)
+			}
+		}
+	}
+	return nil
+}
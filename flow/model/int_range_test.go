@@ -0,0 +1,161 @@
+package model
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFitIntToKindInRange(t *testing.T) {
+	for _, policy := range []protos.IntRangePolicy{
+		protos.IntRangePolicy_INT_RANGE_POLICY_ERROR,
+		protos.IntRangePolicy_INT_RANGE_POLICY_CLAMP,
+		protos.IntRangePolicy_INT_RANGE_POLICY_NULL,
+	} {
+		value, err := FitIntToKind("small", qvalue.QValueKindInt16, int64(-32768), policy)
+		require.NoError(t, err)
+		require.Equal(t, int64(-32768), value)
+
+		value, err = FitIntToKind("big", qvalue.QValueKindInt64, int32(42), policy)
+		require.NoError(t, err)
+		require.Equal(t, int32(42), value)
+	}
+}
+
+func TestFitIntToKindOutOfRangeError(t *testing.T) {
+	_, err := FitIntToKind("small", qvalue.QValueKindInt16, int64(40000),
+		protos.IntRangePolicy_INT_RANGE_POLICY_ERROR)
+	require.EqualError(t, err, "value 40000 of column small is out of range for int16")
+
+	_, err = FitIntToKind("big", qvalue.QValueKindInt64, uint64(math.MaxUint64),
+		protos.IntRangePolicy_INT_RANGE_POLICY_ERROR)
+	require.EqualError(t, err, "value 18446744073709551615 of column big is out of range for int64")
+}
+
+func TestFitIntToKindOutOfRangeClamp(t *testing.T) {
+	value, err := FitIntToKind("small", qvalue.QValueKindInt16, int64(40000),
+		protos.IntRangePolicy_INT_RANGE_POLICY_CLAMP)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt16), value)
+
+	value, err = FitIntToKind("medium", qvalue.QValueKindInt32, int64(math.MinInt64),
+		protos.IntRangePolicy_INT_RANGE_POLICY_CLAMP)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MinInt32), value)
+
+	value, err = FitIntToKind("big", qvalue.QValueKindInt64, uint64(math.MaxUint64),
+		protos.IntRangePolicy_INT_RANGE_POLICY_CLAMP)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxInt64), value)
+}
+
+func TestFitIntToKindOutOfRangeNull(t *testing.T) {
+	value, err := FitIntToKind("medium", qvalue.QValueKindInt32, int64(math.MaxInt32)+1,
+		protos.IntRangePolicy_INT_RANGE_POLICY_NULL)
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+// the source column is a bigint, which the value fits, and the destination column a smallint.
+func fitIntRangesBatch(policy protos.IntRangePolicy) (*QRecordStream, error) {
+	batch := &QRecordBatch{
+		NumRecords: 1,
+		Records: []*QRecord{{
+			NumEntries: 2,
+			Entries: []qvalue.QValue{
+				{Kind: qvalue.QValueKindString, Value: "a"},
+				{Kind: qvalue.QValueKindInt64, Value: int64(-40000)},
+			},
+		}},
+		Schema: NewQRecordSchema([]*QField{
+			{Name: "name", Type: qvalue.QValueKindString},
+			{Name: "small", Type: qvalue.QValueKindInt64},
+		}),
+	}
+	stream, err := batch.ToQRecordStream(1)
+	if err != nil {
+		return nil, err
+	}
+	destinationKinds := map[string]qvalue.QValueKind{
+		"name":  qvalue.QValueKindString,
+		"small": qvalue.QValueKindInt16,
+	}
+	return FitIntRanges(context.Background(), stream, destinationKinds, policy), nil
+}
+
+func TestFitIntRangesStream(t *testing.T) {
+	stream, err := fitIntRangesBatch(protos.IntRangePolicy_INT_RANGE_POLICY_ERROR)
+	require.NoError(t, err)
+	schema, err := stream.Schema()
+	require.NoError(t, err)
+	require.Equal(t, []string{"name", "small"}, schema.GetColumnNames())
+	recordOrErr := <-stream.Records
+	require.EqualError(t, recordOrErr.Err, "value -40000 of column small is out of range for int16")
+	_, ok := <-stream.Records
+	require.False(t, ok)
+
+	stream, err = fitIntRangesBatch(protos.IntRangePolicy_INT_RANGE_POLICY_CLAMP)
+	require.NoError(t, err)
+	recordOrErr = <-stream.Records
+	require.NoError(t, recordOrErr.Err)
+	require.Equal(t, "a", recordOrErr.Record.Entries[0].Value)
+	require.Equal(t, qvalue.QValueKindInt64, recordOrErr.Record.Entries[1].Kind)
+	require.Equal(t, int64(math.MinInt16), recordOrErr.Record.Entries[1].Value)
+
+	stream, err = fitIntRangesBatch(protos.IntRangePolicy_INT_RANGE_POLICY_NULL)
+	require.NoError(t, err)
+	recordOrErr = <-stream.Records
+	require.NoError(t, recordOrErr.Err)
+	require.Nil(t, recordOrErr.Record.Entries[1].Value)
+}
+
+func fitIntRangesRecordBatch() *RecordBatch {
+	return &RecordBatch{
+		Records: []Record{
+			&InsertRecord{
+				DestinationTableName: "public.narrow",
+				Items: NewRecordItemWithData([]string{"id", "small"}, []*qvalue.QValue{
+					{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+					{Kind: qvalue.QValueKindInt64, Value: int64(40000)},
+				}),
+			},
+			&InsertRecord{
+				DestinationTableName: "public.wide",
+				Items: NewRecordItemWithData([]string{"id", "small"}, []*qvalue.QValue{
+					{Kind: qvalue.QValueKindInt64, Value: int64(2)},
+					{Kind: qvalue.QValueKindInt64, Value: int64(40000)},
+				}),
+			},
+		},
+	}
+}
+
+func TestFitIntRangesRecordBatch(t *testing.T) {
+	destinationKinds := map[string]map[string]qvalue.QValueKind{
+		"public.narrow": {"id": qvalue.QValueKindInt64, "small": qvalue.QValueKindInt16},
+	}
+
+	batch := fitIntRangesRecordBatch()
+	err := batch.FitIntRanges(destinationKinds, protos.IntRangePolicy_INT_RANGE_POLICY_ERROR)
+	require.EqualError(t, err, "table public.narrow: value 40000 of column small is out of range for int16")
+
+	for policy, expected := range map[protos.IntRangePolicy]interface{}{
+		protos.IntRangePolicy_INT_RANGE_POLICY_CLAMP: int64(math.MaxInt16),
+		protos.IntRangePolicy_INT_RANGE_POLICY_NULL:  nil,
+	} {
+		batch = fitIntRangesRecordBatch()
+		err = batch.FitIntRanges(destinationKinds, policy)
+		require.NoError(t, err)
+		value, err := batch.Records[0].GetItems().GetValueByColName("small")
+		require.NoError(t, err)
+		require.Equal(t, expected, value.Value)
+		// the table without narrower columns on the destination is left as is
+		value, err = batch.Records[1].GetItems().GetValueByColName("small")
+		require.NoError(t, err)
+		require.Equal(t, int64(40000), value.Value)
+	}
+}
@@ -1,10 +1,13 @@
 package model
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/generated/protos"
@@ -20,6 +23,9 @@ type PullRecordsRequest struct {
 	MaxBatchSize uint32
 	// IdleTimeout is the timeout to wait for new records.
 	IdleTimeout time.Duration
+	// MaxPullDuration is the longest the pull may take, the records pulled so far are returned
+	// once it is exceeded. Defaults to 10 minutes.
+	MaxPullDuration time.Duration
 	//relId to name Mapping
 	SrcTableIDNameMapping map[uint32]string
 	// source to destination table name mapping
@@ -32,6 +38,23 @@ type PullRecordsRequest struct {
 	OverrideReplicationSlotName string
 	// for supporting schema changes
 	RelationMessageMapping RelationMessageMapping
+	// LSN to start from when nothing has been synced yet, in X/X form
+	StartLSN string
+	// PersistedSyncState is the checkpoint persisted on the destination, if it is behind LastSyncState.
+	// Only changes up to it are confirmed to the source, so that a crash can resume from it.
+	PersistedSyncState *protos.LastSyncState
+	// JSONAsText replicates json columns as text, exactly as written.
+	JSONAsText bool
+	// RangesAsText replicates range columns in their Postgres text form instead of as json.
+	RangesAsText bool
+	// DisabledTables are the source tables whose changes are skipped.
+	DisabledTables map[string]bool
+	// CountEmptyTransactions counts transactions without changes to replicated tables
+	// in the Transactions of the pulled batch.
+	CountEmptyTransactions bool
+	// FlushOnSchemaChange ends the pull at the last transaction before a schema change,
+	// returning only the change, so it is replayed before any later records are synced.
+	FlushOnSchemaChange bool
 }
 
 type Record interface {
@@ -110,7 +133,35 @@ func (r *RecordItems) Len() int {
 	return len(r.values)
 }
 
-func (r *RecordItems) toMap() (map[string]interface{}, error) {
+// ColumnNames returns the names of the columns, in the order they were added.
+func (r *RecordItems) ColumnNames() []string {
+	columns := make([]string, len(r.values))
+	for col, idx := range r.colToValIdx {
+		columns[idx] = col
+	}
+	return columns
+}
+
+// floatToJSONValue maps NaN and +/-Infinity, which JSON cannot represent, to either null or
+// the string tokens that Snowflake and BigQuery casts to float understand.
+func floatToJSONValue(f float64, nullSpecialValues bool) interface{} {
+	if !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f
+	}
+	if nullSpecialValues {
+		return nil
+	}
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "inf"
+	default:
+		return "-inf"
+	}
+}
+
+func (r *RecordItems) toMap(opts *ToJSONOptions) (map[string]interface{}, error) {
 	if r.colToValIdx == nil {
 		return nil, errors.New("colToValIdx is nil")
 	}
@@ -123,6 +174,9 @@ func (r *RecordItems) toMap() (map[string]interface{}, error) {
 		case qvalue.QValueKindString, qvalue.QValueKindJSON:
 			if len(v.Value.(string)) > 15*1024*1024 {
 				jsonStruct[col] = ""
+			} else if opts.EmptyStringsAsNull && v.Kind == qvalue.QValueKindString && v.Value.(string) == "" &&
+				!opts.keepEmptyStrings[col] {
+				jsonStruct[col] = nil
 			} else {
 				jsonStruct[col] = v.Value
 			}
@@ -138,6 +192,18 @@ func (r *RecordItems) toMap() (map[string]interface{}, error) {
 				return nil, errors.New("expected *big.Rat value")
 			}
 			jsonStruct[col] = bigRat.FloatString(9)
+		case qvalue.QValueKindFloat32:
+			if floatVal, ok := v.Value.(float32); ok {
+				jsonStruct[col] = floatToJSONValue(float64(floatVal), opts.NullFloatSpecialValues)
+			} else {
+				jsonStruct[col] = v.Value
+			}
+		case qvalue.QValueKindFloat64:
+			if floatVal, ok := v.Value.(float64); ok {
+				jsonStruct[col] = floatToJSONValue(floatVal, opts.NullFloatSpecialValues)
+			} else {
+				jsonStruct[col] = v.Value
+			}
 		default:
 			jsonStruct[col] = v.Value
 		}
@@ -148,6 +214,34 @@ func (r *RecordItems) toMap() (map[string]interface{}, error) {
 
 type ToJSONOptions struct {
 	UnnestColumns map[string]bool
+	// NullFloatSpecialValues writes NaN and +/-Infinity as null instead of string tokens.
+	NullFloatSpecialValues bool
+	// EmptyStringsAsNull writes empty strings as null, for sources that treat the two the same.
+	// Primary key columns are left as is for the options returned by ForRecord.
+	EmptyStringsAsNull bool
+	// TableNameSchemaMapping is used by ForRecord to look up the primary key of a record's table.
+	TableNameSchemaMapping map[string]*protos.TableSchema
+	// keepEmptyStrings are the columns EmptyStringsAsNull leaves as is.
+	keepEmptyStrings map[string]bool
+}
+
+// ForRecord returns the options to serialize the items of a record with. Empty strings are kept in
+// the primary key columns of the record's table, as rows are deduplicated and merged by their key.
+func (o *ToJSONOptions) ForRecord(record Record) *ToJSONOptions {
+	if !o.EmptyStringsAsNull {
+		return o
+	}
+	tableSchema, ok := o.TableNameSchemaMapping[recordDestinationTableName(record)]
+	if !ok || len(tableSchema.PrimaryKeyColumns) == 0 {
+		return o
+	}
+
+	recordOpts := *o
+	recordOpts.keepEmptyStrings = make(map[string]bool, len(tableSchema.PrimaryKeyColumns))
+	for _, pkeyCol := range tableSchema.PrimaryKeyColumns {
+		recordOpts.keepEmptyStrings[pkeyCol] = true
+	}
+	return &recordOpts
 }
 
 func NewToJSONOptions(unnestCols []string) *ToJSONOptions {
@@ -161,7 +255,7 @@ func NewToJSONOptions(unnestCols []string) *ToJSONOptions {
 }
 
 func (r *RecordItems) ToJSONWithOpts(opts *ToJSONOptions) (string, error) {
-	jsonStruct, err := r.toMap()
+	jsonStruct, err := r.toMap(opts)
 	if err != nil {
 		return "", err
 	}
@@ -290,6 +384,110 @@ type RecordBatch struct {
 	LastCheckPointID int64
 	//TablePkey to record index mapping
 	TablePKeyLastSeen map[TableWithPkey]int
+	// Transactions is the number of transactions committed in the batch, only counting those
+	// without changes to replicated tables if the pull was asked to.
+	Transactions int64
+	// LastCommitTime is the source commit time of the last transaction in the batch.
+	LastCommitTime time.Time
+}
+
+// CheckPointRange returns the lowest and highest checkpoint IDs in the batch. records may arrive
+// out of order, so these aren't necessarily those of the first and last records. The highest
+// checkpoint ID is never below LastCheckPointID, and the lowest is 0 for an empty batch.
+func (r *RecordBatch) CheckPointRange() (int64, int64) {
+	var firstCP int64 = 0
+	lastCP := r.LastCheckPointID
+	for i, record := range r.Records {
+		checkPointID := record.GetCheckPointID()
+		if i == 0 || checkPointID < firstCP {
+			firstCP = checkPointID
+		}
+		if checkPointID > lastCP {
+			lastCP = checkPointID
+		}
+	}
+	return firstCP, lastCP
+}
+
+func recordDestinationTableName(record Record) string {
+	switch r := record.(type) {
+	case *InsertRecord:
+		return r.DestinationTableName
+	case *UpdateRecord:
+		return r.DestinationTableName
+	case *DeleteRecord:
+		return r.DestinationTableName
+	default:
+		return ""
+	}
+}
+
+// DestinationTableNames returns the names of the destination tables with records in the batch.
+func (r *RecordBatch) DestinationTableNames() []string {
+	tableNames := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, record := range r.Records {
+		tableName := recordDestinationTableName(record)
+		if _, ok := seen[tableName]; ok || tableName == "" {
+			continue
+		}
+		seen[tableName] = struct{}{}
+		tableNames = append(tableNames, tableName)
+	}
+	return tableNames
+}
+
+// CompactRecords keeps only the last change to each primary key in the batch, dropping earlier
+// changes that the merge would discard anyway. The kept records retain their relative order.
+// Records of tables without a primary key or with replica identity full are never dropped.
+func (r *RecordBatch) CompactRecords(tableNameSchemaMapping map[string]*protos.TableSchema) {
+	seenPKeys := make(map[TableWithPkey]struct{})
+	compacted := make([]Record, 0, len(r.Records))
+	// walk backwards so that the first change seen for a key is the latest one
+	for i := len(r.Records) - 1; i >= 0; i-- {
+		record := r.Records[i]
+		tableName := recordDestinationTableName(record)
+		tableSchema, ok := tableNameSchemaMapping[tableName]
+		if !ok || len(tableSchema.PrimaryKeyColumns) == 0 || tableSchema.IsReplicaIdentityFull {
+			compacted = append(compacted, record)
+			continue
+		}
+
+		pkeyVals := make([]string, 0, len(tableSchema.PrimaryKeyColumns))
+		for _, pkeyCol := range tableSchema.PrimaryKeyColumns {
+			pkeyVal, err := record.GetItems().GetValueByColName(pkeyCol)
+			if err != nil {
+				break
+			}
+			pkeyVals = append(pkeyVals, fmt.Sprintf("%v", pkeyVal.Value))
+		}
+		// can't identify the row this change belongs to, so keep it
+		if len(pkeyVals) != len(tableSchema.PrimaryKeyColumns) {
+			compacted = append(compacted, record)
+			continue
+		}
+
+		tablePkeyVal := TableWithPkey{
+			TableName:  tableName,
+			PkeyColVal: strings.Join(pkeyVals, "\x00"),
+		}
+		if _, seen := seenPKeys[tablePkeyVal]; seen {
+			continue
+		}
+		seenPKeys[tablePkeyVal] = struct{}{}
+		compacted = append(compacted, record)
+	}
+
+	for i, j := 0, len(compacted)-1; i < j; i, j = i+1, j-1 {
+		compacted[i], compacted[j] = compacted[j], compacted[i]
+	}
+	r.Records = compacted
+	// the first records pulled may have been dropped, the last checkpoint stays with the commit
+	if len(compacted) > 0 {
+		r.FirstCheckPointID, _ = r.CheckPointRange()
+	}
+	// indices no longer line up with the compacted records
+	r.TablePKeyLastSeen = nil
 }
 
 type SyncRecordsRequest struct {
@@ -304,11 +502,131 @@ type SyncRecordsRequest struct {
 	PushBatchSize int64
 	// PushParallelism is the number of batches in Event Hub to push in parallel.
 	PushParallelism int64
-}
+	// NullFloatSpecialValues syncs NaN and +/-Infinity as null instead of string tokens.
+	NullFloatSpecialValues bool
+	// EmptyStringsAsNull syncs empty strings as null.
+	EmptyStringsAsNull bool
+	// RawDataAsVariant parses record data into a VARIANT when inserting into the raw table.
+	RawDataAsVariant bool
+	// DeferCheckpoint advances the sync batch ID without persisting the checkpoint.
+	DeferCheckpoint bool
+	// MaxInlineRecordSize is the size in bytes above which a record is staged as a file
+	// instead of inserted inline, 0 means no limit.
+	MaxInlineRecordSize int64
+	// SourceLSNColumn adds the checkpoint of each record to its data, to be merged into a
+	// _PEERDB_SOURCE_LSN column of the normalized table.
+	SourceLSNColumn bool
+	// GuardSyncBatchID fails the sync with ErrSyncBatchIDConflict if the sync batch ID was advanced
+	// by another sync of the mirror since this sync read it. Only syncs inserting their raw rows in
+	// the transaction recording the batch can be guarded.
+	GuardSyncBatchID bool
+	// IdempotentStagedInserts deletes the raw table rows of the batch left behind by a failed
+	// attempt to sync it before syncing it again, which syncs loading raw rows outside of the
+	// sync transaction always do.
+	IdempotentStagedInserts bool
+	// ParallelRawInserts is the number of connections the chunks of a multi insert sync are inserted
+	// over at once, outside the sync transaction. 0 or 1 inserts them in the transaction.
+	ParallelRawInserts uint32
+}
+
+// LoadsRawRowsOutsideTx tells if the sync loads raw rows that commit ahead of the transaction recording
+// the batch: Avro syncs, parallel raw inserts and records staged for their size. A failed sync leaves
+// these rows behind in the raw table.
+func (req *SyncRecordsRequest) LoadsRawRowsOutsideTx() bool {
+	return req.SyncMode == protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO || req.ParallelRawInserts > 1 ||
+		req.MaxInlineRecordSize > 0
+}
+
+// FirstSyncBatchID is the ID of the first batch a mirror syncs, later batches count up from it.
+const FirstSyncBatchID int64 = 1
+
+// NoNormalizedBatchID is the normalize batch ID of a mirror that has not normalized any batch yet, which
+// the metadata tables record as NULL. It comes right before the first synced batch, so normalizing the
+// batches after it starts at the first one.
+const NoNormalizedBatchID = FirstSyncBatchID - 1
+
+// ErrSyncBatchIDConflict fails a sync that lost the race to advance the sync batch ID of its mirror
+// to another sync of the same mirror, which recorded the batch first.
+var ErrSyncBatchIDConflict = errors.New("sync batch ID was advanced by another sync of the mirror")
 
 type NormalizeRecordsRequest struct {
 	FlowJobName string
 	SoftDelete  bool
+	// MergeLimiter bounds the merges running concurrently across all mirrors of the worker.
+	MergeLimiter ConcurrencyLimiter
+	// RawDataAsVariant means the raw table stores record data as a VARIANT that needs no parsing.
+	RawDataAsVariant bool
+	// DynamicTables means the normalized tables are dynamic tables, which need no normalizing. The synced
+	// batches are only recorded as normalized.
+	DynamicTables bool
+	// RawOnlyTables are the destination tables that are left in the raw table and not normalized.
+	// Disabled tables are still normalized, so that the raw records they synced before are merged.
+	RawOnlyTables map[string]bool
+	// SourceLSNColumn means the normalized tables have a _PEERDB_SOURCE_LSN column to merge into.
+	SourceLSNColumn bool
+	// DedupBySourceLSN skips changes to rows that already have a change at the same or a later LSN,
+	// so changes synced again in a later batch are not applied twice. Requires SourceLSNColumn.
+	DedupBySourceLSN bool
+	// Force re-runs the merge of the last normalized batch when normalize has caught up with sync.
+	Force bool
+	// ColumnDefaults are the values inserted into destination columns that are not replicated,
+	// keyed by destination table identifier.
+	ColumnDefaults map[string]*protos.ColumnDefaults
+	// MaxDisconnectRetries is how many times the normalize is run again within the activity
+	// after the connection to the destination dropped while merging.
+	MaxDisconnectRetries uint64
+	// DedupNullsOrdering is where records with a NULL timestamp rank when keeping the latest record.
+	DedupNullsOrdering protos.DedupNullsOrdering
+	// VariantNullPolicy is how a column that is json null in a raw record is normalized.
+	VariantNullPolicy protos.VariantNullPolicy
+	// MaxRecordsPerMerge caps the raw table rows read by each merge of the normalize. Batches are merged
+	// in transactions of at most that many rows, and a batch with more rows is merged by several merges
+	// in a transaction of its own. 0 merges all batches at once.
+	MaxRecordsPerMerge uint32
+	// TimeoutSplitPolicy is how the batches of a merge that ran into the statement timeout are split
+	// to be merged again.
+	TimeoutSplitPolicy protos.MergeTimeoutSplitPolicy
+	// KeyVersionPolicy is how raw records missing a column of the current primary key are normalized.
+	KeyVersionPolicy protos.PrimaryKeyVersionPolicy
+	// LastOpColumn means the normalized tables have a _PEERDB_LAST_OP column to merge the operation into.
+	LastOpColumn bool
+	// RecordTypePolicy is what to do with raw records that are not inserts, updates or deletes.
+	RecordTypePolicy protos.UnknownRecordTypePolicy
+	// PreNormalizeSQL and PostNormalizeSQL are statements run on the destination before and after
+	// the merges of the normalize, outside their transactions. Empty statements are not run.
+	PreNormalizeSQL  string
+	PostNormalizeSQL string
+}
+
+// RebuildNormalizedTableRequest truncates a normalized table of a CDC mirror and re-merges it from the raw table.
+type RebuildNormalizedTableRequest struct {
+	// Normalize is the normalize of the mirror, whose options the table is merged with.
+	Normalize                  *NormalizeRecordsRequest
+	DestinationTableIdentifier string
+	// BatchesPerMerge is the number of raw table batches merged per statement, 0 for the default.
+	BatchesPerMerge int64
+	// InitialCopy means the mirror snapshotted its tables. The rows of the snapshot were loaded straight
+	// into the normalized tables and are not in the raw table, so the tables can't be rebuilt from it.
+	InitialCopy bool
+}
+
+// ConcurrencyLimiter bounds work that is shared across mirrors, such as merges into a common warehouse.
+type ConcurrencyLimiter interface {
+	// Acquire blocks until the work may start, heartbeating while it waits.
+	Acquire(ctx context.Context, heartbeatInterval time.Duration, message func() string) error
+	// Release signals that work started after Acquire is done.
+	Release()
+}
+
+// RawTable is a raw table found on a destination.
+type RawTable struct {
+	// TableIdentifier is the name of the raw table in the internal schema.
+	TableIdentifier string
+	// MirrorJobName is the mirror this raw table belongs to, empty if it belongs to none of the
+	// mirrors it was listed for.
+	MirrorJobName string
+	// LastAltered is when the raw table was last written to or changed.
+	LastAltered time.Time
 }
 
 type SyncResponse struct {
@@ -346,6 +664,8 @@ type RecordsWithTableSchemaDelta struct {
 type RelationRecord struct {
 	CheckPointID     int64
 	TableSchemaDelta *protos.TableSchemaDelta
+	// RelationID is the source relation whose schema changed.
+	RelationID uint32
 }
 
 // Implement Record interface for RelationRecord.
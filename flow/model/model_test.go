@@ -0,0 +1,169 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/assert"
+)
+
+func floatSpecialValuesRecordItems() *RecordItems {
+	return NewRecordItemWithData(
+		[]string{"nan", "pos_inf", "neg_inf", "f32_nan", "regular"},
+		[]*qvalue.QValue{
+			{Kind: qvalue.QValueKindFloat64, Value: math.NaN()},
+			{Kind: qvalue.QValueKindFloat64, Value: math.Inf(1)},
+			{Kind: qvalue.QValueKindFloat64, Value: math.Inf(-1)},
+			{Kind: qvalue.QValueKindFloat32, Value: float32(math.NaN())},
+			{Kind: qvalue.QValueKindFloat64, Value: 1.5},
+		},
+	)
+}
+
+func TestToJSONFloatSpecialValuesAsTokens(t *testing.T) {
+	jsonStr, err := floatSpecialValuesRecordItems().ToJSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t,
+		`{"nan":"NaN","pos_inf":"inf","neg_inf":"-inf","f32_nan":"NaN","regular":1.5}`, jsonStr)
+}
+
+func TestToJSONFloatSpecialValuesAsNull(t *testing.T) {
+	opts := NewToJSONOptions(nil)
+	opts.NullFloatSpecialValues = true
+
+	jsonStr, err := floatSpecialValuesRecordItems().ToJSONWithOpts(opts)
+	assert.NoError(t, err)
+	assert.JSONEq(t,
+		`{"nan":null,"pos_inf":null,"neg_inf":null,"f32_nan":null,"regular":1.5}`, jsonStr)
+}
+
+func TestToJSONEmptyStringsAsNull(t *testing.T) {
+	items := NewRecordItemWithData(
+		[]string{"empty", "non_empty", "empty_json"},
+		[]*qvalue.QValue{
+			{Kind: qvalue.QValueKindString, Value: ""},
+			{Kind: qvalue.QValueKindString, Value: "a"},
+			{Kind: qvalue.QValueKindJSON, Value: ""},
+		},
+	)
+
+	jsonStr, err := items.ToJSON()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"empty":"","non_empty":"a","empty_json":""}`, jsonStr)
+
+	opts := NewToJSONOptions(nil)
+	opts.EmptyStringsAsNull = true
+	jsonStr, err = items.ToJSONWithOpts(opts)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"empty":null,"non_empty":"a","empty_json":""}`, jsonStr)
+
+	// empty primary keys are still keys
+	opts.TableNameSchemaMapping = map[string]*protos.TableSchema{
+		"public.t": {TableIdentifier: "public.t", PrimaryKeyColumns: []string{"empty"}},
+	}
+	record := &InsertRecord{DestinationTableName: "public.t", Items: items}
+	jsonStr, err = items.ToJSONWithOpts(opts.ForRecord(record))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"empty":"","non_empty":"a","empty_json":""}`, jsonStr)
+	record = &InsertRecord{DestinationTableName: "public.other", Items: items}
+	jsonStr, err = items.ToJSONWithOpts(opts.ForRecord(record))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"empty":null,"non_empty":"a","empty_json":""}`, jsonStr)
+}
+
+func compactionTestItems(id int64, value string) *RecordItems {
+	return NewRecordItemWithData(
+		[]string{"id", "value"},
+		[]*qvalue.QValue{
+			{Kind: qvalue.QValueKindInt64, Value: id},
+			{Kind: qvalue.QValueKindString, Value: value},
+		},
+	)
+}
+
+func TestCompactRecords(t *testing.T) {
+	tableNameSchemaMapping := map[string]*protos.TableSchema{
+		"public.t": {
+			TableIdentifier: "public.t",
+			Columns: map[string]string{
+				"id":    string(qvalue.QValueKindInt64),
+				"value": string(qvalue.QValueKindString),
+			},
+			PrimaryKeyColumns: []string{"id"},
+		},
+	}
+
+	batch := &RecordBatch{FirstCheckPointID: 100, LastCheckPointID: 200}
+	batch.Records = append(batch.Records, &InsertRecord{
+		DestinationTableName: "public.t",
+		CheckPointID:         100,
+		Items:                compactionTestItems(1, "v0"),
+	})
+	for i := 1; i <= 10; i++ {
+		batch.Records = append(batch.Records, &UpdateRecord{
+			DestinationTableName: "public.t",
+			CheckPointID:         int64(100 + i),
+			NewItems:             compactionTestItems(1, fmt.Sprintf("v%d", i)),
+		})
+	}
+	// a row inserted and then deleted within the batch should only leave the delete behind
+	batch.Records = append(batch.Records,
+		&InsertRecord{DestinationTableName: "public.t", CheckPointID: 120, Items: compactionTestItems(2, "gone")},
+		&DeleteRecord{DestinationTableName: "public.t", CheckPointID: 130, Items: compactionTestItems(2, "gone")},
+	)
+
+	batch.CompactRecords(tableNameSchemaMapping)
+
+	assert.Len(t, batch.Records, 2)
+	assert.Equal(t, int64(110), batch.FirstCheckPointID)
+	assert.Equal(t, int64(200), batch.LastCheckPointID)
+	updateRecord, ok := batch.Records[0].(*UpdateRecord)
+	assert.True(t, ok)
+	value, err := updateRecord.NewItems.GetValueByColName("value")
+	assert.NoError(t, err)
+	assert.Equal(t, "v10", value.Value)
+	_, ok = batch.Records[1].(*DeleteRecord)
+	assert.True(t, ok)
+}
+
+func TestCompactRecordsWithoutPrimaryKey(t *testing.T) {
+	batch := &RecordBatch{}
+	for i := 0; i < 10; i++ {
+		batch.Records = append(batch.Records, &InsertRecord{
+			DestinationTableName: "public.no_pkey",
+			Items:                compactionTestItems(1, "v"),
+		})
+	}
+
+	batch.CompactRecords(map[string]*protos.TableSchema{})
+
+	assert.Len(t, batch.Records, 10)
+}
+
+func TestCheckPointRangeOutOfOrder(t *testing.T) {
+	batch := &RecordBatch{
+		Records: []Record{
+			&InsertRecord{DestinationTableName: "public.t", CheckPointID: 30},
+			&UpdateRecord{DestinationTableName: "public.t", CheckPointID: 10},
+			&DeleteRecord{DestinationTableName: "public.t", CheckPointID: 50},
+			&InsertRecord{DestinationTableName: "public.t", CheckPointID: 20},
+		},
+		LastCheckPointID: 40,
+	}
+
+	firstCP, lastCP := batch.CheckPointRange()
+	assert.Equal(t, int64(10), firstCP)
+	assert.Equal(t, int64(50), lastCP)
+
+	// the batch's last checkpoint counts even if no record reaches it
+	batch.LastCheckPointID = 60
+	_, lastCP = batch.CheckPointRange()
+	assert.Equal(t, int64(60), lastCP)
+
+	firstCP, lastCP = (&RecordBatch{LastCheckPointID: 5}).CheckPointRange()
+	assert.Equal(t, int64(0), firstCP)
+	assert.Equal(t, int64(5), lastCP)
+}
@@ -238,6 +238,27 @@ func (src *QRecordBatchCopyFromSource) Values() ([]interface{}, error) {
 				Valid:    true,
 			}
 
+		case qvalue.QValueKindArrayUUID:
+			v, ok := qValue.Value.([]string)
+			if !ok {
+				src.err = fmt.Errorf("invalid ArrayUUID value")
+				return nil, src.err
+			}
+			elements := make([]pgtype.UUID, 0, len(v))
+			for _, element := range v {
+				u, err := uuid.Parse(element)
+				if err != nil {
+					src.err = fmt.Errorf("invalid UUID %s in ArrayUUID value: %w", element, err)
+					return nil, src.err
+				}
+				elements = append(elements, pgtype.UUID{Bytes: u, Valid: true})
+			}
+			values[i] = pgtype.Array[pgtype.UUID]{
+				Elements: elements,
+				Dims:     []pgtype.ArrayDimension{{Length: int32(len(elements)), LowerBound: 1}},
+				Valid:    true,
+			}
+
 		case qvalue.QValueKindArrayInt32:
 			v, ok := qValue.Value.([]int32)
 			if !ok {
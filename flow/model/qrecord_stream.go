@@ -1,6 +1,10 @@
 package model
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
 
 type QRecordOrError struct {
 	Record *QRecord
@@ -24,6 +28,8 @@ type RecordsToStreamRequest struct {
 	TableMapping map[string]uint32
 	CP           int64
 	BatchID      int64
+	// ToJSONOptions are used to serialize record items, defaults are used if nil.
+	ToJSONOptions *ToJSONOptions
 }
 
 type RecordsToStreamResponse struct {
@@ -62,6 +68,20 @@ func (s *QRecordStream) SetSchema(schema *QRecordSchema) error {
 	return nil
 }
 
+// SetSchemaError fails the schema of the stream, for a query that failed before its schema was known,
+// so that readers waiting on the schema get the error instead.
+func (s *QRecordStream) SetSchemaError(err error) error {
+	if s.schemaSet {
+		return fmt.Errorf("Schema already set")
+	}
+
+	s.schema <- &QRecordSchemaOrError{
+		Err: err,
+	}
+	s.schemaSet = true
+	return nil
+}
+
 func (s *QRecordStream) IsSchemaSet() bool {
 	return s.schemaSet
 }
@@ -69,3 +89,57 @@ func (s *QRecordStream) IsSchemaSet() bool {
 func (s *QRecordStream) SchemaChan() chan *QRecordSchemaOrError {
 	return s.schema
 }
+
+// TransformStream returns a stream with the records of the given stream, each passed through the
+// transform that newTransform makes for the stream's schema, which is nil if the schema failed. Record
+// errors are passed on as is. A transform error ends the stream with that error. Once ctx is done, the
+// remaining records are drained without being passed on.
+func TransformStream(
+	ctx context.Context,
+	stream *QRecordStream,
+	newTransform func(schema *QRecordSchema) func(record *QRecord) error,
+) *QRecordStream {
+	transformed := NewQRecordStream(cap(stream.Records))
+
+	go func() {
+		defer close(transformed.Records)
+
+		schema, err := stream.Schema()
+		transformed.SchemaChan() <- &QRecordSchemaOrError{
+			Schema: schema,
+			Err:    err,
+		}
+
+		transform := newTransform(schema)
+		failed := false
+		for recordOrErr := range stream.Records {
+			if failed || ctx.Err() != nil {
+				continue
+			}
+			if recordOrErr.Err == nil {
+				err := transform(recordOrErr.Record)
+				if err != nil {
+					recordOrErr = &QRecordOrError{Err: err}
+					failed = true
+				}
+			}
+			select {
+			case transformed.Records <- recordOrErr:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return transformed
+}
+
+// CountRecords returns a stream with the records of the given stream, adding each record to counter.
+// Once ctx is done, the remaining records are drained without being passed on.
+func CountRecords(ctx context.Context, stream *QRecordStream, counter *atomic.Int64) *QRecordStream {
+	return TransformStream(ctx, stream, func(*QRecordSchema) func(*QRecord) error {
+		return func(*QRecord) error {
+			counter.Add(1)
+			return nil
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransformStream(t *testing.T) {
+	batch := &QRecordBatch{
+		NumRecords: 3,
+		Records: []*QRecord{
+			{NumEntries: 1, Entries: []qvalue.QValue{{Kind: qvalue.QValueKindInt64, Value: int64(1)}}},
+			{NumEntries: 1, Entries: []qvalue.QValue{{Kind: qvalue.QValueKindInt64, Value: int64(2)}}},
+			{NumEntries: 1, Entries: []qvalue.QValue{{Kind: qvalue.QValueKindInt64, Value: int64(3)}}},
+		},
+		Schema: NewQRecordSchema([]*QField{{Name: "id", Type: qvalue.QValueKindInt64}}),
+	}
+	stream, err := batch.ToQRecordStream(3)
+	require.NoError(t, err)
+	var counter atomic.Int64
+	stream = CountRecords(context.Background(), stream, &counter)
+	// the second record fails the stream, the third is not passed on
+	stream = TransformStream(context.Background(), stream, func(schema *QRecordSchema) func(*QRecord) error {
+		require.Equal(t, []string{"id"}, schema.GetColumnNames())
+		return func(record *QRecord) error {
+			if record.Entries[0].Value == int64(2) {
+				return errors.New("failed to transform record")
+			}
+			return nil
+		}
+	})
+
+	var results []*QRecordOrError
+	for recordOrErr := range stream.Records {
+		results = append(results, recordOrErr)
+	}
+	require.Len(t, results, 2)
+	require.NoError(t, results[0].Err)
+	require.ErrorContains(t, results[1].Err, "failed to transform record")
+	require.Equal(t, int64(3), counter.Load())
+}
@@ -110,7 +110,7 @@ func GetAvroSchemaFromQValueKind(kind QValueKind, nullable bool) (*QValueKindAvr
 				"items": "long",
 			},
 		}, nil
-	case QValueKindArrayString:
+	case QValueKindArrayString, QValueKindArrayUUID:
 		return &QValueKindAvroSchema{
 			AvroLogicalSchema: map[string]interface{}{
 				"type":  "array",
@@ -202,7 +202,7 @@ func (c *QValueAvroConverter) ToAvroValue() (interface{}, error) {
 		return c.processArrayInt32()
 	case QValueKindArrayInt64:
 		return c.processArrayInt64()
-	case QValueKindArrayString:
+	case QValueKindArrayString, QValueKindArrayUUID:
 		return c.processArrayString()
 	case QValueKindUUID:
 		return c.processUUID()
@@ -223,6 +223,11 @@ func (c *QValueAvroConverter) processGoTime() (interface{}, error) {
 		return nil, fmt.Errorf("invalid Time value")
 	}
 
+	// Snowflake reads a time of day given as an integer as seconds since the epoch, write it as text instead.
+	if c.TargetDWH == QDWHTypeSnowflake && (c.Value.Kind == QValueKindTime || c.Value.Kind == QValueKindTimeTZ) {
+		return c.Value.GoTimeConvert()
+	}
+
 	ret := t.UnixMicro()
 	// Snowflake has issues with avro timestamp types, returning as string form of the int64
 	// See: https://stackoverflow.com/questions/66104762/snowflake-date-column-have-incorrect-date-from-avro-file
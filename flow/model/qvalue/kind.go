@@ -33,6 +33,8 @@ const (
 	QValueKindArrayInt32   QValueKind = "array_int32"
 	QValueKindArrayInt64   QValueKind = "array_int64"
 	QValueKindArrayString  QValueKind = "array_string"
+	// arrays of uuids, held as their string form
+	QValueKindArrayUUID QValueKind = "array_uuid"
 )
 
 func QValueKindIsArray(kind QValueKind) bool {
@@ -41,7 +43,8 @@ func QValueKindIsArray(kind QValueKind) bool {
 		QValueKindArrayFloat64,
 		QValueKindArrayInt32,
 		QValueKindArrayInt64,
-		QValueKindArrayString:
+		QValueKindArrayString,
+		QValueKindArrayUUID:
 		return true
 	default:
 		return false
@@ -61,7 +61,7 @@ func (q *QValue) Equals(other *QValue) bool {
 		return compareNumericArrays(q.Value, other.Value)
 	case QValueKindArrayInt64:
 		return compareNumericArrays(q.Value, other.Value)
-	case QValueKindArrayString:
+	case QValueKindArrayString, QValueKindArrayUUID:
 		return compareArrayString(q.Value, other.Value)
 	}
 
@@ -69,11 +69,9 @@ func (q *QValue) Equals(other *QValue) bool {
 }
 
 func (q *QValue) GoTimeConvert() (string, error) {
+	// time with time zone is converted to UTC when read, so both are written as a time of day.
 	if q.Kind == QValueKindTime || q.Kind == QValueKindTimeTZ {
 		return q.Value.(time.Time).Format("15:04:05.999999"), nil
-		// no connector supports time with timezone yet
-		// } else if q.Kind == QValueKindTimeTZ {
-		// 	return q.Value.(time.Time).Format("15:04:05.999999-0700"), nil
 	} else if q.Kind == QValueKindDate {
 		return q.Value.(time.Time).Format("2006-01-02"), nil
 	} else if q.Kind == QValueKindTimestamp {
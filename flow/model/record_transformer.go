@@ -0,0 +1,164 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+)
+
+// RecordTransformer rewrites the columns of records before they are synced. Mirrors select the
+// transformers to apply by the names they are registered under, see RegisterRecordTransformer.
+//
+// Transform is called with the destination table and the items of every record in a batch, in
+// batch order: the items of inserts and deletes, and both the new and old items of updates. It returns
+// the items to sync in their place, which may be the same items changed in place. An error fails the
+// sync of the batch, which is retried. Transform is called for the batches of all mirrors using the
+// transformer, so it must be safe for concurrent use. Batches are pulled and transformed again when
+// their sync fails, so a record may be transformed more than once.
+//
+// Transformers only apply to the records of CDC mirrors, not to their initial snapshot or to qrep mirrors.
+// Only the columns of the destination table schema are normalized, columns a transformer adds or
+// renames are kept in the raw table, and by destinations syncing records as they are, like Event Hubs.
+type RecordTransformer interface {
+	Transform(destinationTableName string, items *RecordItems) (*RecordItems, error)
+}
+
+// RecordTransformerFunc adapts a function to a RecordTransformer.
+type RecordTransformerFunc func(destinationTableName string, items *RecordItems) (*RecordItems, error)
+
+func (f RecordTransformerFunc) Transform(destinationTableName string, items *RecordItems) (*RecordItems, error) {
+	return f(destinationTableName, items)
+}
+
+const (
+	// LowercaseKeysTransformer renames every column to its lowercase name. The renamed columns would
+	// no longer match the destination table schema, so it can't be used by mirrors that normalize.
+	LowercaseKeysTransformer = "lowercase-keys"
+	// AddIngestTimestampTransformer adds an IngestTimestampColumn column with the time the record
+	// was synced.
+	AddIngestTimestampTransformer = "add-ingest-ts"
+	// IngestTimestampColumn is the column added by the add-ingest-ts transformer.
+	IngestTimestampColumn = "_peerdb_ingested_at"
+)
+
+var (
+	recordTransformersLock sync.RWMutex
+	recordTransformers     = map[string]RecordTransformer{
+		LowercaseKeysTransformer:      RecordTransformerFunc(lowercaseKeys),
+		AddIngestTimestampTransformer: RecordTransformerFunc(addIngestTimestamp),
+	}
+)
+
+// RegisterRecordTransformer makes a transformer available to mirrors under the given name. It is meant
+// to be called from the init function of a package compiled into the worker, and panics if the name is
+// already taken.
+func RegisterRecordTransformer(name string, transformer RecordTransformer) {
+	recordTransformersLock.Lock()
+	defer recordTransformersLock.Unlock()
+	if transformer == nil {
+		panic("record transformer " + name + " is nil")
+	}
+	if _, ok := recordTransformers[name]; ok {
+		panic("record transformer " + name + " is already registered")
+	}
+	recordTransformers[name] = transformer
+}
+
+// GetRecordTransformers returns the transformers registered under the given names, in order.
+func GetRecordTransformers(names []string) ([]RecordTransformer, error) {
+	recordTransformersLock.RLock()
+	defer recordTransformersLock.RUnlock()
+	transformers := make([]RecordTransformer, 0, len(names))
+	for _, name := range names {
+		transformer, ok := recordTransformers[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown record transformer %s, registered transformers are %s",
+				name, strings.Join(registeredRecordTransformerNames(), ", "))
+		}
+		transformers = append(transformers, transformer)
+	}
+	return transformers, nil
+}
+
+// CheckRecordTransformers returns an error if a transformer isn't registered, or if it renames columns
+// and the mirror normalizes records into destination tables.
+func CheckRecordTransformers(names []string, normalizes bool) error {
+	if _, err := GetRecordTransformers(names); err != nil {
+		return err
+	}
+	if normalizes {
+		for _, name := range names {
+			if name == LowercaseKeysTransformer {
+				return fmt.Errorf("record transformer %s renames columns, which would not be normalized "+
+					"into the destination tables", name)
+			}
+		}
+	}
+	return nil
+}
+
+func registeredRecordTransformerNames() []string {
+	names := make([]string, 0, len(recordTransformers))
+	for name := range recordTransformers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TransformRecords applies the transformers to the records of the batch, one after the other.
+func (r *RecordBatch) TransformRecords(transformers []RecordTransformer) error {
+	for _, transformer := range transformers {
+		for _, record := range r.Records {
+			tableName := recordDestinationTableName(record)
+			var err error
+			switch typedRecord := record.(type) {
+			case *InsertRecord:
+				typedRecord.Items, err = transformItems(transformer, tableName, typedRecord.Items)
+			case *UpdateRecord:
+				typedRecord.NewItems, err = transformItems(transformer, tableName, typedRecord.NewItems)
+				if err == nil {
+					typedRecord.OldItems, err = transformItems(transformer, tableName, typedRecord.OldItems)
+				}
+			case *DeleteRecord:
+				typedRecord.Items, err = transformItems(transformer, tableName, typedRecord.Items)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to transform record of table %s: %w", tableName, err)
+			}
+		}
+	}
+	return nil
+}
+
+func transformItems(transformer RecordTransformer, tableName string, items *RecordItems) (*RecordItems, error) {
+	if items == nil {
+		return nil, nil
+	}
+	return transformer.Transform(tableName, items)
+}
+
+func lowercaseKeys(_ string, items *RecordItems) (*RecordItems, error) {
+	columns := items.ColumnNames()
+	lowercased := NewRecordItems()
+	for _, column := range columns {
+		lowercaseColumn := strings.ToLower(column)
+		if _, ok := lowercased.colToValIdx[lowercaseColumn]; ok {
+			return nil, fmt.Errorf("more than one column is named %s in lowercase", lowercaseColumn)
+		}
+		lowercased.AddColumn(lowercaseColumn, items.GetColumnValue(column))
+	}
+	return lowercased, nil
+}
+
+func addIngestTimestamp(_ string, items *RecordItems) (*RecordItems, error) {
+	items.AddColumn(IngestTimestampColumn, &qvalue.QValue{
+		Kind:  qvalue.QValueKindTimestampTZ,
+		Value: time.Now().UTC(),
+	})
+	return items, nil
+}
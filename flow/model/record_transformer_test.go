@@ -0,0 +1,82 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltinRecordTransformers(t *testing.T) {
+	int64Value := func(v int64) *qvalue.QValue { return &qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: v} }
+	batch := &RecordBatch{Records: []Record{
+		&InsertRecord{DestinationTableName: "public.t", Items: NewRecordItemWithData(
+			[]string{"ID", "Name"}, []*qvalue.QValue{int64Value(1), {Kind: qvalue.QValueKindString, Value: "a"}})},
+		&UpdateRecord{
+			DestinationTableName: "public.t",
+			NewItems:             NewRecordItemWithData([]string{"ID"}, []*qvalue.QValue{int64Value(2)}),
+			OldItems:             NewRecordItemWithData([]string{"ID"}, []*qvalue.QValue{int64Value(1)}),
+		},
+		&DeleteRecord{DestinationTableName: "public.t", Items: NewRecordItemWithData(
+			[]string{"ID"}, []*qvalue.QValue{int64Value(2)})},
+	}}
+
+	transformers, err := GetRecordTransformers([]string{LowercaseKeysTransformer, AddIngestTimestampTransformer})
+	require.NoError(t, err)
+	before := time.Now()
+	require.NoError(t, batch.TransformRecords(transformers))
+
+	insert := batch.Records[0].(*InsertRecord)
+	require.Equal(t, []string{"id", "name", IngestTimestampColumn}, insert.Items.ColumnNames())
+	require.Equal(t, "a", insert.Items.GetColumnValue("name").Value)
+	ingestedAt := insert.Items.GetColumnValue(IngestTimestampColumn)
+	require.Equal(t, qvalue.QValueKindTimestampTZ, ingestedAt.Kind)
+	require.False(t, ingestedAt.Value.(time.Time).Before(before.Truncate(time.Microsecond)))
+
+	update := batch.Records[1].(*UpdateRecord)
+	require.Equal(t, []string{"id", IngestTimestampColumn}, update.NewItems.ColumnNames())
+	require.Equal(t, []string{"id", IngestTimestampColumn}, update.OldItems.ColumnNames())
+	require.Equal(t, int64(1), update.OldItems.GetColumnValue("id").Value)
+	del := batch.Records[2].(*DeleteRecord)
+	require.Equal(t, []string{"id", IngestTimestampColumn}, del.Items.ColumnNames())
+
+	// columns only differing in case can't be told apart once lowercased
+	batch = &RecordBatch{Records: []Record{&InsertRecord{DestinationTableName: "public.t",
+		Items: NewRecordItemWithData([]string{"Id", "ID"}, []*qvalue.QValue{int64Value(1), int64Value(2)})}}}
+	transformers, err = GetRecordTransformers([]string{LowercaseKeysTransformer})
+	require.NoError(t, err)
+	require.ErrorContains(t, batch.TransformRecords(transformers), "more than one column is named id in lowercase")
+}
+
+func TestRegisterRecordTransformer(t *testing.T) {
+	RegisterRecordTransformer("test-uppercase-names", RecordTransformerFunc(
+		func(_ string, items *RecordItems) (*RecordItems, error) {
+			name := items.GetColumnValue("name")
+			items.AddColumn("name", &qvalue.QValue{Kind: name.Kind, Value: strings.ToUpper(name.Value.(string))})
+			return items, nil
+		}))
+	require.Panics(t, func() {
+		RegisterRecordTransformer(LowercaseKeysTransformer, RecordTransformerFunc(lowercaseKeys))
+	})
+
+	transformers, err := GetRecordTransformers([]string{"test-uppercase-names"})
+	require.NoError(t, err)
+	batch := &RecordBatch{Records: []Record{&InsertRecord{DestinationTableName: "public.t",
+		Items: NewRecordItemWithData([]string{"name"}, []*qvalue.QValue{{Kind: qvalue.QValueKindString, Value: "a"}})}}}
+	require.NoError(t, batch.TransformRecords(transformers))
+	require.Equal(t, "A", batch.Records[0].GetItems().GetColumnValue("name").Value)
+
+	_, err = GetRecordTransformers([]string{"missing"})
+	require.ErrorContains(t, err,
+		"unknown record transformer missing, registered transformers are add-ingest-ts, lowercase-keys")
+}
+
+func TestCheckRecordTransformers(t *testing.T) {
+	require.NoError(t, CheckRecordTransformers([]string{AddIngestTimestampTransformer}, true))
+	require.NoError(t, CheckRecordTransformers([]string{LowercaseKeysTransformer}, false))
+	require.ErrorContains(t, CheckRecordTransformers([]string{LowercaseKeysTransformer}, true),
+		"record transformer lowercase-keys renames columns")
+	require.ErrorContains(t, CheckRecordTransformers([]string{"missing"}, false), "unknown record transformer missing")
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"context"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"golang.org/x/text/unicode/norm"
+)
+
+// nfcItems rewrites the text values of the given columns in NFC.
+func nfcItems(items *RecordItems, columns []string) {
+	if items == nil {
+		return
+	}
+	for _, column := range columns {
+		value := items.GetColumnValue(column)
+		if value == nil || value.Kind != qvalue.QValueKindString {
+			continue
+		}
+		if text, ok := value.Value.(string); ok && !norm.NFC.IsNormalString(text) {
+			items.AddColumn(column, &qvalue.QValue{Kind: value.Kind, Value: norm.NFC.String(text)})
+		}
+	}
+}
+
+// NFCPrimaryKeys rewrites the text primary key values of the records in the batch in NFC, so that
+// keys which only differ in their unicode normalization, such as an accented character written as one
+// code point or as a letter followed by a combining accent, are merged into the same row.
+func (r *RecordBatch) NFCPrimaryKeys(tableNameSchemaMapping map[string]*protos.TableSchema) {
+	for _, record := range r.Records {
+		tableSchema, ok := tableNameSchemaMapping[recordDestinationTableName(record)]
+		if !ok || len(tableSchema.PrimaryKeyColumns) == 0 {
+			continue
+		}
+		switch typedRecord := record.(type) {
+		case *InsertRecord:
+			nfcItems(typedRecord.Items, tableSchema.PrimaryKeyColumns)
+		case *UpdateRecord:
+			nfcItems(typedRecord.NewItems, tableSchema.PrimaryKeyColumns)
+			nfcItems(typedRecord.OldItems, tableSchema.PrimaryKeyColumns)
+		case *DeleteRecord:
+			nfcItems(typedRecord.Items, tableSchema.PrimaryKeyColumns)
+		}
+	}
+}
+
+// NFCColumns returns a stream with the records of the given stream, where the text values of the
+// given columns are rewritten in NFC like NFCPrimaryKeys does for cdc records. Once ctx is done, the
+// remaining records are drained without being passed on. Without columns, the stream is returned as is.
+func NFCColumns(ctx context.Context, stream *QRecordStream, columns []string) *QRecordStream {
+	if len(columns) == 0 {
+		return stream
+	}
+	return TransformStream(ctx, stream, func(schema *QRecordSchema) func(*QRecord) error {
+		nfcFields := make(map[int]struct{}, len(columns))
+		if schema != nil {
+			for i, field := range schema.Fields {
+				for _, column := range columns {
+					if field.Name == column {
+						nfcFields[i] = struct{}{}
+					}
+				}
+			}
+		}
+
+		return func(record *QRecord) error {
+			for i := range nfcFields {
+				if i >= len(record.Entries) {
+					continue
+				}
+				entry := &record.Entries[i]
+				if text, ok := entry.Value.(string); ok && entry.Kind == qvalue.QValueKindString {
+					entry.Value = norm.NFC.String(text)
+				}
+			}
+			return nil
+		}
+	})
+}
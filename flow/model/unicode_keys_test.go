@@ -0,0 +1,94 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// "café" with the é as a single code point
+	nfcKey = "café"
+	// "café" with the é as an e followed by a combining acute accent
+	nfdKey = "café"
+)
+
+func nfcTestItems(key string, val string) *RecordItems {
+	items := NewRecordItems()
+	items.AddColumn("id", &qvalue.QValue{Kind: qvalue.QValueKindString, Value: key})
+	items.AddColumn("val", &qvalue.QValue{Kind: qvalue.QValueKindString, Value: val})
+	return items
+}
+
+func TestNFCPrimaryKeys(t *testing.T) {
+	tableNameSchemaMapping := map[string]*protos.TableSchema{
+		"public.t": {
+			TableIdentifier:   "public.t",
+			Columns:           map[string]string{"id": string(qvalue.QValueKindString)},
+			PrimaryKeyColumns: []string{"id"},
+		},
+	}
+	batch := &RecordBatch{
+		Records: []Record{
+			&InsertRecord{DestinationTableName: "public.t", CheckPointID: 1, Items: nfcTestItems(nfcKey, nfdKey)},
+			&UpdateRecord{
+				DestinationTableName: "public.t",
+				CheckPointID:         2,
+				OldItems:             nfcTestItems(nfdKey, nfdKey),
+				NewItems:             nfcTestItems(nfdKey, "updated"),
+			},
+		},
+	}
+	batch.NFCPrimaryKeys(tableNameSchemaMapping)
+
+	insertJSON, err := batch.Records[0].GetItems().ToJSON()
+	require.NoError(t, err)
+	updateJSON, err := batch.Records[1].GetItems().ToJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"`+nfcKey+`","val":"`+nfdKey+`"}`, insertJSON)
+	require.JSONEq(t, `{"id":"`+nfcKey+`","val":"updated"}`, updateJSON)
+	oldKey, err := batch.Records[1].(*UpdateRecord).OldItems.GetValueByColName("id")
+	require.NoError(t, err)
+	require.Equal(t, nfcKey, oldKey.Value)
+
+	// both changes are to the same row now, so only the update is kept
+	batch.CompactRecords(tableNameSchemaMapping)
+	require.Len(t, batch.Records, 1)
+	require.Equal(t, int64(2), batch.Records[0].GetCheckPointID())
+}
+
+func TestNFCColumns(t *testing.T) {
+	batch := &QRecordBatch{
+		NumRecords: 2,
+		Records: []*QRecord{
+			{NumEntries: 2, Entries: []qvalue.QValue{
+				{Kind: qvalue.QValueKindString, Value: nfcKey},
+				{Kind: qvalue.QValueKindString, Value: nfdKey},
+			}},
+			{NumEntries: 2, Entries: []qvalue.QValue{
+				{Kind: qvalue.QValueKindString, Value: nfdKey},
+				{Kind: qvalue.QValueKindString, Value: nfdKey},
+			}},
+		},
+		Schema: NewQRecordSchema([]*QField{
+			{Name: "id", Type: qvalue.QValueKindString},
+			{Name: "val", Type: qvalue.QValueKindString},
+		}),
+	}
+	stream, err := batch.ToQRecordStream(2)
+	require.NoError(t, err)
+	stream = NFCColumns(context.Background(), stream, []string{"id"})
+
+	schema, err := stream.Schema()
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "val"}, schema.GetColumnNames())
+	for recordOrErr := range stream.Records {
+		require.NoError(t, recordOrErr.Err)
+		require.Equal(t, nfcKey, recordOrErr.Record.Entries[0].Value)
+		// other columns are left as is
+		require.Equal(t, nfdKey, recordOrErr.Record.Entries[1].Value)
+	}
+}
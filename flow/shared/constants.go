@@ -1,9 +1,11 @@
 package shared
 
 const (
-	PeerFlowTaskQueue     = "peer-flow-task-queue"
-	SnapshotFlowTaskQueue = "snapshot-flow-task-queue"
-	CDCFlowSignalName     = "peer-flow-signal"
+	PeerFlowTaskQueue        = "peer-flow-task-queue"
+	SnapshotFlowTaskQueue    = "snapshot-flow-task-queue"
+	CDCFlowSignalName        = "peer-flow-signal"
+	TableSyncSignalName      = "table-sync-signal"
+	ForceNormalizeSignalName = "force-normalize-signal"
 )
 
 type CDCFlowSignal int64
@@ -14,6 +16,17 @@ const (
 	ShutdownSignal
 	EnableMetricsKey    ContextKey = "enableMetrics"
 	CDCMirrorMonitorKey ContextKey = "cdcMirrorMonitor"
+	MetricsLabelsKey    ContextKey = "metricsLabels"
 )
 
+// TableSyncSignal disables or re-enables the replication of a table of a running mirror.
+type TableSyncSignal struct {
+	DestinationTableIdentifier string
+	Disabled                   bool
+}
+
 const FetchAndChannelSize = 256 * 1024
+
+// QuotaExceededErrorType is the type of the errors of activities whose destination ran out of quota,
+// which the mirror backs off from instead of retrying.
+const QuotaExceededErrorType = "QuotaExceededError"
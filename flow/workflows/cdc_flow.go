@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/PeerDB-io/peer-flow/model"
 	"github.com/PeerDB-io/peer-flow/shared"
@@ -16,8 +17,9 @@ import (
 )
 
 const (
-	CDCFlowStatusQuery     = "q-cdc-flow-status"
-	maxSyncFlowsPerCDCFlow = 32
+	CDCFlowStatusQuery       = "q-cdc-flow-status"
+	CDCFlowQuotaBackoffQuery = "q-cdc-flow-quota-backoff"
+	maxSyncFlowsPerCDCFlow   = 32
 )
 
 type CDCFlowLimits struct {
@@ -69,6 +71,16 @@ type CDCFlowState struct {
 	// Global mapping of relation IDs to RelationMessages sent as a part of logical replication.
 	// Needed to support schema changes.
 	RelationMessageMapping model.RelationMessageMapping
+	// Last checkpoint synced, ahead of the one persisted on the destination if checkpoints are deferred.
+	LastSyncState *protos.LastSyncState
+	// Sync batches synced since the checkpoint was last persisted.
+	BatchesSinceCheckpoint uint32
+	// When the checkpoint was last persisted.
+	LastCheckpointTime time.Time
+	// Batch size of the next sync batch while ramping up to the max batch size, 0 before the first batch.
+	BatchSize int32
+	// Backoff of the mirror while its destination is out of quota.
+	QuotaBackoff QuotaBackoffState
 }
 
 // returns a new empty PeerFlowState
@@ -104,6 +116,23 @@ func (s *CDCFlowState) TruncateProgress() {
 	}
 }
 
+// updateCheckpoint tracks the checkpoint of a completed sync flow, and whether it was persisted.
+func (s *CDCFlowState) updateCheckpoint(ctx workflow.Context, res *model.SyncResponse, deferred bool) {
+	// nothing was synced, so there is no new checkpoint either.
+	if res.LastSyncedCheckPointID == 0 {
+		return
+	}
+	s.LastSyncState = &protos.LastSyncState{
+		Checkpoint: res.LastSyncedCheckPointID,
+	}
+	if deferred {
+		s.BatchesSinceCheckpoint++
+	} else {
+		s.BatchesSinceCheckpoint = 0
+		s.LastCheckpointTime = workflow.Now(ctx)
+	}
+}
+
 func (s *CDCFlowState) SendWALHeartbeat(ctx workflow.Context, cfg *protos.FlowConnectionConfigs) error {
 	walHeartbeatCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
 		StartToCloseTimeout: 5 * time.Minute,
@@ -164,6 +193,14 @@ func CDCFlowWorkflowWithConfig(
 		return nil, fmt.Errorf("invalid connection configs")
 	}
 
+	// every activity connects through the config's destination peer, so pointing it at the
+	// mirror's database puts every table, raw table and metadata table the mirror uses there.
+	destination, err := utils.WithDatabaseOverride(cfg.Destination, cfg.DestinationDatabase)
+	if err != nil {
+		return state, fmt.Errorf("invalid destination database: %w", err)
+	}
+	cfg.Destination = destination
+
 	w := NewCDCFlowWorkflowExecution(ctx)
 
 	if limits.TotalSyncFlows == 0 {
@@ -171,13 +208,22 @@ func CDCFlowWorkflowWithConfig(
 	}
 
 	// Support a Query for the current state of the peer flow.
-	err := workflow.SetQueryHandler(ctx, CDCFlowStatusQuery, func(jobName string) (CDCFlowState, error) {
+	err = workflow.SetQueryHandler(ctx, CDCFlowStatusQuery, func(jobName string) (CDCFlowState, error) {
 		return *state, nil
 	})
 	if err != nil {
 		return state, fmt.Errorf("failed to set `%s` query handler: %w", CDCFlowStatusQuery, err)
 	}
 
+	// Support a Query for the backoff of the peer flow from destination quota errors, which unlike the
+	// whole state still encodes once the flow has errors.
+	err = workflow.SetQueryHandler(ctx, CDCFlowQuotaBackoffQuery, func() (QuotaBackoffState, error) {
+		return state.QuotaBackoff, nil
+	})
+	if err != nil {
+		return state, fmt.Errorf("failed to set `%s` query handler: %w", CDCFlowQuotaBackoffQuery, err)
+	}
+
 	signalChan := workflow.GetSignalChannel(ctx, shared.CDCFlowSignalName)
 	signalHandler := func(_ workflow.Context, v shared.CDCFlowSignal) {
 		w.logger.Info("received signal - ", v)
@@ -192,6 +238,30 @@ func CDCFlowWorkflowWithConfig(
 		signalHandler(ctx, signalVal)
 	})
 
+	// Support a signal to disable or re-enable the replication of a table, keeping it in the mirror.
+	tableSyncSignalChan := workflow.GetSignalChannel(ctx, shared.TableSyncSignalName)
+	selector.AddReceive(tableSyncSignalChan, func(c workflow.ReceiveChannel, more bool) {
+		var signalVal shared.TableSyncSignal
+		c.Receive(ctx, &signalVal)
+		for _, mapping := range cfg.TableMappings {
+			if mapping.DestinationTableIdentifier == signalVal.DestinationTableIdentifier {
+				mapping.Disabled = signalVal.Disabled
+			}
+		}
+		w.logger.Info("received table sync signal - ", signalVal)
+		state.Progress = append(state.Progress, fmt.Sprintf("set disabled=%t for table %s",
+			signalVal.Disabled, signalVal.DestinationTableIdentifier))
+	})
+
+	// Support a signal to re-run the merge of the last normalized batch on the next normalize.
+	forceNormalizeSignalChan := workflow.GetSignalChannel(ctx, shared.ForceNormalizeSignalName)
+	selector.AddReceive(forceNormalizeSignalChan, func(c workflow.ReceiveChannel, more bool) {
+		c.Receive(ctx, nil)
+		cfg.ForceNormalize = true
+		w.logger.Info("received force normalize signal")
+		state.Progress = append(state.Progress, "forcing the next normalize")
+	})
+
 	if !state.SetupComplete {
 		// start the SetupFlow workflow as a child workflow, and wait for it to complete
 		// it should return the table schema for the source peer
@@ -235,11 +305,22 @@ func CDCFlowWorkflowWithConfig(
 		state.Progress = append(state.Progress, "executed setup flow and snapshot flow")
 	}
 
+	// the setup could have been undone or never completed on the destination, which is checked once per
+	// run instead of on every sync. the check is retried until the setup is complete.
+	ensureSyncSetupCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+	})
+	if err := workflow.ExecuteActivity(ensureSyncSetupCtx, flowable.EnsureSyncSetup, cfg).Get(ctx, nil); err != nil {
+		return state, fmt.Errorf("failed to ensure sync setup: %w", err)
+	}
+
 	syncFlowOptions := &protos.SyncFlowOptions{
 		BatchSize: int32(limits.MaxBatchSize),
 	}
 
 	currentSyncFlowNum := 0
+	// the last quota error of a sync or normalize, backed off from at the end of the iteration.
+	var quotaErr error
 
 	for {
 		// check if the peer flow has been shutdown
@@ -270,7 +351,15 @@ func CDCFlowWorkflowWithConfig(
 			},
 		}
 		ctx = workflow.WithChildOptions(ctx, childSyncFlowOpts)
+		if state.BatchSize == 0 {
+			state.BatchSize = model.NextBatchSize(0, int32(cfg.InitialBatchSize), int32(limits.MaxBatchSize), true)
+		}
+		syncFlowOptions.BatchSize = state.BatchSize
 		syncFlowOptions.RelationMessageMapping = state.RelationMessageMapping
+		syncFlowOptions.LastSyncState = state.LastSyncState
+		syncFlowOptions.DeferCheckpoint = !model.ShouldPersistCheckpoint(cfg.CheckpointEveryNBatches,
+			time.Duration(cfg.MaxCheckpointIntervalSeconds)*time.Second,
+			state.BatchesSinceCheckpoint, workflow.Now(ctx).Sub(state.LastCheckpointTime))
 		childSyncFlowFuture := workflow.ExecuteChildWorkflow(
 			ctx,
 			SyncFlowWorkflow,
@@ -279,13 +368,20 @@ func CDCFlowWorkflowWithConfig(
 		)
 
 		var childSyncFlowRes *model.SyncResponse
-		if err := childSyncFlowFuture.Get(ctx, &childSyncFlowRes); err != nil {
-			w.logger.Error("failed to execute sync flow: ", err)
-			state.SyncFlowErrors = multierror.Append(state.SyncFlowErrors, err)
+		syncErr := childSyncFlowFuture.Get(ctx, &childSyncFlowRes)
+		state.BatchSize = model.NextBatchSize(state.BatchSize, int32(cfg.InitialBatchSize),
+			int32(limits.MaxBatchSize), syncErr == nil)
+		if syncErr != nil {
+			w.logger.Error("failed to execute sync flow: ", syncErr)
+			state.SyncFlowErrors = multierror.Append(state.SyncFlowErrors, syncErr)
+			if isQuotaExceededError(syncErr) {
+				quotaErr = syncErr
+			}
 		} else {
 			state.SyncFlowStatuses = append(state.SyncFlowStatuses, childSyncFlowRes)
 			if childSyncFlowRes != nil {
 				state.RelationMessageMapping = childSyncFlowRes.RelationMessageMapping
+				state.updateCheckpoint(ctx, childSyncFlowRes, syncFlowOptions.DeferCheckpoint)
 			}
 		}
 
@@ -322,10 +418,7 @@ func CDCFlowWorkflowWithConfig(
 				StartToCloseTimeout: 5 * time.Minute,
 			})
 			getModifiedSchemaFuture := workflow.ExecuteActivity(getModifiedSchemaCtx, flowable.GetTableSchema,
-				&protos.GetTableSchemaBatchInput{
-					PeerConnectionConfig: cfg.Source,
-					TableIdentifiers:     modifiedSrcTables,
-				})
+				utils.GetTableSchemaInput(cfg, modifiedSrcTables))
 
 			var getModifiedSchemaRes *protos.GetTableSchemaBatchOutput
 			if err := getModifiedSchemaFuture.Get(ctx, &getModifiedSchemaRes); err != nil {
@@ -344,17 +437,31 @@ func CDCFlowWorkflowWithConfig(
 			NormalizeFlowWorkflow,
 			cfg,
 		)
+		// a forced normalize only runs once, later ones only normalize new batches again.
+		cfg.ForceNormalize = false
 
 		selector.AddFuture(childNormalizeFlowFuture, func(f workflow.Future) {
 			var childNormalizeFlowRes *model.NormalizeResponse
 			if err := f.Get(ctx, &childNormalizeFlowRes); err != nil {
 				w.logger.Error("failed to execute normalize flow: ", err)
 				state.NormalizeFlowErrors = multierror.Append(state.NormalizeFlowErrors, err)
+				if isQuotaExceededError(err) {
+					quotaErr = err
+				}
 			} else {
 				state.NormalizeFlowStatuses = append(state.NormalizeFlowStatuses, childNormalizeFlowRes)
 			}
 		})
 		selector.Select(ctx)
+
+		if quotaErr != nil {
+			err := quotaErr
+			quotaErr = nil
+			w.logger.Warn("destination out of quota, backing off: ", err)
+			state.backOffFromQuotaError(ctx, cfg, selector, err)
+		} else if syncErr == nil {
+			state.QuotaBackoff = QuotaBackoffState{}
+		}
 	}
 
 	// send WAL heartbeat
@@ -5,6 +5,7 @@ import (
 
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 )
 
@@ -36,5 +37,25 @@ func DropFlowWorkflow(ctx workflow.Context, req *protos.ShutdownRequest) error {
 		return err
 	}
 
+	// also clean up after earlier drops of mirrors to the same peer whose cleanup failed,
+	// the mirror itself is dropped either way.
+	janitorCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		HeartbeatTimeout:    1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	})
+	dropStaleRawTablesFuture := workflow.ExecuteActivity(janitorCtx, flowable.DropStaleRawTables,
+		&protos.DropStaleRawTablesInput{
+			PeerConnectionConfig: req.DestinationPeer,
+		})
+	var dropStaleRawTablesOutput *protos.DropStaleRawTablesOutput
+	if err := dropStaleRawTablesFuture.Get(janitorCtx, &dropStaleRawTablesOutput); err != nil {
+		execution.logger.Warn("failed to drop stale raw tables: ", err)
+	} else if len(dropStaleRawTablesOutput.StaleRawTables) > 0 {
+		execution.logger.Info("dropped stale raw tables: ", dropStaleRawTablesOutput.StaleRawTables)
+	}
+
 	return nil
 }
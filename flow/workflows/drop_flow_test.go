@@ -0,0 +1,47 @@
+package peerflow
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/activities"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestDropFlowDropsStaleRawTablesOfDestination(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&activities.FlowableActivity{})
+
+	destinationPeer := &protos.Peer{Name: "test_sf_peer"}
+	env.OnActivity(flowable.DropFlow, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(flowable.DropStaleRawTables, mock.Anything, mock.MatchedBy(
+		func(input *protos.DropStaleRawTablesInput) bool {
+			return input.PeerConnectionConfig.Name == destinationPeer.Name
+		})).Return(&protos.DropStaleRawTablesOutput{StaleRawTables: []string{"_PEERDB_RAW_GONE"}}, nil).Once()
+
+	env.ExecuteWorkflow(DropFlowWorkflow, &protos.ShutdownRequest{
+		FlowJobName:     "test_drop_flow",
+		DestinationPeer: destinationPeer,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestDropFlowIgnoresStaleRawTableFailures(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&activities.FlowableActivity{})
+
+	env.OnActivity(flowable.DropFlow, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(flowable.DropStaleRawTables, mock.Anything, mock.Anything).Return(
+		nil, errors.New("the catalog is needed to find stale raw tables"))
+
+	env.ExecuteWorkflow(DropFlowWorkflow, &protos.ShutdownRequest{FlowJobName: "test_drop_flow"})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+}
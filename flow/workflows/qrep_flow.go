@@ -58,6 +58,8 @@ func (q *QRepFlowExecution) SetupWatermarkTableOnDestination(ctx workflow.Contex
 		tableSchemaInput := &protos.GetTableSchemaBatchInput{
 			PeerConnectionConfig: q.config.SourcePeer,
 			TableIdentifiers:     []string{q.config.WatermarkTable},
+			JsonAsText:           q.config.JsonAsText,
+			RangesAsText:         q.config.RangesAsText,
 		}
 
 		future := workflow.ExecuteActivity(ctx, flowable.GetTableSchema, tableSchemaInput)
@@ -162,6 +164,23 @@ func (q *QRepFlowExecution) startChildWorkflow(
 	return future, nil
 }
 
+// selectSyncMode replaces sync mode auto with the sync mode selected for the first partition's rows.
+func (q *QRepFlowExecution) selectSyncMode(ctx workflow.Context, partition *protos.QRepPartition) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Hour,
+		HeartbeatTimeout:    5 * time.Minute,
+	})
+
+	var syncMode protos.QRepSyncMode
+	if err := workflow.ExecuteActivity(ctx, flowable.SelectQRepSyncMode, q.config,
+		partition).Get(ctx, &syncMode); err != nil {
+		return fmt.Errorf("failed to select sync mode: %w", err)
+	}
+	q.logger.Info("selected sync mode ", syncMode.String(), " for peer flow - ", q.config.FlowJobName)
+	q.config.SyncMode = syncMode
+	return nil
+}
+
 // processPartitions handles the logic for processing the partitions.
 func (q *QRepFlowExecution) processPartitions(
 	ctx workflow.Context,
@@ -323,13 +342,25 @@ func QRepFlowWorkflow(
 	}
 
 	logger.Info("partitions to replicate - ", len(partitions.Partitions))
+	if config.SyncMode == protos.QRepSyncMode_QREP_SYNC_MODE_AUTO && len(partitions.Partitions) > 0 {
+		// the selected sync mode is kept in the config, which carries over when continuing as new
+		if err = q.selectSyncMode(ctx, partitions.Partitions[0]); err != nil {
+			return err
+		}
+	}
 	if err = q.processPartitions(ctx, maxParallelWorkers, partitions.Partitions); err != nil {
 		return err
 	}
 
-	logger.Info("consolidating partitions for peer flow - ", config.FlowJobName)
-	if err = q.consolidatePartitions(ctx); err != nil {
-		return err
+	// with the sync mode still to be selected, no partition has been synced yet.
+	// Without any partitions nothing was staged either, so there is nothing to consolidate.
+	if len(partitions.Partitions) == 0 {
+		logger.Info("no partitions to replicate for peer flow - ", config.FlowJobName)
+	} else if config.SyncMode != protos.QRepSyncMode_QREP_SYNC_MODE_AUTO {
+		logger.Info("consolidating partitions for peer flow - ", config.FlowJobName)
+		if err = q.consolidatePartitions(ctx); err != nil {
+			return err
+		}
 	}
 
 	if config.InitialCopyOnly {
@@ -0,0 +1,60 @@
+package peerflow
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/activities"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestQRepFlowWithoutPartitionsCompletes(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&activities.FlowableActivity{})
+
+	config := &protos.QRepConfig{
+		FlowJobName:                      "test_qrep_empty_source",
+		WatermarkTable:                   "public.empty",
+		DestinationTableIdentifier:       "public.empty_dst",
+		SyncMode:                         protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO,
+		InitialCopyOnly:                  true,
+		SetupWatermarkTableOnDestination: true,
+	}
+	tableSchema := &protos.TableSchema{
+		TableIdentifier:   config.WatermarkTable,
+		Columns:           map[string]string{"id": "int64"},
+		PrimaryKeyColumns: []string{"id"},
+	}
+
+	env.OnActivity(flowable.SetupQRepMetadataTables, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(flowable.GetTableSchema, mock.Anything, mock.Anything).Return(
+		&protos.GetTableSchemaBatchOutput{
+			TableNameSchemaMapping: map[string]*protos.TableSchema{config.WatermarkTable: tableSchema},
+		}, nil)
+	var createdTables []string
+	env.OnActivity(flowable.CreateNormalizedTable, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, input *protos.SetupNormalizedTableBatchInput) (
+			*protos.SetupNormalizedTableBatchOutput, error) {
+			for table := range input.TableNameSchemaMapping {
+				createdTables = append(createdTables, table)
+			}
+			return &protos.SetupNormalizedTableBatchOutput{}, nil
+		})
+	// the source is empty for the watermark range
+	env.OnActivity(flowable.GetQRepPartitions, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		&protos.QRepParitionResult{}, nil)
+	// nothing was staged, consolidating would fail on the missing stage
+	env.OnActivity(flowable.ConsolidateQRepPartitions, mock.Anything, mock.Anything, mock.Anything).Return(
+		errors.New("stage does not exist"))
+
+	env.ExecuteWorkflow(QRepFlowWorkflow, config, nil, 0)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.Equal(t, []string{config.DestinationTableIdentifier}, createdTables)
+	env.AssertNotCalled(t, "ConsolidateQRepPartitions", mock.Anything, mock.Anything, mock.Anything)
+}
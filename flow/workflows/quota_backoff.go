@@ -0,0 +1,86 @@
+package peerflow
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/shared"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	defaultQuotaBackoff    = 5 * time.Minute
+	defaultMaxQuotaBackoff = 1 * time.Hour
+)
+
+// QuotaBackoffState is the extended backoff of a mirror whose destination ran out of quota.
+type QuotaBackoffState struct {
+	// ConsecutiveErrors counts the syncs and normalizes in a row that failed for lack of quota.
+	ConsecutiveErrors uint32
+	// Until is when the mirror syncs again, zero if it is not backing off.
+	Until time.Time
+	// LastError is the message of the last quota error.
+	LastError string
+}
+
+// isQuotaExceededError returns true if err is an activity or child workflow failing because the
+// destination ran out of quota.
+func isQuotaExceededError(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == shared.QuotaExceededErrorType
+}
+
+// flowError wraps the error of a sync or normalize activity for the workflow to return. Quota errors stay
+// non-retryable, so that the child workflow isn't retried and the mirror backs off instead.
+func flowError(err error) error {
+	if isQuotaExceededError(err) {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("failed to flow: %v", err),
+			shared.QuotaExceededErrorType, err)
+	}
+	return fmt.Errorf("failed to flow: %w", err)
+}
+
+// quotaBackoff is how long to back off after the given number of quota errors in a row, doubling
+// from the configured backoff up to the configured max.
+func quotaBackoff(cfg *protos.FlowConnectionConfigs, consecutiveErrors uint32) time.Duration {
+	backoff := defaultQuotaBackoff
+	if cfg.QuotaBackoffSeconds > 0 {
+		backoff = time.Duration(cfg.QuotaBackoffSeconds) * time.Second
+	}
+	maxBackoff := defaultMaxQuotaBackoff
+	if cfg.MaxQuotaBackoffSeconds > 0 {
+		maxBackoff = time.Duration(cfg.MaxQuotaBackoffSeconds) * time.Second
+	}
+	for i := uint32(1); i < consecutiveErrors && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// backOffFromQuotaError waits out the extended backoff after a sync or normalize failed with quotaErr.
+// Signals are still handled through the selector while waiting, a shutdown ends the wait early.
+func (s *CDCFlowState) backOffFromQuotaError(ctx workflow.Context, cfg *protos.FlowConnectionConfigs,
+	selector workflow.Selector, quotaErr error) {
+	s.QuotaBackoff.ConsecutiveErrors++
+	backoff := quotaBackoff(cfg, s.QuotaBackoff.ConsecutiveErrors)
+	s.QuotaBackoff.Until = workflow.Now(ctx).Add(backoff)
+	s.QuotaBackoff.LastError = quotaErr.Error()
+	s.Progress = append(s.Progress, fmt.Sprintf("destination out of quota, backing off for %v", backoff))
+
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+	timerFired := false
+	selector.AddFuture(workflow.NewTimer(timerCtx, backoff), func(workflow.Future) {
+		timerFired = true
+	})
+	for !timerFired && s.ActiveSignal != shared.ShutdownSignal {
+		selector.Select(ctx)
+	}
+	s.QuotaBackoff.Until = time.Time{}
+}
@@ -0,0 +1,85 @@
+package peerflow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/activities"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestQuotaBackoffDoublesUpToMax(t *testing.T) {
+	cfg := &protos.FlowConnectionConfigs{QuotaBackoffSeconds: 60, MaxQuotaBackoffSeconds: 300}
+	require.Equal(t, time.Minute, quotaBackoff(cfg, 1))
+	require.Equal(t, 2*time.Minute, quotaBackoff(cfg, 2))
+	require.Equal(t, 4*time.Minute, quotaBackoff(cfg, 3))
+	require.Equal(t, 5*time.Minute, quotaBackoff(cfg, 4))
+	require.Equal(t, 5*time.Minute, quotaBackoff(cfg, 40))
+
+	require.Equal(t, defaultQuotaBackoff, quotaBackoff(&protos.FlowConnectionConfigs{}, 1))
+}
+
+func TestCDCFlowBacksOffFromQuotaErrors(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&activities.FlowableActivity{})
+	env.RegisterWorkflow(SyncFlowWorkflow)
+	env.RegisterWorkflow(NormalizeFlowWorkflow)
+
+	// the first sync runs out of quota at the destination, the next one succeeds.
+	var syncTimes []time.Time
+	env.OnWorkflow(SyncFlowWorkflow, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(ctx workflow.Context, _ *protos.FlowConnectionConfigs,
+			_ *protos.SyncFlowOptions) (*model.SyncResponse, error) {
+			syncTimes = append(syncTimes, workflow.Now(ctx))
+			if len(syncTimes) == 1 {
+				return nil, temporal.NewNonRetryableApplicationError("failed to flow: exceeded its quota",
+					shared.QuotaExceededErrorType, errors.New("exceeded its quota"))
+			}
+			return &model.SyncResponse{}, nil
+		})
+	env.OnWorkflow(NormalizeFlowWorkflow, mock.Anything, mock.Anything).Return(&model.NormalizeResponse{}, nil)
+	env.OnActivity(flowable.SendWALHeartbeat, mock.Anything, mock.Anything).Return(nil)
+	// the setup is checked once for the run, not for every sync.
+	ensureSyncSetupCalls := 0
+	env.OnActivity(flowable.EnsureSyncSetup, mock.Anything, mock.Anything).Return(
+		func(context.Context, *protos.FlowConnectionConfigs) error {
+			ensureSyncSetupCalls++
+			return nil
+		})
+
+	// the status of the mirror shows the backoff while it lasts.
+	var backoffState QuotaBackoffState
+	env.RegisterDelayedCallback(func() {
+		res, err := env.QueryWorkflow(CDCFlowQuotaBackoffQuery)
+		require.NoError(t, err)
+		require.NoError(t, res.Get(&backoffState))
+	}, 5*time.Minute)
+
+	cfg := &protos.FlowConnectionConfigs{
+		FlowJobName:            "test_quota_backoff",
+		Destination:            &protos.Peer{Name: "dst", Type: protos.DBType_SNOWFLAKE},
+		QuotaBackoffSeconds:    600,
+		MaxQuotaBackoffSeconds: 3600,
+	}
+	state := NewCDCFlowState()
+	state.SetupComplete = true
+	env.ExecuteWorkflow(CDCFlowWorkflowWithConfig, cfg, &CDCFlowLimits{TotalSyncFlows: 2}, state)
+	require.True(t, env.IsWorkflowCompleted())
+
+	require.Len(t, syncTimes, 2)
+	require.Equal(t, 1, ensureSyncSetupCalls)
+	require.GreaterOrEqual(t, syncTimes[1].Sub(syncTimes[0]), 10*time.Minute)
+	require.Equal(t, uint32(1), backoffState.ConsecutiveErrors)
+	require.Contains(t, backoffState.LastError, "exceeded its quota")
+	require.Equal(t, syncTimes[0].Add(10*time.Minute), backoffState.Until)
+}
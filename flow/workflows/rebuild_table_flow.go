@@ -0,0 +1,61 @@
+package peerflow
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// RebuildNormalizedTableWorkflow truncates a normalized table of a CDC mirror and re-merges it from the
+// raw table. Normalizes of the mirror wait for the rebuild, the mirror keeps syncing.
+func RebuildNormalizedTableWorkflow(ctx workflow.Context,
+	input *protos.RebuildNormalizedTableInput,
+) (*protos.RebuildNormalizedTableOutput, error) {
+	cfg := input.FlowConnectionConfigs
+	logger := workflow.GetLogger(ctx)
+	logger.Info("rebuilding normalized table ", input.DestinationTableIdentifier, " of flow ", cfg.FlowJobName)
+
+	var sourceTable string
+	for _, mapping := range cfg.TableMappings {
+		if mapping.DestinationTableIdentifier == input.DestinationTableIdentifier {
+			sourceTable = mapping.SourceTableIdentifier
+		}
+	}
+	if sourceTable == "" {
+		return nil, fmt.Errorf("table %s is not a table of mirror %s", input.DestinationTableIdentifier,
+			cfg.FlowJobName)
+	}
+
+	// the table is merged with its current schema, like the mirror's normalizes after a schema change
+	schemaCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+	})
+	var schemaOutput *protos.GetTableSchemaBatchOutput
+	err := workflow.ExecuteActivity(schemaCtx, flowable.GetTableSchema,
+		utils.GetTableSchemaInput(cfg, []string{sourceTable})).Get(ctx, &schemaOutput)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schema for source table %s: %w", sourceTable, err)
+	}
+	cfg.TableNameSchemaMapping = map[string]*protos.TableSchema{
+		input.DestinationTableIdentifier: schemaOutput.TableNameSchemaMapping[sourceTable],
+	}
+
+	// a failed rebuild leaves the table partly rebuilt, it is started again once the cause is fixed
+	rebuildCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 7 * 24 * time.Hour,
+		HeartbeatTimeout:    5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	})
+	var output *protos.RebuildNormalizedTableOutput
+	err = workflow.ExecuteActivity(rebuildCtx, flowable.RebuildNormalizedTable, input).Get(ctx, &output)
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
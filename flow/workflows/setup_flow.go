@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/activities"
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
 	"golang.org/x/exp/maps"
 
 	"go.temporal.io/sdk/log"
@@ -108,9 +110,10 @@ func (s *SetupFlowExecution) ensurePullability(
 
 	// create EnsurePullabilityInput for the srcTableName
 	ensurePullabilityInput := &protos.EnsurePullabilityBatchInput{
-		PeerConnectionConfig:   config.Source,
-		FlowJobName:            s.CDCFlowName,
-		SourceTableIdentifiers: srcTblIdentifiers,
+		PeerConnectionConfig:         config.Source,
+		FlowJobName:                  s.CDCFlowName,
+		SourceTableIdentifiers:       srcTblIdentifiers,
+		ReplicaIdentityNothingPolicy: config.ReplicaIdentityNothingPolicy,
 	}
 
 	future := workflow.ExecuteActivity(ctx, flowable.EnsurePullability, ensurePullabilityInput)
@@ -151,6 +154,9 @@ func (s *SetupFlowExecution) createRawTable(
 		FlowJobName:          s.CDCFlowName,
 		TableNameMapping:     s.tableNameMapping,
 		CdcSyncMode:          config.CdcSyncMode,
+		ClusterRawTable:      config.ClusterRawTable,
+		RawDataAsVariant:     config.RawDataAsVariant,
+		Tags:                 config.DestinationTags,
 	}
 
 	rawTblFuture := workflow.ExecuteActivity(ctx, flowable.CreateRawTable, createRawTblInput)
@@ -175,10 +181,7 @@ func (s *SetupFlowExecution) fetchTableSchemaAndSetupNormalizedTables(
 	sourceTables := maps.Keys(s.tableNameMapping)
 	sort.Strings(sourceTables)
 
-	tableSchemaInput := &protos.GetTableSchemaBatchInput{
-		PeerConnectionConfig: flowConnectionConfigs.Source,
-		TableIdentifiers:     sourceTables,
-	}
+	tableSchemaInput := utils.GetTableSchemaInput(flowConnectionConfigs, sourceTables)
 
 	future := workflow.ExecuteActivity(ctx, flowable.GetTableSchema, tableSchemaInput)
 
@@ -193,19 +196,28 @@ func (s *SetupFlowExecution) fetchTableSchemaAndSetupNormalizedTables(
 	sort.Strings(sortedSourceTables)
 
 	s.logger.Info("setting up normalized tables for peer flow - ", s.CDCFlowName)
+	rawOnlyTables := utils.RawOnlyTables(flowConnectionConfigs.TableMappings)
+	// raw-only tables still need their schema to be synced, but are not created.
 	normalizedTableMapping := make(map[string]*protos.TableSchema)
 	for _, srcTableName := range sortedSourceTables {
 		tableSchema := tableNameSchemaMapping[srcTableName]
 		normalizedTableName := s.tableNameMapping[srcTableName]
 		normalizedTableMapping[normalizedTableName] = tableSchema
+		if !rawOnlyTables[normalizedTableName] {
+			warnings, err := utils.CheckPrimaryKeyTypes(normalizedTableName, tableSchema,
+				flowConnectionConfigs.RiskyPrimaryKeyPolicy)
+			if err != nil {
+				return nil, fmt.Errorf("risky primary key types: %w", err)
+			}
+			for _, warning := range warnings {
+				s.logger.Warn(warning)
+			}
+		}
 		s.logger.Info("normalized table schema: ", normalizedTableName, " -> ", tableSchema)
 	}
 
 	// now setup the normalized tables on the destination peer
-	setupConfig := &protos.SetupNormalizedTableBatchInput{
-		PeerConnectionConfig:   flowConnectionConfigs.Destination,
-		TableNameSchemaMapping: normalizedTableMapping,
-	}
+	setupConfig := utils.SetupNormalizedTablesInput(flowConnectionConfigs, normalizedTableMapping)
 
 	future = workflow.ExecuteActivity(ctx, flowable.CreateNormalizedTable, setupConfig)
 	var createNormalizedTablesOutput *protos.SetupNormalizedTableBatchOutput
@@ -252,6 +264,58 @@ func (s *SetupFlowExecution) executeSetupFlow(
 // SetupFlowWorkflow is the workflow that sets up the flow.
 func SetupFlowWorkflow(ctx workflow.Context,
 	config *protos.FlowConnectionConfigs) (*protos.FlowConnectionConfigs, error) {
+	// truncated names are returned with the config, so the rest of the mirror uses them as well
+	err := utils.FitDestinationIdentifiers(config.TableMappings, config.Destination.Type,
+		config.IdentifierLengthPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid table mappings: %w", err)
+	}
+	err = utils.ValidateTableMappings(config.TableMappings)
+	if err != nil {
+		return nil, fmt.Errorf("invalid table mappings: %w", err)
+	}
+	// event hubs destinations sync records as they are, without normalizing them
+	err = model.CheckRecordTransformers(config.RecordTransformers, config.Destination.Type != protos.DBType_EVENTHUB)
+	if err != nil {
+		return nil, fmt.Errorf("invalid record transformers: %w", err)
+	}
+	if config.DynamicNormalizedTables {
+		if config.Destination.Type != protos.DBType_SNOWFLAKE {
+			return nil, fmt.Errorf("dynamic normalized tables are only supported for snowflake destinations")
+		}
+		if config.DoInitialCopy {
+			return nil, fmt.Errorf("dynamic normalized tables cannot be used with initial copy")
+		}
+	}
+	if config.CdcSyncMode == protos.QRepSyncMode_QREP_SYNC_MODE_AUTO {
+		return nil, fmt.Errorf("sync mode auto is only supported for snapshots and qrep mirrors")
+	}
+	if config.SourceLsnColumn && config.Destination.Type != protos.DBType_SNOWFLAKE {
+		return nil, fmt.Errorf("the source LSN column is only supported for snowflake destinations")
+	}
+	if config.DedupBySourceLsn && !config.SourceLsnColumn {
+		return nil, fmt.Errorf("deduplicating by source LSN requires the source LSN column")
+	}
+	if config.LastOpColumn {
+		if config.Destination.Type != protos.DBType_SNOWFLAKE {
+			return nil, fmt.Errorf("the last operation column is only supported for snowflake destinations")
+		}
+		if config.DynamicNormalizedTables {
+			return nil, fmt.Errorf("the last operation column cannot be used with dynamic normalized tables")
+		}
+	}
+	if config.SyncBatchIdConflictPolicy == protos.SyncBatchIDConflictPolicy_SYNC_BATCH_ID_CONFLICT_POLICY_FAIL &&
+		(config.CdcSyncMode == protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO || config.IdempotentStagedRawInserts ||
+			config.MaxParallelRawInserts > 1 || config.MaxInlineRecordSizeBytes > 0) {
+		return nil, fmt.Errorf("failing syncs on batch id conflicts cannot be used with avro syncs, idempotent " +
+			"staged raw inserts, parallel raw inserts or a max inline record size, which load raw rows outside " +
+			"of the sync transaction")
+	}
+	if (config.PreNormalizeSql != "" || config.PostNormalizeSql != "") &&
+		config.Destination.Type != protos.DBType_SNOWFLAKE {
+		return nil, fmt.Errorf("pre and post normalize sql are only supported for snowflake destinations")
+	}
+
 	tblNameMapping := make(map[string]string)
 	for _, v := range config.TableMappings {
 		tblNameMapping[v.SourceTableIdentifier] = v.DestinationTableIdentifier
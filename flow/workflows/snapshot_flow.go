@@ -46,6 +46,7 @@ func (s *SnapshotFlowExecution) setupReplication(
 		DoInitialCopy:               s.config.DoInitialCopy,
 		ExistingPublicationName:     s.config.PublicationName,
 		ExistingReplicationSlotName: s.config.ReplicationSlotName,
+		StartLsn:                    s.config.StartLsn,
 	}
 
 	res := &protos.SetupReplicationOutput{}
@@ -142,6 +143,13 @@ func (s *SnapshotFlowExecution) cloneTable(
 		numRowsPerPartition = s.config.SnapshotNumRowsPerPartition
 	}
 
+	var nfcColumns []string
+	if s.config.NfcPrimaryKeys {
+		if tableSchema, ok := s.config.TableNameSchemaMapping[dstName]; ok {
+			nfcColumns = tableSchema.PrimaryKeyColumns
+		}
+	}
+
 	config := &protos.QRepConfig{
 		FlowJobName:                childWorkflowID,
 		SourcePeer:                 sourcePostgres,
@@ -154,7 +162,13 @@ func (s *SnapshotFlowExecution) cloneTable(
 		NumRowsPerPartition:        numRowsPerPartition,
 		SyncMode:                   s.config.SnapshotSyncMode,
 		MaxParallelWorkers:         numWorkers,
+		MaxSourceConnections:       s.config.SnapshotMaxSourceConnections,
+		MaxDestinationConnections:  s.config.SnapshotMaxDestinationConnections,
 		StagingPath:                s.config.SnapshotStagingPath,
+		JsonAsText:                 s.config.JsonAsText,
+		RangesAsText:               s.config.RangesAsText,
+		NfcColumns:                 nfcColumns,
+		SourceIsolationLevel:       s.config.SnapshotIsolationLevel,
 		WriteMode: &protos.QRepWriteMode{
 			WriteType: protos.QRepWriteType_QREP_WRITE_MODE_APPEND,
 		},
@@ -166,22 +180,82 @@ func (s *SnapshotFlowExecution) cloneTable(
 	return nil
 }
 
+// isSnapshotCompleted checks if the initial copy of the mirror completed in an earlier snapshot.
+func (s *SnapshotFlowExecution) isSnapshotCompleted(ctx workflow.Context) (bool, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		TaskQueue:           shared.PeerFlowTaskQueue,
+	})
+
+	var completed bool
+	completedFuture := workflow.ExecuteActivity(ctx, flowable.IsSnapshotCompleted, s.config.FlowJobName)
+	if err := completedFuture.Get(ctx, &completed); err != nil {
+		return false, fmt.Errorf("failed to check if snapshot completed: %w", err)
+	}
+	return completed, nil
+}
+
+// markSnapshotCompleted records the initial copy of the mirror as completed.
+func (s *SnapshotFlowExecution) markSnapshotCompleted(ctx workflow.Context) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		TaskQueue:           shared.PeerFlowTaskQueue,
+	})
+
+	markFuture := workflow.ExecuteActivity(ctx, flowable.MarkSnapshotCompleted, s.config.FlowJobName)
+	if err := markFuture.Get(ctx, nil); err != nil {
+		return fmt.Errorf("failed to mark snapshot as completed: %w", err)
+	}
+	return nil
+}
+
+// sourceSnapshot returns the snapshot the initial copy reads the source tables in. Postgres can't
+// import the snapshot exported with the slot into a serializable transaction, so a serializable copy
+// reads a later snapshot of its own, and the changes made in between are replicated on top of the
+// copied rows. Only tables with a primary key merge those changes without duplicating rows.
+func (s *SnapshotFlowExecution) sourceSnapshot(slotSnapshotName string) (string, error) {
+	if s.config.SnapshotIsolationLevel != protos.SourceIsolationLevel_SOURCE_ISOLATION_LEVEL_SERIALIZABLE {
+		return slotSnapshotName, nil
+	}
+	for _, v := range s.config.TableMappings {
+		if v.RawOnly {
+			continue
+		}
+		tableSchema, ok := s.config.TableNameSchemaMapping[v.DestinationTableIdentifier]
+		if !ok || len(tableSchema.PrimaryKeyColumns) == 0 {
+			return "", fmt.Errorf("table %s has no primary key, which the initial copy needs to read "+
+				"the source with isolation level serializable", v.SourceTableIdentifier)
+		}
+	}
+	return "", nil
+}
+
 // startChildQrepWorkflow starts a child workflow for query based replication.
 func (s *SnapshotFlowExecution) cloneTables(
 	ctx workflow.Context,
 	slotInfo *protos.SetupReplicationOutput,
 	maxParallelClones int,
-) {
+) error {
 	logrus.Infof("cloning tables for slot name %s and snapshotName %s",
 		slotInfo.SlotName, slotInfo.SnapshotName)
 
+	snapshotName, err := s.sourceSnapshot(slotInfo.SnapshotName)
+	if err != nil {
+		return err
+	}
+
 	numTables := len(s.config.TableMappings)
 	boundSelector := concurrency.NewBoundSelector(maxParallelClones, numTables, ctx)
 
+	var cloneErr error
+
 	for _, v := range s.config.TableMappings {
 		source := v.SourceTableIdentifier
 		destination := v.DestinationTableIdentifier
-		snapshotName := slotInfo.SnapshotName
+		if v.RawOnly {
+			s.logger.Info("skipping initial copy of raw-only table ", source)
+			continue
+		}
 		logrus.WithFields(logrus.Fields{
 			"snapshotName": snapshotName,
 		}).Infof(
@@ -191,16 +265,21 @@ func (s *SnapshotFlowExecution) cloneTables(
 		err := s.cloneTable(boundSelector, ctx, snapshotName, v)
 		if err != nil {
 			s.logger.Error("failed to start clone child workflow: ", err)
+			cloneErr = err
 			continue
 		}
 	}
 
 	if err := boundSelector.Wait(); err != nil {
 		s.logger.Error("failed to clone some tables", "error", err)
-		return
+		return err
+	}
+	if cloneErr != nil {
+		return cloneErr
 	}
 
 	s.logger.Info("finished cloning tables")
+	return nil
 }
 
 func SnapshotFlowWorkflow(ctx workflow.Context, config *protos.FlowConnectionConfigs) error {
@@ -211,6 +290,17 @@ func SnapshotFlowWorkflow(ctx workflow.Context, config *protos.FlowConnectionCon
 		logger: logger,
 	}
 
+	if config.DoInitialCopy && !config.ForceSnapshot {
+		completed, err := se.isSnapshotCompleted(ctx)
+		if err != nil {
+			return err
+		}
+		if completed {
+			logger.Info("initial copy already completed for flow, skipping snapshot: ", config.FlowJobName)
+			return nil
+		}
+	}
+
 	var replCtx = ctx
 
 	if config.DoInitialCopy {
@@ -246,7 +336,12 @@ func SnapshotFlowWorkflow(ctx workflow.Context, config *protos.FlowConnectionCon
 		}
 
 		logger.Info("cloning tables in parallel: ", numTablesInParallel)
-		se.cloneTables(ctx, slotInfo, numTablesInParallel)
+		if err := se.cloneTables(ctx, slotInfo, numTablesInParallel); err != nil {
+			// the initial copy is run again by the next snapshot for this mirror
+			logger.Error("failed to clone tables, not marking snapshot as completed", "error", err)
+		} else if err := se.markSnapshotCompleted(ctx); err != nil {
+			return err
+		}
 	} else {
 		logger.Info("skipping initial copy as 'doInitialCopy' is false")
 	}
@@ -0,0 +1,93 @@
+package peerflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/activities"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+)
+
+// snapshotCompletions stands in for the catalog the snapshot completions are recorded in.
+type snapshotCompletions struct {
+	completed  map[string]bool
+	setupCalls int
+}
+
+func (s *snapshotCompletions) runSnapshot(t *testing.T, config *protos.FlowConnectionConfigs) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.SetWorkerOptions(worker.Options{EnableSessionWorker: true})
+	env.RegisterActivity(&activities.FlowableActivity{})
+	env.RegisterActivity(&activities.SnapshotActivity{})
+
+	env.OnActivity(flowable.IsSnapshotCompleted, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, flowJobName string) (bool, error) {
+			return s.completed[flowJobName], nil
+		})
+	env.OnActivity(flowable.MarkSnapshotCompleted, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, flowJobName string) error {
+			s.completed[flowJobName] = true
+			return nil
+		})
+	env.OnActivity(snapshot.SetupReplication, mock.Anything, mock.Anything).Return(
+		func(context.Context, *protos.SetupReplicationInput) (*protos.SetupReplicationOutput, error) {
+			s.setupCalls++
+			return &protos.SetupReplicationOutput{SlotName: "slot", SnapshotName: "snapshot"}, nil
+		})
+	env.OnActivity(snapshot.CloseSlotKeepAlive, mock.Anything, mock.Anything).Return(nil)
+
+	env.ExecuteWorkflow(SnapshotFlowWorkflow, config)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+}
+
+func TestSnapshotFlowSkipsCompletedSnapshot(t *testing.T) {
+	completions := &snapshotCompletions{completed: make(map[string]bool)}
+	config := &protos.FlowConnectionConfigs{
+		FlowJobName:   "test_snapshot_idempotency",
+		DoInitialCopy: true,
+	}
+
+	completions.runSnapshot(t, config)
+	require.Equal(t, 1, completions.setupCalls)
+	require.True(t, completions.completed[config.FlowJobName])
+
+	// the mirror was snapshotted already, so this is a no-op
+	completions.runSnapshot(t, config)
+	require.Equal(t, 1, completions.setupCalls)
+
+	config.ForceSnapshot = true
+	completions.runSnapshot(t, config)
+	require.Equal(t, 2, completions.setupCalls)
+}
+
+func TestSnapshotSourceSnapshot(t *testing.T) {
+	se := &SnapshotFlowExecution{config: &protos.FlowConnectionConfigs{
+		TableMappings: []*protos.TableMapping{
+			{SourceTableIdentifier: "public.t", DestinationTableIdentifier: "public.t_dst"},
+			{SourceTableIdentifier: "public.raw", DestinationTableIdentifier: "public.raw_dst", RawOnly: true},
+		},
+		TableNameSchemaMapping: map[string]*protos.TableSchema{
+			"public.t_dst": {TableIdentifier: "public.t_dst", PrimaryKeyColumns: []string{"id"}},
+		},
+	}}
+
+	snapshotName, err := se.sourceSnapshot("slot_snapshot")
+	require.NoError(t, err)
+	require.Equal(t, "slot_snapshot", snapshotName)
+
+	// a serializable copy can't import the slot's snapshot
+	se.config.SnapshotIsolationLevel = protos.SourceIsolationLevel_SOURCE_ISOLATION_LEVEL_SERIALIZABLE
+	snapshotName, err = se.sourceSnapshot("slot_snapshot")
+	require.NoError(t, err)
+	require.Empty(t, snapshotName)
+
+	se.config.TableNameSchemaMapping["public.t_dst"].PrimaryKeyColumns = nil
+	_, err = se.sourceSnapshot("slot_snapshot")
+	require.ErrorContains(t, err, "public.t has no primary key")
+}
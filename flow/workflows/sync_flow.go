@@ -99,7 +99,7 @@ func (s *SyncFlowExecution) executeSyncFlow(
 
 	var syncRes *model.SyncResponse
 	if err := fStartFlow.Get(startFlowCtx, &syncRes); err != nil {
-		return nil, fmt.Errorf("failed to flow: %w", err)
+		return nil, flowError(err)
 	}
 
 	replayTableSchemaDeltaCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
@@ -164,7 +164,7 @@ func (s *NormalizeFlowExecution) executeNormalizeFlow(
 
 	var normalizeResponse *model.NormalizeResponse
 	if err := fStartNormalize.Get(normalizeFlowCtx, &normalizeResponse); err != nil {
-		return nil, fmt.Errorf("failed to flow: %w", err)
+		return nil, flowError(err)
 	}
 
 	return normalizeResponse, nil
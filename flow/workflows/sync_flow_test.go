@@ -0,0 +1,48 @@
+package peerflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/activities"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestSyncFlowReplaysSchemaChangesWithoutRecords(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&activities.FlowableActivity{})
+
+	// a pure DDL change, the pull has a schema delta but no rows
+	tableSchemaDeltas := []*protos.TableSchemaDelta{{
+		SrcTableName: "public.src",
+		DstTableName: "public.dst",
+		AddedColumns: []*protos.DeltaAddedColumn{{ColumnName: "c2", ColumnType: "int64"}},
+	}}
+	env.OnActivity(flowable.GetLastSyncedID, mock.Anything, mock.Anything).Return(nil, nil)
+	env.OnActivity(flowable.StartFlow, mock.Anything, mock.Anything).Return(
+		&model.SyncResponse{TableSchemaDeltas: tableSchemaDeltas}, nil)
+
+	var replayed []*protos.TableSchemaDelta
+	env.OnActivity(flowable.ReplayTableSchemaDeltas, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, input *protos.ReplayTableSchemaDeltaInput) error {
+			replayed = append(replayed, input.TableSchemaDeltas...)
+			return nil
+		})
+
+	config := &protos.FlowConnectionConfigs{FlowJobName: "test_ddl_only_sync"}
+	env.ExecuteWorkflow(SyncFlowWorkflow, config, &protos.SyncFlowOptions{BatchSize: 100})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var syncRes *model.SyncResponse
+	require.NoError(t, env.GetWorkflowResult(&syncRes))
+	require.Zero(t, syncRes.NumRecordsSynced)
+	require.Len(t, replayed, 1)
+	require.Equal(t, "public.dst", replayed[0].DstTableName)
+	require.Equal(t, "c2", replayed[0].AddedColumns[0].ColumnName)
+}
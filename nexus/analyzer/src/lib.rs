@@ -514,6 +514,25 @@ fn parse_db_options(
                     .context("unable to parse query_timeout")?,
                 password: opts.get("password").map(|s| s.to_string()),
                 s3_integration: s3_int,
+                dsn_extras: opts
+                    .iter()
+                    .filter_map(|(k, v)| {
+                        k.strip_prefix("dsn_")
+                            .map(|param| (param.to_string(), v.to_string()))
+                    })
+                    .collect(),
+                max_concurrent_statements: opts
+                    .get("max_concurrent_statements")
+                    .map(|s| s.parse::<u32>())
+                    .transpose()
+                    .context("unable to parse max_concurrent_statements")?
+                    .unwrap_or_default(),
+                merges_per_warehouse_node: opts
+                    .get("merges_per_warehouse_node")
+                    .map(|s| s.parse::<u32>())
+                    .transpose()
+                    .context("unable to parse merges_per_warehouse_node")?
+                    .unwrap_or_default(),
             };
             let config = Config::SnowflakeConfig(snowflake_config);
             Some(config)
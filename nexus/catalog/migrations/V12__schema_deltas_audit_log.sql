@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS peerdb_stats.schema_deltas_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    flow_job_name TEXT NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    delta_info JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_schema_deltas_audit_log_flow_job_name
+ON peerdb_stats.schema_deltas_audit_log(flow_job_name);
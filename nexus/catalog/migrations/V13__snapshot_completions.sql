@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS peerdb_stats.snapshot_completions (
+    flow_job_name TEXT PRIMARY KEY,
+    completed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
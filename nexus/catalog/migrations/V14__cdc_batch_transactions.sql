@@ -0,0 +1,5 @@
+ALTER TABLE peerdb_stats.cdc_batches
+ADD COLUMN IF NOT EXISTS transactions_in_batch BIGINT;
+
+ALTER TABLE peerdb_stats.cdc_batches
+ADD COLUMN IF NOT EXISTS last_commit_time TIMESTAMPTZ;
@@ -20,6 +20,16 @@ pub struct SnowflakeConfig {
     pub s3_integration: ::prost::alloc::string::String,
     #[prost(string, optional, tag="10")]
     pub password: ::core::option::Option<::prost::alloc::string::String>,
+    /// extra parameters for the connection, merged into the DSN built from the fields above
+    #[prost(map="string, string", tag="11")]
+    pub dsn_extras: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
+    /// statements run concurrently over the peer's connections beyond this are queued, 0 for no limit
+    #[prost(uint32, tag="12")]
+    pub max_concurrent_statements: u32,
+    /// merges normalized concurrently per node of the warehouse, scaled to its size and cluster count as
+    /// reported by SHOW WAREHOUSES. 0 keeps the worker's fixed cap on concurrent merges
+    #[prost(uint32, tag="13")]
+    pub merges_per_warehouse_node: u32,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -1524,6 +1524,15 @@ impl serde::Serialize for SnowflakeConfig {
         if self.password.is_some() {
             len += 1;
         }
+        if !self.dsn_extras.is_empty() {
+            len += 1;
+        }
+        if self.max_concurrent_statements != 0 {
+            len += 1;
+        }
+        if self.merges_per_warehouse_node != 0 {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("peerdb_peers.SnowflakeConfig", len)?;
         if !self.account_id.is_empty() {
             struct_ser.serialize_field("accountId", &self.account_id)?;
@@ -1552,6 +1561,15 @@ impl serde::Serialize for SnowflakeConfig {
         if let Some(v) = self.password.as_ref() {
             struct_ser.serialize_field("password", v)?;
         }
+        if !self.dsn_extras.is_empty() {
+            struct_ser.serialize_field("dsnExtras", &self.dsn_extras)?;
+        }
+        if self.max_concurrent_statements != 0 {
+            struct_ser.serialize_field("maxConcurrentStatements", &self.max_concurrent_statements)?;
+        }
+        if self.merges_per_warehouse_node != 0 {
+            struct_ser.serialize_field("mergesPerWarehouseNode", &self.merges_per_warehouse_node)?;
+        }
         struct_ser.end()
     }
 }
@@ -1575,6 +1593,12 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
             "s3_integration",
             "s3Integration",
             "password",
+            "dsn_extras",
+            "dsnExtras",
+            "max_concurrent_statements",
+            "maxConcurrentStatements",
+            "merges_per_warehouse_node",
+            "mergesPerWarehouseNode",
         ];
 
         #[allow(clippy::enum_variant_names)]
@@ -1588,6 +1612,9 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
             QueryTimeout,
             S3Integration,
             Password,
+            DsnExtras,
+            MaxConcurrentStatements,
+            MergesPerWarehouseNode,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -1619,6 +1646,9 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
                             "queryTimeout" | "query_timeout" => Ok(GeneratedField::QueryTimeout),
                             "s3Integration" | "s3_integration" => Ok(GeneratedField::S3Integration),
                             "password" => Ok(GeneratedField::Password),
+                            "dsnExtras" | "dsn_extras" => Ok(GeneratedField::DsnExtras),
+                            "maxConcurrentStatements" | "max_concurrent_statements" => Ok(GeneratedField::MaxConcurrentStatements),
+                            "mergesPerWarehouseNode" | "merges_per_warehouse_node" => Ok(GeneratedField::MergesPerWarehouseNode),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -1647,6 +1677,9 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
                 let mut query_timeout__ = None;
                 let mut s3_integration__ = None;
                 let mut password__ = None;
+                let mut dsn_extras__ = None;
+                let mut max_concurrent_statements__ = None;
+                let mut merges_per_warehouse_node__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
                         GeneratedField::AccountId => {
@@ -1705,6 +1738,30 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
                             }
                             password__ = map.next_value()?;
                         }
+                        GeneratedField::DsnExtras => {
+                            if dsn_extras__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("dsnExtras"));
+                            }
+                            dsn_extras__ = Some(
+                                map.next_value::<std::collections::HashMap<_, _>>()?
+                            );
+                        }
+                        GeneratedField::MaxConcurrentStatements => {
+                            if max_concurrent_statements__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("maxConcurrentStatements"));
+                            }
+                            max_concurrent_statements__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::MergesPerWarehouseNode => {
+                            if merges_per_warehouse_node__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("mergesPerWarehouseNode"));
+                            }
+                            merges_per_warehouse_node__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
@@ -1720,6 +1777,9 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
                     query_timeout: query_timeout__.unwrap_or_default(),
                     s3_integration: s3_integration__.unwrap_or_default(),
                     password: password__,
+                    dsn_extras: dsn_extras__.unwrap_or_default(),
+                    max_concurrent_statements: max_concurrent_statements__.unwrap_or_default(),
+                    merges_per_warehouse_node: merges_per_warehouse_node__.unwrap_or_default(),
                 })
             }
         }